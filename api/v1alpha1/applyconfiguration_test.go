@@ -0,0 +1,72 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+
+	acv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/applyconfiguration/api/v1alpha1"
+	apiv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("DPFHCPBridge apply configuration", func() {
+	It("should serialize to the DPFHCPBridge schema", func() {
+		bridge := acv1alpha1.DPFHCPBridge("prod", "dpf-hcp").
+			WithLabels(map[string]string{"shard": "a"}).
+			WithSpec(acv1alpha1.DPFHCPBridgeSpec().
+				WithDPUClusterRef(acv1alpha1.DPUClusterReference().WithName("dpu").WithNamespace("dpf-operator-system")).
+				WithBaseDomain("clusters.example.com").
+				WithOCPReleaseImage("quay.io/openshift-release-dev/ocp-release:4.19.0-multi").
+				WithSSHKeySecretRef(corev1.LocalObjectReference{Name: "ssh"}).
+				WithPullSecretRef(corev1.LocalObjectReference{Name: "pull"}).
+				WithControlPlaneAvailabilityPolicy(hyperv1.SingleReplica).
+				WithNodePoolReplicas(2).
+				WithNodePools(acv1alpha1.NodePoolSpec().WithName("bf3").WithReplicas(4)))
+
+		data, err := json.Marshal(bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		decoded := &apiv1alpha1.DPFHCPBridge{}
+		Expect(json.Unmarshal(data, decoded)).To(Succeed())
+		Expect(decoded.APIVersion).To(Equal(apiv1alpha1.GroupVersion.String()))
+		Expect(decoded.Kind).To(Equal("DPFHCPBridge"))
+		Expect(decoded.Namespace).To(Equal("dpf-hcp"))
+		Expect(decoded.Labels).To(HaveKeyWithValue("shard", "a"))
+		Expect(decoded.Spec.DPUClusterRef).To(Equal(apiv1alpha1.DPUClusterReference{Name: "dpu", Namespace: "dpf-operator-system"}))
+		Expect(decoded.Spec.PullSecretRef.Name).To(Equal("pull"))
+		Expect(*decoded.Spec.NodePoolReplicas).To(Equal(int32(2)))
+		Expect(decoded.Spec.NodePools).To(HaveLen(1))
+		Expect(*decoded.Spec.NodePools[0].Replicas).To(Equal(int32(4)))
+	})
+
+	It("should leave unset fields out so that server-side apply does not claim them", func() {
+		bridge := acv1alpha1.DPFHCPBridge("prod", "dpf-hcp").WithSpec(acv1alpha1.DPFHCPBridgeSpec().WithNodePoolReplicas(3))
+		data, err := json.Marshal(bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(MatchJSON(`{
+			"apiVersion": "provisioning.dpu.hcp.io/v1alpha1",
+			"kind": "DPFHCPBridge",
+			"metadata": {"name": "prod", "namespace": "dpf-hcp"},
+			"spec": {"nodePoolReplicas": 3}
+		}`))
+	})
+})
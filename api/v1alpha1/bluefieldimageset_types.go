@@ -0,0 +1,65 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BlueFieldImageSetSpec defines the BlueField container image published for an OCP version
+type BlueFieldImageSetSpec struct {
+	// OCPVersion is the OCP version the image is built for, e.g. 4.19.1
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	OCPVersion string `json:"ocpVersion"`
+
+	// Image is the full pull-spec URL of the BlueField container image
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Image string `json:"image"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=bfis
+// +kubebuilder:printcolumn:name="OCP Version",type=string,JSONPath=`.spec.ocpVersion`
+// +kubebuilder:printcolumn:name="Image",type=string,JSONPath=`.spec.image`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// BlueFieldImageSet is the Schema for the bluefieldimagesets API
+// Like a ClusterImageSet, each object publishes one image, so that several teams can publish
+// BlueField images without sharing a single object.
+type BlueFieldImageSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BlueFieldImageSetSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BlueFieldImageSetList contains a list of BlueFieldImageSet
+type BlueFieldImageSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BlueFieldImageSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BlueFieldImageSet{}, &BlueFieldImageSetList{})
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LabelBridgePool is set on the DPFHCPBridges provisioned by a BridgePool to the name of the pool
+const LabelBridgePool = "provisioning.dpu.hcp.io/bridge-pool"
+
+// BridgePoolTemplate describes the DPFHCPBridges provisioned by a BridgePool
+type BridgePoolTemplate struct {
+	// Labels are added to the spares, e.g. to place them in the shard of an operator instance
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Spec is the spec of the spares. It must not set dpuClusterRef, dpuClusterSelector or dpuClusterRefs,
+	// which are set when a spare is claimed, nor virtualIP, which is assigned from the pool's virtualIPs.
+	// Changes only apply to spares provisioned afterwards; fields that are immutable on DPFHCPBridge
+	// cannot be changed here either.
+	// +kubebuilder:validation:Required
+	// +required
+	Spec DPFHCPBridgeSpec `json:"spec"`
+}
+
+// BridgePoolSpec defines the desired state of BridgePool
+// +kubebuilder:validation:XValidation:rule="!has(self.template.spec.dpuClusterRef) && !has(self.template.spec.dpuClusterSelector) && !has(self.template.spec.dpuClusterRefs)",message="template must not set dpuClusterRef, dpuClusterSelector or dpuClusterRefs, spares are bound to a DPUCluster when claimed"
+// +kubebuilder:validation:XValidation:rule="!has(self.template.spec.virtualIP)",message="template must not set virtualIP, use virtualIPs instead"
+// +kubebuilder:validation:XValidation:rule="!has(self.template.spec.ingressVIP)",message="template must not set ingressVIP, each spare needs its own; set it when claiming the spare"
+// +kubebuilder:validation:XValidation:rule="!has(self.template.spec.bridgePoolRef)",message="template must not set bridgePoolRef"
+type BridgePoolSpec struct {
+	// Replicas is the number of unclaimed spares to keep provisioned
+	// Claimed spares are replaced; spares above the count are deleted, unclaimed ones first.
+	// +kubebuilder:validation:Minimum=0
+	// +required
+	Replicas int32 `json:"replicas"`
+
+	// VirtualIPs are assigned to the spares, one per spare, as their spec.virtualIP
+	// Required when the template's controlPlaneAvailabilityPolicy is HighlyAvailable; no spare is
+	// provisioned once all of them are in use.
+	// +listType=set
+	// +optional
+	VirtualIPs []string `json:"virtualIPs,omitempty"`
+
+	// Template describes the spares
+	// +kubebuilder:validation:Required
+	// +required
+	Template BridgePoolTemplate `json:"template"`
+}
+
+// BridgePoolStatus defines the observed state of BridgePool
+type BridgePoolStatus struct {
+	// Replicas is the number of unclaimed spares
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// AvailableReplicas is the number of unclaimed spares whose HostedCluster is available
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+
+	// Claimed is the number of existing DPFHCPBridges that were claimed from the pool
+	// +optional
+	Claimed int32 `json:"claimed,omitempty"`
+
+	// ObservedGeneration is the generation of the BridgePool last reconciled
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=bpool
+// +kubebuilder:printcolumn:name="Desired",type=integer,JSONPath=`.spec.replicas`
+// +kubebuilder:printcolumn:name="Spares",type=integer,JSONPath=`.status.replicas`
+// +kubebuilder:printcolumn:name="Available",type=integer,JSONPath=`.status.availableReplicas`
+// +kubebuilder:printcolumn:name="Claimed",type=integer,JSONPath=`.status.claimed`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// BridgePool is the Schema for the bridgepools API
+// A BridgePool keeps warm spare hosted control planes provisioned: DPFHCPBridges with a HostedCluster
+// but no NodePool. Claiming a spare binds it to a DPUCluster and attaches its workers without waiting
+// for the control plane to be provisioned.
+type BridgePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BridgePoolSpec   `json:"spec,omitempty"`
+	Status BridgePoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BridgePoolList contains a list of BridgePool
+type BridgePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BridgePool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BridgePool{}, &BridgePoolList{})
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LabelBridgeTemplate opts a DPUCluster into auto-provisioning: once the DPUCluster is Ready, the operator
+// creates a DPFHCPBridge for it from the BridgeTemplate named by the label. It is also set on the
+// DPFHCPBridges created from a BridgeTemplate.
+const LabelBridgeTemplate = "provisioning.dpu.hcp.io/bridge-template"
+
+// BridgeTemplateSpec defines the desired state of BridgeTemplate
+// +kubebuilder:validation:XValidation:rule="!has(self.bridge.dpuClusterRef) && !has(self.bridge.dpuClusterSelector) && !has(self.bridge.dpuClusterRefs)",message="bridge must not set dpuClusterRef, dpuClusterSelector or dpuClusterRefs, bridges are bound to the DPUCluster they are created for"
+// +kubebuilder:validation:XValidation:rule="!has(self.bridge.virtualIP)",message="bridge must not set virtualIP, annotate the DPUClusters with provisioning.dpu.hcp.io/default-virtual-ips instead"
+// +kubebuilder:validation:XValidation:rule="!has(self.bridge.ingressVIP)",message="bridge must not set ingressVIP, each bridge needs its own; set it on the created bridges"
+// +kubebuilder:validation:XValidation:rule="!has(self.bridge.bridgePoolRef)",message="bridge must not set bridgePoolRef"
+type BridgeTemplateSpec struct {
+	// Namespace is the namespace the DPFHCPBridges are created in, the namespace of their DPUCluster if unset.
+	// The secrets referenced by the bridge spec must exist in it.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Labels are added to the DPFHCPBridges, e.g. to place them in the shard of an operator instance
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Bridge is the spec of the DPFHCPBridges. dpuClusterRef is set to the DPUCluster each bridge is
+	// created for; the site defaults annotated on the DPUCluster, such as its virtual IPs, fill the
+	// fields left unset.
+	// Changes only apply to bridges created afterwards.
+	// +kubebuilder:validation:Required
+	// +required
+	Bridge DPFHCPBridgeSpec `json:"bridge"`
+}
+
+// BridgeTemplateStatus defines the observed state of BridgeTemplate
+type BridgeTemplateStatus struct {
+	// Bridges is the number of existing DPFHCPBridges created from the template
+	// +optional
+	Bridges int32 `json:"bridges,omitempty"`
+
+	// PendingDPUClusters is the number of DPUClusters labelled with the template that have no
+	// DPFHCPBridge yet, because they are not Ready or their bridge could not be created
+	// +optional
+	PendingDPUClusters int32 `json:"pendingDPUClusters,omitempty"`
+
+	// ObservedGeneration is the generation of the BridgeTemplate last reconciled
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=btpl
+// +kubebuilder:printcolumn:name="Bridges",type=integer,JSONPath=`.status.bridges`
+// +kubebuilder:printcolumn:name="Pending",type=integer,JSONPath=`.status.pendingDPUClusters`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// BridgeTemplate is the Schema for the bridgetemplates API
+// A BridgeTemplate auto-provisions a DPFHCPBridge for every Ready DPUCluster labelled with
+// provisioning.dpu.hcp.io/bridge-template=<name>, so that fleets do not hand-write one bridge per DPUCluster.
+type BridgeTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BridgeTemplateSpec   `json:"spec,omitempty"`
+	Status BridgeTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BridgeTemplateList contains a list of BridgeTemplate
+type BridgeTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BridgeTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BridgeTemplate{}, &BridgeTemplateList{})
+}
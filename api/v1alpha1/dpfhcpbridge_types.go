@@ -17,7 +17,12 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
@@ -36,16 +41,85 @@ type DPUClusterReference struct {
 	Namespace string `json:"namespace"`
 }
 
+// ReleaseCatalogReference selects an OCP release from a ReleaseCatalog
+type ReleaseCatalogReference struct {
+	// Name is the name of the ReleaseCatalog
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+
+	// Version is the OCP version of the catalog entry, e.g. 4.19.1
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Version string `json:"version"`
+}
+
 // DPFHCPBridgeSpec defines the desired state of DPFHCPBridge
-// +kubebuilder:validation:XValidation:rule="self.controlPlaneAvailabilityPolicy != 'HighlyAvailable' || (has(self.virtualIP) && size(self.virtualIP) > 0)",message="virtualIP is required when controlPlaneAvailabilityPolicy is HighlyAvailable"
+// +kubebuilder:validation:XValidation:rule="[has(self.ocpReleaseImage), has(self.releaseCatalogRef), has(self.channel)].filter(x, x).size() == 1",message="exactly one of ocpReleaseImage, releaseCatalogRef and channel must be set"
+// +kubebuilder:validation:XValidation:rule="[has(self.dpuClusterRef), has(self.dpuClusterSelector), has(self.dpuClusterRefs)].filter(x, x).size() <= 1",message="exactly one of dpuClusterRef, dpuClusterSelector and dpuClusterRefs must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.dpuClusterRef) && !has(oldSelf.dpuClusterSelector) && !has(oldSelf.dpuClusterRefs)) || (has(oldSelf.dpuClusterRef) == has(self.dpuClusterRef) && has(oldSelf.dpuClusterSelector) == has(self.dpuClusterSelector) && has(oldSelf.dpuClusterRefs) == has(self.dpuClusterRefs))",message="cannot switch between dpuClusterRef, dpuClusterSelector and dpuClusterRefs"
+// +kubebuilder:validation:XValidation:rule="!has(self.dpuClusterRefs) || !has(self.nodePools) || !self.nodePools.exists(p, self.dpuClusterRefs.exists(r, r.name == p.name))",message="nodePools names cannot match a dpuClusterRefs name: both name the NodePool <name>-<entry>"
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.bridgePoolRef) == has(self.bridgePoolRef)",message="bridgePoolRef cannot be added or removed"
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.virtualIP) == has(self.virtualIP)",message="virtualIP cannot be added or removed: the HostedCluster services are published from it at creation"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.ingressVIP) || has(self.ingressVIP)",message="ingressVIP cannot be removed: MetalLB keeps announcing it in the hosted cluster"
+// +kubebuilder:validation:XValidation:rule="!has(self.ingressVIP) || !has(self.virtualIP) || self.ingressVIP != self.virtualIP",message="ingressVIP must differ from virtualIP"
+// +kubebuilder:validation:XValidation:rule="!has(self.nodePortAddresses) || !has(self.virtualIP)",message="nodePortAddresses cannot be set with virtualIP: the services are published on the virtual IP"
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.networking) == has(self.networking)",message="networking cannot be added or removed: HyperShift cannot change the network of an existing hosted cluster"
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.platform) == has(self.platform)",message="platform cannot be added or removed: HyperShift cannot change the platform of an existing hosted cluster"
 type DPFHCPBridgeSpec struct {
 	// DPUClusterRef is a cross-namespace reference to a DPUCluster CR for validation and kubeconfig injection
+	// Exactly one of DPUClusterRef, DPUClusterSelector and DPUClusterRefs must be set, except on BridgePool
+	// spares where setting one of them claims the spare.
 	// This field is immutable.
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dpuClusterRef is immutable"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dpuClusterRef is immutable: the hosted cluster is bound to the referenced DPUCluster"
 	// +immutable
-	// +required
-	DPUClusterRef DPUClusterReference `json:"dpuClusterRef"`
+	// +optional
+	DPUClusterRef DPUClusterReference `json:"dpuClusterRef,omitzero"`
+
+	// DPUClusterSelector selects the DPUCluster by label instead of by name, e.g. for GitOps flows
+	// where DPUCluster names include generated suffixes
+	// DPUClusters in all namespaces are considered and exactly one must match. The match is resolved
+	// once and recorded in status.dpuClusterRef.
+	// This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dpuClusterSelector is immutable"
+	// +immutable
+	// +optional
+	DPUClusterSelector *metav1.LabelSelector `json:"dpuClusterSelector,omitempty"`
+
+	// DPUClusterRefs are cross-namespace references to several DPUClusters backing a single hosted
+	// cluster, e.g. when the DPUs are split across management domains but share one tenant control plane
+	// The first DPUCluster is served by the default NodePool and every other one by a NodePool named
+	// <name>-<dpucluster-name>. The nodes of each NodePool are labelled with the DPUCluster they belong
+	// to, and the kubeconfig is injected into every DPUCluster.
+	// Exactly one of DPUClusterRef, DPUClusterSelector and DPUClusterRefs must be set.
+	// This field is immutable.
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dpuClusterRefs is immutable: the hosted cluster is bound to the referenced DPUClusters"
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y.name == x.name))",message="dpuClusterRefs names must be unique: each DPUCluster names a NodePool"
+	// +immutable
+	// +optional
+	DPUClusterRefs []DPUClusterReference `json:"dpuClusterRefs,omitempty"`
+
+	// DPUClusterReadinessPolicy controls whether the HostedCluster is provisioned before the DPUCluster is Ready
+	// Require waits for the DPUCluster to be Ready, WaitWithTimeout waits at most DPUClusterReadinessTimeout,
+	// and Ignore provisions right away, e.g. at sites that create the hosted control plane before the DPUs are racked.
+	// Only the initial provisioning is gated.
+	// +kubebuilder:default=Ignore
+	// +optional
+	DPUClusterReadinessPolicy DPUClusterReadinessPolicy `json:"dpuClusterReadinessPolicy,omitempty"`
+
+	// DPUClusterReadinessTimeout is how long the WaitWithTimeout policy waits for the DPUCluster to be Ready
+	// Default: 30m
+	// +optional
+	DPUClusterReadinessTimeout *metav1.Duration `json:"dpuClusterReadinessTimeout,omitempty"`
+
+	// ProvisioningTimeouts bounds how long each provisioning stage may take before its timeout condition
+	// (HostedClusterAvailableTimedOut, FirstNodeJoinTimedOut, NodePoolReadyTimedOut) is set
+	// +optional
+	ProvisioningTimeouts *ProvisioningTimeouts `json:"provisioningTimeouts,omitempty"`
 
 	// BaseDomain is the base domain for the hosted cluster's DNS records
 	// Example: clusters.example.com results in API endpoint at api.prod-cluster.clusters.example.com
@@ -54,16 +128,28 @@ type DPFHCPBridgeSpec struct {
 	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)+[a-z]{2,}$`
 	// +kubebuilder:validation:MinLength=4
 	// +kubebuilder:validation:MaxLength=253
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="baseDomain is immutable"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="baseDomain is immutable: the hosted cluster DNS names and certificates are derived from it"
 	// +immutable
 	// +required
 	BaseDomain string `json:"baseDomain"`
 
 	// OCPReleaseImage is the full pull-spec URL for the OCP release image
 	// The operator uses this to look up the corresponding BlueField container image from the central ConfigMap
-	// +kubebuilder:validation:Required
-	// +required
-	OCPReleaseImage string `json:"ocpReleaseImage"`
+	// Exactly one of OCPReleaseImage, ReleaseCatalogRef and Channel must be set.
+	// +optional
+	OCPReleaseImage string `json:"ocpReleaseImage,omitempty"`
+
+	// ReleaseCatalogRef selects the OCP release and its BlueField image from a ReleaseCatalog entry
+	// instead of a raw ocpReleaseImage
+	// +optional
+	ReleaseCatalogRef *ReleaseCatalogReference `json:"releaseCatalogRef,omitempty"`
+
+	// Channel tracks the latest z-stream release of an OpenShift update channel, e.g. stable-4.17,
+	// instead of a raw ocpReleaseImage. The release is looked up in the OpenShift update graph
+	// and recorded in status.channelRelease.
+	// +kubebuilder:validation:Pattern=`^(stable|fast|eus|candidate)-[0-9]+\.[0-9]+$`
+	// +optional
+	Channel string `json:"channel,omitempty"`
 
 	// SSHKeySecretRef is a reference to a Secret containing the SSH public key for cluster node access
 	// Secret must be in the same namespace as the DPFHCPBridge CR and contain key 'id_rsa.pub'
@@ -103,12 +189,73 @@ type DPFHCPBridgeSpec struct {
 	// VirtualIP is the virtual IP address for load balancer
 	// Required when ControlPlaneAvailabilityPolicy is HighlyAvailable
 	// Must be a routable IP in the management cluster network
-	// This field is immutable.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="virtualIP is immutable"
+	// This field is immutable and cannot be added or removed after creation.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="virtualIP is immutable: the HostedCluster load balancer is configured from it"
 	// +immutable
 	// +optional
 	VirtualIP string `json:"virtualIP,omitempty"`
 
+	// IngressVIP is the virtual IP address the *.apps routes of the hosted cluster are served on
+	// When set, the operator installs MetalLB in the hosted cluster, announces the address from the DPU
+	// workers and publishes the default ingress controller on it through a LoadBalancer Service.
+	// Must be a free IP in the DPU worker network; the wildcard DNS record to create for it is reported
+	// in status.ingressDNSRecord. It can be changed but not removed.
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="ingressVIP must be an IPv4 or IPv6 address"
+	// +optional
+	IngressVIP string `json:"ingressVIP,omitempty"`
+
+	// NodePortAddresses are the management cluster node addresses the control plane services can be published on
+	// in NodePort mode (SingleReplica without virtualIP), in order of preference. The services are published on the
+	// first address whose node is Ready; when that node goes NotReady, the HostedCluster is switched to the next
+	// address whose node is Ready. When unset, the address of the first node is used and never changed.
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	// +listType=set
+	// +optional
+	NodePortAddresses []string `json:"nodePortAddresses,omitempty"`
+
+	// Networking configures the network CIDRs of the hosted cluster
+	// When unset, the cluster network is 10.132.0.0/14 and the service network 172.31.0.0/16;
+	// set it when these overlap with the DPU management network.
+	// This field is immutable and cannot be added or removed after creation.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="networking is immutable: HyperShift cannot change the network of an existing hosted cluster"
+	// +immutable
+	// +optional
+	Networking *ClusterNetworkingSpec `json:"networking,omitempty"`
+
+	// Proxy configures the egress proxy the hosted cluster and its DPU workers reach external
+	// endpoints such as registries through
+	// Changing it rolls out the new configuration to the hosted cluster.
+	// +optional
+	Proxy *ProxySpec `json:"proxy,omitempty"`
+
+	// ImageMirrors redirect image pulls of the hosted control plane and the DPU workers to mirror
+	// registries, e.g. an offline mirror in disconnected installations
+	// Changing them rolls out the new configuration to the hosted cluster.
+	// +kubebuilder:validation:MaxItems=50
+	// +optional
+	ImageMirrors []ImageMirror `json:"imageMirrors,omitempty"`
+
+	// TimeSync configures the NTP servers the DPU workers synchronize their clock with through chrony
+	// It is rendered into a MachineConfig referenced by every NodePool, and applies to DPUs booted after it is changed.
+	// +optional
+	TimeSync *TimeSyncSpec `json:"timeSync,omitempty"`
+
+	// NodeTuning selects kernel tuning presets for the DPU workers, such as hugepages, isolated CPUs and the IOMMU mode
+	// They are rendered into the kernel arguments of a MachineConfig referenced by every NodePool.
+	// Changing them rolls out the new configuration to the DPU workers, which reboots them.
+	// +optional
+	NodeTuning *NodeTuningSpec `json:"nodeTuning,omitempty"`
+
+	// ContainerRuntime configures CRI-O on the DPU workers, such as the pids limit, the log size and
+	// registries.conf drop-ins
+	// It is rendered into a ContainerRuntimeConfig and a MachineConfig referenced by every NodePool.
+	// Changing it rolls out the new configuration to the DPU workers.
+	// +optional
+	ContainerRuntime *ContainerRuntimeSpec `json:"containerRuntime,omitempty"`
+
 	// NodeSelector defines the node selector for the hosted control plane pods
 	// It specifies which nodes in the management cluster can host the control plane workloads
 	// Default: {"node-role.kubernetes.io/control-plane": ""} (schedules on control-plane nodes)
@@ -118,9 +265,525 @@ type DPFHCPBridgeSpec struct {
 	// +immutable
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// NodePoolReplicas is the desired number of DPU worker nodes in the NodePool
+	// DPU workers join the hosted cluster through CSR approval, so this is the number of nodes
+	// HyperShift expects rather than a number of machines it provisions.
+	// Exposed through the scale subresource, e.g. kubectl scale dpfhcpbridge/<name> --replicas=N
+	// When unset, the NodePool is created with 0 replicas and its replica count is left alone afterwards,
+	// so that NodePools scaled directly are not scaled down.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	NodePoolReplicas *int32 `json:"nodePoolReplicas,omitempty"`
+
+	// SizeProfile right-sizes the hosted control plane for the expected number of DPU workers:
+	// small (up to 10), medium (up to 50) or large (more than 50)
+	// It sets the HyperShift cluster size override and the kube-apiserver and etcd resource requests.
+	// When unset, sizing is left to HyperShift.
+	// +optional
+	SizeProfile SizeProfile `json:"sizeProfile,omitempty"`
+
+	// NodePools are additional NodePools of the hosted cluster, e.g. one per BlueField generation,
+	// created as <name>-<nodePool name> next to the default NodePool named after the bridge
+	// Removing an entry deletes its NodePool.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	NodePools []NodePoolSpec `json:"nodePools,omitempty"`
+
+	// PublishIgnitionSecret copies the current NodePool user-data, ignition token and ignition server CA into
+	// a Secret named <name>-ignition in the bridge namespace, for tooling that boots DPUs out-of-band
+	// The secret references are always reported in status.ignition.
+	// +optional
+	PublishIgnitionSecret bool `json:"publishIgnitionSecret,omitempty"`
+
+	// Platform is the HyperShift platform DPU workers join the hosted cluster through
+	// With None, DPUs boot from the NodePool ignition fetched out-of-band. With Agent, the operator creates
+	// an InfraEnv named after the bridge and approves the DPU workers assisted-service discovers through
+	// it, which the NodePools then claim automatically; this requires assisted-service on the management cluster.
+	// Default: None
+	// This field is immutable and cannot be added or removed after creation.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="platform is immutable: HyperShift cannot change the platform of an existing hosted cluster"
+	// +immutable
+	// +optional
+	Platform PlatformType `json:"platform,omitempty"`
+
+	// PreDeleteHooks are Jobs executed by the finalizer before the HostedCluster is deleted,
+	// e.g. to gracefully drain DOCA services off the DPUs
+	// Hooks run sequentially in the order they are listed.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	PreDeleteHooks []LifecycleHook `json:"preDeleteHooks,omitempty"`
+
+	// PostProvisionHooks are Jobs executed once the HostedCluster becomes Available,
+	// e.g. to apply day-1 manifests or register the cluster with an external CMDB
+	// Hooks run sequentially in the order they are listed, and each successful hook runs only once.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	PostProvisionHooks []LifecycleHook `json:"postProvisionHooks,omitempty"`
+
+	// AdditionalManifestsRefs are references to ConfigMaps containing YAML manifests
+	// (e.g. DPU-specific DaemonSets and NetworkPolicies) applied into the hosted cluster
+	// as soon as its control plane is available
+	// ConfigMaps must be in the same namespace as the DPFHCPBridge CR. Every key holds one or more
+	// YAML documents; keys are applied in sorted order.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	AdditionalManifestsRefs []corev1.LocalObjectReference `json:"additionalManifestsRefs,omitempty"`
+
+	// EnableDPUDevicePlugins injects the built-in SR-IOV network operator and RDMA shared device plugin
+	// manifests into the hosted cluster as day-1 manifests, ahead of any AdditionalManifestsRefs
+	// Default: false
+	// +optional
+	EnableDPUDevicePlugins bool `json:"enableDPUDevicePlugins,omitempty"`
+
+	// ForwardEventsToHostedCluster mirrors the most recent events of this DPFHCPBridge into the
+	// dpf-hcp-bridge-events ConfigMap in the openshift-config namespace of the hosted cluster,
+	// so that hosted cluster admins can see the provisioning and upgrade context
+	// Default: false
+	// +optional
+	ForwardEventsToHostedCluster bool `json:"forwardEventsToHostedCluster,omitempty"`
+
+	// KubeconfigExport copies the hosted cluster admin kubeconfig into a Secret of the chosen name and
+	// namespace, so that tenant teams can be granted access to just that Secret through RBAC
+	// The Secret is kept in sync with the admin kubeconfig and deleted when the field is removed or the
+	// DPFHCPBridge is deleted.
+	// +optional
+	KubeconfigExport *KubeconfigExportSpec `json:"kubeconfigExport,omitempty"`
+
+	// BridgePoolRef is the BridgePool that provisioned this DPFHCPBridge as a warm spare
+	// Set by the BridgePool controller. A spare provisions its HostedCluster without a NodePool until it
+	// is claimed by setting dpuClusterRef, dpuClusterSelector or dpuClusterRefs.
+	// This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="bridgePoolRef is immutable"
+	// +immutable
+	// +optional
+	BridgePoolRef *corev1.LocalObjectReference `json:"bridgePoolRef,omitempty"`
+}
+
+// DPUClusterReadinessPolicy specifies whether provisioning waits for the DPUCluster to be Ready
+// +kubebuilder:validation:Enum=Require;Ignore;WaitWithTimeout
+type DPUClusterReadinessPolicy string
+
+const (
+	// DPUClusterReadinessRequire waits for the DPUCluster to be Ready before provisioning
+	DPUClusterReadinessRequire DPUClusterReadinessPolicy = "Require"
+
+	// DPUClusterReadinessIgnore provisions regardless of the DPUCluster readiness
+	DPUClusterReadinessIgnore DPUClusterReadinessPolicy = "Ignore"
+
+	// DPUClusterReadinessWaitWithTimeout waits for the DPUCluster to be Ready, and provisions anyway once the timeout expires
+	DPUClusterReadinessWaitWithTimeout DPUClusterReadinessPolicy = "WaitWithTimeout"
+)
+
+// SizeProfile is a hosted control plane size
+// +kubebuilder:validation:Enum=small;medium;large
+type SizeProfile string
+
+const (
+	// SizeProfileSmall sizes the control plane for up to 10 DPU workers
+	SizeProfileSmall SizeProfile = "small"
+
+	// SizeProfileMedium sizes the control plane for up to 50 DPU workers
+	SizeProfileMedium SizeProfile = "medium"
+
+	// SizeProfileLarge sizes the control plane for more than 50 DPU workers
+	SizeProfileLarge SizeProfile = "large"
+)
+
+// PlatformType is the HyperShift platform of the hosted cluster and its NodePools
+// +kubebuilder:validation:Enum=None;Agent
+type PlatformType string
+
+const (
+	// PlatformNone has DPU workers boot from the NodePool ignition fetched out-of-band
+	PlatformNone PlatformType = "None"
+
+	// PlatformAgent has DPU workers discovered by assisted-service through an InfraEnv of the bridge
+	PlatformAgent PlatformType = "Agent"
+)
+
+// HookTarget specifies which cluster a lifecycle hook Job operates on
+// +kubebuilder:validation:Enum=ManagementCluster;HostedCluster
+type HookTarget string
+
+const (
+	// HookTargetManagementCluster runs the hook Job with the management cluster credentials of its service account
+	HookTargetManagementCluster HookTarget = "ManagementCluster"
+
+	// HookTargetHostedCluster runs the hook Job with the hosted cluster admin kubeconfig mounted and KUBECONFIG set
+	HookTargetHostedCluster HookTarget = "HostedCluster"
+)
+
+// HookFailurePolicy specifies how a failed or timed out lifecycle hook is handled
+// +kubebuilder:validation:Enum=Ignore;Fail
+type HookFailurePolicy string
+
+const (
+	// HookFailurePolicyIgnore records the failure in status and continues
+	HookFailurePolicyIgnore HookFailurePolicy = "Ignore"
+
+	// HookFailurePolicyFail blocks further progress until the hook succeeds or is removed from the spec
+	HookFailurePolicyFail HookFailurePolicy = "Fail"
+)
+
+// CIDR is an IP address range in CIDR notation, e.g. 10.132.0.0/14
+// +kubebuilder:validation:Format=cidr
+// +kubebuilder:validation:MaxLength=43
+type CIDR string
+
+// ClusterNetworkingSpec configures the network CIDRs of the hosted cluster
+type ClusterNetworkingSpec struct {
+	// ClusterNetwork are the CIDRs pod IPs are allocated from
+	// Default: 10.132.0.0/14
+	// +kubebuilder:validation:MaxItems=2
+	// +optional
+	ClusterNetwork []CIDR `json:"clusterNetwork,omitempty"`
+
+	// ServiceNetwork are the CIDRs service IPs are allocated from
+	// Default: 172.31.0.0/16
+	// +kubebuilder:validation:MaxItems=2
+	// +optional
+	ServiceNetwork []CIDR `json:"serviceNetwork,omitempty"`
+
+	// MachineNetwork are the CIDRs the DPU worker node addresses are in
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	MachineNetwork []CIDR `json:"machineNetwork,omitempty"`
+
+	// HostPrefix is the prefix length of the pod subnet each node gets from the cluster network
+	// When unset, HyperShift assigns a /23 per node.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	HostPrefix *int32 `json:"hostPrefix,omitempty"`
+}
+
+// ProvisioningTimeouts bounds the provisioning stages of the DPFHCPBridge
+// A stage that overruns its timeout is reported, provisioning itself continues. A zero timeout disables the check.
+type ProvisioningTimeouts struct {
+	// HostedClusterAvailable is how long the HostedCluster may take to become Available after it is created
+	// Default: 30m
+	// +optional
+	HostedClusterAvailable *metav1.Duration `json:"hostedClusterAvailable,omitempty"`
+
+	// FirstNodeJoined is how long the first DPU may take to join the hosted cluster as a Ready node
+	// after the HostedCluster became Available
+	// Default: 1h
+	// +optional
+	FirstNodeJoined *metav1.Duration `json:"firstNodeJoined,omitempty"`
+
+	// NodePoolReady is how long the NodePools may take to have all their nodes Ready after the HostedCluster
+	// became Available
+	// Default: 2h
+	// +optional
+	NodePoolReady *metav1.Duration `json:"nodePoolReady,omitempty"`
+}
+
+// KubeconfigExportSpec names the Secret the hosted cluster admin kubeconfig is exported to
+type KubeconfigExportSpec struct {
+	// Name is the name of the Secret
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Secret
+	// Default: the namespace of the DPFHCPBridge
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ProxySpec configures the cluster-wide egress proxy of the hosted cluster
+type ProxySpec struct {
+	// HTTPProxy is the URL of the proxy for HTTP requests, e.g. http://proxy.example.com:3128
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the URL of the proxy for HTTPS requests
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy is a comma-separated list of hostnames, domains, IP addresses and CIDRs that are
+	// reached without the proxy, e.g. .cluster.local,10.0.0.0/8
+	// +kubebuilder:validation:MaxLength=4096
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
+
+	// TrustedCA is a reference to a ConfigMap with the CA bundle of the proxy
+	// ConfigMap must be in the same namespace as the DPFHCPBridge CR and contain key 'ca-bundle.crt'
+	// +optional
+	TrustedCA *corev1.LocalObjectReference `json:"trustedCA,omitempty"`
+}
+
+// TimeSyncSpec configures the clock synchronization of the DPU workers
+type TimeSyncSpec struct {
+	// Servers are the hostnames or IP addresses of the NTP servers, e.g. ntp1.example.com
+	// DPU workers step their clock whenever it is more than one second off, since drifted BlueField cards
+	// otherwise fail TLS to the hosted cluster API.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9]([-A-Za-z0-9.:]*[A-Za-z0-9])?$`
+	// +listType=set
+	// +required
+	Servers []string `json:"servers"`
+}
+
+// NodeTuningSpec selects kernel tuning presets for the DPU workers
+// +kubebuilder:validation:XValidation:rule="has(self.hugepages) || has(self.isolatedCPUs) || has(self.iommu)",message="at least one of hugepages, isolatedCPUs and iommu must be set"
+type NodeTuningSpec struct {
+	// Hugepages reserves hugepages at boot, e.g. for DPDK-based workloads
+	// +optional
+	Hugepages *HugepagesSpec `json:"hugepages,omitempty"`
+
+	// IsolatedCPUs is the list of CPUs removed from the general kernel scheduling with isolcpus, e.g. 2-7 or 1,3-5
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[0-9]+(-[0-9]+)?(,[0-9]+(-[0-9]+)?)*$`
+	// +optional
+	IsolatedCPUs string `json:"isolatedCPUs,omitempty"`
+
+	// IOMMU sets the IOMMU mode of the DPU workers
+	// Passthrough maps DMA addresses 1:1, which avoids the translation overhead for devices owned by the
+	// kernel; Strict translates them and invalidates the IOTLB synchronously.
+	// +optional
+	IOMMU IOMMUMode `json:"iommu,omitempty"`
+}
+
+// HugepagesSpec reserves hugepages of a single size at boot
+type HugepagesSpec struct {
+	// Size is the size of each hugepage, which also becomes the default hugepage size
+	// Default: 2M
+	// +kubebuilder:default="2M"
+	// +optional
+	Size HugepageSize `json:"size,omitempty"`
+
+	// Count is the number of hugepages reserved
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65536
+	// +required
+	Count int32 `json:"count"`
+}
+
+// HugepageSize is the size of a hugepage supported by the arm64 kernel of the DPU workers
+// +kubebuilder:validation:Enum="2M";"32M";"1G"
+type HugepageSize string
+
+const (
+	// HugepageSize2M reserves 2 MiB hugepages
+	HugepageSize2M HugepageSize = "2M"
+
+	// HugepageSize32M reserves 32 MiB hugepages
+	HugepageSize32M HugepageSize = "32M"
+
+	// HugepageSize1G reserves 1 GiB hugepages
+	HugepageSize1G HugepageSize = "1G"
+)
+
+// IOMMUMode is the mode of the IOMMU of the DPU workers
+// +kubebuilder:validation:Enum=Passthrough;Strict
+type IOMMUMode string
+
+const (
+	// IOMMUModePassthrough bypasses DMA translation for devices owned by the kernel
+	IOMMUModePassthrough IOMMUMode = "Passthrough"
+
+	// IOMMUModeStrict translates DMA and invalidates the IOTLB synchronously
+	IOMMUModeStrict IOMMUMode = "Strict"
+)
+
+// ContainerRuntimeSpec configures CRI-O on the DPU workers
+// +kubebuilder:validation:XValidation:rule="has(self.pidsLimit) || has(self.logSizeMax) || has(self.registriesConfDropIns)",message="at least one of pidsLimit, logSizeMax and registriesConfDropIns must be set"
+type ContainerRuntimeSpec struct {
+	// PidsLimit is the maximum number of processes in a container
+	// +kubebuilder:validation:Minimum=20
+	// +optional
+	PidsLimit *int64 `json:"pidsLimit,omitempty"`
+
+	// LogSizeMax is the size a container log file is rotated at, e.g. 50Mi
+	// CRI-O rejects sizes below 8Ki.
+	// +optional
+	LogSizeMax *resource.Quantity `json:"logSizeMax,omitempty"`
+
+	// RegistriesConfDropIns are written to /etc/containers/registries.conf.d on the DPU workers,
+	// e.g. to block registries or to configure registries beyond spec.imageMirrors
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	RegistriesConfDropIns []RegistriesConfDropIn `json:"registriesConfDropIns,omitempty"`
+}
+
+// RegistriesConfDropIn is a registries.conf drop-in file of the DPU workers
+type RegistriesConfDropIn struct {
+	// Name is the file name of the drop-in without the .conf extension, e.g. 50-blocked-registries
+	// Drop-ins are applied in lexical order of their file names.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Name string `json:"name"`
+
+	// Content is the TOML content of the drop-in, in the registries.conf format
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=16384
+	// +required
+	Content string `json:"content"`
+}
+
+// ImageMirror redirects pulls from a source repository to mirror repositories
+type ImageMirror struct {
+	// Source is the repository the images are referenced by, e.g. quay.io/openshift-release-dev/ocp-release
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	// +required
+	Source string `json:"source"`
+
+	// Mirrors are the repositories the images are pulled from instead, tried in order
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +required
+	Mirrors []string `json:"mirrors"`
+}
+
+// NodePoolSpec defines an additional NodePool of the hosted cluster
+type NodePoolSpec struct {
+	// Name uniquely identifies the NodePool within the bridge
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=30
+	// +required
+	Name string `json:"name"`
+
+	// Replicas is the desired number of DPU worker nodes in the NodePool
+	// Default: 0
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// OCPReleaseImage is the release image of the NodePool, defaulting to the release image of the bridge
+	// HyperShift rejects NodePools newer than the hosted control plane or more than two minors older.
+	// +optional
+	OCPReleaseImage string `json:"ocpReleaseImage,omitempty"`
+}
+
+// LifecycleHook defines a Job run by the operator at a specific point in the bridge lifecycle
+type LifecycleHook struct {
+	// Name uniquely identifies the hook within its list
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=30
+	// +required
+	Name string `json:"name"`
+
+	// Target specifies which cluster the hook operates on
+	// The Job always runs in the DPFHCPBridge namespace of the management cluster; with HostedCluster
+	// the hosted cluster admin kubeconfig is mounted at /etc/hook/kubeconfig and KUBECONFIG is set.
+	// +kubebuilder:default=ManagementCluster
+	// +optional
+	Target HookTarget `json:"target,omitempty"`
+
+	// TimeoutSeconds is the maximum time the hook Job may run before it is considered timed out
+	// Default: 600
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// FailurePolicy specifies how a failed or timed out hook is handled
+	// +kubebuilder:default=Ignore
+	// +optional
+	FailurePolicy HookFailurePolicy `json:"failurePolicy,omitempty"`
+
+	// RetryLimit is the number of times a failed or timed out hook is re-run
+	// before its FailurePolicy is applied
+	// Default: 0
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	RetryLimit int32 `json:"retryLimit,omitempty"`
+
+	// Template is the Job template executed for this hook
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	// +required
+	Template batchv1.JobTemplateSpec `json:"template"`
+}
+
+// HookPhase represents the execution state of a lifecycle hook
+// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed;TimedOut
+type HookPhase string
+
+const (
+	// HookPhasePending indicates the hook is waiting for its previous Job to be removed before a retry
+	HookPhasePending HookPhase = "Pending"
+
+	// HookPhaseRunning indicates the hook Job has been created and has not finished yet
+	HookPhaseRunning HookPhase = "Running"
+
+	// HookPhaseSucceeded indicates the hook Job completed successfully
+	HookPhaseSucceeded HookPhase = "Succeeded"
+
+	// HookPhaseFailed indicates the hook Job failed
+	HookPhaseFailed HookPhase = "Failed"
+
+	// HookPhaseTimedOut indicates the hook Job did not finish within its timeout
+	HookPhaseTimedOut HookPhase = "TimedOut"
+)
+
+// HookStatus reports the execution state of a single lifecycle hook
+type HookStatus struct {
+	// Name is the name of the hook
+	Name string `json:"name"`
+
+	// Phase is the execution state of the hook
+	// +optional
+	Phase HookPhase `json:"phase,omitempty"`
+
+	// JobName is the name of the Job created for the hook
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+
+	// Attempts is the number of Jobs created for the hook
+	// +optional
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// StartTime is when the hook Job was created
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the hook reached a terminal phase
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message is a human-readable description of the hook state
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // DPFHCPBridgePhase represents the lifecycle phase of the DPFHCPBridge
+// A bridge moves from Pending to Provisioning once its HostedCluster is created, and between Provisioning
+// and Ready as the Ready condition changes. A failing validation moves it to Failed from any phase, and it
+// resumes once the validation passes. Deleting is final.
 // +kubebuilder:validation:Enum=Pending;Provisioning;Ready;Failed;Deleting
 type DPFHCPBridgePhase string
 
@@ -178,6 +841,10 @@ const (
 	// HostedClusterCleanup indicates the status of HostedCluster deletion during finalizer cleanup.
 	HostedClusterCleanup string = "HostedClusterCleanup"
 
+	// SecretsSynced indicates whether the pull secret and SSH key were copied and the ETCD encryption key
+	// generated for the HostedCluster.
+	SecretsSynced string = "SecretsSynced"
+
 	// Validation conditions.
 
 	// SecretsValid indicates whether required secrets (pull secret, SSH key) are valid.
@@ -194,28 +861,131 @@ const (
 
 	// DPUClusterInUse indicates whether the DPUCluster is already in use by another DPFHCPBridge.
 	DPUClusterInUse string = "DPUClusterInUse"
-)
 
-// Condition reasons for DPFHCPBridge Ready status.
-// These are used as the Reason field in the Ready condition to indicate why the bridge is ready or not ready.
-const (
-	// ReasonAllComponentsOperational indicates all required components are operational and healthy.
-	ReasonAllComponentsOperational string = "AllComponentsOperational"
+	// DPUClusterReady indicates whether the DPUCluster is Ready. It only gates provisioning when
+	// spec.dpuClusterReadinessPolicy is not Ignore.
+	DPUClusterReady string = "DPUClusterReady"
 
-	// ReasonHostedClusterNotReady indicates the HostedCluster is not yet available or healthy.
-	// Used when: HostedClusterAvailable condition is False or not set.
-	ReasonHostedClusterNotReady string = "HostedClusterNotReady"
+	// ExternallyApproved indicates whether an external system approved the initial provisioning through the
+	// approved annotation. Only set when the operator requires external approval.
+	ExternallyApproved string = "ExternallyApproved"
 
-	// ReasonKubeConfigNotInjected indicates the kubeconfig has not been injected into DPUCluster.
-	// Used when: KubeConfigInjected condition is False or not set.
-	ReasonKubeConfigNotInjected string = "KubeConfigNotInjected"
-)
+	// ResourceConflict indicates whether a HostedCluster or NodePool with the bridge's name exists
+	// that is not owned by the bridge.
+	ResourceConflict string = "ResourceConflict"
 
-// Condition reasons for DPFHCPBridge KubeConfigInjected status.
-// These are used as the Reason field in the KubeConfigInjected condition.
-const (
-	// ReasonKubeConfigInjected indicates kubeconfig was successfully injected into DPUCluster.
-	ReasonKubeConfigInjected string = "Injected"
+	// ReleaseResolved indicates whether the OCP release was resolved from spec.releaseCatalogRef, or
+	// approved by a strict ReleaseCatalog. Only set when ReleaseCatalogs are in use.
+	ReleaseResolved string = "ReleaseResolved"
+
+	// ReleaseChannelResolved indicates whether the latest release of spec.channel was looked up in the
+	// OpenShift update graph. Only set when spec.channel is set.
+	ReleaseChannelResolved string = "ReleaseChannelResolved"
+
+	// ReleaseImagePinned indicates whether the release image was pinned to a digest, and its signature
+	// verified if signature keys are configured. Only set when release image pinning is enabled.
+	ReleaseImagePinned string = "ReleaseImagePinned"
+
+	// NodePoolVersionSkewSupported indicates whether the NodePools in spec.nodePools are within the
+	// version skew HyperShift supports. Only set when spec.nodePools is not empty.
+	NodePoolVersionSkewSupported string = "NodePoolVersionSkewSupported"
+
+	// InfraEnvReady indicates whether the discovery image of the InfraEnv DPU workers are discovered through
+	// was created. Only set when spec.platform is Agent.
+	InfraEnvReady string = "InfraEnvReady"
+
+	// BFBReady indicates whether DPF downloaded the BFB created from the resolved BlueField image.
+	// Only set when the operator publishes BFBs and the BlueField image is resolved.
+	BFBReady string = "BFBReady"
+
+	// PullSecretRolledOut indicates whether the nodes of the hosted cluster run with the current pull secret.
+	// Only set once the pull secret was rotated.
+	PullSecretRolledOut string = "PullSecretRolledOut"
+
+	// NodePortAddressReady indicates whether the node the control plane services are published on in NodePort mode is Ready.
+	// Only set when spec.nodePortAddresses is set.
+	NodePortAddressReady string = "NodePortAddressReady"
+
+	// HostedClusterAdmitted indicates whether HostedCluster admission accepted the spec rendered for the bridge.
+	// Only set once admission rejected it.
+	HostedClusterAdmitted string = "HostedClusterAdmitted"
+
+	// Provisioning stage timeout conditions, True once a stage overran its timeout in spec.provisioningTimeouts.
+	// Each is set once its stage started and stays False after the stage completed.
+
+	// HostedClusterAvailableTimedOut indicates the HostedCluster did not become Available in time.
+	HostedClusterAvailableTimedOut string = "HostedClusterAvailableTimedOut"
+
+	// FirstNodeJoinTimedOut indicates no DPU joined the hosted cluster as a Ready node in time.
+	// Not set for unclaimed BridgePool spares or NodePools without replicas.
+	FirstNodeJoinTimedOut string = "FirstNodeJoinTimedOut"
+
+	// NodePoolReadyTimedOut indicates the NodePools did not have all their nodes Ready in time.
+	// Not set for unclaimed BridgePool spares or NodePools without replicas.
+	NodePoolReadyTimedOut string = "NodePoolReadyTimedOut"
+
+	// Lifecycle hook conditions.
+
+	// PostProvisionHooksCompleted indicates whether all post-provision hooks have finished.
+	PostProvisionHooksCompleted string = "PostProvisionHooksCompleted"
+
+	// AdditionalManifestsApplied indicates whether the additional manifests were applied into the hosted cluster.
+	AdditionalManifestsApplied string = "AdditionalManifestsApplied"
+
+	// UpgradeRevalidated indicates whether the spec still passes the preflight checks of the running operator version.
+	// It is set once per bridge after an operator upgrade and does not affect the phase.
+	UpgradeRevalidated string = "UpgradeRevalidated"
+
+	// DependenciesAvailable indicates whether the operator can reach the APIs it provisions bridges through.
+	// It reports the operator-wide circuit breaker state, is the same on all bridges and does not affect the phase.
+	DependenciesAvailable string = "DependenciesAvailable"
+
+	// CRDSchemaCompatible indicates whether the installed DPFHCPBridge CRD serves the API versions and fields of the
+	// running operator version. It is the same on all bridges and does not affect the phase.
+	CRDSchemaCompatible string = "CRDSchemaCompatible"
+
+	// Paused indicates whether reconciliation of the HostedCluster is paused through its spec.pausedUntil.
+	// Only set once the HostedCluster was paused; it is informational and does not affect the phase.
+	Paused string = "Paused"
+
+	// ReadOnly indicates the operator runs in read-only mode: its writes other than this status are dry-runs, so the
+	// phase and the other conditions describe what it would have done. Only set in read-only mode; it is informational
+	// and does not affect the phase.
+	ReadOnly string = "ReadOnly"
+)
+
+// Condition reasons for DPFHCPBridge Ready status.
+// These are used as the Reason field in the Ready condition to indicate why the bridge is ready or not ready.
+const (
+	// ReasonAllComponentsOperational indicates all required components are operational and healthy.
+	ReasonAllComponentsOperational string = "AllComponentsOperational"
+
+	// ReasonHostedClusterNotReady indicates the HostedCluster is not yet available or healthy.
+	// Used when: HostedClusterAvailable condition is False or not set.
+	ReasonHostedClusterNotReady string = "HostedClusterNotReady"
+
+	// ReasonKubeConfigNotInjected indicates the kubeconfig has not been injected into DPUCluster.
+	// Used when: KubeConfigInjected condition is False or not set.
+	ReasonKubeConfigNotInjected string = "KubeConfigNotInjected"
+
+	// ReasonAwaitingClaim indicates a BridgePool spare whose control plane is available but has no DPUCluster yet.
+	// Used when: spec.bridgePoolRef is set and neither dpuClusterRef nor dpuClusterSelector is.
+	ReasonAwaitingClaim string = "AwaitingClaim"
+
+	// ReasonValidationFailed indicates a validation of the spec fails, so the bridge is Failed.
+	// Used when: a validation condition such as SecretsValid or ClusterTypeValid reports a failure.
+	ReasonValidationFailed string = "ValidationFailed"
+
+	// ReasonDeleting indicates the DPFHCPBridge is being deleted.
+	// Used when: the deletionTimestamp is set.
+	ReasonDeleting string = "Deleting"
+)
+
+// Condition reasons for DPFHCPBridge KubeConfigInjected status.
+// These are used as the Reason field in the KubeConfigInjected condition.
+const (
+	// ReasonKubeConfigInjected indicates kubeconfig was successfully injected into DPUCluster.
+	ReasonKubeConfigInjected string = "Injected"
 
 	// ReasonKubeConfigPending indicates waiting for Hypershift to create the kubeconfig secret.
 	ReasonKubeConfigPending string = "KubeconfigPending"
@@ -224,12 +994,499 @@ const (
 	ReasonKubeConfigInjectionFailed string = "InjectionFailed"
 )
 
+// Condition reasons for DPFHCPBridge SecretsSynced status.
+// These are used as the Reason field in the SecretsSynced condition.
+const (
+	// ReasonSecretsSynced indicates the pull secret copy, SSH key copy and ETCD encryption key exist.
+	ReasonSecretsSynced string = "Synced"
+
+	// ReasonSecretsSyncFailed indicates a secret could not be copied or generated.
+	ReasonSecretsSyncFailed string = "SyncFailed"
+)
+
+// Condition reasons for DPFHCPBridge PostProvisionHooksCompleted status.
+// These are used as the Reason field in the PostProvisionHooksCompleted condition.
+const (
+	// ReasonHooksSucceeded indicates all post-provision hooks finished without blocking failures.
+	ReasonHooksSucceeded string = "HooksSucceeded"
+
+	// ReasonHooksRunning indicates a post-provision hook Job is still in progress.
+	ReasonHooksRunning string = "HooksRunning"
+
+	// ReasonHookFailed indicates a post-provision hook with failurePolicy Fail exhausted its retries.
+	ReasonHookFailed string = "HookFailed"
+)
+
+// Condition reasons for DPFHCPBridge AdditionalManifestsApplied status.
+// These are used as the Reason field in the AdditionalManifestsApplied condition.
+const (
+	// ReasonManifestsApplied indicates all additional manifests were applied into the hosted cluster.
+	ReasonManifestsApplied string = "Applied"
+
+	// ReasonManifestsConfigMapNotFound indicates a referenced ConfigMap does not exist.
+	ReasonManifestsConfigMapNotFound string = "ConfigMapNotFound"
+
+	// ReasonManifestsInvalid indicates a referenced ConfigMap contains data that is not a valid manifest.
+	ReasonManifestsInvalid string = "InvalidManifest"
+
+	// ReasonManifestsKubeconfigPending indicates waiting for Hypershift to create the admin kubeconfig secret.
+	ReasonManifestsKubeconfigPending string = "KubeconfigPending"
+
+	// ReasonManifestsApplyFailed indicates applying a manifest into the hosted cluster failed.
+	ReasonManifestsApplyFailed string = "ApplyFailed"
+
+	// ReasonManifestsCRDPending indicates the hosted cluster does not serve the kind of a manifest yet,
+	// e.g. until an operator installed by the manifests created its CRDs.
+	ReasonManifestsCRDPending string = "CRDPending"
+)
+
+// Condition reasons for DPFHCPBridge ResourceConflict status.
+// These are used as the Reason field in the ResourceConflict condition.
+const (
+	// ReasonNoResourceConflict indicates the HostedCluster and NodePool names are free or owned by the bridge.
+	ReasonNoResourceConflict string = "NoConflict"
+
+	// ReasonHostedClusterConflict indicates a HostedCluster with the bridge's name is owned by something else.
+	ReasonHostedClusterConflict string = "HostedClusterConflict"
+
+	// ReasonNodePoolConflict indicates a NodePool with the bridge's name is owned by something else.
+	ReasonNodePoolConflict string = "NodePoolConflict"
+)
+
+// Condition reasons for DPFHCPBridge NodePoolVersionSkewSupported status.
+// These are used as the Reason field in the NodePoolVersionSkewSupported condition.
+const (
+	// ReasonVersionSkewSupported indicates all NodePools are within the supported version skew, or their skew is unknown.
+	ReasonVersionSkewSupported string = "SkewSupported"
+
+	// ReasonVersionSkewUnsupported indicates a NodePool is newer than the control plane or too far behind it.
+	// Its release image is not changed, or it is not created, until the skew is supported.
+	ReasonVersionSkewUnsupported string = "UnsupportedSkew"
+)
+
+// Condition reasons for DPFHCPBridge InfraEnvReady status.
+// These are used as the Reason field in the InfraEnvReady condition.
+const (
+	// ReasonDiscoveryImageCreated indicates the discovery image of the InfraEnv can be downloaded.
+	ReasonDiscoveryImageCreated string = "ImageCreated"
+
+	// ReasonDiscoveryImagePending indicates assisted-service has not created the discovery image yet.
+	ReasonDiscoveryImagePending string = "ImagePending"
+
+	// ReasonDiscoveryImageFailed indicates assisted-service failed to create the discovery image.
+	ReasonDiscoveryImageFailed string = "ImageCreationFailed"
+
+	// ReasonInfraEnvAPIUnavailable indicates the InfraEnv API of assisted-service is not installed.
+	ReasonInfraEnvAPIUnavailable string = "InfraEnvAPIUnavailable"
+)
+
+// Condition reasons for DPFHCPBridge BFBReady status.
+// These are used as the Reason field in the BFBReady condition.
+const (
+	// ReasonBFBDownloaded indicates DPF downloaded the BFB in every DPUCluster namespace.
+	ReasonBFBDownloaded string = "BFBDownloaded"
+
+	// ReasonBFBDownloading indicates DPF has not finished downloading the BFB yet.
+	ReasonBFBDownloading string = "BFBDownloading"
+
+	// ReasonBFBDownloadFailed indicates DPF failed to download the BFB.
+	ReasonBFBDownloadFailed string = "BFBDownloadFailed"
+
+	// ReasonBFBURLInvalid indicates the BFB URL rendered for the BlueField image is not a valid BFB URL.
+	ReasonBFBURLInvalid string = "BFBURLInvalid"
+)
+
+// Condition reasons for DPFHCPBridge PullSecretRolledOut status.
+// These are used as the Reason field in the PullSecretRolledOut condition.
+const (
+	// ReasonPullSecretRolledOut indicates every NodePool finished rolling out the rotated pull secret.
+	ReasonPullSecretRolledOut string = "PullSecretRolledOut"
+
+	// ReasonPullSecretRollingOut indicates the HostedCluster references the rotated pull secret and
+	// its NodePools are still rolling it out to the nodes.
+	ReasonPullSecretRollingOut string = "PullSecretRollingOut"
+)
+
+// Condition reasons for DPFHCPBridge NodePortAddressReady status.
+// These are used as the Reason field in the NodePortAddressReady condition.
+const (
+	// ReasonNodeReady indicates the node of the address the services are published on is Ready.
+	ReasonNodeReady string = "NodeReady"
+
+	// ReasonNoReadyNode indicates no node of spec.nodePortAddresses is Ready, so the services stay on their address.
+	ReasonNoReadyNode string = "NoReadyNode"
+
+	// ReasonNodePortSwitchRejected indicates the HostedCluster could not be switched to the address of a Ready node.
+	ReasonNodePortSwitchRejected string = "SwitchRejected"
+)
+
+// Condition reasons for DPFHCPBridge HostedClusterAdmitted status.
+// These are used as the Reason field in the HostedClusterAdmitted condition.
+const (
+	// ReasonHostedClusterAdmitted indicates the HostedCluster was created after an earlier rejection.
+	ReasonHostedClusterAdmitted string = "Admitted"
+
+	// ReasonHostedClusterSpecSanitized indicates the HostedCluster was created after the fields admission
+	// rejected were sanitized or dropped.
+	ReasonHostedClusterSpecSanitized string = "Sanitized"
+
+	// ReasonInvalidSubjectAltName indicates admission rejected a hostname the API server certificate is issued
+	// for, and it could not be sanitized.
+	ReasonInvalidSubjectAltName string = "InvalidSubjectAltName"
+
+	// ReasonUnsupportedField indicates admission rejected a field the HyperShift version does not support,
+	// and it could not be dropped.
+	ReasonUnsupportedField string = "UnsupportedField"
+
+	// ReasonAdmissionRejected indicates admission rejected the spec for any other reason.
+	ReasonAdmissionRejected string = "AdmissionRejected"
+)
+
+// Condition reasons for the DPFHCPBridge provisioning stage timeout conditions.
+// These are used as the Reason field in the HostedClusterAvailableTimedOut, FirstNodeJoinTimedOut and
+// NodePoolReadyTimedOut conditions.
+const (
+	// ReasonStageInProgress indicates the stage has started and is within its timeout.
+	ReasonStageInProgress string = "InProgress"
+
+	// ReasonStageTimedOut indicates the stage overran its timeout and has not completed yet.
+	ReasonStageTimedOut string = "TimedOut"
+
+	// ReasonStageCompleted indicates the stage completed; the condition is no longer updated.
+	ReasonStageCompleted string = "Completed"
+)
+
+// Condition reasons for DPFHCPBridge UpgradeRevalidated status.
+// These are used as the Reason field in the UpgradeRevalidated condition.
+const (
+	// ReasonPreflightsPassed indicates all preflight checks pass under the running operator version.
+	ReasonPreflightsPassed string = "PreflightsPassed"
+
+	// ReasonPreflightsFailed indicates at least one preflight check fails under the running operator version.
+	ReasonPreflightsFailed string = "PreflightsFailed"
+)
+
+// Condition reasons for DPFHCPBridge DependenciesAvailable status.
+// These are used as the Reason field in the DependenciesAvailable condition.
+const (
+	// ReasonCircuitClosed indicates requests to all dependencies are let through.
+	ReasonCircuitClosed string = "CircuitClosed"
+
+	// ReasonCircuitOpen indicates a dependency keeps failing and all bridges back off from it.
+	ReasonCircuitOpen string = "CircuitOpen"
+)
+
+// Condition reasons for DPFHCPBridge CRDSchemaCompatible status.
+// These are used as the Reason field in the CRDSchemaCompatible condition.
+const (
+	// ReasonCRDSchemaMatches indicates the installed CRD matches the API types of the operator.
+	ReasonCRDSchemaMatches string = "SchemaMatches"
+
+	// ReasonCRDSchemaMismatch indicates the installed CRD lacks an API version or field of the operator, or has
+	// fields the operator does not know, e.g. after a partial upgrade.
+	ReasonCRDSchemaMismatch string = "SchemaMismatch"
+)
+
+// Condition reasons for DPFHCPBridge Paused status.
+// These are used as the Reason field in the Paused condition.
+const (
+	// ReasonPausedUntil indicates reconciliation of the HostedCluster is paused until status.pausedUntil.
+	ReasonPausedUntil string = "PausedUntil"
+
+	// ReasonPausedIndefinitely indicates reconciliation of the HostedCluster is paused until spec.pausedUntil is removed.
+	ReasonPausedIndefinitely string = "PausedIndefinitely"
+
+	// ReasonResumed indicates reconciliation of the HostedCluster is no longer paused.
+	ReasonResumed string = "Resumed"
+)
+
+// Condition reasons for DPFHCPBridge ReadOnly status.
+// These are used as the Reason field in the ReadOnly condition.
+const (
+	// ReasonDryRun indicates the writes of the operator other than the status of its own resources are dry-runs.
+	ReasonDryRun string = "DryRun"
+)
+
+// AnnotationAdoptExisting marks a DPFHCPBridge created for a pre-existing HostedCluster.
+// When set to "true", the operator takes ownership of an existing HostedCluster, NodePool and
+// their secrets that are not controlled by any object, instead of reporting a name conflict.
+const AnnotationAdoptExisting = "provisioning.dpu.hcp.io/adopt-existing"
+
+// AnnotationPreviewCleanup asks for a preview of the finalizer cleanup of a DPFHCPBridge.
+// While set to "true", the operator lists the resources deleting the bridge would delete, in the order the
+// finalizer deletes them, in status.cleanupPreview and a CleanupPreview event.
+const AnnotationPreviewCleanup = "provisioning.dpu.hcp.io/preview-cleanup"
+
+// AnnotationRetainOnDelete marks a Secret that must outlive the DPFHCPBridges using it, e.g. a pull secret or
+// SSH key managed by an external system (ExternalSecrets, a vault) that a bridge adopted. When set to "true" on
+// a Secret owned by a bridge, the finalizer releases the Secret instead of deleting it: its ownership labels,
+// back-references and owner reference to the bridge are removed.
+const AnnotationRetainOnDelete = "provisioning.dpu.hcp.io/retain-on-delete"
+
+// AnnotationApproved approves the provisioning of a DPFHCPBridge when the operator requires external approval,
+// e.g. by a change-management system once the change was approved. Its value identifies the approval, such as a
+// change ticket, and is reported in the ExternallyApproved condition; an empty value or "false" does not approve.
+const AnnotationApproved = "provisioning.dpu.hcp.io/approved"
+
+// AnnotationRenderManifests asks for the manifests the operator would create for a DPFHCPBridge, e.g. for a
+// GitOps review. While set to "true", the operator writes the HostedCluster, NodePools and built-in hosted
+// cluster manifests of the bridge to the <name>-rendered-manifests ConfigMap and, if the HostedCluster was
+// not created yet, holds its provisioning back.
+const AnnotationRenderManifests = "provisioning.dpu.hcp.io/render-manifests"
+
+// LabelAgentBridge is set on the Agents discovered through the InfraEnv of a DPFHCPBridge with
+// spec.platform Agent to the name of the bridge; its NodePools select the Agents by this label
+const LabelAgentBridge = "provisioning.dpu.hcp.io/bridge"
+
+// Node labels set by the NodePools of a DPFHCPBridge with spec.dpuClusterRefs, telling which
+// DPUCluster the DPU worker nodes belong to
+const (
+	// LabelDPUCluster is the name of the DPUCluster the node belongs to
+	LabelDPUCluster = "provisioning.dpu.hcp.io/dpucluster"
+
+	// LabelDPUClusterNamespace is the namespace of the DPUCluster the node belongs to
+	LabelDPUClusterNamespace = "provisioning.dpu.hcp.io/dpucluster-namespace"
+)
+
+// Annotations on a DPUCluster that provide per-site defaults for the DPFHCPBridges created for it,
+// typically set by the DPF installer. They fill unset spec fields when a DPFHCPBridge is created.
+const (
+	// AnnotationDefaultBaseDomain is the default spec.baseDomain, e.g. the site DNS suffix
+	AnnotationDefaultBaseDomain = "provisioning.dpu.hcp.io/default-base-domain"
+
+	// AnnotationDefaultVirtualIPs is a comma-separated pool of virtual IPs; a HighlyAvailable bridge
+	// without spec.virtualIP gets the first one not used by another DPFHCPBridge
+	AnnotationDefaultVirtualIPs = "provisioning.dpu.hcp.io/default-virtual-ips"
+
+	// AnnotationDefaultSizeProfile is the default spec.sizeProfile
+	AnnotationDefaultSizeProfile = "provisioning.dpu.hcp.io/default-size-profile"
+)
+
+// NodePoolStatus reports the observed state of the NodePool created for the DPFHCPBridge
+type NodePoolStatus struct {
+	// Name is the name of the NodePool in spec.nodePools, or of its DPUCluster in spec.dpuClusterRefs, empty for the default NodePool
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Replicas is the desired number of nodes set on the NodePool
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// ReadyReplicas is the number of nodes HyperShift reports in the NodePool
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// UpdatedReplicas is the number of machines of the NodePool running its current config and release,
+	// read from the Cluster API MachineDeployment of the NodePool. Unset if HyperShift manages no machines
+	// for the NodePool, e.g. on platform None.
+	// +optional
+	UpdatedReplicas *int32 `json:"updatedReplicas,omitempty"`
+
+	// Version is the OCP version of the NodePool release image, empty if it is referenced by digest
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// CurrentVersion is the OCP version HyperShift reports the nodes of the NodePool run,
+	// empty until the NodePool rolled out its first release
+	// +optional
+	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// MinorVersionSkew is the number of minor versions the NodePool is behind the control plane,
+	// negative if it is ahead. Unset if either version is unknown.
+	// +optional
+	MinorVersionSkew *int32 `json:"minorVersionSkew,omitempty"`
+
+	// Conditions mirrors the UpdatingConfig and UpdatingVersion conditions of the NodePool,
+	// True while HyperShift rolls out a new config or release to its nodes
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// DNSRecord is a DNS record the hosted cluster needs, created by the user in the site DNS
+type DNSRecord struct {
+	// Name is the fully qualified name of the record, e.g. *.apps.my-cluster.example.com
+	Name string `json:"name"`
+
+	// Type is the record type, A for an IPv4 and AAAA for an IPv6 address
+	// +kubebuilder:validation:Enum=A;AAAA
+	Type string `json:"type"`
+
+	// Address is the IP address the record resolves to
+	Address string `json:"address"`
+}
+
+// IgnitionStatus reports where the NodePool boot artifacts generated by HyperShift can be found
+type IgnitionStatus struct {
+	// Endpoint is the ignition server endpoint nodes fetch their configuration from
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// CASecretRef is the Secret in the hosted control plane namespace holding the ignition server CA
+	// Its "tls.crt" key holds the certificate nodes verify the ignition server with.
+	// +optional
+	CASecretRef *corev1.SecretReference `json:"caSecretRef,omitempty"`
+
+	// UserDataSecretRef is the current user-data Secret of the NodePool in the hosted control plane namespace
+	// Its "value" key holds the ignition stub pointing nodes to the ignition server.
+	// +optional
+	UserDataSecretRef *corev1.SecretReference `json:"userDataSecretRef,omitempty"`
+
+	// TokenSecretRef is the current ignition token Secret of the NodePool in the hosted control plane namespace
+	// Its "token" key holds the token nodes authenticate to the ignition server with.
+	// +optional
+	TokenSecretRef *corev1.SecretReference `json:"tokenSecretRef,omitempty"`
+
+	// TokenExpirationTime is when HyperShift expires the current token, if it has been scheduled for rotation
+	// +optional
+	TokenExpirationTime *metav1.Time `json:"tokenExpirationTime,omitempty"`
+
+	// PublishedSecretRef is the copy of the boot artifacts in the bridge namespace, set when
+	// spec.publishIgnitionSecret is true
+	// +optional
+	PublishedSecretRef *corev1.LocalObjectReference `json:"publishedSecretRef,omitempty"`
+}
+
+// SecretCopyStatus records which version of a source Secret was copied for the HostedCluster, and when
+type SecretCopyStatus struct {
+	// Name is the name of the copy in the DPFHCPBridge namespace
+	Name string `json:"name"`
+
+	// SourceName is the name of the Secret the data was copied from
+	SourceName string `json:"sourceName"`
+
+	// SourceResourceVersion is the resourceVersion of the source Secret at copy time.
+	// Empty for copies made before the operator recorded it.
+	// +optional
+	SourceResourceVersion string `json:"sourceResourceVersion,omitempty"`
+
+	// DataHash is the SHA-256 hash of the copied data, computed over the sorted keys and their values
+	// +optional
+	DataHash string `json:"dataHash,omitempty"`
+
+	// LastSyncTime is when the data was last copied from the source Secret
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// PullSecretRolloutStatus tracks the rollout of a rotated pull secret to the hosted cluster
+type PullSecretRolloutStatus struct {
+	// SecretName is the copy of the rotated pull secret in the DPFHCPBridge namespace the HostedCluster is
+	// switched to
+	SecretName string `json:"secretName"`
+
+	// DataHash is the SHA-256 hash of the rotated pull secret data
+	DataHash string `json:"dataHash"`
+
+	// StartTime is when the HostedCluster was switched to SecretName
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when every NodePool finished rolling out SecretName, unset while the rollout is in progress
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// NodePortAddressStatus reports the node address the control plane services are published on in NodePort mode
+type NodePortAddressStatus struct {
+	// Address is the address the HostedCluster publishes its services on
+	Address string `json:"address"`
+
+	// Node is the management cluster node Address belongs to, empty if no node has it
+	// +optional
+	Node string `json:"node,omitempty"`
+
+	// PreviousAddress is the address the services were published on before the last switch
+	// +optional
+	PreviousAddress string `json:"previousAddress,omitempty"`
+
+	// LastSwitchTime is when the HostedCluster was last switched to another address
+	// +optional
+	LastSwitchTime *metav1.Time `json:"lastSwitchTime,omitempty"`
+}
+
+// CleanupPreviewStep is a step of the finalizer cleanup of a DPFHCPBridge
+type CleanupPreviewStep struct {
+	// Handler is the name of the cleanup handler running the step, e.g. hostedcluster
+	Handler string `json:"handler"`
+
+	// Resources are the resources the step deletes, in order; empty if it deletes none, e.g. pre-delete hooks
+	// +listType=atomic
+	// +optional
+	Resources []CleanupResource `json:"resources,omitempty"`
+}
+
+// CleanupResource identifies a resource deleted by the finalizer cleanup of a DPFHCPBridge
+type CleanupResource struct {
+	// Kind is the kind of the resource, e.g. HostedCluster
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the resource
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the resource
+	Name string `json:"name"`
+}
+
+// ReleaseImagePin records the digest a release image was pinned to
+type ReleaseImagePin struct {
+	// Image is the release image that was pinned: spec.ocpReleaseImage, or the image resolved
+	// from spec.releaseCatalogRef
+	Image string `json:"image"`
+
+	// Digest is the manifest digest Image referred to when it was pinned, e.g. sha256:...
+	Digest string `json:"digest"`
+
+	// SignatureVerified is true if a signature of Digest was verified against the configured keys
+	// +optional
+	SignatureVerified bool `json:"signatureVerified,omitempty"`
+}
+
+// ChannelRelease is a release chosen from an OpenShift update channel
+type ChannelRelease struct {
+	// Channel is the update channel the release was chosen from, e.g. stable-4.17
+	Channel string `json:"channel"`
+
+	// Version is the OCP version of the release, e.g. 4.17.12
+	Version string `json:"version"`
+
+	// Image is the release image of the version, as listed in the update graph
+	Image string `json:"image"`
+
+	// LastCheckTime is when the update graph was last checked for a newer release
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+}
+
+// ReconcileError is an error a reconcile of the DPFHCPBridge failed with
+type ReconcileError struct {
+	// Step is the reconcile step that failed, e.g. HostedClusterCreation
+	Step string `json:"step"`
+
+	// Message is the error message
+	Message string `json:"message"`
+
+	// Time is when the reconcile failed
+	Time metav1.Time `json:"time"`
+}
+
 // DPFHCPBridgeStatus defines the observed state of DPFHCPBridge
 type DPFHCPBridgeStatus struct {
 	// Phase represents the current lifecycle phase
 	// +optional
 	Phase DPFHCPBridgePhase `json:"phase,omitempty"`
 
+	// ObservedGeneration is the generation of the DPFHCPBridge last fully reconciled; the phase and the
+	// Ready condition describe the current spec once it equals metadata.generation
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// SpecChecksum is the checksum of the normalized spec of the observed generation, e.g. sha256:9f86d0...
+	// Fields identifying the bridge, such as its DPUClusters, base domain and addresses, are left out and
+	// defaults are filled in, so that bridges running the same fleet configuration have the same checksum.
+	// +optional
+	SpecChecksum string `json:"specChecksum,omitempty"`
+
 	// Conditions represent the latest available observations of the DPFHCPBridge's state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -238,22 +1495,145 @@ type DPFHCPBridgeStatus struct {
 	// +optional
 	HostedClusterRef *corev1.ObjectReference `json:"hostedClusterRef,omitempty"`
 
+	// DPUClusterRef is the DPUCluster resolved from spec.dpuClusterSelector
+	// +optional
+	DPUClusterRef *DPUClusterReference `json:"dpuClusterRef,omitempty"`
+
 	// KubeConfigSecretRef is a reference to the created kubeconfig Secret in the DPUCluster's namespace
 	// +optional
 	KubeConfigSecretRef *corev1.LocalObjectReference `json:"kubeConfigSecretRef,omitempty"`
 
+	// APIEndpoint is the URL of the API server of the hosted cluster, e.g. https://api.my-cluster.example.com:6443
+	// Set once the HostedCluster publishes its control plane endpoint.
+	// +optional
+	APIEndpoint string `json:"apiEndpoint,omitempty"`
+
+	// ConsoleURL is the URL of the web console of the hosted cluster, on the ingress domain of the hosted cluster
+	// Set once the HostedCluster is Available.
+	// +optional
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	// OAuthEndpoint is the URL of the OAuth server of the hosted cluster
+	// Set once the HostedCluster publishes its OAuth callback URL.
+	// +optional
+	OAuthEndpoint string `json:"oauthEndpoint,omitempty"`
+
 	// BlueFieldContainerImage is the resolved BlueField container image URL
 	// +optional
 	BlueFieldContainerImage string `json:"blueFieldContainerImage,omitempty"`
+
+	// BFBName is the name of the DPF BFB created from the BlueField image in the namespace of each
+	// DPUCluster, for DPUSets to reference
+	// +optional
+	BFBName string `json:"bfbName,omitempty"`
+
+	// OCPVersion is the OCP version extracted from ocpReleaseImage and used to resolve the BlueField image
+	// +optional
+	OCPVersion string `json:"ocpVersion,omitempty"`
+
+	// OCPReleaseImage is the release image resolved from spec.releaseCatalogRef or spec.channel
+	// +optional
+	OCPReleaseImage string `json:"ocpReleaseImage,omitempty"`
+
+	// ChannelRelease is the release last chosen from spec.channel
+	// +optional
+	ChannelRelease *ChannelRelease `json:"channelRelease,omitempty"`
+
+	// ReleaseImageDigest is the digest of the OCP release image the HostedCluster runs, e.g. sha256:...
+	// It is only set once the release image is known by digest, either because ocpReleaseImage
+	// is pinned by digest or because HyperShift reports the resolved image
+	// +optional
+	ReleaseImageDigest string `json:"releaseImageDigest,omitempty"`
+
+	// PinnedReleaseImage is the digest the release image was pinned to before it was rolled out,
+	// so that moving its tag does not change what the HostedCluster and NodePools install
+	// +optional
+	PinnedReleaseImage *ReleaseImagePin `json:"pinnedReleaseImage,omitempty"`
+
+	// NodePoolStatus reports the observed state of the NodePool
+	// +optional
+	NodePoolStatus *NodePoolStatus `json:"nodePoolStatus,omitempty"`
+
+	// NodePools reports the observed state of the NodePools in spec.nodePools and of the NodePools of spec.dpuClusterRefs
+	// +optional
+	NodePools []NodePoolStatus `json:"nodePools,omitempty"`
+
+	// Ignition reports the NodePool user-data and ignition token Secrets, for booting DPUs out-of-band
+	// +optional
+	Ignition *IgnitionStatus `json:"ignition,omitempty"`
+
+	// IngressDNSRecord is the wildcard DNS record the *.apps routes of the hosted cluster need, resolving
+	// to spec.ingressVIP; unset when spec.ingressVIP is not set
+	// +optional
+	IngressDNSRecord *DNSRecord `json:"ingressDNSRecord,omitempty"`
+
+	// NodePortAddress reports the node address the control plane services are published on and its last
+	// switch; only set when spec.nodePortAddresses is set
+	// +optional
+	NodePortAddress *NodePortAddressStatus `json:"nodePortAddress,omitempty"`
+
+	// PausedUntil is when the paused reconciliation of the HostedCluster resumes; unset while it is not
+	// paused or paused indefinitely
+	// +optional
+	PausedUntil *metav1.Time `json:"pausedUntil,omitempty"`
+
+	// PreDeleteHooks reports the execution state of the pre-delete hooks
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	PreDeleteHooks []HookStatus `json:"preDeleteHooks,omitempty"`
+
+	// CleanupPreview lists the steps of the finalizer cleanup and the resources each of them deletes, in the
+	// order they run; only set while the provisioning.dpu.hcp.io/preview-cleanup annotation is "true"
+	// +listType=atomic
+	// +optional
+	CleanupPreview []CleanupPreviewStep `json:"cleanupPreview,omitempty"`
+
+	// PostProvisionHooks reports the execution state of the post-provision hooks
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	PostProvisionHooks []HookStatus `json:"postProvisionHooks,omitempty"`
+
+	// SecretCopies is the audit trail of the pull secret and SSH key copied for the HostedCluster
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	SecretCopies []SecretCopyStatus `json:"secretCopies,omitempty"`
+
+	// PullSecretRollout tracks the rollout of the pull secret after its last rotation; unset until the
+	// referenced pull secret is changed for the first time, unless the bridge was created with the shared copy
+	// of its pull secret (operator flag --shared-pull-secrets)
+	// +optional
+	PullSecretRollout *PullSecretRolloutStatus `json:"pullSecretRollout,omitempty"`
+
+	// AdditionalManifestsHash is the hash of the additional manifests last applied into the hosted cluster
+	// +optional
+	AdditionalManifestsHash string `json:"additionalManifestsHash,omitempty"`
+
+	// ValidatedOperatorVersion is the operator version the spec was last revalidated against after an upgrade
+	// +optional
+	ValidatedOperatorVersion string `json:"validatedOperatorVersion,omitempty"`
+
+	// LastError is the error the last failed reconcile stopped at, so that errors the operator retries
+	// are visible without access to its logs. It is cleared once a reconcile succeeds.
+	// +optional
+	LastError *ReconcileError `json:"lastError,omitempty"`
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.nodePoolReplicas,statuspath=.status.nodePoolStatus.readyReplicas
 // +kubebuilder:resource:scope=Namespaced,shortName=dpfhcp
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="HostedCluster",type=string,JSONPath=`.status.hostedClusterRef.name`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.ocpVersion`,priority=1
+// +kubebuilder:printcolumn:name="API",type=string,JSONPath=`.status.apiEndpoint`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:validation:XValidation:rule="has(self.spec.dpuClusterRef) || has(self.spec.dpuClusterSelector) || has(self.spec.dpuClusterRefs) || has(self.spec.bridgePoolRef)",message="exactly one of dpuClusterRef, dpuClusterSelector and dpuClusterRefs must be set"
+// +kubebuilder:validation:XValidation:rule="self.spec.controlPlaneAvailabilityPolicy != 'HighlyAvailable' || (has(self.spec.virtualIP) && size(self.spec.virtualIP) > 0)",message="virtualIP is required when controlPlaneAvailabilityPolicy is HighlyAvailable"
 
 // DPFHCPBridge is the Schema for the dpfhcpbridges API
 type DPFHCPBridge struct {
@@ -298,6 +1678,103 @@ func (b *DPFHCPBridge) ShouldExposeThroughLoadBalancer() bool {
 	return false
 }
 
+// ResolvedDPUClusterRef returns the DPUCluster the DPFHCPBridge refers to: spec.dpuClusterRef, the
+// DPUCluster resolved from spec.dpuClusterSelector, or the first of spec.dpuClusterRefs. It returns an
+// empty reference while the selector has not been resolved yet.
+func (b *DPFHCPBridge) ResolvedDPUClusterRef() DPUClusterReference {
+	if len(b.Spec.DPUClusterRefs) > 0 {
+		return b.Spec.DPUClusterRefs[0]
+	}
+	if b.Spec.DPUClusterSelector == nil {
+		return b.Spec.DPUClusterRef
+	}
+	if b.Status.DPUClusterRef == nil {
+		return DPUClusterReference{}
+	}
+	return *b.Status.DPUClusterRef
+}
+
+// ResolvedDPUClusterRefs returns all the DPUClusters the DPFHCPBridge refers to, starting with
+// ResolvedDPUClusterRef. It returns nil while the DPUCluster is not known yet.
+func (b *DPFHCPBridge) ResolvedDPUClusterRefs() []DPUClusterReference {
+	if len(b.Spec.DPUClusterRefs) > 0 {
+		return b.Spec.DPUClusterRefs
+	}
+	if ref := b.ResolvedDPUClusterRef(); ref.Name != "" {
+		return []DPUClusterReference{ref}
+	}
+	return nil
+}
+
+// RefersToDPUCluster returns true if the DPUCluster is one of ResolvedDPUClusterRefs
+func (b *DPFHCPBridge) RefersToDPUCluster(name, namespace string) bool {
+	for _, ref := range b.ResolvedDPUClusterRefs() {
+		if ref.Name == name && ref.Namespace == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// PullSecretCopyName returns the name of the pull secret copy the HostedCluster and InfraEnv reference:
+// the copy of the last rotation or the shared copy, or <name>-pull-secret before the pull secret was rotated
+func (b *DPFHCPBridge) PullSecretCopyName() string {
+	if b.Status.PullSecretRollout != nil && b.Status.PullSecretRollout.SecretName != "" {
+		return b.Status.PullSecretRollout.SecretName
+	}
+	return b.Name + "-pull-secret"
+}
+
+// ResolvedOCPReleaseImage returns the OCP release image of the DPFHCPBridge: spec.ocpReleaseImage, or
+// the image resolved from spec.releaseCatalogRef or spec.channel. It returns an empty string while the
+// catalog entry or channel has not been resolved yet.
+func (b *DPFHCPBridge) ResolvedOCPReleaseImage() string {
+	if b.Spec.ReleaseCatalogRef == nil && b.Spec.Channel == "" {
+		return b.Spec.OCPReleaseImage
+	}
+	return b.Status.OCPReleaseImage
+}
+
+// PinnedOCPReleaseImage returns the release image rolled out to the HostedCluster and NodePools.
+// When ResolvedOCPReleaseImage was pinned, it is referenced by the pinned digest. It returns ""
+// while pinning it fails, so that the running release is kept.
+func (b *DPFHCPBridge) PinnedOCPReleaseImage() string {
+	image := b.ResolvedOCPReleaseImage()
+	if pin := b.Status.PinnedReleaseImage; pin != nil && image != "" && pin.Image == image {
+		repository, _, _ := strings.Cut(image, "@")
+		if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+			repository = repository[:i]
+		}
+		return repository + "@" + pin.Digest
+	}
+	if meta.IsStatusConditionFalse(b.Status.Conditions, ReleaseImagePinned) {
+		return ""
+	}
+	return image
+}
+
+// IsSpare returns true if the DPFHCPBridge is a BridgePool spare that has not been claimed yet,
+// i.e. it has no DPUCluster to bind to
+func (b *DPFHCPBridge) IsSpare() bool {
+	return b.Spec.BridgePoolRef != nil && b.Spec.DPUClusterRef == (DPUClusterReference{}) && b.Spec.DPUClusterSelector == nil &&
+		len(b.Spec.DPUClusterRefs) == 0
+}
+
+// AdoptsExisting returns true if the DPFHCPBridge is in adoption mode (see AnnotationAdoptExisting)
+func (b *DPFHCPBridge) AdoptsExisting() bool {
+	return b.Annotations[AnnotationAdoptExisting] == "true"
+}
+
+// RetainedOnDelete returns true if the object is marked to be kept when the DPFHCPBridge owning it is deleted
+func RetainedOnDelete(obj metav1.Object) bool {
+	return obj.GetAnnotations()[AnnotationRetainOnDelete] == "true"
+}
+
+// UsesAgentPlatform returns true if DPU workers are discovered by assisted-service (spec.platform Agent)
+func (b *DPFHCPBridge) UsesAgentPlatform() bool {
+	return b.Spec.Platform == PlatformAgent
+}
+
 // IsVIPRequired determines if VirtualIP is required for the given configuration
 // Returns true if ControlPlaneAvailabilityPolicy is HighlyAvailable
 func (b *DPFHCPBridge) IsVIPRequired() bool {
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -345,4 +346,144 @@ var _ = Describe("DPFHCPBridge API Types", func() {
 			Expect(copied.Spec.NodeSelector["new-key"]).To(Equal("new-value"))
 		})
 	})
+
+	Context("ResolvedDPUClusterRef", func() {
+		It("should return spec.dpuClusterRef when no selector is set", func() {
+			bridge := &DPFHCPBridge{Spec: DPFHCPBridgeSpec{
+				DPUClusterRef: DPUClusterReference{Name: "dpu", Namespace: "dpu-system"},
+			}}
+			Expect(bridge.ResolvedDPUClusterRef()).To(Equal(DPUClusterReference{Name: "dpu", Namespace: "dpu-system"}))
+		})
+
+		It("should return the resolved DPUCluster for a selector", func() {
+			bridge := &DPFHCPBridge{Spec: DPFHCPBridgeSpec{
+				DPUClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"site": "lab-1"}},
+			}}
+			Expect(bridge.ResolvedDPUClusterRef()).To(Equal(DPUClusterReference{}))
+
+			bridge.Status.DPUClusterRef = &DPUClusterReference{Name: "dpu-x7k2p", Namespace: "dpu-system"}
+			Expect(bridge.ResolvedDPUClusterRef()).To(Equal(DPUClusterReference{Name: "dpu-x7k2p", Namespace: "dpu-system"}))
+		})
+
+		It("should return the first of several DPUClusters", func() {
+			bridge := &DPFHCPBridge{Spec: DPFHCPBridgeSpec{
+				DPUClusterRefs: []DPUClusterReference{
+					{Name: "dpu-east", Namespace: "dpf-east"},
+					{Name: "dpu-west", Namespace: "dpf-west"},
+				},
+			}}
+			Expect(bridge.ResolvedDPUClusterRef()).To(Equal(DPUClusterReference{Name: "dpu-east", Namespace: "dpf-east"}))
+		})
+	})
+
+	Context("ResolvedDPUClusterRefs", func() {
+		It("should return every DPUCluster of the bridge", func() {
+			bridge := &DPFHCPBridge{Spec: DPFHCPBridgeSpec{
+				DPUClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"site": "lab-1"}},
+			}}
+			Expect(bridge.ResolvedDPUClusterRefs()).To(BeNil())
+
+			bridge.Status.DPUClusterRef = &DPUClusterReference{Name: "dpu-x7k2p", Namespace: "dpu-system"}
+			Expect(bridge.ResolvedDPUClusterRefs()).To(Equal([]DPUClusterReference{{Name: "dpu-x7k2p", Namespace: "dpu-system"}}))
+
+			bridge = &DPFHCPBridge{Spec: DPFHCPBridgeSpec{
+				DPUClusterRefs: []DPUClusterReference{
+					{Name: "dpu-east", Namespace: "dpf-east"},
+					{Name: "dpu-west", Namespace: "dpf-west"},
+				},
+			}}
+			Expect(bridge.ResolvedDPUClusterRefs()).To(HaveLen(2))
+			Expect(bridge.RefersToDPUCluster("dpu-west", "dpf-west")).To(BeTrue())
+			Expect(bridge.RefersToDPUCluster("dpu-west", "dpf-east")).To(BeFalse())
+		})
+	})
+
+	Context("ResolvedOCPReleaseImage", func() {
+		It("should return spec.ocpReleaseImage when no catalog entry is referenced", func() {
+			bridge := &DPFHCPBridge{Spec: DPFHCPBridgeSpec{OCPReleaseImage: "quay.io/ocp-release:4.19.0"}}
+			Expect(bridge.ResolvedOCPReleaseImage()).To(Equal("quay.io/ocp-release:4.19.0"))
+		})
+
+		It("should return the release image resolved from the catalog", func() {
+			bridge := &DPFHCPBridge{Spec: DPFHCPBridgeSpec{
+				ReleaseCatalogRef: &ReleaseCatalogReference{Name: "production", Version: "4.19.1"},
+			}}
+			Expect(bridge.ResolvedOCPReleaseImage()).To(BeEmpty())
+
+			bridge.Status.OCPReleaseImage = "quay.io/ocp-release:4.19.1"
+			Expect(bridge.ResolvedOCPReleaseImage()).To(Equal("quay.io/ocp-release:4.19.1"))
+		})
+	})
+
+	Context("PinnedOCPReleaseImage", func() {
+		It("should reference the pinned digest of the current release image", func() {
+			bridge := &DPFHCPBridge{Spec: DPFHCPBridgeSpec{OCPReleaseImage: "registry.example.com:5000/ocp-release:4.19.0"}}
+			Expect(bridge.PinnedOCPReleaseImage()).To(Equal("registry.example.com:5000/ocp-release:4.19.0"))
+
+			bridge.Status.PinnedReleaseImage = &ReleaseImagePin{Image: "registry.example.com:5000/ocp-release:4.19.0", Digest: "sha256:abc"}
+			Expect(bridge.PinnedOCPReleaseImage()).To(Equal("registry.example.com:5000/ocp-release@sha256:abc"))
+		})
+
+		It("should ignore the pin of a previous release image", func() {
+			bridge := &DPFHCPBridge{Spec: DPFHCPBridgeSpec{OCPReleaseImage: "quay.io/ocp-release:4.19.1"}}
+			bridge.Status.PinnedReleaseImage = &ReleaseImagePin{Image: "quay.io/ocp-release:4.19.0", Digest: "sha256:abc"}
+			Expect(bridge.PinnedOCPReleaseImage()).To(Equal("quay.io/ocp-release:4.19.1"))
+		})
+
+		It("should return nothing while the release image cannot be pinned", func() {
+			bridge := &DPFHCPBridge{Spec: DPFHCPBridgeSpec{OCPReleaseImage: "quay.io/ocp-release:4.19.1"}}
+			bridge.Status.Conditions = []metav1.Condition{{Type: ReleaseImagePinned, Status: metav1.ConditionFalse}}
+			Expect(bridge.PinnedOCPReleaseImage()).To(BeEmpty())
+		})
+	})
+
+	Context("IsSpare", func() {
+		It("should only report unclaimed BridgePool spares", func() {
+			bridge := &DPFHCPBridge{}
+			Expect(bridge.IsSpare()).To(BeFalse())
+
+			bridge.Spec.BridgePoolRef = &corev1.LocalObjectReference{Name: "warm"}
+			Expect(bridge.IsSpare()).To(BeTrue())
+
+			bridge.Spec.DPUClusterRef = DPUClusterReference{Name: "dpu", Namespace: "dpf"}
+			Expect(bridge.IsSpare()).To(BeFalse())
+
+			bridge.Spec.DPUClusterRef = DPUClusterReference{}
+			bridge.Spec.DPUClusterSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"site": "lab-1"}}
+			Expect(bridge.IsSpare()).To(BeFalse())
+
+			bridge.Spec.DPUClusterSelector = nil
+			bridge.Spec.DPUClusterRefs = []DPUClusterReference{{Name: "dpu-east", Namespace: "dpf"}, {Name: "dpu-west", Namespace: "dpf"}}
+			Expect(bridge.IsSpare()).To(BeFalse())
+		})
+	})
+
+	Context("ReleaseCatalog", func() {
+		catalog := &ReleaseCatalog{Spec: ReleaseCatalogSpec{Releases: []ReleaseCatalogEntry{
+			{Version: "4.19.1", ReleaseImage: "quay.io/ocp-release:4.19.1", BlueFieldImage: "quay.io/bf:4.19.1"},
+		}}}
+
+		It("should look up entries by version and release image", func() {
+			Expect(catalog.Entry("4.19.1")).ToNot(BeNil())
+			Expect(catalog.Entry("4.18.0")).To(BeNil())
+			Expect(catalog.ListsReleaseImage("quay.io/ocp-release:4.19.1")).To(BeTrue())
+			Expect(catalog.ListsReleaseImage("quay.io/ocp-release:4.18.0")).To(BeFalse())
+		})
+
+		It("should check the support window", func() {
+			now := metav1.Now()
+			entry := ReleaseCatalogEntry{}
+			Expect(entry.Supports(now)).To(BeTrue())
+
+			entry.SupportedUntil = &metav1.Time{Time: now.Add(time.Hour)}
+			Expect(entry.Supports(now)).To(BeTrue())
+
+			entry.SupportedUntil = &now
+			Expect(entry.Supports(now)).To(BeFalse())
+
+			entry.SupportedUntil = nil
+			entry.SupportedFrom = &metav1.Time{Time: now.Add(time.Hour)}
+			Expect(entry.Supports(now)).To(BeFalse())
+		})
+	})
 })
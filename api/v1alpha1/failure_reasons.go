@@ -0,0 +1,64 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// FailureReason is a machine-readable category describing why a condition is in a failed state.
+// Condition Reason values stay specific to each feature (e.g. PullSecretMissing); every failing
+// Reason maps to exactly one FailureReason so fleet automation can branch on a small, stable set
+// of values instead of parsing condition messages.
+type FailureReason string
+
+const (
+	// FailureReasonSecretMissing indicates a referenced Secret does not exist.
+	FailureReasonSecretMissing FailureReason = "SecretMissing"
+
+	// FailureReasonSecretInvalid indicates a referenced Secret exists but has invalid content.
+	FailureReasonSecretInvalid FailureReason = "SecretInvalid"
+
+	// FailureReasonImageUnresolvable indicates the BlueField container image could not be determined.
+	FailureReasonImageUnresolvable FailureReason = "ImageUnresolvable"
+
+	// FailureReasonDependencyMissing indicates a referenced object (DPUCluster, ConfigMap, ...) does not exist.
+	FailureReasonDependencyMissing FailureReason = "DependencyMissing"
+
+	// FailureReasonDependencyNotReady indicates a dependent resource exists but is not yet usable.
+	FailureReasonDependencyNotReady FailureReason = "DependencyNotReady"
+
+	// FailureReasonAccessDenied indicates the operator lacks permissions to read or modify a resource.
+	FailureReasonAccessDenied FailureReason = "AccessDenied"
+
+	// FailureReasonInvalidConfiguration indicates the DPFHCPBridge spec or referenced data is invalid.
+	FailureReasonInvalidConfiguration FailureReason = "InvalidConfiguration"
+
+	// FailureReasonConflict indicates a resource is already claimed by another DPFHCPBridge.
+	FailureReasonConflict FailureReason = "Conflict"
+
+	// FailureReasonQuotaExceeded indicates a request was rejected because a quota or limit was exceeded.
+	FailureReasonQuotaExceeded FailureReason = "QuotaExceeded"
+
+	// FailureReasonTimeout indicates an operation did not complete within its allotted time.
+	FailureReasonTimeout FailureReason = "Timeout"
+
+	// FailureReasonHookFailed indicates a lifecycle hook with failurePolicy Fail did not succeed.
+	FailureReasonHookFailed FailureReason = "HookFailed"
+
+	// FailureReasonTransientError indicates a temporary API or network error that will be retried.
+	FailureReasonTransientError FailureReason = "TransientError"
+
+	// FailureReasonUnknown indicates a failed condition whose Reason is not in the catalog.
+	FailureReasonUnknown FailureReason = "Unknown"
+)
@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReleaseCatalogEntry is an approved OCP release and the BlueField image paired with it
+type ReleaseCatalogEntry struct {
+	// Version is the OCP version of the release, e.g. 4.19.1
+	// DPFHCPBridges select the entry by this version.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Version string `json:"version"`
+
+	// ReleaseImage is the full pull-spec URL of the OCP release image
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	ReleaseImage string `json:"releaseImage"`
+
+	// BlueFieldImage is the BlueField container image paired with the release
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	BlueFieldImage string `json:"blueFieldImage"`
+
+	// SupportedFrom is when the release becomes available for new DPFHCPBridges
+	// +optional
+	SupportedFrom *metav1.Time `json:"supportedFrom,omitempty"`
+
+	// SupportedUntil is when the release stops being available for new DPFHCPBridges
+	// Bridges that already run the release are not affected.
+	// +optional
+	SupportedUntil *metav1.Time `json:"supportedUntil,omitempty"`
+}
+
+// ReleaseCatalogSpec defines the approved OCP releases of a fleet
+type ReleaseCatalogSpec struct {
+	// Strict rejects DPFHCPBridges whose spec.ocpReleaseImage is not listed in any ReleaseCatalog
+	// +optional
+	Strict bool `json:"strict,omitempty"`
+
+	// Releases lists the approved releases
+	// +listType=map
+	// +listMapKey=version
+	// +optional
+	Releases []ReleaseCatalogEntry `json:"releases,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=relcat
+// +kubebuilder:printcolumn:name="Strict",type=boolean,JSONPath=`.spec.strict`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ReleaseCatalog is the Schema for the releasecatalogs API
+type ReleaseCatalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ReleaseCatalogSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReleaseCatalogList contains a list of ReleaseCatalog
+type ReleaseCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReleaseCatalog `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReleaseCatalog{}, &ReleaseCatalogList{})
+}
+
+// Entry returns the catalog entry of the given version, or nil if the catalog does not list it
+func (c *ReleaseCatalog) Entry(version string) *ReleaseCatalogEntry {
+	for i := range c.Spec.Releases {
+		if c.Spec.Releases[i].Version == version {
+			return &c.Spec.Releases[i]
+		}
+	}
+	return nil
+}
+
+// ListsReleaseImage returns true if one of the catalog entries has the given release image
+func (c *ReleaseCatalog) ListsReleaseImage(image string) bool {
+	for i := range c.Spec.Releases {
+		if c.Spec.Releases[i].ReleaseImage == image {
+			return true
+		}
+	}
+	return false
+}
+
+// Supports returns true if the entry is within its support window at the given time
+func (e *ReleaseCatalogEntry) Supports(now metav1.Time) bool {
+	if e.SupportedFrom != nil && now.Before(e.SupportedFrom) {
+		return false
+	}
+	if e.SupportedUntil != nil && !now.Before(e.SupportedUntil) {
+		return false
+	}
+	return true
+}
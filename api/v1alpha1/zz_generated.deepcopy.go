@@ -27,26 +27,99 @@ import (
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DPFHCPBridge) DeepCopyInto(out *DPFHCPBridge) {
+func (in *BlueFieldImageSet) DeepCopyInto(out *BlueFieldImageSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueFieldImageSet.
+func (in *BlueFieldImageSet) DeepCopy() *BlueFieldImageSet {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueFieldImageSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BlueFieldImageSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueFieldImageSetList) DeepCopyInto(out *BlueFieldImageSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BlueFieldImageSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueFieldImageSetList.
+func (in *BlueFieldImageSetList) DeepCopy() *BlueFieldImageSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueFieldImageSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BlueFieldImageSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueFieldImageSetSpec) DeepCopyInto(out *BlueFieldImageSetSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueFieldImageSetSpec.
+func (in *BlueFieldImageSetSpec) DeepCopy() *BlueFieldImageSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueFieldImageSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgePool) DeepCopyInto(out *BridgePool) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DPFHCPBridge.
-func (in *DPFHCPBridge) DeepCopy() *DPFHCPBridge {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgePool.
+func (in *BridgePool) DeepCopy() *BridgePool {
 	if in == nil {
 		return nil
 	}
-	out := new(DPFHCPBridge)
+	out := new(BridgePool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DPFHCPBridge) DeepCopyObject() runtime.Object {
+func (in *BridgePool) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -54,31 +127,31 @@ func (in *DPFHCPBridge) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DPFHCPBridgeList) DeepCopyInto(out *DPFHCPBridgeList) {
+func (in *BridgePoolList) DeepCopyInto(out *BridgePoolList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DPFHCPBridge, len(*in))
+		*out = make([]BridgePool, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DPFHCPBridgeList.
-func (in *DPFHCPBridgeList) DeepCopy() *DPFHCPBridgeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgePoolList.
+func (in *BridgePoolList) DeepCopy() *BridgePoolList {
 	if in == nil {
 		return nil
 	}
-	out := new(DPFHCPBridgeList)
+	out := new(BridgePoolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DPFHCPBridgeList) DeepCopyObject() runtime.Object {
+func (in *BridgePoolList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -86,73 +159,1106 @@ func (in *DPFHCPBridgeList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DPFHCPBridgeSpec) DeepCopyInto(out *DPFHCPBridgeSpec) {
+func (in *BridgePoolSpec) DeepCopyInto(out *BridgePoolSpec) {
 	*out = *in
-	out.DPUClusterRef = in.DPUClusterRef
-	out.SSHKeySecretRef = in.SSHKeySecretRef
-	out.PullSecretRef = in.PullSecretRef
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
+	if in.VirtualIPs != nil {
+		in, out := &in.VirtualIPs, &out.VirtualIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgePoolSpec.
+func (in *BridgePoolSpec) DeepCopy() *BridgePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgePoolStatus) DeepCopyInto(out *BridgePoolStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgePoolStatus.
+func (in *BridgePoolStatus) DeepCopy() *BridgePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgePoolTemplate) DeepCopyInto(out *BridgePoolTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DPFHCPBridgeSpec.
-func (in *DPFHCPBridgeSpec) DeepCopy() *DPFHCPBridgeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgePoolTemplate.
+func (in *BridgePoolTemplate) DeepCopy() *BridgePoolTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(DPFHCPBridgeSpec)
+	out := new(BridgePoolTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DPFHCPBridgeStatus) DeepCopyInto(out *DPFHCPBridgeStatus) {
+func (in *BridgeTemplate) DeepCopyInto(out *BridgeTemplate) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeTemplate.
+func (in *BridgeTemplate) DeepCopy() *BridgeTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BridgeTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeTemplateList) DeepCopyInto(out *BridgeTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BridgeTemplate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.HostedClusterRef != nil {
-		in, out := &in.HostedClusterRef, &out.HostedClusterRef
-		*out = new(corev1.ObjectReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeTemplateList.
+func (in *BridgeTemplateList) DeepCopy() *BridgeTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BridgeTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeTemplateSpec) DeepCopyInto(out *BridgeTemplateSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Bridge.DeepCopyInto(&out.Bridge)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeTemplateSpec.
+func (in *BridgeTemplateSpec) DeepCopy() *BridgeTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeTemplateStatus) DeepCopyInto(out *BridgeTemplateStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeTemplateStatus.
+func (in *BridgeTemplateStatus) DeepCopy() *BridgeTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelRelease) DeepCopyInto(out *ChannelRelease) {
+	*out = *in
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelRelease.
+func (in *ChannelRelease) DeepCopy() *ChannelRelease {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelRelease)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CleanupPreviewStep) DeepCopyInto(out *CleanupPreviewStep) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]CleanupResource, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupPreviewStep.
+func (in *CleanupPreviewStep) DeepCopy() *CleanupPreviewStep {
+	if in == nil {
+		return nil
+	}
+	out := new(CleanupPreviewStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CleanupResource) DeepCopyInto(out *CleanupResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupResource.
+func (in *CleanupResource) DeepCopy() *CleanupResource {
+	if in == nil {
+		return nil
+	}
+	out := new(CleanupResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNetworkingSpec) DeepCopyInto(out *ClusterNetworkingSpec) {
+	*out = *in
+	if in.ClusterNetwork != nil {
+		in, out := &in.ClusterNetwork, &out.ClusterNetwork
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceNetwork != nil {
+		in, out := &in.ServiceNetwork, &out.ServiceNetwork
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.MachineNetwork != nil {
+		in, out := &in.MachineNetwork, &out.MachineNetwork
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostPrefix != nil {
+		in, out := &in.HostPrefix, &out.HostPrefix
+		*out = new(int32)
 		**out = **in
 	}
-	if in.KubeConfigSecretRef != nil {
-		in, out := &in.KubeConfigSecretRef, &out.KubeConfigSecretRef
-		*out = new(corev1.LocalObjectReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNetworkingSpec.
+func (in *ClusterNetworkingSpec) DeepCopy() *ClusterNetworkingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNetworkingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerRuntimeSpec) DeepCopyInto(out *ContainerRuntimeSpec) {
+	*out = *in
+	if in.PidsLimit != nil {
+		in, out := &in.PidsLimit, &out.PidsLimit
+		*out = new(int64)
 		**out = **in
 	}
+	if in.LogSizeMax != nil {
+		in, out := &in.LogSizeMax, &out.LogSizeMax
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.RegistriesConfDropIns != nil {
+		in, out := &in.RegistriesConfDropIns, &out.RegistriesConfDropIns
+		*out = make([]RegistriesConfDropIn, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DPFHCPBridgeStatus.
-func (in *DPFHCPBridgeStatus) DeepCopy() *DPFHCPBridgeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRuntimeSpec.
+func (in *ContainerRuntimeSpec) DeepCopy() *ContainerRuntimeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DPFHCPBridgeStatus)
+	out := new(ContainerRuntimeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DPUClusterReference) DeepCopyInto(out *DPUClusterReference) {
+func (in *DNSRecord) DeepCopyInto(out *DNSRecord) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DPUClusterReference.
-func (in *DPUClusterReference) DeepCopy() *DPUClusterReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecord.
+func (in *DNSRecord) DeepCopy() *DNSRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(DPUClusterReference)
+	out := new(DNSRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DPFHCPBridge) DeepCopyInto(out *DPFHCPBridge) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DPFHCPBridge.
+func (in *DPFHCPBridge) DeepCopy() *DPFHCPBridge {
+	if in == nil {
+		return nil
+	}
+	out := new(DPFHCPBridge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DPFHCPBridge) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DPFHCPBridgeList) DeepCopyInto(out *DPFHCPBridgeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DPFHCPBridge, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DPFHCPBridgeList.
+func (in *DPFHCPBridgeList) DeepCopy() *DPFHCPBridgeList {
+	if in == nil {
+		return nil
+	}
+	out := new(DPFHCPBridgeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DPFHCPBridgeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DPFHCPBridgeSpec) DeepCopyInto(out *DPFHCPBridgeSpec) {
+	*out = *in
+	out.DPUClusterRef = in.DPUClusterRef
+	if in.DPUClusterSelector != nil {
+		in, out := &in.DPUClusterSelector, &out.DPUClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DPUClusterRefs != nil {
+		in, out := &in.DPUClusterRefs, &out.DPUClusterRefs
+		*out = make([]DPUClusterReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DPUClusterReadinessTimeout != nil {
+		in, out := &in.DPUClusterReadinessTimeout, &out.DPUClusterReadinessTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ProvisioningTimeouts != nil {
+		in, out := &in.ProvisioningTimeouts, &out.ProvisioningTimeouts
+		*out = new(ProvisioningTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReleaseCatalogRef != nil {
+		in, out := &in.ReleaseCatalogRef, &out.ReleaseCatalogRef
+		*out = new(ReleaseCatalogReference)
+		**out = **in
+	}
+	out.SSHKeySecretRef = in.SSHKeySecretRef
+	out.PullSecretRef = in.PullSecretRef
+	if in.NodePortAddresses != nil {
+		in, out := &in.NodePortAddresses, &out.NodePortAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Networking != nil {
+		in, out := &in.Networking, &out.Networking
+		*out = new(ClusterNetworkingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageMirrors != nil {
+		in, out := &in.ImageMirrors, &out.ImageMirrors
+		*out = make([]ImageMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimeSync != nil {
+		in, out := &in.TimeSync, &out.TimeSync
+		*out = new(TimeSyncSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeTuning != nil {
+		in, out := &in.NodeTuning, &out.NodeTuning
+		*out = new(NodeTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerRuntime != nil {
+		in, out := &in.ContainerRuntime, &out.ContainerRuntime
+		*out = new(ContainerRuntimeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodePoolReplicas != nil {
+		in, out := &in.NodePoolReplicas, &out.NodePoolReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]NodePoolSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreDeleteHooks != nil {
+		in, out := &in.PreDeleteHooks, &out.PreDeleteHooks
+		*out = make([]LifecycleHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostProvisionHooks != nil {
+		in, out := &in.PostProvisionHooks, &out.PostProvisionHooks
+		*out = make([]LifecycleHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalManifestsRefs != nil {
+		in, out := &in.AdditionalManifestsRefs, &out.AdditionalManifestsRefs
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.KubeconfigExport != nil {
+		in, out := &in.KubeconfigExport, &out.KubeconfigExport
+		*out = new(KubeconfigExportSpec)
+		**out = **in
+	}
+	if in.BridgePoolRef != nil {
+		in, out := &in.BridgePoolRef, &out.BridgePoolRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DPFHCPBridgeSpec.
+func (in *DPFHCPBridgeSpec) DeepCopy() *DPFHCPBridgeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DPFHCPBridgeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DPFHCPBridgeStatus) DeepCopyInto(out *DPFHCPBridgeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HostedClusterRef != nil {
+		in, out := &in.HostedClusterRef, &out.HostedClusterRef
+		*out = new(corev1.ObjectReference)
+		**out = **in
+	}
+	if in.DPUClusterRef != nil {
+		in, out := &in.DPUClusterRef, &out.DPUClusterRef
+		*out = new(DPUClusterReference)
+		**out = **in
+	}
+	if in.KubeConfigSecretRef != nil {
+		in, out := &in.KubeConfigSecretRef, &out.KubeConfigSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ChannelRelease != nil {
+		in, out := &in.ChannelRelease, &out.ChannelRelease
+		*out = new(ChannelRelease)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PinnedReleaseImage != nil {
+		in, out := &in.PinnedReleaseImage, &out.PinnedReleaseImage
+		*out = new(ReleaseImagePin)
+		**out = **in
+	}
+	if in.NodePoolStatus != nil {
+		in, out := &in.NodePoolStatus, &out.NodePoolStatus
+		*out = new(NodePoolStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]NodePoolStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ignition != nil {
+		in, out := &in.Ignition, &out.Ignition
+		*out = new(IgnitionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IngressDNSRecord != nil {
+		in, out := &in.IngressDNSRecord, &out.IngressDNSRecord
+		*out = new(DNSRecord)
+		**out = **in
+	}
+	if in.NodePortAddress != nil {
+		in, out := &in.NodePortAddress, &out.NodePortAddress
+		*out = new(NodePortAddressStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PausedUntil != nil {
+		in, out := &in.PausedUntil, &out.PausedUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.PreDeleteHooks != nil {
+		in, out := &in.PreDeleteHooks, &out.PreDeleteHooks
+		*out = make([]HookStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CleanupPreview != nil {
+		in, out := &in.CleanupPreview, &out.CleanupPreview
+		*out = make([]CleanupPreviewStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostProvisionHooks != nil {
+		in, out := &in.PostProvisionHooks, &out.PostProvisionHooks
+		*out = make([]HookStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecretCopies != nil {
+		in, out := &in.SecretCopies, &out.SecretCopies
+		*out = make([]SecretCopyStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PullSecretRollout != nil {
+		in, out := &in.PullSecretRollout, &out.PullSecretRollout
+		*out = new(PullSecretRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(ReconcileError)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DPFHCPBridgeStatus.
+func (in *DPFHCPBridgeStatus) DeepCopy() *DPFHCPBridgeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DPFHCPBridgeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DPUClusterReference) DeepCopyInto(out *DPUClusterReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DPUClusterReference.
+func (in *DPUClusterReference) DeepCopy() *DPUClusterReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DPUClusterReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookStatus) DeepCopyInto(out *HookStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookStatus.
+func (in *HookStatus) DeepCopy() *HookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HugepagesSpec) DeepCopyInto(out *HugepagesSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HugepagesSpec.
+func (in *HugepagesSpec) DeepCopy() *HugepagesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HugepagesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnitionStatus) DeepCopyInto(out *IgnitionStatus) {
+	*out = *in
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+	if in.UserDataSecretRef != nil {
+		in, out := &in.UserDataSecretRef, &out.UserDataSecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+	if in.TokenExpirationTime != nil {
+		in, out := &in.TokenExpirationTime, &out.TokenExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PublishedSecretRef != nil {
+		in, out := &in.PublishedSecretRef, &out.PublishedSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionStatus.
+func (in *IgnitionStatus) DeepCopy() *IgnitionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnitionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageMirror) DeepCopyInto(out *ImageMirror) {
+	*out = *in
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageMirror.
+func (in *ImageMirror) DeepCopy() *ImageMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigExportSpec) DeepCopyInto(out *KubeconfigExportSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigExportSpec.
+func (in *KubeconfigExportSpec) DeepCopy() *KubeconfigExportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigExportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleHook) DeepCopyInto(out *LifecycleHook) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleHook.
+func (in *LifecycleHook) DeepCopy() *LifecycleHook {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolSpec) DeepCopyInto(out *NodePoolSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolSpec.
+func (in *NodePoolSpec) DeepCopy() *NodePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolStatus) DeepCopyInto(out *NodePoolStatus) {
+	*out = *in
+	if in.UpdatedReplicas != nil {
+		in, out := &in.UpdatedReplicas, &out.UpdatedReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinorVersionSkew != nil {
+		in, out := &in.MinorVersionSkew, &out.MinorVersionSkew
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolStatus.
+func (in *NodePoolStatus) DeepCopy() *NodePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePortAddressStatus) DeepCopyInto(out *NodePortAddressStatus) {
+	*out = *in
+	if in.LastSwitchTime != nil {
+		in, out := &in.LastSwitchTime, &out.LastSwitchTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePortAddressStatus.
+func (in *NodePortAddressStatus) DeepCopy() *NodePortAddressStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePortAddressStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTuningSpec) DeepCopyInto(out *NodeTuningSpec) {
+	*out = *in
+	if in.Hugepages != nil {
+		in, out := &in.Hugepages, &out.Hugepages
+		*out = new(HugepagesSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTuningSpec.
+func (in *NodeTuningSpec) DeepCopy() *NodeTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningTimeouts) DeepCopyInto(out *ProvisioningTimeouts) {
+	*out = *in
+	if in.HostedClusterAvailable != nil {
+		in, out := &in.HostedClusterAvailable, &out.HostedClusterAvailable
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FirstNodeJoined != nil {
+		in, out := &in.FirstNodeJoined, &out.FirstNodeJoined
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.NodePoolReady != nil {
+		in, out := &in.NodePoolReady, &out.NodePoolReady
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningTimeouts.
+func (in *ProvisioningTimeouts) DeepCopy() *ProvisioningTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySpec) DeepCopyInto(out *ProxySpec) {
+	*out = *in
+	if in.TrustedCA != nil {
+		in, out := &in.TrustedCA, &out.TrustedCA
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySpec.
+func (in *ProxySpec) DeepCopy() *ProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullSecretRolloutStatus) DeepCopyInto(out *PullSecretRolloutStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullSecretRolloutStatus.
+func (in *PullSecretRolloutStatus) DeepCopy() *PullSecretRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PullSecretRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileError) DeepCopyInto(out *ReconcileError) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileError.
+func (in *ReconcileError) DeepCopy() *ReconcileError {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistriesConfDropIn) DeepCopyInto(out *RegistriesConfDropIn) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistriesConfDropIn.
+func (in *RegistriesConfDropIn) DeepCopy() *RegistriesConfDropIn {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistriesConfDropIn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseCatalog) DeepCopyInto(out *ReleaseCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseCatalog.
+func (in *ReleaseCatalog) DeepCopy() *ReleaseCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleaseCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseCatalogEntry) DeepCopyInto(out *ReleaseCatalogEntry) {
+	*out = *in
+	if in.SupportedFrom != nil {
+		in, out := &in.SupportedFrom, &out.SupportedFrom
+		*out = (*in).DeepCopy()
+	}
+	if in.SupportedUntil != nil {
+		in, out := &in.SupportedUntil, &out.SupportedUntil
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseCatalogEntry.
+func (in *ReleaseCatalogEntry) DeepCopy() *ReleaseCatalogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseCatalogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseCatalogList) DeepCopyInto(out *ReleaseCatalogList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReleaseCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseCatalogList.
+func (in *ReleaseCatalogList) DeepCopy() *ReleaseCatalogList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseCatalogList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleaseCatalogList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseCatalogReference) DeepCopyInto(out *ReleaseCatalogReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseCatalogReference.
+func (in *ReleaseCatalogReference) DeepCopy() *ReleaseCatalogReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseCatalogReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseCatalogSpec) DeepCopyInto(out *ReleaseCatalogSpec) {
+	*out = *in
+	if in.Releases != nil {
+		in, out := &in.Releases, &out.Releases
+		*out = make([]ReleaseCatalogEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseCatalogSpec.
+func (in *ReleaseCatalogSpec) DeepCopy() *ReleaseCatalogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseCatalogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseImagePin) DeepCopyInto(out *ReleaseImagePin) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseImagePin.
+func (in *ReleaseImagePin) DeepCopy() *ReleaseImagePin {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseImagePin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretCopyStatus) DeepCopyInto(out *SecretCopyStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretCopyStatus.
+func (in *SecretCopyStatus) DeepCopy() *SecretCopyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretCopyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeSyncSpec) DeepCopyInto(out *TimeSyncSpec) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeSyncSpec.
+func (in *TimeSyncSpec) DeepCopy() *TimeSyncSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeSyncSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,145 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// ConvertTo converts this DPFHCPBridge to the hub version (v1alpha1)
+func (src *DPFHCPBridge) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*provisioningv1alpha1.DPFHCPBridge)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = provisioningv1alpha1.DPFHCPBridgeSpec{
+		DPUClusterRef:                  src.Spec.DPUClusterRef,
+		DPUClusterSelector:             src.Spec.DPUClusterSelector,
+		DPUClusterRefs:                 src.Spec.DPUClusterRefs,
+		DPUClusterReadinessPolicy:      src.Spec.DPUClusterReadinessPolicy,
+		DPUClusterReadinessTimeout:     src.Spec.DPUClusterReadinessTimeout,
+		ProvisioningTimeouts:           src.Spec.ProvisioningTimeouts,
+		BaseDomain:                     src.Spec.Networking.BaseDomain,
+		OCPReleaseImage:                src.Spec.OCPReleaseImage,
+		ReleaseCatalogRef:              src.Spec.ReleaseCatalogRef,
+		Channel:                        src.Spec.Channel,
+		SSHKeySecretRef:                src.Spec.SSHKeySecretRef,
+		PullSecretRef:                  src.Spec.PullSecretRef,
+		EtcdStorageClass:               src.Spec.EtcdStorageClass,
+		ControlPlaneAvailabilityPolicy: src.Spec.ControlPlaneAvailabilityPolicy,
+		VirtualIP:                      src.Spec.Networking.VirtualIP,
+		IngressVIP:                     src.Spec.Networking.IngressVIP,
+		NodePortAddresses:              src.Spec.Networking.NodePortAddresses,
+		Networking:                     src.Spec.Networking.clusterNetworking(),
+		Proxy:                          src.Spec.Proxy,
+		ImageMirrors:                   src.Spec.ImageMirrors,
+		TimeSync:                       src.Spec.TimeSync,
+		NodeTuning:                     src.Spec.NodeTuning,
+		ContainerRuntime:               src.Spec.ContainerRuntime,
+		NodeSelector:                   src.Spec.NodeSelector,
+		NodePoolReplicas:               src.Spec.NodePool.Replicas,
+		SizeProfile:                    src.Spec.SizeProfile,
+		NodePools:                      src.Spec.AdditionalNodePools,
+		PublishIgnitionSecret:          src.Spec.NodePool.PublishIgnitionSecret,
+		Platform:                       src.Spec.Platform,
+		PreDeleteHooks:                 src.Spec.PreDeleteHooks,
+		PostProvisionHooks:             src.Spec.PostProvisionHooks,
+		AdditionalManifestsRefs:        src.Spec.AdditionalManifestsRefs,
+		EnableDPUDevicePlugins:         src.Spec.EnableDPUDevicePlugins,
+		ForwardEventsToHostedCluster:   src.Spec.ForwardEventsToHostedCluster,
+		KubeconfigExport:               src.Spec.KubeconfigExport,
+		BridgePoolRef:                  src.Spec.BridgePoolRef,
+	}
+	dst.Status = src.Status
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1alpha1) to this version
+func (dst *DPFHCPBridge) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*provisioningv1alpha1.DPFHCPBridge)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = DPFHCPBridgeSpec{
+		DPUClusterRef:                  src.Spec.DPUClusterRef,
+		DPUClusterSelector:             src.Spec.DPUClusterSelector,
+		DPUClusterRefs:                 src.Spec.DPUClusterRefs,
+		DPUClusterReadinessPolicy:      src.Spec.DPUClusterReadinessPolicy,
+		DPUClusterReadinessTimeout:     src.Spec.DPUClusterReadinessTimeout,
+		ProvisioningTimeouts:           src.Spec.ProvisioningTimeouts,
+		OCPReleaseImage:                src.Spec.OCPReleaseImage,
+		ReleaseCatalogRef:              src.Spec.ReleaseCatalogRef,
+		Channel:                        src.Spec.Channel,
+		SSHKeySecretRef:                src.Spec.SSHKeySecretRef,
+		PullSecretRef:                  src.Spec.PullSecretRef,
+		EtcdStorageClass:               src.Spec.EtcdStorageClass,
+		ControlPlaneAvailabilityPolicy: src.Spec.ControlPlaneAvailabilityPolicy,
+		Networking:                     networkingFrom(&src.Spec),
+		Proxy:                          src.Spec.Proxy,
+		ImageMirrors:                   src.Spec.ImageMirrors,
+		TimeSync:                       src.Spec.TimeSync,
+		NodeTuning:                     src.Spec.NodeTuning,
+		ContainerRuntime:               src.Spec.ContainerRuntime,
+		NodeSelector:                   src.Spec.NodeSelector,
+		NodePool: DefaultNodePoolSpec{
+			Replicas:              src.Spec.NodePoolReplicas,
+			PublishIgnitionSecret: src.Spec.PublishIgnitionSecret,
+		},
+		AdditionalNodePools:          src.Spec.NodePools,
+		SizeProfile:                  src.Spec.SizeProfile,
+		Platform:                     src.Spec.Platform,
+		PreDeleteHooks:               src.Spec.PreDeleteHooks,
+		PostProvisionHooks:           src.Spec.PostProvisionHooks,
+		AdditionalManifestsRefs:      src.Spec.AdditionalManifestsRefs,
+		EnableDPUDevicePlugins:       src.Spec.EnableDPUDevicePlugins,
+		ForwardEventsToHostedCluster: src.Spec.ForwardEventsToHostedCluster,
+		KubeconfigExport:             src.Spec.KubeconfigExport,
+		BridgePoolRef:                src.Spec.BridgePoolRef,
+	}
+	dst.Status = src.Status
+	return nil
+}
+
+// clusterNetworking returns the v1alpha1 network CIDRs, nil if none is set
+func (in *NetworkingSpec) clusterNetworking() *provisioningv1alpha1.ClusterNetworkingSpec {
+	if in.ClusterNetwork == nil && in.ServiceNetwork == nil && in.MachineNetwork == nil && in.HostPrefix == nil {
+		return nil
+	}
+	return &provisioningv1alpha1.ClusterNetworkingSpec{
+		ClusterNetwork: in.ClusterNetwork,
+		ServiceNetwork: in.ServiceNetwork,
+		MachineNetwork: in.MachineNetwork,
+		HostPrefix:     in.HostPrefix,
+	}
+}
+
+// networkingFrom groups the v1alpha1 network settings
+func networkingFrom(spec *provisioningv1alpha1.DPFHCPBridgeSpec) NetworkingSpec {
+	networking := NetworkingSpec{
+		BaseDomain:        spec.BaseDomain,
+		VirtualIP:         spec.VirtualIP,
+		IngressVIP:        spec.IngressVIP,
+		NodePortAddresses: spec.NodePortAddresses,
+	}
+	if spec.Networking != nil {
+		networking.ClusterNetwork = spec.Networking.ClusterNetwork
+		networking.ServiceNetwork = spec.Networking.ServiceNetwork
+		networking.MachineNetwork = spec.Networking.MachineNetwork
+		networking.HostPrefix = spec.Networking.HostPrefix
+	}
+	return networking
+}
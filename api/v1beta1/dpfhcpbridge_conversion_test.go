@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("DPFHCPBridge conversion", func() {
+	newHub := func() *provisioningv1alpha1.DPFHCPBridge {
+		return &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "dpf-hcp", Generation: 3},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef:                  provisioningv1alpha1.DPUClusterReference{Name: "dpu", Namespace: "dpf-operator-system"},
+				BaseDomain:                     "clusters.example.com",
+				OCPReleaseImage:                "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+				SSHKeySecretRef:                corev1.LocalObjectReference{Name: "ssh"},
+				PullSecretRef:                  corev1.LocalObjectReference{Name: "pull"},
+				EtcdStorageClass:               "lvms",
+				ControlPlaneAvailabilityPolicy: hyperv1.HighlyAvailable,
+				VirtualIP:                      "192.168.1.100",
+				IngressVIP:                     "192.168.1.101",
+				ProvisioningTimeouts: &provisioningv1alpha1.ProvisioningTimeouts{
+					NodePoolReady: &metav1.Duration{Duration: 3 * time.Hour},
+				},
+				NodePoolReplicas: ptr.To[int32](2),
+				NodePools: []provisioningv1alpha1.NodePoolSpec{
+					{Name: "bf3", Replicas: ptr.To[int32](4)},
+				},
+				PublishIgnitionSecret: true,
+				KubeconfigExport: &provisioningv1alpha1.KubeconfigExportSpec{
+					Name:      "tenant-kubeconfig",
+					Namespace: "tenant-a",
+				},
+				Networking: &provisioningv1alpha1.ClusterNetworkingSpec{
+					ClusterNetwork: []provisioningv1alpha1.CIDR{"10.200.0.0/14"},
+					ServiceNetwork: []provisioningv1alpha1.CIDR{"172.40.0.0/16"},
+					HostPrefix:     ptr.To[int32](24),
+				},
+				Proxy: &provisioningv1alpha1.ProxySpec{
+					HTTPSProxy: "http://proxy.example.com:3128",
+					TrustedCA:  &corev1.LocalObjectReference{Name: "proxy-ca"},
+				},
+				ImageMirrors: []provisioningv1alpha1.ImageMirror{
+					{Source: "quay.io/openshift-release-dev/ocp-release", Mirrors: []string{"mirror.example.com/ocp/release"}},
+				},
+				TimeSync: &provisioningv1alpha1.TimeSyncSpec{
+					Servers: []string{"ntp1.example.com", "10.0.0.123"},
+				},
+				NodeTuning: &provisioningv1alpha1.NodeTuningSpec{
+					Hugepages: &provisioningv1alpha1.HugepagesSpec{Size: provisioningv1alpha1.HugepageSize1G, Count: 4},
+					IOMMU:     provisioningv1alpha1.IOMMUModePassthrough,
+				},
+				ContainerRuntime: &provisioningv1alpha1.ContainerRuntimeSpec{
+					PidsLimit:  ptr.To(int64(4096)),
+					LogSizeMax: ptr.To(resource.MustParse("50Mi")),
+					RegistriesConfDropIns: []provisioningv1alpha1.RegistriesConfDropIn{
+						{Name: "50-blocked", Content: "[[registry]]\nlocation = \"docker.io\"\nblocked = true\n"},
+					},
+				},
+			},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{Phase: provisioningv1alpha1.PhaseReady},
+		}
+	}
+
+	It("should group the network and NodePool settings", func() {
+		bridge := &DPFHCPBridge{}
+		Expect(bridge.ConvertFrom(newHub())).To(Succeed())
+
+		Expect(bridge.Name).To(Equal("prod"))
+		Expect(bridge.Spec.Networking).To(Equal(NetworkingSpec{
+			BaseDomain:     "clusters.example.com",
+			VirtualIP:      "192.168.1.100",
+			IngressVIP:     "192.168.1.101",
+			ClusterNetwork: []provisioningv1alpha1.CIDR{"10.200.0.0/14"},
+			ServiceNetwork: []provisioningv1alpha1.CIDR{"172.40.0.0/16"},
+			HostPrefix:     ptr.To[int32](24),
+		}))
+		Expect(bridge.Spec.NodePool).To(Equal(DefaultNodePoolSpec{Replicas: ptr.To[int32](2), PublishIgnitionSecret: true}))
+		Expect(bridge.Spec.AdditionalNodePools).To(HaveLen(1))
+		Expect(bridge.Spec.AdditionalNodePools[0].Name).To(Equal("bf3"))
+		Expect(bridge.Status.Phase).To(Equal(provisioningv1alpha1.PhaseReady))
+	})
+
+	It("should round-trip through v1beta1 without loss", func() {
+		hub := newHub()
+		bridge := &DPFHCPBridge{}
+		Expect(bridge.ConvertFrom(hub)).To(Succeed())
+
+		restored := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(bridge.ConvertTo(restored)).To(Succeed())
+		Expect(restored.ObjectMeta).To(Equal(hub.ObjectMeta))
+		Expect(restored.Spec).To(Equal(hub.Spec))
+		Expect(restored.Status).To(Equal(hub.Status))
+	})
+
+	It("should not set v1alpha1 networking without network CIDRs", func() {
+		hub := newHub()
+		hub.Spec.Networking = nil
+		bridge := &DPFHCPBridge{}
+		Expect(bridge.ConvertFrom(hub)).To(Succeed())
+
+		restored := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(bridge.ConvertTo(restored)).To(Succeed())
+		Expect(restored.Spec.Networking).To(BeNil())
+	})
+})
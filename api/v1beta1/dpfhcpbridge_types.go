@@ -0,0 +1,418 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// DPFHCPBridgeSpec defines the desired state of DPFHCPBridge
+// Compared to v1alpha1, the hosted cluster network settings are grouped under networking and the
+// NodePool settings under nodePool and additionalNodePools.
+// +kubebuilder:validation:XValidation:rule="[has(self.ocpReleaseImage), has(self.releaseCatalogRef), has(self.channel)].filter(x, x).size() == 1",message="exactly one of ocpReleaseImage, releaseCatalogRef and channel must be set"
+// +kubebuilder:validation:XValidation:rule="[has(self.dpuClusterRef), has(self.dpuClusterSelector), has(self.dpuClusterRefs)].filter(x, x).size() <= 1",message="exactly one of dpuClusterRef, dpuClusterSelector and dpuClusterRefs must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.dpuClusterRef) && !has(oldSelf.dpuClusterSelector) && !has(oldSelf.dpuClusterRefs)) || (has(oldSelf.dpuClusterRef) == has(self.dpuClusterRef) && has(oldSelf.dpuClusterSelector) == has(self.dpuClusterSelector) && has(oldSelf.dpuClusterRefs) == has(self.dpuClusterRefs))",message="cannot switch between dpuClusterRef, dpuClusterSelector and dpuClusterRefs"
+// +kubebuilder:validation:XValidation:rule="!has(self.dpuClusterRefs) || !has(self.additionalNodePools) || !self.additionalNodePools.exists(p, self.dpuClusterRefs.exists(r, r.name == p.name))",message="additionalNodePools names cannot match a dpuClusterRefs name: both name the NodePool <name>-<entry>"
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.bridgePoolRef) == has(self.bridgePoolRef)",message="bridgePoolRef cannot be added or removed"
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.platform) == has(self.platform)",message="platform cannot be added or removed: HyperShift cannot change the platform of an existing hosted cluster"
+type DPFHCPBridgeSpec struct {
+	// DPUClusterRef is a cross-namespace reference to a DPUCluster CR for validation and kubeconfig injection
+	// Exactly one of DPUClusterRef, DPUClusterSelector and DPUClusterRefs must be set, except on BridgePool
+	// spares where setting one of them claims the spare.
+	// This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dpuClusterRef is immutable: the hosted cluster is bound to the referenced DPUCluster"
+	// +immutable
+	// +optional
+	DPUClusterRef provisioningv1alpha1.DPUClusterReference `json:"dpuClusterRef,omitzero"`
+
+	// DPUClusterSelector selects the DPUCluster by label instead of by name, e.g. for GitOps flows
+	// where DPUCluster names include generated suffixes
+	// DPUClusters in all namespaces are considered and exactly one must match. The match is resolved
+	// once and recorded in status.dpuClusterRef.
+	// This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dpuClusterSelector is immutable"
+	// +immutable
+	// +optional
+	DPUClusterSelector *metav1.LabelSelector `json:"dpuClusterSelector,omitempty"`
+
+	// DPUClusterRefs are cross-namespace references to several DPUClusters backing a single hosted
+	// cluster, e.g. when the DPUs are split across management domains but share one tenant control plane
+	// The first DPUCluster is served by the default NodePool and every other one by a NodePool named
+	// <name>-<dpucluster-name>. The nodes of each NodePool are labelled with the DPUCluster they belong
+	// to, and the kubeconfig is injected into every DPUCluster.
+	// Exactly one of DPUClusterRef, DPUClusterSelector and DPUClusterRefs must be set.
+	// This field is immutable.
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dpuClusterRefs is immutable: the hosted cluster is bound to the referenced DPUClusters"
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y.name == x.name))",message="dpuClusterRefs names must be unique: each DPUCluster names a NodePool"
+	// +immutable
+	// +optional
+	DPUClusterRefs []provisioningv1alpha1.DPUClusterReference `json:"dpuClusterRefs,omitempty"`
+
+	// DPUClusterReadinessPolicy controls whether the HostedCluster is provisioned before the DPUCluster is Ready
+	// Require waits for the DPUCluster to be Ready, WaitWithTimeout waits at most DPUClusterReadinessTimeout,
+	// and Ignore provisions right away, e.g. at sites that create the hosted control plane before the DPUs are racked.
+	// Only the initial provisioning is gated.
+	// +kubebuilder:default=Ignore
+	// +optional
+	DPUClusterReadinessPolicy provisioningv1alpha1.DPUClusterReadinessPolicy `json:"dpuClusterReadinessPolicy,omitempty"`
+
+	// DPUClusterReadinessTimeout is how long the WaitWithTimeout policy waits for the DPUCluster to be Ready
+	// Default: 30m
+	// +optional
+	DPUClusterReadinessTimeout *metav1.Duration `json:"dpuClusterReadinessTimeout,omitempty"`
+
+	// ProvisioningTimeouts bounds how long each provisioning stage may take before its timeout condition
+	// (HostedClusterAvailableTimedOut, FirstNodeJoinTimedOut, NodePoolReadyTimedOut) is set
+	// +optional
+	ProvisioningTimeouts *provisioningv1alpha1.ProvisioningTimeouts `json:"provisioningTimeouts,omitempty"`
+
+	// OCPReleaseImage is the full pull-spec URL for the OCP release image
+	// The operator uses this to look up the corresponding BlueField container image from the central ConfigMap
+	// Exactly one of OCPReleaseImage, ReleaseCatalogRef and Channel must be set.
+	// +optional
+	OCPReleaseImage string `json:"ocpReleaseImage,omitempty"`
+
+	// ReleaseCatalogRef selects the OCP release and its BlueField image from a ReleaseCatalog entry
+	// instead of a raw ocpReleaseImage
+	// +optional
+	ReleaseCatalogRef *provisioningv1alpha1.ReleaseCatalogReference `json:"releaseCatalogRef,omitempty"`
+
+	// Channel tracks the latest z-stream release of an OpenShift update channel, e.g. stable-4.17,
+	// instead of a raw ocpReleaseImage. The release is looked up in the OpenShift update graph
+	// and recorded in status.channelRelease.
+	// +kubebuilder:validation:Pattern=`^(stable|fast|eus|candidate)-[0-9]+\.[0-9]+$`
+	// +optional
+	Channel string `json:"channel,omitempty"`
+
+	// SSHKeySecretRef is a reference to a Secret containing the SSH public key for cluster node access
+	// Secret must be in the same namespace as the DPFHCPBridge CR and contain key 'id_rsa.pub'
+	// This field is immutable.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sshKeySecretRef is immutable"
+	// +immutable
+	// +required
+	SSHKeySecretRef corev1.LocalObjectReference `json:"sshKeySecretRef"`
+
+	// PullSecretRef is a reference to a Secret containing the container registry pull secret
+	// Secret must be in the same namespace as the DPFHCPBridge CR and contain key '.dockerconfigjson'
+	// This field is immutable.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="pullSecretRef is immutable"
+	// +immutable
+	// +required
+	PullSecretRef corev1.LocalObjectReference `json:"pullSecretRef"`
+
+	// EtcdStorageClass is the storage class name for etcd persistent volumes in the hosted cluster control plane
+	// This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="etcdStorageClass is immutable"
+	// +immutable
+	// +optional
+	EtcdStorageClass string `json:"etcdStorageClass,omitempty"`
+
+	// ControlPlaneAvailabilityPolicy specifies the availability policy for the control plane
+	// Valid values: SingleReplica, HighlyAvailable
+	// This field is immutable.
+	// +kubebuilder:validation:Enum=SingleReplica;HighlyAvailable
+	// +kubebuilder:default=HighlyAvailable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="controlPlaneAvailabilityPolicy is immutable"
+	// +immutable
+	// +optional
+	ControlPlaneAvailabilityPolicy hyperv1.AvailabilityPolicy `json:"controlPlaneAvailabilityPolicy,omitempty"`
+
+	// Networking configures how the hosted cluster is addressed
+	// +kubebuilder:validation:Required
+	// +required
+	Networking NetworkingSpec `json:"networking"`
+
+	// Proxy configures the egress proxy the hosted cluster and its DPU workers reach external
+	// endpoints such as registries through
+	// Changing it rolls out the new configuration to the hosted cluster.
+	// +optional
+	Proxy *provisioningv1alpha1.ProxySpec `json:"proxy,omitempty"`
+
+	// ImageMirrors redirect image pulls of the hosted control plane and the DPU workers to mirror
+	// registries, e.g. an offline mirror in disconnected installations
+	// Changing them rolls out the new configuration to the hosted cluster.
+	// +kubebuilder:validation:MaxItems=50
+	// +optional
+	ImageMirrors []provisioningv1alpha1.ImageMirror `json:"imageMirrors,omitempty"`
+
+	// TimeSync configures the NTP servers the DPU workers synchronize their clock with through chrony
+	// It is rendered into a MachineConfig referenced by every NodePool, and applies to DPUs booted after it is changed.
+	// +optional
+	TimeSync *provisioningv1alpha1.TimeSyncSpec `json:"timeSync,omitempty"`
+
+	// NodeTuning selects kernel tuning presets for the DPU workers, such as hugepages, isolated CPUs and the IOMMU mode
+	// They are rendered into the kernel arguments of a MachineConfig referenced by every NodePool.
+	// Changing them rolls out the new configuration to the DPU workers, which reboots them.
+	// +optional
+	NodeTuning *provisioningv1alpha1.NodeTuningSpec `json:"nodeTuning,omitempty"`
+
+	// ContainerRuntime configures CRI-O on the DPU workers, such as the pids limit, the log size and
+	// registries.conf drop-ins
+	// It is rendered into a ContainerRuntimeConfig and a MachineConfig referenced by every NodePool.
+	// Changing it rolls out the new configuration to the DPU workers.
+	// +optional
+	ContainerRuntime *provisioningv1alpha1.ContainerRuntimeSpec `json:"containerRuntime,omitempty"`
+
+	// NodeSelector defines the node selector for the hosted control plane pods
+	// It specifies which nodes in the management cluster can host the control plane workloads
+	// Default: {"node-role.kubernetes.io/control-plane": ""} (schedules on control-plane nodes)
+	// This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="nodeSelector is immutable"
+	// +kubebuilder:validation:XValidation:rule="size(self) <= 20",message="nodeSelector map can have at most 20 entries"
+	// +immutable
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// NodePool configures the default NodePool of the hosted cluster, named after the bridge
+	// +kubebuilder:default={}
+	// +optional
+	NodePool DefaultNodePoolSpec `json:"nodePool,omitzero"`
+
+	// AdditionalNodePools are additional NodePools of the hosted cluster, e.g. one per BlueField generation,
+	// created as <name>-<nodePool name> next to the default NodePool
+	// Removing an entry deletes its NodePool.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	AdditionalNodePools []provisioningv1alpha1.NodePoolSpec `json:"additionalNodePools,omitempty"`
+
+	// SizeProfile right-sizes the hosted control plane for the expected number of DPU workers:
+	// small (up to 10), medium (up to 50) or large (more than 50)
+	// It sets the HyperShift cluster size override and the kube-apiserver and etcd resource requests.
+	// When unset, sizing is left to HyperShift.
+	// +optional
+	SizeProfile provisioningv1alpha1.SizeProfile `json:"sizeProfile,omitempty"`
+
+	// Platform is the HyperShift platform DPU workers join the hosted cluster through
+	// With None, DPUs boot from the NodePool ignition fetched out-of-band. With Agent, the operator creates
+	// an InfraEnv named after the bridge and approves the DPU workers assisted-service discovers through
+	// it, which the NodePools then claim automatically; this requires assisted-service on the management cluster.
+	// Default: None
+	// This field is immutable and cannot be added or removed after creation.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="platform is immutable: HyperShift cannot change the platform of an existing hosted cluster"
+	// +immutable
+	// +optional
+	Platform provisioningv1alpha1.PlatformType `json:"platform,omitempty"`
+
+	// PreDeleteHooks are Jobs executed by the finalizer before the HostedCluster is deleted,
+	// e.g. to gracefully drain DOCA services off the DPUs
+	// Hooks run sequentially in the order they are listed.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	PreDeleteHooks []provisioningv1alpha1.LifecycleHook `json:"preDeleteHooks,omitempty"`
+
+	// PostProvisionHooks are Jobs executed once the HostedCluster becomes Available,
+	// e.g. to apply day-1 manifests or register the cluster with an external CMDB
+	// Hooks run sequentially in the order they are listed, and each successful hook runs only once.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	PostProvisionHooks []provisioningv1alpha1.LifecycleHook `json:"postProvisionHooks,omitempty"`
+
+	// AdditionalManifestsRefs are references to ConfigMaps containing YAML manifests
+	// (e.g. DPU-specific DaemonSets and NetworkPolicies) applied into the hosted cluster
+	// as soon as its control plane is available
+	// ConfigMaps must be in the same namespace as the DPFHCPBridge CR. Every key holds one or more
+	// YAML documents; keys are applied in sorted order.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	AdditionalManifestsRefs []corev1.LocalObjectReference `json:"additionalManifestsRefs,omitempty"`
+
+	// EnableDPUDevicePlugins injects the built-in SR-IOV network operator and RDMA shared device plugin
+	// manifests into the hosted cluster as day-1 manifests, ahead of any AdditionalManifestsRefs
+	// Default: false
+	// +optional
+	EnableDPUDevicePlugins bool `json:"enableDPUDevicePlugins,omitempty"`
+
+	// ForwardEventsToHostedCluster mirrors the most recent events of this DPFHCPBridge into the
+	// dpf-hcp-bridge-events ConfigMap in the openshift-config namespace of the hosted cluster,
+	// so that hosted cluster admins can see the provisioning and upgrade context
+	// Default: false
+	// +optional
+	ForwardEventsToHostedCluster bool `json:"forwardEventsToHostedCluster,omitempty"`
+
+	// KubeconfigExport copies the hosted cluster admin kubeconfig into a Secret of the chosen name and
+	// namespace, so that tenant teams can be granted access to just that Secret through RBAC
+	// The Secret is kept in sync with the admin kubeconfig and deleted when the field is removed or the
+	// DPFHCPBridge is deleted.
+	// +optional
+	KubeconfigExport *provisioningv1alpha1.KubeconfigExportSpec `json:"kubeconfigExport,omitempty"`
+
+	// BridgePoolRef is the BridgePool that provisioned this DPFHCPBridge as a warm spare
+	// Set by the BridgePool controller. A spare provisions its HostedCluster without a NodePool until it
+	// is claimed by setting dpuClusterRef, dpuClusterSelector or dpuClusterRefs.
+	// This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="bridgePoolRef is immutable"
+	// +immutable
+	// +optional
+	BridgePoolRef *corev1.LocalObjectReference `json:"bridgePoolRef,omitempty"`
+}
+
+// NetworkingSpec configures how the hosted cluster is addressed
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.virtualIP) == has(self.virtualIP)",message="virtualIP cannot be added or removed: the HostedCluster services are published from it at creation"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.ingressVIP) || has(self.ingressVIP)",message="ingressVIP cannot be removed: MetalLB keeps announcing it in the hosted cluster"
+// +kubebuilder:validation:XValidation:rule="!has(self.ingressVIP) || !has(self.virtualIP) || self.ingressVIP != self.virtualIP",message="ingressVIP must differ from virtualIP"
+// +kubebuilder:validation:XValidation:rule="!has(self.nodePortAddresses) || !has(self.virtualIP)",message="nodePortAddresses cannot be set with virtualIP: the services are published on the virtual IP"
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.clusterNetwork) == has(self.clusterNetwork) && has(oldSelf.serviceNetwork) == has(self.serviceNetwork) && has(oldSelf.machineNetwork) == has(self.machineNetwork) && has(oldSelf.hostPrefix) == has(self.hostPrefix)",message="network CIDRs cannot be added or removed: HyperShift cannot change the network of an existing hosted cluster"
+type NetworkingSpec struct {
+	// BaseDomain is the base domain for the hosted cluster's DNS records
+	// Example: clusters.example.com results in API endpoint at api.prod-cluster.clusters.example.com
+	// This field is immutable.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)+[a-z]{2,}$`
+	// +kubebuilder:validation:MinLength=4
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="baseDomain is immutable: the hosted cluster DNS names and certificates are derived from it"
+	// +immutable
+	// +required
+	BaseDomain string `json:"baseDomain"`
+
+	// VirtualIP is the virtual IP address for load balancer
+	// Required when ControlPlaneAvailabilityPolicy is HighlyAvailable
+	// Must be a routable IP in the management cluster network
+	// This field is immutable and cannot be added or removed after creation.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="virtualIP is immutable: the HostedCluster load balancer is configured from it"
+	// +immutable
+	// +optional
+	VirtualIP string `json:"virtualIP,omitempty"`
+
+	// IngressVIP is the virtual IP address the *.apps routes of the hosted cluster are served on
+	// When set, the operator installs MetalLB in the hosted cluster, announces the address from the DPU
+	// workers and publishes the default ingress controller on it through a LoadBalancer Service.
+	// Must be a free IP in the DPU worker network; the wildcard DNS record to create for it is reported
+	// in status.ingressDNSRecord. It can be changed but not removed.
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="ingressVIP must be an IPv4 or IPv6 address"
+	// +optional
+	IngressVIP string `json:"ingressVIP,omitempty"`
+
+	// NodePortAddresses are the management cluster node addresses the control plane services can be published on
+	// in NodePort mode (SingleReplica without virtualIP), in order of preference. The services are published on the
+	// first address whose node is Ready; when that node goes NotReady, the HostedCluster is switched to the next
+	// address whose node is Ready. When unset, the address of the first node is used and never changed.
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	// +listType=set
+	// +optional
+	NodePortAddresses []string `json:"nodePortAddresses,omitempty"`
+
+	// ClusterNetwork are the CIDRs pod IPs are allocated from
+	// Default: 10.132.0.0/14
+	// This field is immutable.
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="clusterNetwork is immutable"
+	// +immutable
+	// +optional
+	ClusterNetwork []provisioningv1alpha1.CIDR `json:"clusterNetwork,omitempty"`
+
+	// ServiceNetwork are the CIDRs service IPs are allocated from
+	// Default: 172.31.0.0/16
+	// This field is immutable.
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="serviceNetwork is immutable"
+	// +immutable
+	// +optional
+	ServiceNetwork []provisioningv1alpha1.CIDR `json:"serviceNetwork,omitempty"`
+
+	// MachineNetwork are the CIDRs the DPU worker node addresses are in
+	// This field is immutable.
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="machineNetwork is immutable"
+	// +immutable
+	// +optional
+	MachineNetwork []provisioningv1alpha1.CIDR `json:"machineNetwork,omitempty"`
+
+	// HostPrefix is the prefix length of the pod subnet each node gets from the cluster network
+	// When unset, HyperShift assigns a /23 per node.
+	// This field is immutable.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="hostPrefix is immutable"
+	// +immutable
+	// +optional
+	HostPrefix *int32 `json:"hostPrefix,omitempty"`
+}
+
+// DefaultNodePoolSpec configures the default NodePool of the hosted cluster
+type DefaultNodePoolSpec struct {
+	// Replicas is the desired number of DPU worker nodes in the NodePool
+	// DPU workers join the hosted cluster through CSR approval, so this is the number of nodes
+	// HyperShift expects rather than a number of machines it provisions.
+	// Exposed through the scale subresource, e.g. kubectl scale dpfhcpbridge/<name> --replicas=N
+	// When unset, the NodePool is created with 0 replicas and its replica count is left alone afterwards,
+	// so that NodePools scaled directly are not scaled down.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// PublishIgnitionSecret copies the current NodePool user-data, ignition token and ignition server CA into
+	// a Secret named <name>-ignition in the bridge namespace, for tooling that boots DPUs out-of-band
+	// The secret references are always reported in status.ignition.
+	// +optional
+	PublishIgnitionSecret bool `json:"publishIgnitionSecret,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.nodePool.replicas,statuspath=.status.nodePoolStatus.readyReplicas
+// +kubebuilder:resource:scope=Namespaced,shortName=dpfhcp
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="HostedCluster",type=string,JSONPath=`.status.hostedClusterRef.name`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.ocpVersion`,priority=1
+// +kubebuilder:printcolumn:name="API",type=string,JSONPath=`.status.apiEndpoint`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:validation:XValidation:rule="has(self.spec.dpuClusterRef) || has(self.spec.dpuClusterSelector) || has(self.spec.dpuClusterRefs) || has(self.spec.bridgePoolRef)",message="exactly one of dpuClusterRef, dpuClusterSelector and dpuClusterRefs must be set"
+// +kubebuilder:validation:XValidation:rule="self.spec.controlPlaneAvailabilityPolicy != 'HighlyAvailable' || (has(self.spec.networking.virtualIP) && size(self.spec.networking.virtualIP) > 0)",message="networking.virtualIP is required when controlPlaneAvailabilityPolicy is HighlyAvailable"
+
+// DPFHCPBridge is the Schema for the dpfhcpbridges API
+type DPFHCPBridge struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DPFHCPBridgeSpec                        `json:"spec,omitempty"`
+	Status provisioningv1alpha1.DPFHCPBridgeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DPFHCPBridgeList contains a list of DPFHCPBridge
+type DPFHCPBridgeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DPFHCPBridge `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DPFHCPBridge{}, &DPFHCPBridgeList{})
+}
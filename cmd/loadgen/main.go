@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command loadgen measures DPFHCPBridge reconcile performance. It creates synthetic DPUClusters
+// and bridges in a dedicated namespace of a test apiserver running the operator, and reports how
+// long the controller takes to reconcile them. It must not be pointed at a production cluster.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/loadgen"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(provisioningv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(dpuprovisioningv1alpha1.AddToScheme(scheme))
+}
+
+func main() {
+	var opts loadgen.Options
+	var targetPhase string
+	var cleanup bool
+	var maxP99 time.Duration
+	flag.StringVar(&opts.Namespace, "namespace", "dpf-hcp-loadgen",
+		"Namespace for the synthetic objects. It is created if missing; an existing namespace must have been created by loadgen.")
+	flag.IntVar(&opts.Count, "count", 50, "Number of synthetic DPUCluster and DPFHCPBridge pairs.")
+	flag.IntVar(&opts.Concurrency, "concurrency", 10, "Number of create requests in flight.")
+	flag.StringVar(&targetPhase, "target-phase", string(provisioningv1alpha1.PhaseProvisioning),
+		"Bridge phase whose latency is measured.")
+	flag.DurationVar(&opts.Timeout, "timeout", 10*time.Minute, "Maximum duration of the run.")
+	flag.StringVar(&opts.ReleaseImage, "release-image", "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+		"OCP release image set on the synthetic bridges.")
+	flag.StringVar(&opts.RunID, "run-id", "", "Label value identifying the objects of the run. Defaults to a random ID.")
+	flag.BoolVar(&cleanup, "cleanup", true, "Delete the objects of the run when it ends.")
+	flag.DurationVar(&maxP99, "max-p99", 0,
+		"Fail if the p99 latency to the target phase exceeds this duration. Set to 0 to only report.")
+	flag.Parse()
+	opts.TargetPhase = provisioningv1alpha1.DPFHCPBridgePhase(targetPhase)
+
+	ctx := ctrl.SetupSignalHandler()
+	if err := run(ctx, opts, cleanup, maxP99); err != nil {
+		fmt.Fprintf(os.Stderr, "load test failed: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, opts loadgen.Options, cleanup bool, maxP99 time.Duration) error {
+	c, err := client.NewWithWatch(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	report, err := loadgen.Run(ctx, c, opts)
+	if err != nil {
+		return err
+	}
+	if cleanup {
+		// The run context may already be cancelled by a signal
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := loadgen.Cleanup(cleanupCtx, c, opts.Namespace, report.RunID); err != nil {
+			fmt.Fprintf(os.Stderr, "cleanup failed: %v\n", err)
+		}
+	}
+
+	printReport(os.Stdout, report)
+
+	var createErrors, missed int
+	for _, s := range report.Samples {
+		switch {
+		case s.CreateError != nil:
+			createErrors++
+		case !s.Reached():
+			missed++
+		}
+	}
+	if createErrors > 0 {
+		return fmt.Errorf("%d DPFHCPBridge(s) could not be created", createErrors)
+	}
+	if missed > 0 {
+		return fmt.Errorf("%d DPFHCPBridge(s) did not reach phase %s", missed, report.TargetPhase)
+	}
+	if p99 := loadgen.Percentile(report.TargetLatencies(), 99); maxP99 > 0 && p99 > maxP99 {
+		return fmt.Errorf("p99 latency to phase %s is %s, above the %s limit", report.TargetPhase, p99, maxP99)
+	}
+	return nil
+}
+
+// printReport prints the latency percentiles followed by the bridges that did not reach the
+// target phase
+func printReport(out io.Writer, report *loadgen.Report) {
+	_, _ = fmt.Fprintf(out, "run %s: %d bridges in %s, %.2f bridges/s reached phase %s\n\n",
+		report.RunID, len(report.Samples), report.Elapsed.Round(time.Millisecond), report.Throughput(), report.TargetPhase)
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "LATENCY\tCOUNT\tP50\tP90\tP99\tMAX")
+	for _, row := range []struct {
+		name string
+		ds   []time.Duration
+	}{
+		{"first reconcile", report.FirstReconcileLatencies()},
+		{"phase " + string(report.TargetPhase), report.TargetLatencies()},
+	} {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n", row.name, len(row.ds),
+			percentile(row.ds, 50), percentile(row.ds, 90), percentile(row.ds, 99), percentile(row.ds, 100))
+	}
+	_ = w.Flush()
+
+	var failed []*loadgen.Sample
+	for _, s := range report.Samples {
+		if !s.Reached() {
+			failed = append(failed, s)
+		}
+	}
+	if len(failed) == 0 {
+		return
+	}
+	_, _ = fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "BRIDGE\tPHASE\tERROR")
+	for _, s := range failed {
+		phase, errMsg := string(s.Phase), "-"
+		if phase == "" {
+			phase = "-"
+		}
+		if s.CreateError != nil {
+			errMsg = s.CreateError.Error()
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", s.Name, phase, errMsg)
+	}
+	_ = w.Flush()
+}
+
+func percentile(ds []time.Duration, p float64) string {
+	if len(ds) == 0 {
+		return "-"
+	}
+	return loadgen.Percentile(ds, p).Round(time.Millisecond).String()
+}
@@ -17,20 +17,27 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
+	"fmt"
+	"hash/fnv"
 	"os"
 	"path/filepath"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -40,13 +47,39 @@ import (
 	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	provisioningv1beta1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1beta1"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/agentplatform"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/approval"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/bfb"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/blackout"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/bluefield"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/chargeback"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/circuitbreaker"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/conditions"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/crdcheck"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/dpucluster"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/dpurollout"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/eventforward"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/finalizer"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/hooks"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/hostedcluster"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/inventory"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/kubeconfiginjection"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/manifests"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/metrics"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/orphans"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/overlays"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/releasecatalog"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/releasechannel"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/releasepin"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/render"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/retry"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/revalidation"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/secrets"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/throttle"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/readonly"
+	webhookprovisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -58,7 +91,10 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+
 	utilruntime.Must(provisioningv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(provisioningv1beta1.AddToScheme(scheme))
 	utilruntime.Must(dpuprovisioningv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(hyperv1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
@@ -73,6 +109,42 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var publishMergedKubeconfig bool
+	var kubeconfigReplicaNamespace string
+	var bfbURLTemplate string
+	var conditionDebounceWindow time.Duration
+	var shardLabelSelector string
+	var hostedClusterUpdateInterval time.Duration
+	var releaseVersionSource string
+	var blueFieldImageSource string
+	var blueFieldImageIndexURL string
+	var releaseMetadataCacheTTL time.Duration
+	var pinReleaseImages bool
+	var releaseSignatureKeysFile string
+	var releaseGraphURL string
+	var releaseChannelRecheckInterval time.Duration
+	var versionOverlaysFile string
+	var oidcPublishing string
+	var blackoutWindowsFile string
+	var holdUpgradesDuringDPURollouts bool
+	var dpuRolloutSelector string
+	var chargebackLabelsFile string
+	var secretBackendKind string
+	var secretBackendDir string
+	var sharedPullSecrets bool
+	var requireExternalApproval bool
+	var readOnly bool
+	var operatorVersion string
+	var conversionWebhookService string
+	var crdSchemaMismatch string
+	var bridgeAPIQPS float64
+	var bridgeAPIBurst int
+	var inventoryConfigMap string
+	var inventoryPushURL string
+	var inventoryPushTokenFile string
+	var inventoryReportInterval time.Duration
+	var orphanSweepInterval time.Duration
+	retryPolicies := retry.DefaultPolicies()
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -91,6 +163,119 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.BoolVar(&publishMergedKubeconfig, "publish-merged-kubeconfig", false,
+		"If set, a Secret with a merged kubeconfig covering all DPFHCPBridges is published in each bridge namespace.")
+	flag.StringVar(&kubeconfigReplicaNamespace, "kubeconfig-replica-namespace", "",
+		"If set, the HostedCluster admin kubeconfig of each DPFHCPBridge is replicated into this namespace "+
+			"(typically the DPF operator namespace) as <dpucluster>-admin-kubeconfig with key admin.conf.")
+	flag.StringVar(&bfbURLTemplate, "bfb-url-template", "",
+		"If set, a DPF BFB is created in the DPUCluster namespaces of each DPFHCPBridge once its BlueField image is resolved. "+
+			"The BFB URL is rendered from this Go template with the fields .OCPVersion, .Image and .ImageTag, "+
+			"e.g. https://bfb.example.com/rhcos-{{.OCPVersion}}.bfb.")
+	flag.DurationVar(&conditionDebounceWindow, "condition-debounce-window", conditions.DefaultDebounceWindow,
+		"How long a status change of a flapping condition (e.g. HostedClusterAvailable) must persist before it is recorded. "+
+			"Set to 0 to disable debouncing.")
+	flag.DurationVar(&hostedClusterUpdateInterval, "hostedcluster-update-interval", hostedcluster.DefaultUpdateInterval,
+		"Minimum time between two spec updates of the same HostedCluster; bridge edits made in between are coalesced. "+
+			"Set to 0 to disable rate limiting.")
+	flag.StringVar(&releaseVersionSource, "release-version-source", bluefield.VersionSourceMetadata,
+		"How the OCP version of ocpReleaseImage is determined: \"metadata\" reads the io.openshift.release label "+
+			"from the registry and falls back to parsing the image tag, \"tag\" only parses the image tag.")
+	flag.StringVar(&blueFieldImageSource, "bluefield-image-source", bluefield.ImageSourceConfigMap,
+		"Where BlueField images are looked up by OCP version: \"configmap\" reads the ocp-bluefield-images ConfigMap, "+
+			"\"imageset\" reads BlueFieldImageSet resources, \"http\" reads the JSON index at --bluefield-image-index-url.")
+	flag.StringVar(&blueFieldImageIndexURL, "bluefield-image-index-url", "",
+		"URL of a JSON object mapping OCP versions to BlueField images. Requires --bluefield-image-source=http.")
+	flag.DurationVar(&releaseMetadataCacheTTL, "release-metadata-cache-ttl", bluefield.DefaultRegistryCacheTTL,
+		"How long the release version read from the registry is reused per image and pull secret. "+
+			"Set to 0 to read it on every reconcile.")
+	flag.BoolVar(&pinReleaseImages, "pin-release-images", false,
+		"If set, the release image of a DPFHCPBridge is pinned to the digest its tag refers to before it is rolled out, "+
+			"and the HostedCluster and NodePools run the pinned digest.")
+	flag.StringVar(&releaseSignatureKeysFile, "release-signature-keys-file", "",
+		"Path to PEM encoded public keys. If set, a pinned release image digest must carry a cosign signature by one of them. "+
+			"Requires --pin-release-images.")
+	flag.StringVar(&releaseGraphURL, "release-graph-url", releasechannel.DefaultGraphURL,
+		"URL of the OpenShift update graph (Cincinnati) the spec.channel of DPFHCPBridges is resolved against.")
+	flag.DurationVar(&releaseChannelRecheckInterval, "release-channel-recheck-interval", releasechannel.DefaultRecheckInterval,
+		"How often the update graph is checked for a newer release of the channel of each DPFHCPBridge. "+
+			"Set to 0 to only resolve a channel when it is set or changed.")
+	flag.StringVar(&versionOverlaysFile, "version-overlays-file", "",
+		"Path to a YAML file with per-OCP-minor annotations, labels and spec defaults applied to new HostedClusters.")
+	flag.StringVar(&oidcPublishing, "oidc-service-publishing", hostedcluster.OIDCPublishingAuto,
+		"Whether new HostedClusters publish the OIDC service: \"auto\" follows publishOIDC of the version overlay "+
+			"and otherwise only publishes it in NodePort mode, \"always\" and \"never\" override it.")
+	flag.StringVar(&secretBackendKind, "secret-backend", secrets.BackendCluster,
+		"Where the pull secret and SSH key referenced by a DPFHCPBridge are read from: \"cluster\" reads Secrets in "+
+			"the bridge namespace, \"file\" reads <secret-backend-dir>/<namespace>/<name>/<key> files mounted into the operator.")
+	flag.StringVar(&secretBackendDir, "secret-backend-dir", "",
+		"Root directory of the file secret backend.")
+	flag.BoolVar(&sharedPullSecrets, "shared-pull-secrets", false,
+		"If set, the DPFHCPBridges of a namespace whose pull secret has the same data reference a single shared copy of it, "+
+			"reference-counted with labels, instead of one copy each.")
+	flag.BoolVar(&requireExternalApproval, "require-external-approval", false,
+		"If set, the HostedCluster of a DPFHCPBridge is only provisioned once an external system, e.g. a change-management "+
+			"workflow, approved it by setting the provisioning.dpu.hcp.io/approved annotation.")
+	flag.BoolVar(&readOnly, "read-only", false,
+		"If set, the operator only computes and reports the status, conditions and preflight results of the bridges: "+
+			"all other writes, in the management and the hosted clusters, are server-side dry-runs. "+
+			"Used to investigate a cluster without changing it, e.g. during disaster recovery.")
+	flag.StringVar(&blackoutWindowsFile, "blackout-windows-file", "",
+		"Path to a YAML file with recurring, time-zone aware blackout windows during which HostedCluster spec updates "+
+			"and NodePool upgrades of all bridges are deferred until the window ends.")
+	flag.BoolVar(&holdUpgradesDuringDPURollouts, "hold-upgrades-during-dpu-rollouts", false,
+		"If set, NodePool upgrades of a bridge are deferred while DPF rolls out DPUDeployments or DPUServices in the "+
+			"namespaces of its DPUClusters. Requires the DPF svc.dpu.nvidia.com API.")
+	flag.StringVar(&dpuRolloutSelector, "dpu-rollout-selector", "",
+		"If set, only the rollouts of the critical DPUDeployments and DPUServices matching this label selector "+
+			"hold back NodePool upgrades. Used with --hold-upgrades-during-dpu-rollouts.")
+	flag.StringVar(&chargebackLabelsFile, "chargeback-labels-file", "",
+		"Path to a YAML file with chargeback labels stamped on every HostedCluster and hosted control plane namespace, "+
+			"derived from the bridge namespace or labels.")
+	flag.DurationVar(&retryPolicies.Conflict.InitialDelay, "retry-conflict-initial-delay", retry.DefaultConflictInitialDelay,
+		"Delay before the first retry of a reconcile that failed on an update conflict; doubles on every consecutive conflict.")
+	flag.DurationVar(&retryPolicies.Conflict.MaxDelay, "retry-conflict-max-delay", retry.DefaultConflictMaxDelay,
+		"Upper bound for the delay between retries of update conflicts.")
+	flag.DurationVar(&retryPolicies.MissingInput.InitialDelay, "retry-missing-input-initial-delay", retry.DefaultMissingInputInitialDelay,
+		"Delay before the first retry of a reconcile that failed because a referenced object is missing or not readable; "+
+			"doubles on every consecutive failure.")
+	flag.DurationVar(&retryPolicies.MissingInput.MaxDelay, "retry-missing-input-max-delay", retry.DefaultMissingInputMaxDelay,
+		"Upper bound for the delay between retries of missing inputs.")
+	flag.DurationVar(&retryPolicies.Transient.InitialDelay, "retry-transient-initial-delay", retry.DefaultTransientInitialDelay,
+		"Delay before the first retry of a reconcile that failed on any other retryable error (timeouts, apiserver errors); "+
+			"doubles on every consecutive failure.")
+	flag.DurationVar(&retryPolicies.Transient.MaxDelay, "retry-transient-max-delay", retry.DefaultTransientMaxDelay,
+		"Upper bound for the delay between retries of transient errors.")
+	flag.StringVar(&operatorVersion, "operator-version", "",
+		"Version of this operator build. When it differs from the version a DPFHCPBridge was last revalidated against, "+
+			"its preflight checks are re-run once at startup. Leave empty to disable upgrade revalidation.")
+	flag.StringVar(&shardLabelSelector, "shard-label-selector", "",
+		"If set, only DPFHCPBridges matching this label selector (e.g. shard=a) are reconciled by this instance. "+
+			"Each shard uses its own leader election lease.")
+	flag.StringVar(&conversionWebhookService, "conversion-webhook-service", "",
+		"Name of the Service in front of the webhook server, in the namespace from the POD_NAMESPACE environment variable. "+
+			"If set, the DPFHCPBridge CRD conversion is pointed at it on startup. Leave empty when the CRD is configured externally.")
+	flag.StringVar(&crdSchemaMismatch, "crd-schema-mismatch", crdcheck.MismatchRefuse,
+		"What to do while the installed DPFHCPBridge CRD does not match the API types of this operator version: "+
+			"\"refuse\" stops reconciling bridges and fails the readiness check, \"warn\" only reports it.")
+	flag.Float64Var(&bridgeAPIQPS, "bridge-api-qps", throttle.DefaultQPS,
+		"Sustained rate of management cluster API requests the reconcile of each DPFHCPBridge may send, so that a "+
+			"misbehaving bridge cannot starve the rest of the fleet. 0 disables the per-bridge throttling.")
+	flag.IntVar(&bridgeAPIBurst, "bridge-api-burst", throttle.DefaultBurst,
+		"Number of management cluster API requests a DPFHCPBridge may send at once before --bridge-api-qps applies.")
+	flag.StringVar(&inventoryConfigMap, "inventory-configmap", "",
+		"If set, a report of all DPFHCPBridges (OCP version, DPUClusters, phase, API endpoint) is published as JSON "+
+			"into this ConfigMap in the namespace from the POD_NAMESPACE environment variable.")
+	flag.StringVar(&inventoryPushURL, "inventory-push-url", "",
+		"If set, the report of all DPFHCPBridges is POSTed as JSON to this URL.")
+	flag.StringVar(&inventoryPushTokenFile, "inventory-push-token-file", "",
+		"Path to a file with a bearer token sent with every push to --inventory-push-url. Read before every push.")
+	flag.DurationVar(&inventoryReportInterval, "inventory-report-interval", inventory.DefaultReportInterval,
+		"How often the report of all DPFHCPBridges is published.")
+	flag.DurationVar(&orphanSweepInterval, "orphan-sweep-interval", 0,
+		"How often Secrets, ConfigMaps and BFBs labelled as owned by a DPFHCPBridge that no longer exists are looked for "+
+			"and deleted, e.g. "+orphans.DefaultSweepInterval.String()+". With --shard-label-selector, only the objects whose "+
+			"labels match the selector are deleted. Disabled by default.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -188,13 +373,59 @@ func main() {
 		})
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	if err := retryPolicies.Validate(); err != nil {
+		setupLog.Error(err, "invalid retry policies")
+		os.Exit(1)
+	}
+
+	// Shards must not compete for the same leader election lease, so each selector gets its own
+	leaderElectionID := "4ebdb3db.dpu.hcp.io"
+	var shardSelector labels.Selector
+	if shardLabelSelector != "" {
+		var err error
+		shardSelector, err = labels.Parse(shardLabelSelector)
+		if err != nil {
+			setupLog.Error(err, "invalid shard label selector", "shard-label-selector", shardLabelSelector)
+			os.Exit(1)
+		}
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(shardSelector.String()))
+		leaderElectionID = fmt.Sprintf("%08x.%s", h.Sum32(), leaderElectionID)
+		setupLog.Info("Sharding enabled", "shard-label-selector", shardSelector.String(), "leader-election-id", leaderElectionID)
+	}
+
+	// Break the latency and errors of the management cluster API requests down by API group
+	restConfig := ctrl.GetConfigOrDie()
+	metrics.InstrumentAPIRequests(restConfig)
+
+	// Each bridge sends its API requests on a budget of its own; wrapped last so that the time a request
+	// waits for its budget is not reported as apiserver latency
+	var apiBudget *throttle.Budget
+	switch {
+	case bridgeAPIQPS < 0 || bridgeAPIBurst < 1:
+		setupLog.Error(fmt.Errorf("qps must be at least 0 and burst at least 1"), "invalid per-bridge API request budget",
+			"bridge-api-qps", bridgeAPIQPS, "bridge-api-burst", bridgeAPIBurst)
+		os.Exit(1)
+	case bridgeAPIQPS > 0:
+		apiBudget = throttle.NewBudget(float32(bridgeAPIQPS), bridgeAPIBurst)
+		apiBudget.Wrap(restConfig)
+	}
+
+	// In read-only mode every write but the status of the operator's own resources is a dry-run
+	var newClient client.NewClientFunc
+	if readOnly {
+		setupLog.Info("Running in read-only mode, only the status of the operator's resources is written")
+		newClient = readonly.NewClient
+	}
+
+	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		Scheme:                 scheme,
+		NewClient:              newClient,
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "4ebdb3db.dpu.hcp.io",
+		LeaderElectionID:       leaderElectionID,
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -214,37 +445,213 @@ func main() {
 
 	// Initialize BlueField Image Resolver
 	imageResolver := bluefield.NewImageResolver(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"))
+	imageSource, err := bluefield.NewImageSource(blueFieldImageSource, mgr.GetClient(), blueFieldImageIndexURL)
+	if err != nil {
+		setupLog.Error(err, "invalid BlueField image source", "bluefield-image-source", blueFieldImageSource)
+		os.Exit(1)
+	}
+	if blueFieldImageIndexURL != "" && blueFieldImageSource != bluefield.ImageSourceHTTP {
+		setupLog.Error(fmt.Errorf("requires --bluefield-image-source=%s", bluefield.ImageSourceHTTP),
+			"BlueField image index URL set for another image source", "bluefield-image-index-url", blueFieldImageIndexURL)
+		os.Exit(1)
+	}
+	imageResolver.Source = imageSource
+	// The registry client is shared by release version detection and release image pinning
+	registryReader := bluefield.NewRegistryMetadataReader()
+	registryReader.CacheTTL = releaseMetadataCacheTTL
+	switch releaseVersionSource {
+	case bluefield.VersionSourceMetadata:
+		imageResolver.MetadataReader = registryReader
+	case bluefield.VersionSourceTag:
+	default:
+		setupLog.Error(fmt.Errorf("must be %q or %q", bluefield.VersionSourceMetadata, bluefield.VersionSourceTag),
+			"invalid release version source", "release-version-source", releaseVersionSource)
+		os.Exit(1)
+	}
+
+	// Initialize Release Image Pinner
+	var releasePinner *releasepin.Pinner
+	if pinReleaseImages {
+		releasePinner = releasepin.NewPinner(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"), registryReader)
+		if releaseSignatureKeysFile != "" {
+			keys, err := releasepin.LoadPublicKeysFile(releaseSignatureKeysFile)
+			if err != nil {
+				setupLog.Error(err, "invalid release signature keys", "release-signature-keys-file", releaseSignatureKeysFile)
+				os.Exit(1)
+			}
+			releasePinner.PublicKeys = keys
+		}
+	} else if releaseSignatureKeysFile != "" {
+		setupLog.Error(fmt.Errorf("requires --pin-release-images"),
+			"release signature keys set without release image pinning", "release-signature-keys-file", releaseSignatureKeysFile)
+		os.Exit(1)
+	}
+
+	// Initialize Release Channel Resolver
+	channelResolver := releasechannel.NewResolver(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"),
+		releasechannel.NewCincinnatiGraph(releaseGraphURL))
+	channelResolver.RecheckInterval = releaseChannelRecheckInterval
 
 	// Initialize DPUCluster Validator
 	dpuClusterValidator := dpucluster.NewValidator(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"))
 
+	// Initialize the backend the referenced pull secrets and SSH keys are read from
+	secretBackend, err := secrets.NewBackend(secretBackendKind, mgr.GetClient(), secretBackendDir)
+	if err != nil {
+		setupLog.Error(err, "invalid secret backend", "secret-backend", secretBackendKind)
+		os.Exit(1)
+	}
+
 	// Initialize Secrets Validator
 	secretsValidator := secrets.NewValidator(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"))
+	secretsValidator.Backend = secretBackend
+	if secretBackendKind == secrets.BackendFile {
+		secretsValidator.RecheckInterval = secrets.DefaultFileRecheckInterval
+	}
 
 	// Initialize Secret Manager for HostedCluster lifecycle
 	secretManager := hostedcluster.NewSecretManager(mgr.GetClient(), mgr.GetScheme())
+	secretManager.Recorder = mgr.GetEventRecorderFor("dpfhcpbridge-controller")
+	secretManager.Backend = secretBackend
+	secretManager.SharedPullSecrets = sharedPullSecrets
+
+	// Initialize the circuit breaker shared by all bridges for HyperShift API writes
+	hypershiftBreaker := circuitbreaker.NewBreaker("hypershift", circuitbreaker.DefaultFailureThreshold, circuitbreaker.DefaultOpenDuration)
 
 	// Initialize HostedCluster Manager
 	hostedClusterManager := hostedcluster.NewHostedClusterManager(mgr.GetClient(), mgr.GetScheme())
+	hostedClusterManager.Breaker = hypershiftBreaker
+	hostedClusterManager.Recorder = mgr.GetEventRecorderFor("dpfhcpbridge-controller")
+	hostedClusterManager.UpdateInterval = hostedClusterUpdateInterval
+	if versionOverlaysFile != "" {
+		versionOverlays, err := overlays.LoadFile(versionOverlaysFile)
+		if err != nil {
+			setupLog.Error(err, "invalid version overlays", "version-overlays-file", versionOverlaysFile)
+			os.Exit(1)
+		}
+		hostedClusterManager.Overlays = versionOverlays
+	}
+	switch oidcPublishing {
+	case hostedcluster.OIDCPublishingAuto, hostedcluster.OIDCPublishingAlways, hostedcluster.OIDCPublishingNever:
+		hostedClusterManager.OIDCPublishing = oidcPublishing
+	default:
+		setupLog.Error(fmt.Errorf("must be %q, %q or %q", hostedcluster.OIDCPublishingAuto,
+			hostedcluster.OIDCPublishingAlways, hostedcluster.OIDCPublishingNever),
+			"invalid OIDC service publishing", "oidc-service-publishing", oidcPublishing)
+		os.Exit(1)
+	}
 
 	// Initialize NodePool Manager
 	nodePoolManager := hostedcluster.NewNodePoolManager(mgr.GetClient(), mgr.GetScheme())
+	nodePoolManager.Breaker = hypershiftBreaker
+
+	// Blackout windows apply to all bridges of this operator instance
+	if blackoutWindowsFile != "" {
+		blackoutWindows, err := blackout.LoadFile(blackoutWindowsFile)
+		if err != nil {
+			setupLog.Error(err, "invalid blackout windows", "blackout-windows-file", blackoutWindowsFile)
+			os.Exit(1)
+		}
+		hostedClusterManager.Blackout = blackoutWindows
+		nodePoolManager.Blackout = blackoutWindows
+	}
+
+	// NodePool upgrades wait for the DPU service rollouts DPF runs during maintenance
+	if holdUpgradesDuringDPURollouts {
+		nodePoolManager.DPURollouts = dpurollout.NewGuard(mgr.GetClient())
+		if dpuRolloutSelector != "" {
+			selector, err := labels.Parse(dpuRolloutSelector)
+			if err != nil {
+				setupLog.Error(err, "invalid DPU rollout selector", "dpu-rollout-selector", dpuRolloutSelector)
+				os.Exit(1)
+			}
+			nodePoolManager.DPURollouts.Selector = selector
+		}
+	}
+
+	// Chargeback labels apply to all bridges of this operator instance
+	if chargebackLabelsFile != "" {
+		chargebackLabels, err := chargeback.LoadFile(chargebackLabelsFile)
+		if err != nil {
+			setupLog.Error(err, "invalid chargeback labels", "chargeback-labels-file", chargebackLabelsFile)
+			os.Exit(1)
+		}
+		hostedClusterManager.Chargeback = chargebackLabels
+	}
 
 	// Initialize Kubeconfig Injector
 	kubeconfigInjector := kubeconfiginjection.NewKubeconfigInjector(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"))
+	kubeconfigInjector.PublishMergedKubeconfig = publishMergedKubeconfig
+	kubeconfigInjector.ReplicaNamespace = kubeconfigReplicaNamespace
 
 	// Initialize Finalizer Manager with pluggable cleanup handlers
 	// Handlers are executed in registration order
 	finalizerManager := finalizer.NewManager(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"))
 
+	// Initialize lifecycle hook runner
+	hookRunner := hooks.NewRunner(mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"))
+
 	// Register cleanup handlers in order (dependent resources first)
+	// 0. Pre-delete hooks (must run while the HostedCluster still exists)
+	finalizerManager.RegisterHandler(hooks.NewPreDeleteCleanupHandler(mgr.GetClient(), hookRunner))
 	// 1. Kubeconfig injection cleanup (removes kubeconfig from DPUCluster namespace)
-	finalizerManager.RegisterHandler(kubeconfiginjection.NewCleanupHandler(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller")))
+	kubeconfigCleanupHandler := kubeconfiginjection.NewCleanupHandler(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"))
+	kubeconfigCleanupHandler.PublishMergedKubeconfig = publishMergedKubeconfig
+	kubeconfigCleanupHandler.ReplicaNamespace = kubeconfigReplicaNamespace
+	finalizerManager.RegisterHandler(kubeconfigCleanupHandler)
+	// 1b. BFB cleanup (removes the BFBs created in the DPUCluster namespaces)
+	var bfbPublisher *bfb.Publisher
+	if bfbURLTemplate != "" {
+		bfbPublisher, err = bfb.NewPublisher(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"), bfbURLTemplate)
+		if err != nil {
+			setupLog.Error(err, "unable to set up BFB publishing")
+			os.Exit(1)
+		}
+		finalizerManager.RegisterHandler(bfb.NewCleanupHandler(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller")))
+	}
 	// 2. HostedCluster cleanup (removes HostedCluster, NodePool, and secrets)
 	finalizerManager.RegisterHandler(hostedcluster.NewCleanupHandler(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller")))
 
+	var approvalGate *approval.Gate
+	if requireExternalApproval {
+		approvalGate = approval.NewGate(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"))
+	}
+
+	manifestApplier := manifests.NewApplier(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"))
+	eventForwarder := eventforward.NewForwarder(mgr.GetClient(), mgr.GetAPIReader())
+	if readOnly {
+		manifestApplier.NewHostedClusterClient = readonly.NewHostedClusterClient
+		eventForwarder.NewHostedClusterClient = readonly.NewHostedClusterClient
+	}
+
+	// Manifests are rendered for review through the render-manifests annotation
+	manifestRenderer := render.NewRenderer(mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"),
+		hostedClusterManager, nodePoolManager)
+
+	// Compare the installed CRD before any bridge is reconciled, as writing through a CRD of another
+	// operator version silently drops the fields only one of them knows
+	crdChecker := crdcheck.NewChecker(mgr.GetClient(), mgr.GetAPIReader())
+	switch crdSchemaMismatch {
+	case crdcheck.MismatchRefuse, crdcheck.MismatchWarn:
+		crdChecker.Mismatch = crdSchemaMismatch
+	default:
+		setupLog.Error(fmt.Errorf("must be %q or %q", crdcheck.MismatchRefuse, crdcheck.MismatchWarn),
+			"invalid CRD schema mismatch handling", "crd-schema-mismatch", crdSchemaMismatch)
+		os.Exit(1)
+	}
+	crdChecker.ShardSelector = shardSelector
+	if err := crdChecker.Check(context.Background()); err != nil {
+		setupLog.Error(err, "unable to check the installed CRD")
+		os.Exit(1)
+	}
+	if !crdChecker.Compatible() {
+		setupLog.Info("installed CRD does not match this operator version, see the CRDSchemaCompatible condition of the DPFHCPBridges",
+			"crd", crdChecker.CRDName, "crd-schema-mismatch", crdSchemaMismatch)
+	}
+
 	// Initialize Status Syncer for HostedCluster status mirroring
 	statusSyncer := hostedcluster.NewStatusSyncer(mgr.GetClient())
+	statusSyncer.Debouncer = conditions.NewDebouncer(conditionDebounceWindow)
 
 	if err := (&controller.DPFHCPBridgeReconciler{
 		Client:               mgr.GetClient(),
@@ -253,18 +660,137 @@ func main() {
 		ImageResolver:        imageResolver,
 		DPUClusterValidator:  dpuClusterValidator,
 		SecretsValidator:     secretsValidator,
+		ConflictDetector:     hostedcluster.NewConflictDetector(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller")),
+		ChannelResolver:      channelResolver,
+		ReleaseResolver:      releasecatalog.NewResolver(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller")),
+		ReleasePinner:        releasePinner,
 		SecretManager:        secretManager,
 		HostedClusterManager: hostedClusterManager,
 		NodePoolManager:      nodePoolManager,
 		FinalizerManager:     finalizerManager,
 		StatusSyncer:         statusSyncer,
 		KubeconfigInjector:   kubeconfigInjector,
+		ManifestApplier:      manifestApplier,
+		EventForwarder:       eventForwarder,
+		AgentProvisioner:     agentplatform.NewProvisioner(mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("dpfhcpbridge-controller")),
+		BFBPublisher:         bfbPublisher,
+		ApprovalGate:         approvalGate,
+		ManifestRenderer:     manifestRenderer,
+		CRDChecker:           crdChecker,
+		APIBudget:            apiBudget,
+		ReadOnly:             readOnly,
+		PostProvisionManager: hooks.NewPostProvisionManager(mgr.GetClient(), hookRunner, mgr.GetEventRecorderFor("dpfhcpbridge-controller")),
+		ShardSelector:        shardSelector,
+		RetryPolicies:        &retryPolicies,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DPFHCPBridge")
 		os.Exit(1)
 	}
+	if err := (&controller.BridgePoolReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Recorder:      mgr.GetEventRecorderFor("bridgepool-controller"),
+		ShardSelector: shardSelector,
+		RetryPolicies: &retryPolicies,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BridgePool")
+		os.Exit(1)
+	}
+	if err := (&controller.BridgeTemplateReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Recorder:      mgr.GetEventRecorderFor("bridgetemplate-controller"),
+		ShardSelector: shardSelector,
+		RetryPolicies: &retryPolicies,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BridgeTemplate")
+		os.Exit(1)
+	}
+	if err := webhookprovisioningv1alpha1.SetupDPFHCPBridgeWebhookWithManager(mgr, hostedClusterManager.Blackout); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "DPFHCPBridge")
+		os.Exit(1)
+	}
 	// +kubebuilder:scaffold:builder
 
+	if conversionWebhookService != "" {
+		// The conversion is configured even in read-only mode: it only touches the operator's own CRD,
+		// and without it the v1beta1 bridges cannot be read, let alone reported on
+		conversionClient := mgr.GetClient()
+		if readOnly {
+			conversionClient, err = client.New(mgr.GetConfig(),
+				client.Options{Scheme: mgr.GetScheme(), Mapper: mgr.GetRESTMapper()})
+			if err != nil {
+				setupLog.Error(err, "unable to create client for the conversion webhook configuration")
+				os.Exit(1)
+			}
+		}
+		conversionConfigurer := webhookprovisioningv1alpha1.NewConversionConfigurer(conversionClient,
+			os.Getenv("POD_NAMESPACE"), conversionWebhookService)
+		if err := mgr.Add(conversionConfigurer); err != nil {
+			setupLog.Error(err, "unable to add conversion webhook configuration to manager")
+			os.Exit(1)
+		}
+	}
+
+	if operatorVersion != "" {
+		preflights := revalidation.DefaultPreflights(mgr.GetClient(),
+			os.Getenv("ENABLE_BLUEFIELD_VALIDATION") == "true", imageResolver.MetadataReader, imageResolver.Source, secretBackend)
+		revalidator := revalidation.NewRevalidator(mgr.GetClient(), mgr.GetEventRecorderFor("dpfhcpbridge-controller"),
+			operatorVersion, preflights)
+		revalidator.ShardSelector = shardSelector
+		if err := mgr.Add(revalidator); err != nil {
+			setupLog.Error(err, "unable to add upgrade revalidation to manager")
+			os.Exit(1)
+		}
+	}
+
+	if err := mgr.Add(crdChecker); err != nil {
+		setupLog.Error(err, "unable to add CRD schema check to manager")
+		os.Exit(1)
+	}
+
+	// The circuit state is reported on the bridges rather than through the readiness probe:
+	// the operator must stay Ready while the HyperShift API is down so that it can recover
+	breakerReporter := circuitbreaker.NewStatusReporter(mgr.GetClient(), hypershiftBreaker)
+	breakerReporter.ShardSelector = shardSelector
+	if err := mgr.Add(breakerReporter); err != nil {
+		setupLog.Error(err, "unable to add circuit breaker reporter to manager")
+		os.Exit(1)
+	}
+
+	if inventoryConfigMap != "" || inventoryPushURL != "" {
+		if inventoryReportInterval <= 0 {
+			setupLog.Error(fmt.Errorf("must be positive"), "invalid inventory report interval",
+				"inventory-report-interval", inventoryReportInterval)
+			os.Exit(1)
+		}
+		if inventoryConfigMap != "" && os.Getenv("POD_NAMESPACE") == "" {
+			setupLog.Error(fmt.Errorf("POD_NAMESPACE is not set"), "unable to publish the inventory ConfigMap")
+			os.Exit(1)
+		}
+		inventoryPublisher := inventory.NewPublisher(mgr.GetClient())
+		inventoryPublisher.Interval = inventoryReportInterval
+		inventoryPublisher.ConfigMapNamespace = os.Getenv("POD_NAMESPACE")
+		inventoryPublisher.ConfigMapName = inventoryConfigMap
+		inventoryPublisher.URL = inventoryPushURL
+		inventoryPublisher.TokenFile = inventoryPushTokenFile
+		inventoryPublisher.ShardSelector = shardSelector
+		if err := mgr.Add(inventoryPublisher); err != nil {
+			setupLog.Error(err, "unable to add inventory report to manager")
+			os.Exit(1)
+		}
+	}
+
+	if orphanSweepInterval > 0 {
+		orphanSweeper := orphans.NewSweeper(mgr.GetClient(), mgr.GetAPIReader())
+		orphanSweeper.Interval = orphanSweepInterval
+		orphanSweeper.ShardSelector = shardSelector
+		if err := mgr.Add(orphanSweeper); err != nil {
+			setupLog.Error(err, "unable to add orphan sweeper to manager")
+			os.Exit(1)
+		}
+	}
+
 	if metricsCertWatcher != nil {
 		setupLog.Info("Adding metrics certificate watcher to manager")
 		if err := mgr.Add(metricsCertWatcher); err != nil {
@@ -290,6 +816,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := mgr.AddReadyzCheck("crd-schema", crdChecker.ReadyzCheck); err != nil {
+		setupLog.Error(err, "unable to set up CRD schema ready check")
+		os.Exit(1)
+	}
+
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
@@ -0,0 +1,183 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command migrate onboards an existing fleet of hand-created HostedClusters by generating
+// adoption-mode DPFHCPBridges for them. It runs once, by default as a dry-run that only
+// reports what would be created. With --import, it prints the DPFHCPBridge of a single
+// HostedCluster instead, to be reviewed and applied by hand.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/migrate"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(provisioningv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(dpuprovisioningv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(hyperv1.AddToScheme(scheme))
+}
+
+func main() {
+	var namespace string
+	var dryRun bool
+	var printManifests bool
+	var batchSize int
+	var batchInterval time.Duration
+	var importCluster string
+	flag.StringVar(&namespace, "namespace", "", "Only migrate HostedClusters in this namespace. Defaults to all namespaces.")
+	flag.BoolVar(&dryRun, "dry-run", true,
+		"Validate the generated DPFHCPBridges with a server-side dry-run and print the report without creating anything. "+
+			"Use --dry-run=false to create them.")
+	flag.BoolVar(&printManifests, "print-manifests", false, "Print the generated DPFHCPBridges as YAML.")
+	flag.IntVar(&batchSize, "batch-size", 10, "Number of DPFHCPBridges created before pausing. Set to 0 to create all at once.")
+	flag.DurationVar(&batchInterval, "batch-interval", 30*time.Second, "Pause between batches.")
+	flag.StringVar(&importCluster, "import", "",
+		"Print the DPFHCPBridge of the HostedCluster <namespace>/<name> as YAML, with its warnings on stderr, "+
+			"without validating or creating it. The other flags are ignored.")
+	flag.Parse()
+
+	ctx := ctrl.SetupSignalHandler()
+	if importCluster != "" {
+		if err := runImport(ctx, importCluster); err != nil {
+			fmt.Fprintf(os.Stderr, "import failed: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+	if err := run(ctx, namespace, dryRun, printManifests, batchSize, batchInterval); err != nil {
+		fmt.Fprintf(os.Stderr, "migration failed: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, namespace string, dryRun, printManifests bool, batchSize int, batchInterval time.Duration) error {
+	c, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	report, err := migrate.Plan(ctx, c, migrate.Options{Namespace: namespace})
+	if err != nil {
+		return err
+	}
+
+	failed := migrate.Apply(ctx, c, report, migrate.ApplyOptions{
+		DryRun:        dryRun,
+		BatchSize:     batchSize,
+		BatchInterval: batchInterval,
+	})
+
+	printReport(os.Stdout, report)
+	if printManifests {
+		if err := printBridges(os.Stdout, report); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d DPFHCPBridge(s) could not be created", failed)
+	}
+	return nil
+}
+
+// runImport prints the DPFHCPBridge generated for a single HostedCluster
+func runImport(ctx context.Context, hostedCluster string) error {
+	namespace, name, ok := strings.Cut(hostedCluster, "/")
+	if !ok || namespace == "" || name == "" {
+		return fmt.Errorf("--import must be <namespace>/<name>, got %q", hostedCluster)
+	}
+
+	c, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	report, err := migrate.Plan(ctx, c, migrate.Options{Namespace: namespace, Name: name})
+	if err != nil {
+		return err
+	}
+
+	entry := report.Entries[0]
+	if entry.Action == migrate.ActionSkip {
+		return fmt.Errorf("HostedCluster %s cannot be imported: %s", entry.HostedCluster, entry.Reason)
+	}
+	for _, warning := range entry.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
+	return printBridges(os.Stdout, report)
+}
+
+// printReport prints one line per HostedCluster followed by its warnings
+func printReport(out io.Writer, report *migrate.Report) {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "HOSTEDCLUSTER\tDPUCLUSTER\tACTION\tRESULT")
+	for _, e := range report.Entries {
+		dpuCluster := "-"
+		if e.DPUCluster != nil {
+			dpuCluster = e.DPUCluster.String()
+		}
+		result := e.Result
+		if e.Action == migrate.ActionSkip {
+			result = e.Reason
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.HostedCluster, dpuCluster, e.Action, result)
+		for _, warning := range e.Warnings {
+			_, _ = fmt.Fprintf(w, "\t\t\twarning: %s\n", warning)
+		}
+	}
+	_ = w.Flush()
+}
+
+// printBridges prints the generated DPFHCPBridges as a multi-document YAML stream
+func printBridges(out io.Writer, report *migrate.Report) error {
+	var docs []string
+	for _, e := range report.Entries {
+		if e.Bridge == nil {
+			continue
+		}
+		data, err := yaml.Marshal(e.Bridge)
+		if err != nil {
+			return fmt.Errorf("failed to marshal DPFHCPBridge %s/%s: %w", e.Bridge.Namespace, e.Bridge.Name, err)
+		}
+		docs = append(docs, string(data))
+	}
+	if len(docs) > 0 {
+		_, _ = fmt.Fprintf(out, "---\n%s", strings.Join(docs, "---\n"))
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Objects created on behalf of a DPFHCPBridge in another namespace cannot carry an
+// OwnerReference, so garbage collection is done by the operator instead: such objects are
+// labelled with the owning bridge and deleted by label from the bridge finalizer.
+// Same-namespace objects are labelled too, so every cleanup goes through the same path.
+const (
+	// LabelOwnedBy is the label key carrying the name of the owning DPFHCPBridge
+	LabelOwnedBy = "dpf-hcp-bridge-operator/owned-by"
+
+	// LabelNamespace is the label key carrying the namespace of the owning DPFHCPBridge
+	LabelNamespace = "dpf-hcp-bridge-operator/namespace"
+
+	// LabelComponent is the label key telling which feature created an owned object,
+	// so that each cleanup handler only deletes its own objects
+	LabelComponent = "dpf-hcp-bridge-operator/component"
+
+	// ComponentHostedClusterSecrets marks the secrets copied or generated for the HostedCluster
+	ComponentHostedClusterSecrets = "hostedcluster-secrets"
+
+	// ComponentKubeconfig marks the kubeconfig secret injected into the DPUCluster namespace
+	ComponentKubeconfig = "kubeconfig"
+
+	// ComponentKubeconfigReplica marks the kubeconfig copy replicated into the DPF operator namespace
+	ComponentKubeconfigReplica = "kubeconfig-replica"
+
+	// ComponentKubeconfigExport marks the kubeconfig copy exported to the Secret named by spec.kubeconfigExport
+	ComponentKubeconfigExport = "kubeconfig-export"
+
+	// ComponentIgnition marks the copy of the NodePool boot artifacts published in the bridge namespace
+	ComponentIgnition = "ignition"
+
+	// ComponentTimeSync marks the NodePool config ConfigMap carrying the chrony configuration of the DPU workers
+	ComponentTimeSync = "timesync"
+
+	// ComponentNodeTuning marks the NodePool config ConfigMap carrying the kernel arguments of the DPU workers
+	ComponentNodeTuning = "nodetuning"
+
+	// ComponentContainerRuntime marks the NodePool config ConfigMap carrying the CRI-O settings of the DPU workers
+	ComponentContainerRuntime = "containerruntime"
+
+	// ComponentRegistries marks the NodePool config ConfigMap carrying the registries.conf drop-ins of the DPU workers
+	ComponentRegistries = "registries"
+
+	// ComponentEventForwarding marks the ConfigMap the bridge events are forwarded to in the hosted cluster
+	ComponentEventForwarding = "event-forwarding"
+
+	// ComponentBFB marks the DPF BFBs created from the BlueField image in the DPUCluster namespaces
+	ComponentBFB = "bfb"
+
+	// ComponentSharedPullSecret marks the pull secret copies shared by the bridges of a namespace. They carry
+	// no ownership labels, as they are not owned by a single bridge.
+	ComponentSharedPullSecret = "shared-pull-secret"
+
+	// ComponentRenderedManifests marks the ConfigMap holding the manifests rendered for a GitOps review
+	ComponentRenderedManifests = "rendered-manifests"
+)
+
+// OwnerLabels returns the labels identifying objects owned by the given DPFHCPBridge
+func OwnerLabels(owner client.Object) map[string]string {
+	return map[string]string{
+		LabelOwnedBy:   owner.GetName(),
+		LabelNamespace: owner.GetNamespace(),
+	}
+}
+
+// ComponentOwnerLabels returns the ownership labels of the given DPFHCPBridge plus the component label
+func ComponentOwnerLabels(owner client.Object, component string) map[string]string {
+	l := OwnerLabels(owner)
+	l[LabelComponent] = component
+	return l
+}
+
+// SetOwnerLabels adds the ownership and component labels to obj, keeping its other labels
+func SetOwnerLabels(obj client.Object, owner client.Object, component string) {
+	l := obj.GetLabels()
+	if l == nil {
+		l = map[string]string{}
+	}
+	for k, v := range ComponentOwnerLabels(owner, component) {
+		l[k] = v
+	}
+	obj.SetLabels(l)
+}
+
+// ComponentIn returns a label requirement matching objects of one of the given components
+func ComponentIn(components ...string) labels.Requirement {
+	return componentRequirement(selection.In, components)
+}
+
+// ComponentNotIn returns a label requirement matching objects of none of the given components,
+// including objects labelled before component labels were introduced
+func ComponentNotIn(components ...string) labels.Requirement {
+	return componentRequirement(selection.NotIn, components)
+}
+
+func componentRequirement(op selection.Operator, components []string) labels.Requirement {
+	req, err := labels.NewRequirement(LabelComponent, op, components)
+	if err != nil {
+		// Components are constants of this package and always valid label values
+		panic(fmt.Sprintf("invalid component requirement: %v", err))
+	}
+	return *req
+}
+
+// ListOwnedObjects returns all objects of the list's kind in the given namespace (all namespaces if
+// empty) that are labelled as owned by the given DPFHCPBridge and match the additional label
+// requirements (typically ComponentIn).
+func ListOwnedObjects(ctx context.Context, c client.Client, owner client.Object, list client.ObjectList, namespace string, reqs ...labels.Requirement) ([]client.Object, error) {
+	selector := labels.SelectorFromSet(OwnerLabels(owner)).Add(reqs...)
+	if err := c.List(ctx, list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list owned objects: %w", err)
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract owned objects: %w", err)
+	}
+
+	objs := make([]client.Object, 0, len(items))
+	for _, item := range items {
+		if obj, ok := item.(client.Object); ok {
+			objs = append(objs, obj)
+		}
+	}
+	return objs, nil
+}
+
+// DeleteOwnedObjects deletes all objects of the list's kind in the given namespace (all
+// namespaces if empty) that are labelled as owned by the given DPFHCPBridge and match the
+// additional label requirements (typically ComponentIn). It is meant to be called from
+// finalizer cleanup handlers; an error means the cleanup must be retried.
+//
+// Returns the number of objects deleted.
+func DeleteOwnedObjects(ctx context.Context, c client.Client, owner client.Object, list client.ObjectList, namespace string, reqs ...labels.Requirement) (int, error) {
+	log := logf.FromContext(ctx)
+
+	objs, err := ListOwnedObjects(ctx, c, owner, list, namespace, reqs...)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, obj := range objs {
+		if err := c.Delete(ctx, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				// Already deleted (race condition)
+				continue
+			}
+			return deleted, fmt.Errorf("failed to delete owned object %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		}
+
+		deleted++
+		log.V(1).Info("Deleted owned object",
+			"name", obj.GetName(),
+			"namespace", obj.GetNamespace())
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Label-based ownership", func() {
+	var (
+		ctx   context.Context
+		owner *corev1.ConfigMap
+	)
+
+	secret := func(name, namespace string, labels map[string]string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}}
+	}
+
+	remaining := func(c client.Client) []string {
+		list := &corev1.SecretList{}
+		Expect(c.List(ctx, list)).To(Succeed())
+		var names []string
+		for _, s := range list.Items {
+			names = append(names, s.Namespace+"/"+s.Name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		// Any object works as owner; only its name and namespace are used
+		owner = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "bridge", Namespace: "clusters"}}
+	})
+
+	It("should add ownership and component labels without dropping existing ones", func() {
+		obj := secret("s", "clusters", map[string]string{"app": "test"})
+		SetOwnerLabels(obj, owner, ComponentKubeconfig)
+
+		Expect(obj.Labels).To(Equal(map[string]string{
+			"app":          "test",
+			LabelOwnedBy:   "bridge",
+			LabelNamespace: "clusters",
+			LabelComponent: ComponentKubeconfig,
+		}))
+	})
+
+	It("should delete owned objects across namespaces only", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+			secret("kubeconfig", "dpf-system", ComponentOwnerLabels(owner, ComponentKubeconfig)),
+			secret("pull-secret", "clusters", ComponentOwnerLabels(owner, ComponentHostedClusterSecrets)),
+			secret("other-bridge", "dpf-system", map[string]string{LabelOwnedBy: "other", LabelNamespace: "clusters"}),
+			secret("unrelated", "clusters", nil),
+		).Build()
+
+		deleted, err := DeleteOwnedObjects(ctx, c, owner, &corev1.SecretList{}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(Equal(2))
+		Expect(remaining(c)).To(ConsistOf("dpf-system/other-bridge", "clusters/unrelated"))
+	})
+
+	It("should restrict deletion to the requested components", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+			secret("kubeconfig", "dpf-system", ComponentOwnerLabels(owner, ComponentKubeconfig)),
+			secret("legacy-kubeconfig", "dpf-system", OwnerLabels(owner)),
+			secret("pull-secret", "clusters", ComponentOwnerLabels(owner, ComponentHostedClusterSecrets)),
+		).Build()
+
+		deleted, err := DeleteOwnedObjects(ctx, c, owner, &corev1.SecretList{}, "", ComponentNotIn(ComponentHostedClusterSecrets))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(Equal(2))
+		Expect(remaining(c)).To(ConsistOf("clusters/pull-secret"))
+
+		deleted, err = DeleteOwnedObjects(ctx, c, owner, &corev1.SecretList{}, "", ComponentIn(ComponentHostedClusterSecrets))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(Equal(1))
+		Expect(remaining(c)).To(BeEmpty())
+	})
+
+	It("should restrict deletion to the given namespace", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+			secret("kubeconfig", "dpf-system", ComponentOwnerLabels(owner, ComponentKubeconfig)),
+			secret("pull-secret", "clusters", ComponentOwnerLabels(owner, ComponentHostedClusterSecrets)),
+		).Build()
+
+		deleted, err := DeleteOwnedObjects(ctx, c, owner, &corev1.SecretList{}, "dpf-system")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(Equal(1))
+		Expect(remaining(c)).To(ConsistOf("clusters/pull-secret"))
+	})
+})
@@ -0,0 +1,35 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PatchStatus persists the status changes made to obj since base, a deep copy taken before the changes, with
+// a merge patch. Unlike Status().Update, the patch only carries the changed fields and no resourceVersion, so it
+// does not fail when the object was modified since it was read, e.g. by another status writer under load.
+// A patch rejected with a conflict is retried as is, keeping the other in-memory changes of obj.
+func PatchStatus(ctx context.Context, c client.Client, obj, base client.Object) error {
+	patch := client.MergeFrom(base)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		return c.Status().Patch(ctx, obj, patch)
+	})
+}
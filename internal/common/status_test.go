@@ -0,0 +1,86 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("PatchStatus", func() {
+	var (
+		ctx context.Context
+		pod *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"}}
+	})
+
+	It("should persist the status of a stale copy without touching the other fields", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(pod).WithStatusSubresource(pod).Build()
+		stale := &corev1.Pod{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(pod), stale)).To(Succeed())
+
+		// Another writer modifies the pod after the stale copy was read
+		latest := stale.DeepCopy()
+		latest.Status.Message = "set elsewhere"
+		Expect(c.Status().Update(ctx, latest)).To(Succeed())
+
+		base := stale.DeepCopy()
+		stale.Status.Reason = "Patched"
+		Expect(PatchStatus(ctx, c, stale, base)).To(Succeed())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(pod), latest)).To(Succeed())
+		Expect(latest.Status.Reason).To(Equal("Patched"))
+		Expect(latest.Status.Message).To(Equal("set elsewhere"))
+	})
+
+	It("should retry a patch rejected with a conflict", func() {
+		conflicts := 2
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(pod).WithStatusSubresource(pod).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+					if conflicts > 0 {
+						conflicts--
+						return apierrors.NewConflict(schema.GroupResource{Resource: "pods"}, obj.GetName(), nil)
+					}
+					return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+				},
+			}).Build()
+
+		base := pod.DeepCopy()
+		pod.Status.Reason = "Patched"
+		Expect(PatchStatus(ctx, c, pod, base)).To(Succeed())
+		Expect(conflicts).To(BeZero())
+
+		persisted := &corev1.Pod{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(pod), persisted)).To(Succeed())
+		Expect(persisted.Status.Reason).To(Equal("Patched"))
+	})
+})
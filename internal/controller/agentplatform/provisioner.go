@@ -0,0 +1,289 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentplatform
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var (
+	// InfraEnvGVK is the assisted-service InfraEnv kind. InfraEnvs and Agents are handled as unstructured
+	// objects, so that the operator does not depend on the assisted-service API module and runs without it.
+	InfraEnvGVK = schema.GroupVersionKind{Group: "agent-install.openshift.io", Version: "v1beta1", Kind: "InfraEnv"}
+
+	// AgentGVK is the assisted-service Agent kind, one per discovered host
+	AgentGVK = schema.GroupVersionKind{Group: "agent-install.openshift.io", Version: "v1beta1", Kind: "Agent"}
+)
+
+const (
+	// LabelInfraEnv is set by assisted-service on every Agent to the name of the InfraEnv it was discovered through
+	LabelInfraEnv = "infraenvs.agent-install.openshift.io"
+
+	// CPUArchitecture is the architecture of the BlueField Arm cores the discovery image is built for
+	CPUArchitecture = "arm64"
+
+	// DefaultResyncInterval is how often newly discovered Agents are approved. Agents are not watched,
+	// as the assisted-service API may be installed after the operator started.
+	DefaultResyncInterval = time.Minute
+
+	// imageCreatedCondition is the InfraEnv condition reporting whether the discovery image was created
+	imageCreatedCondition = "ImageCreated"
+)
+
+// Provisioner wires the DPFHCPBridges with spec.platform Agent to assisted-service.
+//
+// It creates an InfraEnv named after the bridge in its namespace, whose discovery image DPUs boot
+// from, and approves the Agents assisted-service discovers through it. The InfraEnv labels its Agents
+// with the bridge name, which the NodePools of the bridge select, so that approved DPU workers are
+// claimed by the NodePools and join the hosted cluster without fetching the ignition out-of-band.
+type Provisioner struct {
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+
+	// ResyncInterval is how often newly discovered Agents are approved; defaults to DefaultResyncInterval
+	ResyncInterval time.Duration
+}
+
+// NewProvisioner creates a new Agent platform Provisioner
+func NewProvisioner(c client.Client, scheme *runtime.Scheme, recorder record.EventRecorder) *Provisioner {
+	return &Provisioner{
+		client:         c,
+		scheme:         scheme,
+		recorder:       recorder,
+		ResyncInterval: DefaultResyncInterval,
+	}
+}
+
+// InfraEnvName returns the name of the InfraEnv of a bridge
+func InfraEnvName(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	return cr.Name
+}
+
+// ReconcileAgentPlatform creates or updates the InfraEnv of the bridge, approves the Agents discovered
+// through it and reports whether its discovery image was created in the InfraEnvReady condition, which
+// is persisted when it changes. It does nothing unless spec.platform is Agent.
+//
+// The InfraEnv is owned by the bridge and garbage collected with it. Agents are left in place, as they
+// describe the hardware rather than the hosted cluster.
+//
+// Returns ctrl.Result and error for reconciliation flow; the result requeues after ResyncInterval so
+// that DPUs discovered later are approved too
+func (p *Provisioner) ReconcileAgentPlatform(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if !cr.UsesAgentPlatform() {
+		return ctrl.Result{}, nil
+	}
+
+	infraEnv, err := p.ensureInfraEnv(ctx, cr)
+	if meta.IsNoMatchError(err) {
+		log.Info("InfraEnv API not installed, cannot discover DPU workers", "error", err.Error())
+		if err := p.setCondition(ctx, cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonInfraEnvAPIUnavailable,
+			"The InfraEnv API of assisted-service is not installed on the management cluster"); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: p.ResyncInterval}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := p.approveAgents(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	status, reason, message := imageStatus(infraEnv)
+	if err := p.setCondition(ctx, cr, status, reason, message); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: p.ResyncInterval}, nil
+}
+
+// buildInfraEnv constructs the InfraEnv of a bridge. Its discovery image uses the pull secret copied
+// for the HostedCluster, and assisted-service sets its agent labels on every Agent discovered through it.
+func buildInfraEnv(cr *provisioningv1alpha1.DPFHCPBridge) *unstructured.Unstructured {
+	infraEnv := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"cpuArchitecture": CPUArchitecture,
+			"pullSecretRef": map[string]interface{}{
+				"name": cr.PullSecretCopyName(),
+			},
+			"agentLabels": map[string]interface{}{
+				provisioningv1alpha1.LabelAgentBridge: cr.Name,
+			},
+		},
+	}}
+	infraEnv.SetGroupVersionKind(InfraEnvGVK)
+	infraEnv.SetName(InfraEnvName(cr))
+	infraEnv.SetNamespace(cr.Namespace)
+	return infraEnv
+}
+
+// ensureInfraEnv creates the InfraEnv of the bridge, or restores the spec fields the operator manages
+// on an existing one, leaving fields set by users or defaulted by assisted-service alone
+func (p *Provisioner) ensureInfraEnv(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (*unstructured.Unstructured, error) {
+	log := logf.FromContext(ctx)
+
+	desired := buildInfraEnv(cr)
+	if err := controllerutil.SetControllerReference(cr, desired, p.scheme); err != nil {
+		return nil, fmt.Errorf("failed to set owner reference on InfraEnv: %w", err)
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(InfraEnvGVK)
+	err := p.client.Get(ctx, client.ObjectKeyFromObject(desired), existing)
+	if apierrors.IsNotFound(err) {
+		if err := p.client.Create(ctx, desired); err != nil {
+			return nil, fmt.Errorf("failed to create InfraEnv: %w", err)
+		}
+		log.Info("InfraEnv created", "infraEnv", desired.GetName(), "namespace", desired.GetNamespace())
+		p.recorder.Eventf(cr, corev1.EventTypeNormal, "InfraEnvCreated",
+			"Created InfraEnv %s/%s to discover DPU workers", desired.GetNamespace(), desired.GetName())
+		return desired, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get InfraEnv: %w", err)
+	}
+
+	if !metav1.IsControlledBy(existing, cr) {
+		return nil, fmt.Errorf("InfraEnv %s/%s already exists and is not owned by this DPFHCPBridge",
+			existing.GetNamespace(), existing.GetName())
+	}
+
+	changed := false
+	desiredSpec, _, _ := unstructured.NestedMap(desired.Object, "spec")
+	for field, value := range desiredSpec {
+		current, _, _ := unstructured.NestedFieldNoCopy(existing.Object, "spec", field)
+		if equality.Semantic.DeepEqual(current, value) {
+			continue
+		}
+		if err := unstructured.SetNestedField(existing.Object, value, "spec", field); err != nil {
+			return nil, fmt.Errorf("failed to set InfraEnv spec.%s: %w", field, err)
+		}
+		changed = true
+	}
+	if !changed {
+		return existing, nil
+	}
+	if err := p.client.Update(ctx, existing); err != nil {
+		return nil, fmt.Errorf("failed to update InfraEnv: %w", err)
+	}
+	log.Info("InfraEnv updated", "infraEnv", existing.GetName(), "namespace", existing.GetNamespace())
+	return existing, nil
+}
+
+// approveAgents approves the Agents discovered through the InfraEnv of the bridge. Only the DPUs booted
+// from its discovery image are discovered through it, so they are approved like DPU worker CSRs are.
+func (p *Provisioner) approveAgents(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) error {
+	log := logf.FromContext(ctx)
+
+	agents := &unstructured.UnstructuredList{}
+	agents.SetGroupVersionKind(AgentGVK.GroupVersion().WithKind(AgentGVK.Kind + "List"))
+	if err := p.client.List(ctx, agents, client.InNamespace(cr.Namespace),
+		client.MatchingLabels{LabelInfraEnv: InfraEnvName(cr)}); err != nil {
+		return fmt.Errorf("failed to list Agents: %w", err)
+	}
+
+	for i := range agents.Items {
+		agent := &agents.Items[i]
+		if approved, _, _ := unstructured.NestedBool(agent.Object, "spec", "approved"); approved {
+			continue
+		}
+
+		base := agent.DeepCopy()
+		if err := unstructured.SetNestedField(agent.Object, true, "spec", "approved"); err != nil {
+			return fmt.Errorf("failed to approve Agent %s: %w", agent.GetName(), err)
+		}
+		if err := p.client.Patch(ctx, agent, client.MergeFrom(base)); err != nil {
+			return fmt.Errorf("failed to approve Agent %s: %w", agent.GetName(), err)
+		}
+		log.Info("Agent approved", "agent", agent.GetName(), "namespace", agent.GetNamespace())
+		p.recorder.Eventf(cr, corev1.EventTypeNormal, "AgentApproved",
+			"Approved Agent %s discovered through InfraEnv %s", agent.GetName(), InfraEnvName(cr))
+	}
+	return nil
+}
+
+// imageStatus maps the ImageCreated condition of an InfraEnv to the InfraEnvReady condition
+func imageStatus(infraEnv *unstructured.Unstructured) (metav1.ConditionStatus, string, string) {
+	name := infraEnv.GetNamespace() + "/" + infraEnv.GetName()
+
+	conditions, _, _ := unstructured.NestedSlice(infraEnv.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != imageCreatedCondition {
+			continue
+		}
+		message, _ := condition["message"].(string)
+		switch condition["status"] {
+		case string(metav1.ConditionTrue):
+			return metav1.ConditionTrue, provisioningv1alpha1.ReasonDiscoveryImageCreated,
+				fmt.Sprintf("Discovery image of InfraEnv %s is created, boot the DPUs from its status.isoDownloadURL", name)
+		case string(metav1.ConditionFalse):
+			if condition["reason"] == "ImageCreationError" {
+				return metav1.ConditionFalse, provisioningv1alpha1.ReasonDiscoveryImageFailed,
+					fmt.Sprintf("Failed to create the discovery image of InfraEnv %s: %s", name, message)
+			}
+		}
+	}
+	return metav1.ConditionFalse, provisioningv1alpha1.ReasonDiscoveryImagePending,
+		fmt.Sprintf("Waiting for assisted-service to create the discovery image of InfraEnv %s", name)
+}
+
+// setCondition sets the InfraEnvReady condition and persists it if it changed
+func (p *Provisioner) setCondition(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, status metav1.ConditionStatus, reason, message string) error {
+	condition := metav1.Condition{
+		Type:               provisioningv1alpha1.InfraEnvReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: cr.Generation,
+	}
+	if !meta.SetStatusCondition(&cr.Status.Conditions, condition) {
+		return nil
+	}
+
+	eventType := corev1.EventTypeNormal
+	if status == metav1.ConditionFalse {
+		eventType = corev1.EventTypeWarning
+	}
+	p.recorder.Event(cr, eventType, reason, message)
+
+	if err := p.client.Status().Update(ctx, cr); err != nil {
+		return fmt.Errorf("failed to update InfraEnvReady condition: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentplatform
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// nested returns the field of obj at the given path, nil if it is not set
+func nested(obj *unstructured.Unstructured, fields ...string) interface{} {
+	value, _, _ := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+	return value
+}
+
+// newAgent returns an Agent discovered through the given InfraEnv
+func newAgent(name, infraEnv string, approved bool) *unstructured.Unstructured {
+	agent := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"approved": approved},
+	}}
+	agent.SetGroupVersionKind(AgentGVK)
+	agent.SetName(name)
+	agent.SetNamespace("test-ns")
+	agent.SetLabels(map[string]string{LabelInfraEnv: infraEnv})
+	return agent
+}
+
+var _ = Describe("Agent Platform Provisioner", func() {
+	var (
+		ctx         context.Context
+		scheme      *runtime.Scheme
+		bridge      *provisioningv1alpha1.DPFHCPBridge
+		provisioner *Provisioner
+		funcs       interceptor.Funcs
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+
+		funcs = interceptor.Funcs{}
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-bridge",
+				Namespace: "test-ns",
+				UID:       types.UID("bridge-uid"),
+			},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				Platform: provisioningv1alpha1.PlatformAgent,
+			},
+		}
+	})
+
+	newProvisioner := func(objs ...client.Object) client.Client {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(append(objs, bridge)...).
+			WithStatusSubresource(bridge).
+			WithInterceptorFuncs(funcs).
+			Build()
+		provisioner = NewProvisioner(c, scheme, record.NewFakeRecorder(10))
+		return c
+	}
+
+	getInfraEnv := func(c client.Client) *unstructured.Unstructured {
+		infraEnv := &unstructured.Unstructured{}
+		infraEnv.SetGroupVersionKind(InfraEnvGVK)
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge", Namespace: "test-ns"}, infraEnv)).To(Succeed())
+		return infraEnv
+	}
+
+	It("should do nothing on the None platform", func() {
+		bridge.Spec.Platform = ""
+		newProvisioner()
+
+		result, err := provisioner.ReconcileAgentPlatform(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.InfraEnvReady)).To(BeNil())
+	})
+
+	It("should create an arm64 InfraEnv labelling its Agents with the bridge", func() {
+		c := newProvisioner()
+
+		result, err := provisioner.ReconcileAgentPlatform(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(DefaultResyncInterval))
+
+		infraEnv := getInfraEnv(c)
+		Expect(metav1.IsControlledBy(infraEnv, bridge)).To(BeTrue())
+		Expect(nested(infraEnv, "spec", "cpuArchitecture")).To(Equal("arm64"))
+		Expect(nested(infraEnv, "spec", "pullSecretRef", "name")).To(Equal("test-bridge-pull-secret"))
+		Expect(nested(infraEnv, "spec", "agentLabels")).To(
+			HaveKeyWithValue(provisioningv1alpha1.LabelAgentBridge, "test-bridge"))
+
+		condition := meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.InfraEnvReady)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonDiscoveryImagePending))
+	})
+
+	It("should restore the managed fields and keep the others", func() {
+		existing := buildInfraEnv(bridge)
+		Expect(unstructured.SetNestedField(existing.Object, "x86_64", "spec", "cpuArchitecture")).To(Succeed())
+		Expect(unstructured.SetNestedField(existing.Object, "ssh-ed25519 AAAA", "spec", "sshAuthorizedKey")).To(Succeed())
+		existing.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: provisioningv1alpha1.GroupVersion.String(), Kind: "DPFHCPBridge",
+			Name: bridge.Name, UID: bridge.UID, Controller: ptr.To(true),
+		}})
+		c := newProvisioner(existing)
+
+		_, err := provisioner.ReconcileAgentPlatform(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		infraEnv := getInfraEnv(c)
+		Expect(nested(infraEnv, "spec", "cpuArchitecture")).To(Equal("arm64"))
+		Expect(nested(infraEnv, "spec", "sshAuthorizedKey")).To(Equal("ssh-ed25519 AAAA"))
+	})
+
+	It("should refuse an InfraEnv it does not own", func() {
+		newProvisioner(buildInfraEnv(bridge))
+
+		_, err := provisioner.ReconcileAgentPlatform(ctx, bridge)
+		Expect(err).To(MatchError(ContainSubstring("not owned by this DPFHCPBridge")))
+	})
+
+	It("should approve only the Agents discovered through its InfraEnv", func() {
+		c := newProvisioner(
+			newAgent("dpu-a", "test-bridge", false),
+			newAgent("dpu-b", "test-bridge", true),
+			newAgent("other", "other-infraenv", false),
+		)
+
+		_, err := provisioner.ReconcileAgentPlatform(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		for name, want := range map[string]bool{"dpu-a": true, "dpu-b": true, "other": false} {
+			agent := &unstructured.Unstructured{}
+			agent.SetGroupVersionKind(AgentGVK)
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: "test-ns"}, agent)).To(Succeed())
+			Expect(nested(agent, "spec", "approved")).To(Equal(want), name)
+		}
+	})
+
+	It("should report the created discovery image", func() {
+		c := newProvisioner()
+		_, err := provisioner.ReconcileAgentPlatform(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		infraEnv := getInfraEnv(c)
+		Expect(unstructured.SetNestedSlice(infraEnv.Object, []interface{}{
+			map[string]interface{}{"type": "ImageCreated", "status": "True", "reason": "ImageCreated"},
+		}, "status", "conditions")).To(Succeed())
+		Expect(c.Update(ctx, infraEnv)).To(Succeed())
+
+		_, err = provisioner.ReconcileAgentPlatform(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		condition := meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.InfraEnvReady)
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonDiscoveryImageCreated))
+	})
+
+	It("should report a failed discovery image", func() {
+		infraEnv := buildInfraEnv(bridge)
+		Expect(unstructured.SetNestedSlice(infraEnv.Object, []interface{}{
+			map[string]interface{}{"type": "ImageCreated", "status": "False", "reason": "ImageCreationError", "message": "pull secret is invalid"},
+		}, "status", "conditions")).To(Succeed())
+
+		status, reason, message := imageStatus(infraEnv)
+		Expect(status).To(Equal(metav1.ConditionFalse))
+		Expect(reason).To(Equal(provisioningv1alpha1.ReasonDiscoveryImageFailed))
+		Expect(message).To(ContainSubstring("pull secret is invalid"))
+	})
+
+	It("should report when the InfraEnv API is not installed", func() {
+		funcs.Get = func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if obj.GetObjectKind().GroupVersionKind() == InfraEnvGVK {
+				return &meta.NoKindMatchError{GroupKind: InfraEnvGVK.GroupKind(), SearchedVersions: []string{InfraEnvGVK.Version}}
+			}
+			return c.Get(ctx, key, obj, opts...)
+		}
+		newProvisioner()
+
+		result, err := provisioner.ReconcileAgentPlatform(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(DefaultResyncInterval))
+
+		condition := meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.InfraEnvReady)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonInfraEnvAPIUnavailable))
+	})
+})
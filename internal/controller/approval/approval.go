@@ -0,0 +1,120 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package approval holds back the initial provisioning of DPFHCPBridges until an external system, such as a
+// change-management workflow, approved it through the approved annotation.
+package approval
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const (
+	// ExternallyApproved condition reasons
+	ReasonApproved         = "Approved"
+	ReasonAwaitingApproval = "AwaitingApproval"
+)
+
+// Gate requires external approval before the HostedCluster of a DPFHCPBridge is provisioned.
+// The preflight checks run regardless, so that the bridge is known to be valid by the time it is approved.
+type Gate struct {
+	client   client.Client
+	recorder record.EventRecorder
+}
+
+// NewGate creates a new approval gate
+func NewGate(client client.Client, recorder record.EventRecorder) *Gate {
+	return &Gate{
+		client:   client,
+		recorder: recorder,
+	}
+}
+
+// CheckApproval sets the ExternallyApproved condition from the approved annotation. The bridge waits
+// while the condition is False (see WaitingForApproval); the annotation change wakes it up.
+// Approval only gates the initial provisioning: once the HostedCluster was created the condition is
+// left as it is, and removing the annotation has no effect.
+func (g *Gate) CheckApproval(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) error {
+	log := logf.FromContext(ctx).WithValues("feature", "external-approval")
+
+	if cr.Status.HostedClusterRef != nil {
+		return nil
+	}
+
+	condition := metav1.Condition{
+		Type:   provisioningv1alpha1.ExternallyApproved,
+		Status: metav1.ConditionFalse,
+		Reason: ReasonAwaitingApproval,
+		Message: fmt.Sprintf("Waiting for an external system to approve the provisioning through the %s annotation",
+			provisioningv1alpha1.AnnotationApproved),
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: cr.Generation,
+	}
+	if approval, ok := approvalOf(cr); ok {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonApproved
+		condition.Message = fmt.Sprintf("Provisioning approved: %s", approval)
+	}
+
+	if changed := meta.SetStatusCondition(&cr.Status.Conditions, condition); !changed {
+		return nil
+	}
+
+	g.recorder.Event(cr, corev1.EventTypeNormal, condition.Reason, condition.Message)
+	log.Info("External approval changed", "reason", condition.Reason)
+
+	if err := g.client.Status().Update(ctx, cr); err != nil {
+		log.Error(err, "Failed to update status")
+		return err
+	}
+	return nil
+}
+
+// WaitingForApproval returns true if the initial provisioning waits for external approval
+func WaitingForApproval(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	if cr.Status.HostedClusterRef != nil {
+		return false
+	}
+	cond := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.ExternallyApproved)
+	return cond != nil && cond.Status == metav1.ConditionFalse
+}
+
+// ClearStaleCondition removes an ExternallyApproved condition that still waits for approval, so that
+// bridges are not held back once the operator no longer requires external approval. An approval is
+// kept as a record of who approved the provisioning. It returns true if the condition was removed.
+func ClearStaleCondition(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	cond := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.ExternallyApproved)
+	if cond == nil || cond.Status == metav1.ConditionTrue {
+		return false
+	}
+	return meta.RemoveStatusCondition(&cr.Status.Conditions, provisioningv1alpha1.ExternallyApproved)
+}
+
+// approvalOf returns the value of the approved annotation, and whether it approves the provisioning
+func approvalOf(cr *provisioningv1alpha1.DPFHCPBridge) (string, bool) {
+	value := cr.GetAnnotations()[provisioningv1alpha1.AnnotationApproved]
+	return value, value != "" && value != "false"
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approval
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("External approval gate", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		bridge *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", Generation: 1},
+		}
+	})
+
+	check := func() *metav1.Condition {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(bridge).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+
+		Expect(NewGate(c, record.NewFakeRecorder(10)).CheckApproval(ctx, bridge)).To(Succeed())
+
+		var updated provisioningv1alpha1.DPFHCPBridge
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(bridge), &updated)).To(Succeed())
+		bridge.Status = updated.Status
+		return meta.FindStatusCondition(updated.Status.Conditions, provisioningv1alpha1.ExternallyApproved)
+	}
+
+	It("should wait for a bridge without the approved annotation", func() {
+		cond := check()
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(ReasonAwaitingApproval))
+		Expect(WaitingForApproval(bridge)).To(BeTrue())
+	})
+
+	It("should not approve a bridge whose annotation is false", func() {
+		bridge.Annotations = map[string]string{provisioningv1alpha1.AnnotationApproved: "false"}
+		Expect(check().Status).To(Equal(metav1.ConditionFalse))
+		Expect(WaitingForApproval(bridge)).To(BeTrue())
+	})
+
+	It("should approve a bridge and report the approval", func() {
+		Expect(check().Status).To(Equal(metav1.ConditionFalse))
+
+		bridge.Annotations = map[string]string{provisioningv1alpha1.AnnotationApproved: "CHG0012345"}
+		cond := check()
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(ReasonApproved))
+		Expect(cond.Message).To(ContainSubstring("CHG0012345"))
+		Expect(WaitingForApproval(bridge)).To(BeFalse())
+	})
+
+	It("should leave the condition alone once the HostedCluster was created", func() {
+		bridge.Annotations = map[string]string{provisioningv1alpha1.AnnotationApproved: "CHG0012345"}
+		Expect(check().Status).To(Equal(metav1.ConditionTrue))
+
+		bridge.Annotations = nil
+		bridge.Status.HostedClusterRef = &corev1.ObjectReference{Name: "test-bridge", Namespace: "default"}
+		Expect(check().Status).To(Equal(metav1.ConditionTrue))
+		Expect(WaitingForApproval(bridge)).To(BeFalse())
+	})
+
+	It("should only clear conditions still waiting for approval", func() {
+		Expect(check().Status).To(Equal(metav1.ConditionFalse))
+		Expect(ClearStaleCondition(bridge)).To(BeTrue())
+		Expect(WaitingForApproval(bridge)).To(BeFalse())
+
+		bridge.Annotations = map[string]string{provisioningv1alpha1.AnnotationApproved: "CHG0012345"}
+		Expect(check().Status).To(Equal(metav1.ConditionTrue))
+		Expect(ClearStaleCondition(bridge)).To(BeFalse())
+	})
+})
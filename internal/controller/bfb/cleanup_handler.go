@@ -0,0 +1,92 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bfb
+
+import (
+	"context"
+	"fmt"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/finalizer"
+)
+
+// CleanupHandler deletes the BFBs created for a DPFHCPBridge in its DPUCluster namespaces when
+// the bridge is deleted. They cannot carry an OwnerReference and are found by their ownership labels.
+type CleanupHandler struct {
+	client   client.Client
+	recorder record.EventRecorder
+}
+
+// NewCleanupHandler creates a new BFB cleanup handler
+func NewCleanupHandler(client client.Client, recorder record.EventRecorder) *CleanupHandler {
+	return &CleanupHandler{
+		client:   client,
+		recorder: recorder,
+	}
+}
+
+// Name returns the handler name for logging
+func (h *CleanupHandler) Name() string {
+	return "bfb"
+}
+
+// Cleanup deletes every BFB labelled as created for the bridge in its DPUCluster namespaces
+func (h *CleanupHandler) Cleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithValues(
+		"handler", h.Name(),
+		common.DPFHCPBridgeName, fmt.Sprintf("%s/%s", cr.Namespace, cr.Name),
+	)
+
+	deletedCount := 0
+	for _, namespace := range dpuClusterNamespaces(cr) {
+		deleted, err := common.DeleteOwnedObjects(ctx, h.client, cr, &dpuprovisioningv1alpha1.BFBList{},
+			namespace, common.ComponentIn(common.ComponentBFB))
+		if err != nil {
+			log.Error(err, "Failed to delete BFBs", "namespace", namespace)
+			return ctrl.Result{}, fmt.Errorf("failed to delete BFBs: %w", err)
+		}
+		deletedCount += deleted
+	}
+
+	if deletedCount > 0 {
+		log.Info("BFB cleanup completed successfully", "deletedCount", deletedCount)
+		h.recorder.Eventf(cr, corev1.EventTypeNormal, "BFBCleanupSucceeded", "Deleted %d BFB(s)", deletedCount)
+	}
+	return ctrl.Result{}, nil
+}
+
+// PreviewCleanup returns the BFBs Cleanup would delete
+func (h *CleanupHandler) PreviewCleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) ([]provisioningv1alpha1.CleanupResource, error) {
+	var bfbs []client.Object
+	for _, namespace := range dpuClusterNamespaces(cr) {
+		owned, err := common.ListOwnedObjects(ctx, h.client, cr, &dpuprovisioningv1alpha1.BFBList{},
+			namespace, common.ComponentIn(common.ComponentBFB))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list BFBs: %w", err)
+		}
+		bfbs = append(bfbs, owned...)
+	}
+	return finalizer.CleanupResources("BFB", bfbs), nil
+}
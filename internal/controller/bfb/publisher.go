@@ -0,0 +1,307 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bfb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+// DefaultResyncInterval is how often the download of a BFB is checked. BFBs are not watched,
+// as they live in the DPUCluster namespaces rather than in the bridge namespace.
+const DefaultResyncInterval = 30 * time.Second
+
+// urlPattern is the pattern DPF requires BFB URLs to match
+var urlPattern = regexp.MustCompile(`^(http|https)://.+\.bfb$`)
+
+// invalidNameChars matches the characters of an OCP version that cannot appear in an object name
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// URLData is what the BFB URL template is rendered with
+type URLData struct {
+	// OCPVersion is the OCP version of the bridge, e.g. 4.19.0
+	OCPVersion string
+
+	// Image is the resolved BlueField container image
+	Image string
+
+	// ImageTag is the tag of Image, empty if it is referenced by digest only
+	ImageTag string
+}
+
+// Publisher creates the DPF BFB of each bridge from its resolved BlueField image, so that DPUSets
+// can flash the DPUs with it without a BFB being created by hand for every OCP version.
+//
+// BlueField images are container images while DPF downloads BFBs over HTTP, so the BFB URL is
+// rendered from an operator-wide template, typically pointing to the server the BFB files built
+// from the images are published on.
+type Publisher struct {
+	client      client.Client
+	recorder    record.EventRecorder
+	urlTemplate *template.Template
+
+	// ResyncInterval is how often a BFB that is not downloaded yet is checked; defaults to DefaultResyncInterval
+	ResyncInterval time.Duration
+}
+
+// NewPublisher creates a BFB Publisher rendering BFB URLs from urlTemplate, a Go template over URLData
+func NewPublisher(c client.Client, recorder record.EventRecorder, urlTemplate string) (*Publisher, error) {
+	tmpl, err := template.New("bfb-url").Option("missingkey=error").Parse(urlTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BFB URL template: %w", err)
+	}
+	return &Publisher{
+		client:         c,
+		recorder:       recorder,
+		urlTemplate:    tmpl,
+		ResyncInterval: DefaultResyncInterval,
+	}, nil
+}
+
+// BFBName returns the name of the BFB of a bridge for its current OCP version. BFB URLs are
+// immutable, so every OCP version gets its own BFB.
+func BFBName(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	version := invalidNameChars.ReplaceAllString(strings.ToLower(cr.Status.OCPVersion), "-")
+	return cr.Name + "-" + strings.Trim(version, ".-")
+}
+
+// ReconcileBFB creates the BFB of the bridge in the namespace of each of its DPUClusters once its
+// BlueField image is resolved, reports its name in status.bfbName and whether DPF downloaded it in
+// the BFBReady condition, which is persisted when it changes. Once the BFB of a new OCP version is
+// downloaded, the BFBs of previous versions are deleted.
+//
+// BFBs live in the DPUCluster namespaces and cannot be owned by the bridge: they carry its ownership
+// labels and are deleted by the CleanupHandler.
+//
+// Returns ctrl.Result and error for reconciliation flow; the result requeues after ResyncInterval
+// until the BFB is downloaded
+func (p *Publisher) ReconcileBFB(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	namespaces := dpuClusterNamespaces(cr)
+	if cr.Status.BlueFieldContainerImage == "" || cr.Status.OCPVersion == "" || len(namespaces) == 0 {
+		log.V(1).Info("Waiting for the BlueField image and DPUCluster to be resolved before creating the BFB")
+		return ctrl.Result{}, nil
+	}
+
+	url, err := p.renderURL(cr)
+	if err != nil {
+		log.Error(err, "Cannot render BFB URL")
+		// Not retried: fixing it takes a new BlueField image or operator configuration
+		return ctrl.Result{}, p.setCondition(ctx, cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonBFBURLInvalid,
+			fmt.Sprintf("Cannot create a BFB for BlueField image %s: %v", cr.Status.BlueFieldContainerImage, err))
+	}
+
+	name := BFBName(cr)
+	cr.Status.BFBName = name
+
+	var pending, failed []string
+	for _, namespace := range namespaces {
+		bfb, err := p.ensureBFB(ctx, cr, namespace, name, url)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		switch {
+		case bfb == nil:
+			// Being recreated with a new URL
+			pending = append(pending, namespace+"/"+name)
+		case bfb.Status.Phase == dpuprovisioningv1alpha1.BFBError:
+			failed = append(failed, namespace+"/"+name)
+		case bfb.Status.Phase != dpuprovisioningv1alpha1.BFBReady:
+			pending = append(pending, namespace+"/"+name)
+		}
+	}
+
+	if len(failed) > 0 {
+		if err := p.setCondition(ctx, cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonBFBDownloadFailed,
+			fmt.Sprintf("DPF failed to download BFB %s from %s", strings.Join(failed, ", "), url)); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: p.ResyncInterval}, nil
+	}
+	if len(pending) > 0 {
+		if err := p.setCondition(ctx, cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonBFBDownloading,
+			fmt.Sprintf("Waiting for DPF to download BFB %s from %s", strings.Join(pending, ", "), url)); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: p.ResyncInterval}, nil
+	}
+
+	for _, namespace := range namespaces {
+		if err := p.deleteSupersededBFBs(ctx, cr, namespace, name); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{}, p.setCondition(ctx, cr, metav1.ConditionTrue, provisioningv1alpha1.ReasonBFBDownloaded,
+		fmt.Sprintf("BFB %s is downloaded in namespace %s", name, strings.Join(namespaces, ", ")))
+}
+
+// renderURL renders the BFB URL of the bridge and checks it is a URL DPF accepts
+func (p *Publisher) renderURL(cr *provisioningv1alpha1.DPFHCPBridge) (string, error) {
+	data := URLData{
+		OCPVersion: cr.Status.OCPVersion,
+		Image:      cr.Status.BlueFieldContainerImage,
+		ImageTag:   imageTag(cr.Status.BlueFieldContainerImage),
+	}
+	var buf bytes.Buffer
+	if err := p.urlTemplate.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render BFB URL template: %w", err)
+	}
+	url := buf.String()
+	if !urlPattern.MatchString(url) {
+		return "", fmt.Errorf("BFB URL %q must be an http or https URL of a .bfb file", url)
+	}
+	return url, nil
+}
+
+// imageTag returns the tag of an image reference, or "" if it has none
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	lastSlash := strings.LastIndex(image, "/")
+	if i := strings.LastIndex(image, ":"); i > lastSlash {
+		return image[i+1:]
+	}
+	return ""
+}
+
+// ensureBFB creates the BFB of the bridge in namespace. BFB URLs are immutable, so a BFB with
+// another URL is deleted to be created again on the next reconcile, and nil is returned.
+// A BFB of the same name that is not owned by this bridge is never touched.
+func (p *Publisher) ensureBFB(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, namespace, name, url string) (*dpuprovisioningv1alpha1.BFB, error) {
+	log := logf.FromContext(ctx)
+
+	existing := &dpuprovisioningv1alpha1.BFB{}
+	err := p.client.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, existing)
+	if apierrors.IsNotFound(err) {
+		bfb := &dpuprovisioningv1alpha1.BFB{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    common.ComponentOwnerLabels(cr, common.ComponentBFB),
+			},
+			Spec: dpuprovisioningv1alpha1.BFBSpec{URL: url},
+		}
+		if err := p.client.Create(ctx, bfb); err != nil {
+			return nil, fmt.Errorf("failed to create BFB %s/%s: %w", namespace, name, err)
+		}
+		log.Info("BFB created", "bfb", name, "namespace", namespace, "url", url)
+		p.recorder.Eventf(cr, corev1.EventTypeNormal, "BFBCreated", "Created BFB %s/%s from %s", namespace, name, url)
+		return bfb, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get BFB %s/%s: %w", namespace, name, err)
+	}
+
+	if !ownedBy(existing, cr) {
+		return nil, fmt.Errorf("BFB %s/%s already exists and is not owned by this DPFHCPBridge", namespace, name)
+	}
+	if existing.Spec.URL == url {
+		return existing, nil
+	}
+
+	if err := p.client.Delete(ctx, existing); err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to delete BFB %s/%s with outdated URL: %w", namespace, name, err)
+	}
+	log.Info("BFB deleted to be created with a new URL", "bfb", name, "namespace", namespace,
+		"oldURL", existing.Spec.URL, "url", url)
+	return nil, nil
+}
+
+// deleteSupersededBFBs deletes the BFBs the bridge created for previous OCP versions in namespace
+func (p *Publisher) deleteSupersededBFBs(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, namespace, current string) error {
+	bfbs := &dpuprovisioningv1alpha1.BFBList{}
+	selector := labels.SelectorFromSet(common.OwnerLabels(cr)).Add(common.ComponentIn(common.ComponentBFB))
+	if err := p.client.List(ctx, bfbs, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("failed to list BFBs in namespace %s: %w", namespace, err)
+	}
+	for i := range bfbs.Items {
+		bfb := &bfbs.Items[i]
+		if bfb.Name == current || !bfb.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := p.client.Delete(ctx, bfb); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete superseded BFB %s/%s: %w", namespace, bfb.Name, err)
+		}
+		logf.FromContext(ctx).Info("Superseded BFB deleted", "bfb", bfb.Name, "namespace", namespace)
+		p.recorder.Eventf(cr, corev1.EventTypeNormal, "BFBDeleted",
+			"Deleted BFB %s/%s, superseded by %s", namespace, bfb.Name, current)
+	}
+	return nil
+}
+
+// ownedBy reports whether obj carries the BFB ownership labels of the bridge
+func ownedBy(obj client.Object, cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	l := obj.GetLabels()
+	return l[common.LabelOwnedBy] == cr.Name && l[common.LabelNamespace] == cr.Namespace &&
+		l[common.LabelComponent] == common.ComponentBFB
+}
+
+// dpuClusterNamespaces returns the distinct namespaces of the DPUClusters of the bridge
+func dpuClusterNamespaces(cr *provisioningv1alpha1.DPFHCPBridge) []string {
+	var namespaces []string
+	for _, ref := range cr.ResolvedDPUClusterRefs() {
+		if !slices.Contains(namespaces, ref.Namespace) {
+			namespaces = append(namespaces, ref.Namespace)
+		}
+	}
+	return namespaces
+}
+
+// setCondition sets the BFBReady condition and persists it if it changed
+func (p *Publisher) setCondition(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, status metav1.ConditionStatus, reason, message string) error {
+	condition := metav1.Condition{
+		Type:               provisioningv1alpha1.BFBReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: cr.Generation,
+	}
+	if !meta.SetStatusCondition(&cr.Status.Conditions, condition) {
+		return nil
+	}
+
+	eventType := corev1.EventTypeNormal
+	if status == metav1.ConditionFalse {
+		eventType = corev1.EventTypeWarning
+	}
+	p.recorder.Event(cr, eventType, reason, message)
+
+	if err := p.client.Status().Update(ctx, cr); err != nil {
+		return fmt.Errorf("failed to update BFBReady condition: %w", err)
+	}
+	return nil
+}
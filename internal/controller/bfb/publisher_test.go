@@ -0,0 +1,248 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bfb
+
+import (
+	"context"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+const (
+	testImage       = "quay.io/example/bluefield-rhcos:4.19.0-bf"
+	testURLTemplate = "https://bfb.example.com/rhcos-{{.OCPVersion}}-{{.ImageTag}}.bfb"
+	testURL         = "https://bfb.example.com/rhcos-4.19.0-4.19.0-bf.bfb"
+)
+
+var _ = Describe("BFB Publisher", func() {
+	var (
+		ctx       context.Context
+		scheme    *runtime.Scheme
+		bridge    *provisioningv1alpha1.DPFHCPBridge
+		c         client.Client
+		publisher *Publisher
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(dpuprovisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-bridge",
+				Namespace: "test-ns",
+				UID:       types.UID("bridge-uid"),
+			},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef: provisioningv1alpha1.DPUClusterReference{Name: "dpu-cluster", Namespace: "dpf-operator-system"},
+			},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				BlueFieldContainerImage: testImage,
+				OCPVersion:              "4.19.0",
+			},
+		}
+	})
+
+	newPublisher := func(urlTemplate string, objs ...client.Object) {
+		c = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(append(objs, bridge)...).
+			WithStatusSubresource(bridge).
+			Build()
+		var err error
+		publisher, err = NewPublisher(c, record.NewFakeRecorder(10), urlTemplate)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	getBFB := func(name string) (*dpuprovisioningv1alpha1.BFB, error) {
+		bfb := &dpuprovisioningv1alpha1.BFB{}
+		err := c.Get(ctx, client.ObjectKey{Name: name, Namespace: "dpf-operator-system"}, bfb)
+		return bfb, err
+	}
+
+	setPhase := func(name string, phase dpuprovisioningv1alpha1.BFBPhase) {
+		bfb, err := getBFB(name)
+		Expect(err).NotTo(HaveOccurred())
+		bfb.Status.Phase = phase
+		Expect(c.Update(ctx, bfb)).To(Succeed())
+	}
+
+	bfbCondition := func() *metav1.Condition {
+		return meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.BFBReady)
+	}
+
+	It("should reject an invalid URL template", func() {
+		_, err := NewPublisher(nil, nil, "https://bfb.example.com/{{.OCPVersion")
+		Expect(err).To(MatchError(ContainSubstring("invalid BFB URL template")))
+	})
+
+	It("should wait for the BlueField image to be resolved", func() {
+		bridge.Status.BlueFieldContainerImage = ""
+		newPublisher(testURLTemplate)
+
+		result, err := publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(bfbCondition()).To(BeNil())
+		Expect(bridge.Status.BFBName).To(BeEmpty())
+	})
+
+	It("should create the BFB in the DPUCluster namespace and report its download", func() {
+		newPublisher(testURLTemplate)
+
+		result, err := publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(DefaultResyncInterval))
+		Expect(bridge.Status.BFBName).To(Equal("test-bridge-4.19.0"))
+
+		bfb, err := getBFB("test-bridge-4.19.0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bfb.Spec.URL).To(Equal(testURL))
+		Expect(bfb.Labels).To(Equal(common.ComponentOwnerLabels(bridge, common.ComponentBFB)))
+		Expect(bfbCondition().Status).To(Equal(metav1.ConditionFalse))
+		Expect(bfbCondition().Reason).To(Equal(provisioningv1alpha1.ReasonBFBDownloading))
+
+		setPhase("test-bridge-4.19.0", dpuprovisioningv1alpha1.BFBReady)
+		result, err = publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(bfbCondition().Status).To(Equal(metav1.ConditionTrue))
+		Expect(bfbCondition().Reason).To(Equal(provisioningv1alpha1.ReasonBFBDownloaded))
+	})
+
+	It("should report a failed download", func() {
+		newPublisher(testURLTemplate)
+		_, err := publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		setPhase("test-bridge-4.19.0", dpuprovisioningv1alpha1.BFBError)
+		result, err := publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(DefaultResyncInterval))
+		Expect(bfbCondition().Reason).To(Equal(provisioningv1alpha1.ReasonBFBDownloadFailed))
+	})
+
+	It("should report a template rendering an invalid BFB URL", func() {
+		newPublisher("oci://{{.Image}}")
+
+		result, err := publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(bfbCondition().Status).To(Equal(metav1.ConditionFalse))
+		Expect(bfbCondition().Reason).To(Equal(provisioningv1alpha1.ReasonBFBURLInvalid))
+		Expect(bfbCondition().Message).To(ContainSubstring("http or https URL of a .bfb file"))
+	})
+
+	It("should recreate the BFB when its URL changes", func() {
+		newPublisher("https://old.example.com/{{.OCPVersion}}.bfb")
+		_, err := publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		publisher, err = NewPublisher(c, record.NewFakeRecorder(10), testURLTemplate)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = getBFB("test-bridge-4.19.0")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		_, err = publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		bfb, err := getBFB("test-bridge-4.19.0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bfb.Spec.URL).To(Equal(testURL))
+	})
+
+	It("should delete the BFB of the previous OCP version once the new one is downloaded", func() {
+		newPublisher(testURLTemplate)
+		_, err := publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		setPhase("test-bridge-4.19.0", dpuprovisioningv1alpha1.BFBReady)
+
+		bridge.Status.OCPVersion = "4.19.1"
+		_, err = publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bridge.Status.BFBName).To(Equal("test-bridge-4.19.1"))
+		_, err = getBFB("test-bridge-4.19.0")
+		Expect(err).NotTo(HaveOccurred(), "the previous BFB is kept while the new one downloads")
+
+		setPhase("test-bridge-4.19.1", dpuprovisioningv1alpha1.BFBReady)
+		_, err = publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = getBFB("test-bridge-4.19.0")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		_, err = getBFB("test-bridge-4.19.1")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should not touch a BFB it does not own", func() {
+		foreign := &dpuprovisioningv1alpha1.BFB{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge-4.19.0", Namespace: "dpf-operator-system"},
+			Spec:       dpuprovisioningv1alpha1.BFBSpec{URL: "https://other.example.com/rhcos.bfb"},
+		}
+		newPublisher(testURLTemplate, foreign)
+
+		_, err := publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).To(MatchError(ContainSubstring("not owned by this DPFHCPBridge")))
+		bfb, err := getBFB("test-bridge-4.19.0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bfb.Spec.URL).To(Equal("https://other.example.com/rhcos.bfb"))
+	})
+
+	It("should delete the BFBs of the bridge on cleanup", func() {
+		other := &dpuprovisioningv1alpha1.BFB{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "dpf-operator-system"},
+			Spec:       dpuprovisioningv1alpha1.BFBSpec{URL: "https://other.example.com/rhcos.bfb"},
+		}
+		newPublisher(testURLTemplate, other)
+		_, err := publisher.ReconcileBFB(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = NewCleanupHandler(c, record.NewFakeRecorder(10)).Cleanup(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = getBFB("test-bridge-4.19.0")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		_, err = getBFB("other")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should derive object names and image tags", func() {
+		bridge.Status.OCPVersion = "4.19.0-EC.5+build"
+		Expect(BFBName(bridge)).To(Equal("test-bridge-4.19.0-ec.5-build"))
+
+		Expect(imageTag("quay.io/example/bf:4.19")).To(Equal("4.19"))
+		Expect(imageTag("registry:5000/example/bf@sha256:abcd")).To(BeEmpty())
+		Expect(imageTag("registry:5000/example/bf:1.0@sha256:abcd")).To(Equal("1.0"))
+	})
+})
@@ -0,0 +1,181 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package blackout defers non-essential mutations of HostedClusters and NodePools, such as
+// drift correction and release upgrades, during operator-wide blackout windows.
+package blackout
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	// Blackout windows name IANA time zones, which must resolve even without zoneinfo in the image
+	_ "time/tzdata"
+
+	"sigs.k8s.io/yaml"
+)
+
+// maxDuration bounds a window to a week, so that a recurring window never overlaps itself
+const maxDuration = 7 * 24 * time.Hour
+
+// startPattern matches the HH:MM start time of a window
+var startPattern = regexp.MustCompile(`^([01]\d|2[0-3]):([0-5]\d)$`)
+
+// weekdays maps the day names accepted in a window to their weekday
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Window is a recurring period during which non-essential mutations are deferred
+type Window struct {
+	// Name identifies the window in logs and events
+	Name string `json:"name"`
+
+	// TimeZone is the IANA time zone Start and Days are interpreted in, e.g. "Europe/Berlin".
+	// Defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Days restricts the window to start on these weekdays (Mon, Tue, ...). Empty means every day.
+	Days []string `json:"days,omitempty"`
+
+	// Start is the local time of day the window starts at, as HH:MM
+	Start string `json:"start"`
+
+	// Duration is how long the window lasts, e.g. "8h". A window may extend past midnight.
+	Duration string `json:"duration"`
+
+	location *time.Location
+	days     map[time.Weekday]bool
+	hour     int
+	minute   int
+	duration time.Duration
+}
+
+// Config is the list of blackout windows loaded from the operator configuration
+type Config struct {
+	Windows []Window `json:"windows"`
+}
+
+// LoadFile reads and validates the blackout configuration from a YAML file
+func LoadFile(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blackout windows: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses and validates the blackout configuration
+func Parse(data []byte) (*Config, error) {
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse blackout windows: %w", err)
+	}
+
+	seen := map[string]bool{}
+	for i := range config.Windows {
+		window := &config.Windows[i]
+		if window.Name == "" {
+			return nil, fmt.Errorf("blackout window %d has no name", i)
+		}
+		if seen[window.Name] {
+			return nil, fmt.Errorf("duplicate blackout window %s", window.Name)
+		}
+		seen[window.Name] = true
+
+		if err := window.compile(); err != nil {
+			return nil, fmt.Errorf("invalid blackout window %s: %w", window.Name, err)
+		}
+	}
+
+	return config, nil
+}
+
+func (w *Window) compile() error {
+	location, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return fmt.Errorf("unknown time zone %q", w.TimeZone)
+	}
+	w.location = location
+
+	match := startPattern.FindStringSubmatch(w.Start)
+	if match == nil {
+		return fmt.Errorf("invalid start %q, expected HH:MM", w.Start)
+	}
+	w.hour, _ = strconv.Atoi(match[1])
+	w.minute, _ = strconv.Atoi(match[2])
+
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil || duration <= 0 || duration > maxDuration {
+		return fmt.Errorf("invalid duration %q, expected a positive duration of at most %s", w.Duration, maxDuration)
+	}
+	w.duration = duration
+
+	w.days = map[time.Weekday]bool{}
+	for _, day := range w.Days {
+		weekday, ok := weekdays[strings.ToLower(day)]
+		if !ok {
+			return fmt.Errorf("invalid day %q, expected Mon, Tue, Wed, Thu, Fri, Sat or Sun", day)
+		}
+		w.days[weekday] = true
+	}
+
+	return nil
+}
+
+// Active returns the window now falls into and when it ends, or ok=false outside of all windows.
+// When windows overlap, the one ending last is returned. A nil Config has no windows.
+func (c *Config) Active(now time.Time) (name string, end time.Time, ok bool) {
+	if c == nil {
+		return "", time.Time{}, false
+	}
+
+	for i := range c.Windows {
+		if windowEnd, active := c.Windows[i].activeUntil(now); active && windowEnd.After(end) {
+			name, end, ok = c.Windows[i].Name, windowEnd, true
+		}
+	}
+	return name, end, ok
+}
+
+// activeUntil returns the end of the occurrence of the window containing now
+func (w *Window) activeUntil(now time.Time) (time.Time, bool) {
+	local := now.In(w.location)
+
+	// An occurrence containing now started at most duration ago, on today or one of the days before
+	lookback := int(w.duration/(24*time.Hour)) + 1
+	for offset := 0; offset <= lookback; offset++ {
+		start := time.Date(local.Year(), local.Month(), local.Day()-offset, w.hour, w.minute, 0, 0, w.location)
+		if len(w.days) > 0 && !w.days[start.Weekday()] {
+			continue
+		}
+		end := start.Add(w.duration)
+		if !now.Before(start) && now.Before(end) {
+			return end, true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blackout
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const validConfig = `
+windows:
+- name: weekend
+  timeZone: America/New_York
+  days: [Fri]
+  start: "22:00"
+  duration: 56h
+- name: nightly
+  timeZone: Europe/Berlin
+  start: "23:30"
+  duration: 1h
+`
+
+var _ = Describe("Blackout Windows", func() {
+	var berlin, newYork *time.Location
+
+	BeforeEach(func() {
+		var err error
+		berlin, err = time.LoadLocation("Europe/Berlin")
+		Expect(err).NotTo(HaveOccurred())
+		newYork, err = time.LoadLocation("America/New_York")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Parse", func() {
+		It("should parse a valid configuration", func() {
+			config, err := Parse([]byte(validConfig))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Windows).To(HaveLen(2))
+		})
+
+		DescribeTable("should reject invalid windows",
+			func(window string) {
+				_, err := Parse([]byte("windows:\n- " + window))
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("missing name", `{start: "22:00", duration: 1h}`),
+			Entry("unknown time zone", `{name: w, timeZone: Mars/Olympus, start: "22:00", duration: 1h}`),
+			Entry("invalid start", `{name: w, start: "24:00", duration: 1h}`),
+			Entry("invalid duration", `{name: w, start: "22:00", duration: soon}`),
+			Entry("duration over a week", `{name: w, start: "22:00", duration: 169h}`),
+			Entry("invalid day", `{name: w, days: [Someday], start: "22:00", duration: 1h}`),
+			Entry("unknown field", `{name: w, start: "22:00", duration: 1h, until: "23:00"}`),
+		)
+
+		It("should reject duplicate names", func() {
+			_, err := Parse([]byte("windows:\n- {name: w, start: \"01:00\", duration: 1h}\n- {name: w, start: \"02:00\", duration: 1h}"))
+			Expect(err).To(MatchError(ContainSubstring("duplicate")))
+		})
+	})
+
+	Describe("Active", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			var err error
+			config, err = Parse([]byte(validConfig))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should have no windows when nil", func() {
+			var none *Config
+			_, _, ok := none.Active(time.Now())
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should report a window in its own time zone", func() {
+			// 2026-10-16 is a Friday; 23:45 in Berlin is 21:45 UTC
+			name, end, ok := config.Active(time.Date(2026, 10, 16, 23, 45, 0, 0, berlin))
+			Expect(ok).To(BeTrue())
+			Expect(name).To(Equal("nightly"))
+			Expect(end).To(BeTemporally("==", time.Date(2026, 10, 17, 0, 30, 0, 0, berlin)))
+		})
+
+		It("should report a window that started on a previous day", func() {
+			name, end, ok := config.Active(time.Date(2026, 10, 18, 12, 0, 0, 0, newYork))
+			Expect(ok).To(BeTrue())
+			Expect(name).To(Equal("weekend"))
+			Expect(end).To(BeTemporally("==", time.Date(2026, 10, 19, 6, 0, 0, 0, newYork)))
+		})
+
+		It("should only start windows on the listed days", func() {
+			_, _, ok := config.Active(time.Date(2026, 10, 15, 23, 0, 0, 0, newYork))
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should be inactive at the end of a window", func() {
+			_, _, ok := config.Active(time.Date(2026, 10, 19, 6, 0, 0, 0, newYork))
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should report the window ending last when windows overlap", func() {
+			// Saturday 23:45 in Berlin falls into both the nightly and the weekend window
+			name, _, ok := config.Active(time.Date(2026, 10, 17, 23, 45, 0, 0, berlin))
+			Expect(ok).To(BeTrue())
+			Expect(name).To(Equal("weekend"))
+		})
+	})
+})
@@ -79,3 +79,29 @@ type InvalidBlueFieldImageURLError struct {
 func (e *InvalidBlueFieldImageURLError) Error() string {
 	return fmt.Sprintf("BlueField image URL is invalid for OCP version %s: %s (URL: %s)", e.Version, e.Message, e.URL)
 }
+
+// ImageSourceUnavailableError indicates the BlueField image source could not be read
+// This is a transient error - the source might become reachable again
+type ImageSourceUnavailableError struct {
+	Source string
+	Err    error
+}
+
+func (e *ImageSourceUnavailableError) Error() string {
+	return fmt.Sprintf("BlueField image source %s is unavailable: %v", e.Source, e.Err)
+}
+
+func (e *ImageSourceUnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// ConflictingBlueFieldImagesError indicates several BlueFieldImageSets publish different images for the same OCP version
+// This is a permanent error - admin must remove one of them
+type ConflictingBlueFieldImagesError struct {
+	Version   string
+	ImageSets []string
+}
+
+func (e *ConflictingBlueFieldImagesError) Error() string {
+	return fmt.Sprintf("BlueFieldImageSets %v publish different BlueField images for OCP version %s", e.ImageSets, e.Version)
+}
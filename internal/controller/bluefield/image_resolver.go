@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -40,19 +41,33 @@ const (
 	configMapNamespace = "dpf-hcp-bridge-system"
 
 	// Reason codes
-	reasonImageResolved            = "ImageResolved"
-	reasonConfigMapNotFound        = "ConfigMapNotFound"
-	reasonConfigMapTransientError  = "ConfigMapTransientError"
-	reasonInvalidImageFormat       = "InvalidImageFormat"
-	reasonVersionNotFound          = "VersionNotFound"
-	reasonConfigMapAccessDenied    = "ConfigMapAccessDenied"
-	reasonInvalidBlueFieldImageURL = "InvalidBlueFieldImageURL"
+	ReasonImageResolved            = "ImageResolved"
+	ReasonConfigMapNotFound        = "ConfigMapNotFound"
+	ReasonConfigMapTransientError  = "ConfigMapTransientError"
+	ReasonInvalidImageFormat       = "InvalidImageFormat"
+	ReasonVersionNotFound          = "VersionNotFound"
+	ReasonConfigMapAccessDenied    = "ConfigMapAccessDenied"
+	ReasonInvalidBlueFieldImageURL = "InvalidBlueFieldImageURL"
+	ReasonImageSourceUnavailable   = "ImageSourceUnavailable"
+	ReasonConflictingImages        = "ConflictingBlueFieldImages"
 )
 
+// rechecked is implemented by image sources whose changes are not watched, such as the HTTP index
+type rechecked interface {
+	Recheck() time.Duration
+}
+
 // ImageResolver handles BlueField container image resolution
 type ImageResolver struct {
 	client.Client
 	Recorder record.EventRecorder
+
+	// Source is where BlueField images are looked up; the ocp-bluefield-images ConfigMap by default
+	Source ImageSource
+
+	// MetadataReader, if set, reads the OCP version from the release image metadata.
+	// Parsing the image tag is used as fallback and when it is nil.
+	MetadataReader ReleaseMetadataReader
 }
 
 // NewImageResolver creates a new ImageResolver
@@ -60,16 +75,37 @@ func NewImageResolver(client client.Client, recorder record.EventRecorder) *Imag
 	return &ImageResolver{
 		Client:   client,
 		Recorder: recorder,
+		Source:   NewConfigMapImageSource(client),
 	}
 }
 
 // ResolveBlueFieldImage is the main reconciliation function for BlueField image mapping
 // It extracts the OCP version from the ocpReleaseImage, looks up the corresponding
-// BlueField image in the ConfigMap, validates it, and updates the CR status
+// BlueField image in the image source, validates it, and updates the CR status
 func (r *ImageResolver) ResolveBlueFieldImage(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
 	log = log.WithValues("feature", "bluefield-image-mapping")
 
+	// Releases selected from a ReleaseCatalog carry their BlueField image pairing,
+	// resolved into status by the release catalog resolver
+	if cr.Spec.ReleaseCatalogRef != nil {
+		if cr.Status.BlueFieldContainerImage == "" {
+			log.V(1).Info("Waiting for the release catalog entry to be resolved")
+			return ctrl.Result{}, nil
+		}
+		return r.updateStatusOnSuccess(ctx, cr, cr.Status.BlueFieldContainerImage, cr.Status.OCPVersion)
+	}
+
+	// Releases tracked from an update channel carry their version, resolved into status by the
+	// release channel resolver
+	if cr.Spec.Channel != "" {
+		if cr.Status.ChannelRelease == nil {
+			log.V(1).Info("Waiting for the release channel to be resolved")
+			return ctrl.Result{}, nil
+		}
+		return r.resolveVersionImage(ctx, cr, cr.Status.ChannelRelease.Version)
+	}
+
 	// Step 1: Read ocpReleaseImage from spec
 	ocpReleaseImage := cr.Spec.OCPReleaseImage
 	if ocpReleaseImage == "" {
@@ -81,9 +117,9 @@ func (r *ImageResolver) ResolveBlueFieldImage(ctx context.Context, cr *provision
 		})
 	}
 
-	// Step 2: Parse OCP version from image URL
-	log.V(1).Info("Extracting OCP version from image URL", "ocpReleaseImage", ocpReleaseImage)
-	version, err := extractOCPVersion(ocpReleaseImage)
+	// Step 2: Determine OCP version from release image metadata or image URL
+	log.V(1).Info("Extracting OCP version from release image", "ocpReleaseImage", ocpReleaseImage)
+	version, err := r.resolveOCPVersion(ctx, cr)
 	if err != nil {
 		log.Error(err, "Failed to parse OCP version from image URL", "ocpReleaseImage", ocpReleaseImage)
 		return r.handleValidationError(ctx, cr, &InvalidImageFormatError{
@@ -93,48 +129,98 @@ func (r *ImageResolver) ResolveBlueFieldImage(ctx context.Context, cr *provision
 	}
 	log.V(1).Info("Extracted OCP version", "version", version)
 
-	// Step 3: Fetch ConfigMap
-	log.V(1).Info("Fetching ConfigMap", "name", configMapName, "namespace", configMapNamespace)
-	configMap, err := r.fetchConfigMap(ctx)
+	return r.resolveVersionImage(ctx, cr, version)
+}
+
+// resolveVersionImage looks up the BlueField image of the OCP version in the image source,
+// validates it, and updates the CR status
+func (r *ImageResolver) resolveVersionImage(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, version string) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("feature", "bluefield-image-mapping")
+
+	// Step 3: Lookup version in the image source
+	log.V(1).Info("Looking up BlueField image", "version", version)
+	blueFieldImage, err := r.Source.BlueFieldImage(ctx, version)
 	if err != nil {
 		// Check error type
-		if _, ok := err.(*ConfigMapNotFoundError); ok {
-			return r.handleTransientError(ctx, cr, err, version)
-		}
-		if _, ok := err.(*ConfigMapAccessDeniedError); ok {
+		switch err.(type) {
+		case *VersionNotFoundError, *ConfigMapAccessDeniedError, *ConflictingBlueFieldImagesError:
+			log.V(1).Info("BlueField image lookup failed", "version", version, "error", err.Error())
 			return r.handlePermanentError(ctx, cr, err, version)
 		}
-		// Other API errors - treat as transient
-		log.Error(err, "Transient error fetching ConfigMap")
+		// Other errors reading the source - treat as transient
+		log.Error(err, "Transient error reading BlueField image source")
 		return r.handleTransientError(ctx, cr, err, version)
 	}
 
-	// Step 4: Lookup version in ConfigMap
-	log.V(1).Info("Looking up BlueField image in ConfigMap", "version", version)
-	blueFieldImage, err := lookupBlueFieldImage(configMap, version)
-	if err != nil {
-		log.V(1).Info("BlueField image lookup failed", "version", version, "error", err.Error())
-		return r.handlePermanentError(ctx, cr, err, version)
-	}
-
-	// Step 5: Validate BlueField image URL
+	// Step 4: Validate BlueField image URL
 	log.V(1).Info("Validating BlueField image URL", "blueFieldImage", blueFieldImage)
 	if err := validateBlueFieldImageURL(blueFieldImage, version); err != nil {
 		log.Error(err, "BlueField image URL validation failed", "blueFieldImage", blueFieldImage)
 		return r.handlePermanentError(ctx, cr, err, version)
 	}
 
-	// Step 6: Update status on success
+	// Step 5: Update status on success
 	log.Info("BlueField image resolved successfully",
 		"version", version,
 		"blueFieldImage", blueFieldImage)
 	return r.updateStatusOnSuccess(ctx, cr, blueFieldImage, version)
 }
 
+// RecheckAfter returns when a bridge whose BlueField image could not be resolved should look it up again,
+// or 0 if changes to the image source are watched and trigger the lookup
+func (r *ImageResolver) RecheckAfter(cr *provisioningv1alpha1.DPFHCPBridge) time.Duration {
+	source, ok := r.Source.(rechecked)
+	if !ok || !meta.IsStatusConditionFalse(cr.Status.Conditions, provisioningv1alpha1.BlueFieldImageResolved) {
+		return 0
+	}
+	return source.Recheck()
+}
+
+// resolveOCPVersion determines the OCP version of the release image.
+// When a MetadataReader is configured the io.openshift.release label of the image is used, which
+// also works for digest-referenced and custom-tagged images. If the metadata cannot be read the
+// version is parsed from the image tag instead.
+func (r *ImageResolver) resolveOCPVersion(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (string, error) {
+	log := log.FromContext(ctx)
+
+	if r.MetadataReader != nil {
+		release, err := r.MetadataReader.ReleaseVersion(ctx, cr.Spec.OCPReleaseImage, r.pullSecret(ctx, cr))
+		if err == nil {
+			if version := normalizeVersion(release); version != "" {
+				log.V(1).Info("Read OCP version from release image metadata", "release", release, "version", version)
+				return version, nil
+			}
+			err = fmt.Errorf("%s label is empty after processing: %s", ReleaseVersionLabel, release)
+		}
+		log.V(1).Info("Failed to read OCP version from release image metadata, parsing image tag instead", "error", err.Error())
+	}
+
+	return extractOCPVersion(cr.Spec.OCPReleaseImage)
+}
+
+// pullSecret returns the .dockerconfigjson of the bridge's pull secret, or nil if it cannot be read.
+// The pull secret is optional for reading release metadata, so errors are not fatal.
+func (r *ImageResolver) pullSecret(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) []byte {
+	if cr.Spec.PullSecretRef.Name == "" {
+		return nil
+	}
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: cr.Spec.PullSecretRef.Name, Namespace: cr.Namespace}, secret); err != nil {
+		log.FromContext(ctx).V(1).Info("Failed to get pull secret for release metadata lookup", "error", err.Error())
+		return nil
+	}
+	return secret.Data[corev1.DockerConfigJsonKey]
+}
+
 // extractOCPVersion extracts the OCP version from the ocpReleaseImage URL
 // It strips architecture suffixes like -multi, -amd64, etc.
 // Exported for testing.
 func extractOCPVersion(ocpReleaseImage string) (string, error) {
+	// Digest references carry no version
+	if strings.Contains(ocpReleaseImage, "@") {
+		return "", fmt.Errorf("image is referenced by digest and its release metadata could not be read")
+	}
+
 	// Extract tag (everything after last ':')
 	parts := strings.Split(ocpReleaseImage, ":")
 	if len(parts) < 2 {
@@ -146,13 +232,7 @@ func extractOCPVersion(ocpReleaseImage string) (string, error) {
 		return "", fmt.Errorf("empty tag in image URL")
 	}
 
-	// Strip known architecture suffixes
-	suffixes := []string{"-multi", "-amd64", "-arm64", "-ppc64le", "-s390x", "-x86_64"}
-	version := tag
-	for _, suffix := range suffixes {
-		version = strings.TrimSuffix(version, suffix)
-	}
-
+	version := normalizeVersion(tag)
 	if version == "" {
 		return "", fmt.Errorf("extracted version is empty after processing tag: %s", tag)
 	}
@@ -160,26 +240,14 @@ func extractOCPVersion(ocpReleaseImage string) (string, error) {
 	return version, nil
 }
 
-// fetchConfigMap fetches the ocp-bluefield-images ConfigMap
-func (r *ImageResolver) fetchConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
-	configMap := &corev1.ConfigMap{}
-	err := r.Get(ctx, types.NamespacedName{
-		Name:      configMapName,
-		Namespace: configMapNamespace,
-	}, configMap)
-
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil, &ConfigMapNotFoundError{Err: err}
-		}
-		if apierrors.IsForbidden(err) {
-			return nil, &ConfigMapAccessDeniedError{Err: err}
-		}
-		// Other errors (network, API server issues)
-		return nil, err
+// normalizeVersion strips known architecture suffixes from a release tag or label
+func normalizeVersion(release string) string {
+	suffixes := []string{"-multi", "-amd64", "-arm64", "-ppc64le", "-s390x", "-x86_64"}
+	version := release
+	for _, suffix := range suffixes {
+		version = strings.TrimSuffix(version, suffix)
 	}
-
-	return configMap, nil
+	return version
 }
 
 // lookupBlueFieldImage looks up the BlueField image in the ConfigMap by version
@@ -238,14 +306,15 @@ func (r *ImageResolver) updateStatusOnSuccess(ctx context.Context, cr *provision
 
 	// Get previous condition to check if we need to emit event
 
-	// Update status field
+	// Update status fields
 	cr.Status.BlueFieldContainerImage = blueFieldImage
+	cr.Status.OCPVersion = version
 
 	// Update condition
 	condition := metav1.Condition{
 		Type:               provisioningv1alpha1.BlueFieldImageResolved,
 		Status:             metav1.ConditionTrue,
-		Reason:             reasonImageResolved,
+		Reason:             ReasonImageResolved,
 		Message:            fmt.Sprintf("BlueField container image resolved: %s", blueFieldImage),
 		LastTransitionTime: metav1.Now(),
 		ObservedGeneration: cr.Generation,
@@ -253,7 +322,7 @@ func (r *ImageResolver) updateStatusOnSuccess(ctx context.Context, cr *provision
 
 	// Emit event only if condition status/reason changed
 	if changed := meta.SetStatusCondition(&cr.Status.Conditions, condition); changed {
-		r.Recorder.Event(cr, corev1.EventTypeNormal, reasonImageResolved,
+		r.Recorder.Event(cr, corev1.EventTypeNormal, ReasonImageResolved,
 			fmt.Sprintf("BlueField container image resolved for OCP version %s: %s", version, blueFieldImage))
 	}
 
@@ -279,17 +348,18 @@ func (r *ImageResolver) handleValidationError(ctx context.Context, cr *provision
 
 	// Get previous condition
 
-	// Clear status field
+	// Clear status fields
 	cr.Status.BlueFieldContainerImage = ""
+	cr.Status.OCPVersion = ""
 
 	// Determine reason and message based on error type
 	var reason, message string
 	switch e := err.(type) {
 	case *InvalidImageFormatError:
-		reason = reasonInvalidImageFormat
+		reason = ReasonInvalidImageFormat
 		message = e.Error()
 	default:
-		reason = reasonInvalidImageFormat
+		reason = ReasonInvalidImageFormat
 		message = err.Error()
 	}
 
@@ -324,23 +394,27 @@ func (r *ImageResolver) handlePermanentError(ctx context.Context, cr *provisioni
 
 	// Get previous condition
 
-	// Clear status field
+	// Clear status fields
 	cr.Status.BlueFieldContainerImage = ""
+	cr.Status.OCPVersion = ""
 
 	// Determine reason based on error type
 	var reason, message string
 	switch err.(type) {
 	case *VersionNotFoundError:
-		reason = reasonVersionNotFound
+		reason = ReasonVersionNotFound
 		message = err.Error()
 	case *ConfigMapAccessDeniedError:
-		reason = reasonConfigMapAccessDenied
+		reason = ReasonConfigMapAccessDenied
 		message = err.Error()
 	case *InvalidBlueFieldImageURLError:
-		reason = reasonInvalidBlueFieldImageURL
+		reason = ReasonInvalidBlueFieldImageURL
+		message = err.Error()
+	case *ConflictingBlueFieldImagesError:
+		reason = ReasonConflictingImages
 		message = err.Error()
 	default:
-		reason = reasonVersionNotFound
+		reason = ReasonVersionNotFound
 		message = err.Error()
 	}
 
@@ -378,10 +452,13 @@ func (r *ImageResolver) handleTransientError(ctx context.Context, cr *provisioni
 	var reason, message string
 	switch err.(type) {
 	case *ConfigMapNotFoundError:
-		reason = reasonConfigMapNotFound
+		reason = ReasonConfigMapNotFound
 		message = fmt.Sprintf("ConfigMap %s not found in namespace %s", configMapName, configMapNamespace)
+	case *ImageSourceUnavailableError:
+		reason = ReasonImageSourceUnavailable
+		message = err.Error()
 	default:
-		reason = reasonConfigMapTransientError
+		reason = ReasonConfigMapTransientError
 		message = fmt.Sprintf("Transient error accessing ConfigMap: %v", err)
 	}
 
@@ -17,10 +17,17 @@ limitations under the License.
 package bluefield
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
 )
 
 var _ = Describe("BlueField Image Resolver", func() {
@@ -107,6 +114,48 @@ var _ = Describe("BlueField Image Resolver", func() {
 		})
 	})
 
+	Describe("Release channels", func() {
+		var (
+			resolver *ImageResolver
+			cr       *provisioningv1alpha1.DPFHCPBridge
+		)
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+			cr = &provisioningv1alpha1.DPFHCPBridge{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default"},
+				Spec:       provisioningv1alpha1.DPFHCPBridgeSpec{Channel: "stable-4.17"},
+			}
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(cr, newImageSet("bluefield-4.17.3", "4.17.3", "quay.io/example/bluefield-rhcos:4.17.3")).
+				WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+				Build()
+			resolver = NewImageResolver(c, record.NewFakeRecorder(10))
+			resolver.Source = NewImageSetSource(c)
+		})
+
+		It("should wait for the channel to be resolved", func() {
+			_, err := resolver.ResolveBlueFieldImage(context.Background(), cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cr.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should look up the BlueField image of the channel release", func() {
+			cr.Status.ChannelRelease = &provisioningv1alpha1.ChannelRelease{
+				Channel: "stable-4.17",
+				Version: "4.17.3",
+				Image:   "quay.io/openshift-release-dev/ocp-release@sha256:0123456789abcdef",
+			}
+
+			_, err := resolver.ResolveBlueFieldImage(context.Background(), cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cr.Status.BlueFieldContainerImage).To(Equal("quay.io/example/bluefield-rhcos:4.17.3"))
+			Expect(cr.Status.OCPVersion).To(Equal("4.17.3"))
+		})
+	})
+
 	Describe("ConfigMap Lookup", func() {
 		var configMap *corev1.ConfigMap
 
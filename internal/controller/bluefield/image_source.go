@@ -0,0 +1,235 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bluefield
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const (
+	// Image sources, selected by operator configuration
+	ImageSourceConfigMap = "configmap"
+	ImageSourceImageSet  = "imageset"
+	ImageSourceHTTP      = "http"
+
+	// DefaultIndexTimeout bounds each fetch of the HTTP index
+	DefaultIndexTimeout = 10 * time.Second
+
+	// DefaultIndexRecheckInterval is how often a bridge whose version is missing from the HTTP index looks it up again
+	DefaultIndexRecheckInterval = 5 * time.Minute
+
+	// maxIndexSize bounds the HTTP index response
+	maxIndexSize = 4 << 20
+)
+
+// ImageSource looks up the BlueField container image published for an OCP version.
+// A version that is not published is reported as *VersionNotFoundError; errors reading the source are
+// reported as *ConfigMapNotFoundError or *ImageSourceUnavailableError when they are transient.
+type ImageSource interface {
+	BlueFieldImage(ctx context.Context, version string) (string, error)
+}
+
+// NewImageSource returns the image source of the given kind; indexURL is the URL of the HTTP index
+func NewImageSource(kind string, reader client.Reader, indexURL string) (ImageSource, error) {
+	switch kind {
+	case ImageSourceConfigMap:
+		return NewConfigMapImageSource(reader), nil
+	case ImageSourceImageSet:
+		return NewImageSetSource(reader), nil
+	case ImageSourceHTTP:
+		if indexURL == "" {
+			return nil, fmt.Errorf("the %s image source requires an index URL", ImageSourceHTTP)
+		}
+		return NewHTTPIndexSource(indexURL), nil
+	default:
+		return nil, fmt.Errorf("unknown BlueField image source %q, expected %q, %q or %q",
+			kind, ImageSourceConfigMap, ImageSourceImageSet, ImageSourceHTTP)
+	}
+}
+
+// ConfigMapImageSource reads the ocp-bluefield-images ConfigMap, which maps OCP versions to BlueField images
+type ConfigMapImageSource struct {
+	client.Reader
+}
+
+// NewConfigMapImageSource creates a new ConfigMapImageSource
+func NewConfigMapImageSource(reader client.Reader) *ConfigMapImageSource {
+	return &ConfigMapImageSource{Reader: reader}
+}
+
+// BlueFieldImage looks up the BlueField image of the version in the ConfigMap
+func (s *ConfigMapImageSource) BlueFieldImage(ctx context.Context, version string) (string, error) {
+	configMap, err := s.fetchConfigMap(ctx)
+	if err != nil {
+		return "", err
+	}
+	return lookupBlueFieldImage(configMap, version)
+}
+
+// fetchConfigMap fetches the ocp-bluefield-images ConfigMap
+func (s *ConfigMapImageSource) fetchConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{}
+	err := s.Get(ctx, types.NamespacedName{
+		Name:      configMapName,
+		Namespace: configMapNamespace,
+	}, configMap)
+
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, &ConfigMapNotFoundError{Err: err}
+		}
+		if apierrors.IsForbidden(err) {
+			return nil, &ConfigMapAccessDeniedError{Err: err}
+		}
+		// Other errors (network, API server issues)
+		return nil, err
+	}
+
+	return configMap, nil
+}
+
+// ImageSetSource reads the cluster-scoped BlueFieldImageSets, each of which publishes the image of one OCP version
+type ImageSetSource struct {
+	client.Reader
+}
+
+// NewImageSetSource creates a new ImageSetSource
+func NewImageSetSource(reader client.Reader) *ImageSetSource {
+	return &ImageSetSource{Reader: reader}
+}
+
+// BlueFieldImage returns the image of the BlueFieldImageSets of the version. Several image sets may publish
+// the same version as long as they agree on the image.
+func (s *ImageSetSource) BlueFieldImage(ctx context.Context, version string) (string, error) {
+	var list provisioningv1alpha1.BlueFieldImageSetList
+	if err := s.List(ctx, &list); err != nil {
+		return "", &ImageSourceUnavailableError{Source: "BlueFieldImageSets", Err: err}
+	}
+
+	var image string
+	var matching, available []string
+	images := map[string]bool{}
+	for _, set := range list.Items {
+		if set.Spec.OCPVersion != version {
+			available = append(available, set.Spec.OCPVersion)
+			continue
+		}
+		matching = append(matching, set.Name)
+		images[set.Spec.Image] = true
+		image = set.Spec.Image
+	}
+
+	switch {
+	case len(matching) == 0:
+		sort.Strings(available)
+		return "", &VersionNotFoundError{Version: version, AvailableVersions: available}
+	case len(images) > 1:
+		sort.Strings(matching)
+		return "", &ConflictingBlueFieldImagesError{Version: version, ImageSets: matching}
+	}
+	return image, nil
+}
+
+// HTTPIndexSource reads a JSON index served over HTTP that maps OCP versions to BlueField images,
+// in the same format as the data of the ocp-bluefield-images ConfigMap:
+//
+//	{"4.19.0": "quay.io/example/bluefield-rhcos:4.19.0"}
+type HTTPIndexSource struct {
+	// URL of the index
+	URL string
+
+	// Client is used to fetch the index; http.DefaultClient is used if it is nil
+	Client *http.Client
+
+	// Timeout bounds each fetch of the index; no timeout if 0
+	Timeout time.Duration
+
+	// RecheckInterval is how often bridges whose version is not in the index look it up again,
+	// since changes to the index are not watched
+	RecheckInterval time.Duration
+}
+
+// NewHTTPIndexSource creates a new HTTPIndexSource for the index at url
+func NewHTTPIndexSource(url string) *HTTPIndexSource {
+	return &HTTPIndexSource{
+		URL:             url,
+		Timeout:         DefaultIndexTimeout,
+		RecheckInterval: DefaultIndexRecheckInterval,
+	}
+}
+
+// BlueFieldImage fetches the index and looks up the BlueField image of the version
+func (s *HTTPIndexSource) BlueFieldImage(ctx context.Context, version string) (string, error) {
+	index, err := s.fetchIndex(ctx)
+	if err != nil {
+		return "", &ImageSourceUnavailableError{Source: s.URL, Err: err}
+	}
+	return lookupBlueFieldImage(&corev1.ConfigMap{Data: index}, version)
+}
+
+// Recheck returns how often a version missing from the index is looked up again
+func (s *HTTPIndexSource) Recheck() time.Duration {
+	return s.RecheckInterval
+}
+
+// fetchIndex fetches and decodes the index
+func (s *HTTPIndexSource) fetchIndex(ctx context.Context) (map[string]string, error) {
+	if s.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := s.Client
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var index map[string]string
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxIndexSize)).Decode(&index); err != nil {
+		return nil, fmt.Errorf("failed to decode index: %w", err)
+	}
+	return index, nil
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bluefield
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// newImageSet returns a BlueFieldImageSet publishing image for version
+func newImageSet(name, version, image string) *provisioningv1alpha1.BlueFieldImageSet {
+	return &provisioningv1alpha1.BlueFieldImageSet{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       provisioningv1alpha1.BlueFieldImageSetSpec{OCPVersion: version, Image: image},
+	}
+}
+
+var _ = Describe("BlueField Image Sources", func() {
+	var scheme *runtime.Scheme
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	Describe("NewImageSource", func() {
+		It("should return the source of the given kind", func() {
+			c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			source, err := NewImageSource(ImageSourceImageSet, c, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(source).To(BeAssignableToTypeOf(&ImageSetSource{}))
+
+			source, err = NewImageSource(ImageSourceHTTP, c, "https://images.example.com/index.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(source.(*HTTPIndexSource).URL).To(Equal("https://images.example.com/index.json"))
+		})
+
+		It("should reject the HTTP source without an index URL and unknown kinds", func() {
+			c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			_, err := NewImageSource(ImageSourceHTTP, c, "")
+			Expect(err).To(HaveOccurred())
+			_, err = NewImageSource("oci", c, "")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ConfigMapImageSource", func() {
+		It("should look up the version in the ocp-bluefield-images ConfigMap", func() {
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "ocp-bluefield-images", Namespace: "dpf-hcp-bridge-system"},
+				Data:       map[string]string{"4.19.0": "quay.io/example/bluefield-rhcos:4.19.0"},
+			}
+			source := NewConfigMapImageSource(fake.NewClientBuilder().WithScheme(scheme).WithObjects(configMap).Build())
+
+			image, err := source.BlueFieldImage(context.Background(), "4.19.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(image).To(Equal("quay.io/example/bluefield-rhcos:4.19.0"))
+		})
+
+		It("should return ConfigMapNotFoundError when the ConfigMap does not exist", func() {
+			source := NewConfigMapImageSource(fake.NewClientBuilder().WithScheme(scheme).Build())
+
+			_, err := source.BlueFieldImage(context.Background(), "4.19.0")
+			Expect(err).To(BeAssignableToTypeOf(&ConfigMapNotFoundError{}))
+		})
+	})
+
+	Describe("ImageSetSource", func() {
+		newSource := func(objs ...client.Object) *ImageSetSource {
+			return NewImageSetSource(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build())
+		}
+
+		It("should return the image of the matching BlueFieldImageSet", func() {
+			source := newSource(
+				newImageSet("team-a-4.19.0", "4.19.0", "quay.io/team-a/bluefield:4.19.0"),
+				newImageSet("team-b-4.18.0", "4.18.0", "quay.io/team-b/bluefield:4.18.0"),
+			)
+
+			image, err := source.BlueFieldImage(context.Background(), "4.18.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(image).To(Equal("quay.io/team-b/bluefield:4.18.0"))
+		})
+
+		It("should return VersionNotFoundError listing the published versions", func() {
+			source := newSource(newImageSet("team-a-4.19.0", "4.19.0", "quay.io/team-a/bluefield:4.19.0"))
+
+			_, err := source.BlueFieldImage(context.Background(), "4.20.0")
+			var notFound *VersionNotFoundError
+			Expect(err).To(BeAssignableToTypeOf(notFound))
+			Expect(err.(*VersionNotFoundError).AvailableVersions).To(Equal([]string{"4.19.0"}))
+		})
+
+		It("should accept image sets that agree on the image", func() {
+			source := newSource(
+				newImageSet("team-a-4.19.0", "4.19.0", "quay.io/shared/bluefield:4.19.0"),
+				newImageSet("team-b-4.19.0", "4.19.0", "quay.io/shared/bluefield:4.19.0"),
+			)
+
+			image, err := source.BlueFieldImage(context.Background(), "4.19.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(image).To(Equal("quay.io/shared/bluefield:4.19.0"))
+		})
+
+		It("should return ConflictingBlueFieldImagesError when image sets disagree", func() {
+			source := newSource(
+				newImageSet("team-b-4.19.0", "4.19.0", "quay.io/team-b/bluefield:4.19.0"),
+				newImageSet("team-a-4.19.0", "4.19.0", "quay.io/team-a/bluefield:4.19.0"),
+			)
+
+			_, err := source.BlueFieldImage(context.Background(), "4.19.0")
+			var conflict *ConflictingBlueFieldImagesError
+			Expect(err).To(BeAssignableToTypeOf(conflict))
+			Expect(err.(*ConflictingBlueFieldImagesError).ImageSets).To(Equal([]string{"team-a-4.19.0", "team-b-4.19.0"}))
+		})
+	})
+
+	Describe("HTTPIndexSource", func() {
+		var (
+			server *httptest.Server
+			status int
+		)
+
+		BeforeEach(func() {
+			status = http.StatusOK
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(`{"4.19.0": "quay.io/example/bluefield-rhcos:4.19.0"}`))
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should look up the version in the index", func() {
+			image, err := NewHTTPIndexSource(server.URL).BlueFieldImage(context.Background(), "4.19.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(image).To(Equal("quay.io/example/bluefield-rhcos:4.19.0"))
+		})
+
+		It("should return VersionNotFoundError for versions missing from the index", func() {
+			_, err := NewHTTPIndexSource(server.URL).BlueFieldImage(context.Background(), "4.20.0")
+			Expect(err).To(BeAssignableToTypeOf(&VersionNotFoundError{}))
+		})
+
+		It("should return ImageSourceUnavailableError when the index cannot be fetched", func() {
+			status = http.StatusServiceUnavailable
+
+			_, err := NewHTTPIndexSource(server.URL).BlueFieldImage(context.Background(), "4.19.0")
+			Expect(err).To(BeAssignableToTypeOf(&ImageSourceUnavailableError{}))
+			Expect(err.Error()).To(ContainSubstring("503"))
+		})
+	})
+
+	Describe("Recheck", func() {
+		var (
+			resolver *ImageResolver
+			cr       *provisioningv1alpha1.DPFHCPBridge
+		)
+
+		BeforeEach(func() {
+			resolver = NewImageResolver(fake.NewClientBuilder().WithScheme(scheme).Build(), nil)
+			cr = &provisioningv1alpha1.DPFHCPBridge{}
+			meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+				Type:   provisioningv1alpha1.BlueFieldImageResolved,
+				Status: metav1.ConditionFalse,
+				Reason: ReasonVersionNotFound,
+			})
+		})
+
+		It("should not recheck watched image sources", func() {
+			Expect(resolver.RecheckAfter(cr)).To(BeZero())
+		})
+
+		It("should recheck the HTTP index while the image is not resolved", func() {
+			resolver.Source = NewHTTPIndexSource("https://images.example.com/index.json")
+			Expect(resolver.RecheckAfter(cr)).To(Equal(DefaultIndexRecheckInterval))
+
+			meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+				Type:   provisioningv1alpha1.BlueFieldImageResolved,
+				Status: metav1.ConditionTrue,
+				Reason: ReasonImageResolved,
+			})
+			Expect(resolver.RecheckAfter(cr)).To(BeZero())
+		})
+	})
+})
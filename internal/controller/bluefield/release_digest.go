@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bluefield
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// cosignSignatureAnnotation is the layer annotation carrying a cosign signature of the layer payload
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+	// digestAlgorithm is the only digest algorithm release images are pinned with
+	digestAlgorithm = "sha256:"
+)
+
+// ReleaseDigestReader resolves release images to the digest of their manifest and reads the
+// signatures published for a digest
+type ReleaseDigestReader interface {
+	// ReleaseDigest returns the manifest digest the image currently refers to, e.g. sha256:...
+	// pullSecret is an optional .dockerconfigjson used to authenticate against the registry.
+	ReleaseDigest(ctx context.Context, image string, pullSecret []byte) (string, error)
+
+	// ReleaseSignatures returns the cosign signatures stored for the digest in the repository of the
+	// image, or none if the digest is not signed
+	ReleaseSignatures(ctx context.Context, image, digest string, pullSecret []byte) ([]ReleaseSignature, error)
+}
+
+// ReleaseSignature is a cosign signature of a release image digest
+type ReleaseSignature struct {
+	// Payload is the signed simple signing payload naming the digest
+	Payload []byte
+
+	// Signature is the raw signature of Payload
+	Signature []byte
+}
+
+// ReleaseDigest implements ReleaseDigestReader. Digest references are returned as is; tags are
+// resolved by fetching the manifest they currently point to. Results are not cached, as tags
+// may be moved.
+func (r *RegistryMetadataReader) ReleaseDigest(ctx context.Context, image string, pullSecret []byte) (string, error) {
+	session, err := r.newSession(image, pullSecret)
+	if err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(session.ref.reference, digestAlgorithm) {
+		return session.ref.reference, nil
+	}
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	manifest, _, err := session.fetch(ctx, "manifests/"+session.ref.reference, strings.Join(manifestMediaTypes, ", "))
+	if err != nil {
+		return "", fmt.Errorf("failed to read manifest of %s: %w", image, err)
+	}
+	// The digest of the served bytes rather than the Docker-Content-Digest header, which is not verified
+	sum := sha256.Sum256(manifest)
+	return digestAlgorithm + hex.EncodeToString(sum[:]), nil
+}
+
+// ReleaseSignatures implements ReleaseDigestReader. Signatures are read from the sha256-<hex>.sig
+// tag that cosign publishes next to a signed image.
+func (r *RegistryMetadataReader) ReleaseSignatures(ctx context.Context, image, digest string, pullSecret []byte) ([]ReleaseSignature, error) {
+	if !strings.HasPrefix(digest, digestAlgorithm) {
+		return nil, fmt.Errorf("unsupported digest %q", digest)
+	}
+	session, err := r.newSession(image, pullSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	var manifest struct {
+		Layers []struct {
+			Digest      string            `json:"digest"`
+			Annotations map[string]string `json:"annotations"`
+		} `json:"layers"`
+	}
+	tag := strings.Replace(digest, ":", "-", 1) + ".sig"
+	if err := session.getJSON(ctx, "manifests/"+tag, strings.Join(manifestMediaTypes, ", "), &manifest); err != nil {
+		var notFound *registryNotFoundError
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read signatures of %s: %w", image, err)
+	}
+
+	var signatures []ReleaseSignature
+	for _, layer := range manifest.Layers {
+		encoded := layer.Annotations[cosignSignatureAnnotation]
+		if encoded == "" {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature in layer %s of %s: %w", layer.Digest, tag, err)
+		}
+		payload, _, err := session.fetch(ctx, "blobs/"+layer.Digest, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read signature payload of %s: %w", image, err)
+		}
+		if sum := sha256.Sum256(payload); digestAlgorithm+hex.EncodeToString(sum[:]) != layer.Digest {
+			return nil, fmt.Errorf("signature payload %s of %s does not match its digest", layer.Digest, image)
+		}
+		signatures = append(signatures, ReleaseSignature{Payload: payload, Signature: signature})
+	}
+
+	return signatures, nil
+}
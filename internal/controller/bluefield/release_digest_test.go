@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bluefield
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newStaticRegistry serves fixed responses for the registry API paths of the ocp-release repository
+func newStaticRegistry(paths map[string]string) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, found := paths[strings.TrimPrefix(r.URL.Path, "/v2/openshift-release-dev/ocp-release/")]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	return server, &requests
+}
+
+func sha256Digest(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+var _ = Describe("Release Digest", func() {
+	const (
+		manifest = `{"mediaType":"application/vnd.oci.image.index.v1+json","manifests":[]}`
+		payload  = `{"critical":{"type":"cosign container image signature"}}`
+	)
+
+	var (
+		server   *httptest.Server
+		requests *int
+		reader   *RegistryMetadataReader
+		repo     string
+		digest   string
+	)
+
+	BeforeEach(func() {
+		digest = sha256Digest(manifest)
+		sigManifest := `{"layers":[{"digest":"` + sha256Digest(payload) + `","annotations":{"` +
+			cosignSignatureAnnotation + `":"c2lnbmF0dXJl"}},{"digest":"sha256:other"}]}`
+		server, requests = newStaticRegistry(map[string]string{
+			"manifests/4.19.0-multi": manifest,
+			"manifests/" + strings.Replace(digest, ":", "-", 1) + ".sig": sigManifest,
+			"blobs/" + sha256Digest(payload):                             payload,
+		})
+		reader = NewRegistryMetadataReader()
+		reader.HTTPClient = server.Client()
+		repo = strings.TrimPrefix(server.URL, "https://") + "/openshift-release-dev/ocp-release"
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("ReleaseDigest", func() {
+		It("should resolve a tag to the digest of the served manifest", func() {
+			Expect(reader.ReleaseDigest(context.Background(), repo+":4.19.0-multi", nil)).To(Equal(digest))
+		})
+
+		It("should return digest references without asking the registry", func() {
+			Expect(reader.ReleaseDigest(context.Background(), repo+"@sha256:abc", nil)).To(Equal("sha256:abc"))
+			Expect(*requests).To(BeZero())
+		})
+
+		It("should fail for unknown tags", func() {
+			_, err := reader.ReleaseDigest(context.Background(), repo+":unknown", nil)
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+		})
+	})
+
+	Describe("ReleaseSignatures", func() {
+		It("should read the cosign signatures of the digest", func() {
+			signatures, err := reader.ReleaseSignatures(context.Background(), repo+":4.19.0-multi", digest, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signatures).To(ConsistOf(ReleaseSignature{Payload: []byte(payload), Signature: []byte("signature")}))
+		})
+
+		It("should return no signatures for unsigned digests", func() {
+			signatures, err := reader.ReleaseSignatures(context.Background(), repo+":4.19.0-multi", "sha256:unsigned", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signatures).To(BeEmpty())
+		})
+
+		It("should reject payloads that do not match their digest", func() {
+			server.Close()
+			sigManifest := `{"layers":[{"digest":"sha256:tampered","annotations":{"` + cosignSignatureAnnotation + `":"c2lnbmF0dXJl"}}]}`
+			server, requests = newStaticRegistry(map[string]string{
+				"manifests/sha256-abc.sig": sigManifest,
+				"blobs/sha256:tampered":    payload,
+			})
+			reader.HTTPClient = server.Client()
+			repo = strings.TrimPrefix(server.URL, "https://") + "/openshift-release-dev/ocp-release"
+
+			_, err := reader.ReleaseSignatures(context.Background(), repo+":4.19.0-multi", "sha256:abc", nil)
+			Expect(err).To(MatchError(ContainSubstring("does not match its digest")))
+		})
+	})
+})
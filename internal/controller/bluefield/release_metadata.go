@@ -0,0 +1,480 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bluefield
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// ReleaseVersionLabel is the image label carrying the version of an OCP release payload
+	ReleaseVersionLabel = "io.openshift.release"
+
+	// VersionSourceMetadata reads the version from the release image metadata, falling back to tag parsing
+	VersionSourceMetadata = "metadata"
+	// VersionSourceTag only parses the version from the release image tag
+	VersionSourceTag = "tag"
+
+	// DefaultRegistryTimeout bounds the registry requests needed to read the release image metadata
+	DefaultRegistryTimeout = 10 * time.Second
+	// DefaultRegistryCacheTTL is how long a release version read from the registry is reused
+	DefaultRegistryCacheTTL = time.Hour
+	// DefaultRegistryErrorCacheTTL is how long a failed registry lookup is reused before retrying
+	DefaultRegistryErrorCacheTTL = 30 * time.Second
+
+	defaultRegistry = "docker.io"
+)
+
+// manifestMediaTypes are the manifest formats accepted from the registry
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// ReleaseMetadataReader reads the OCP version of a release image from its metadata
+type ReleaseMetadataReader interface {
+	// ReleaseVersion returns the value of the io.openshift.release label of the image.
+	// pullSecret is an optional .dockerconfigjson used to authenticate against the registry.
+	ReleaseVersion(ctx context.Context, image string, pullSecret []byte) (string, error)
+}
+
+// RegistryMetadataReader reads release image metadata directly from the container registry
+// using the OCI distribution API. Results are cached per image reference and pull secret, so
+// fleets of bridges referencing the same release send one set of registry requests per TTL.
+//
+// Concurrent lookups of the same image and pull secret, e.g. by a reconcile and an upgrade
+// revalidation of the same bridge, share a single set of registry requests.
+type RegistryMetadataReader struct {
+	// HTTPClient is used for registry requests; http.DefaultClient if nil
+	HTTPClient *http.Client
+
+	// Timeout bounds all registry requests of a single lookup; no timeout if 0
+	Timeout time.Duration
+
+	// CacheTTL is how long a successful lookup is reused; no caching if 0
+	CacheTTL time.Duration
+
+	// ErrorCacheTTL is how long a failed lookup is reused, so that a missing image or an
+	// unreachable registry isn't queried on every reconcile; no caching if 0
+	ErrorCacheTTL time.Duration
+
+	// now returns the current time; time.Now if nil
+	now func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedLookup
+
+	// inFlight tracks the running lookups, keyed by image and pull secret hash
+	inFlight singleflight.Group
+}
+
+// NewRegistryMetadataReader creates a new RegistryMetadataReader
+func NewRegistryMetadataReader() *RegistryMetadataReader {
+	return &RegistryMetadataReader{
+		Timeout:       DefaultRegistryTimeout,
+		CacheTTL:      DefaultRegistryCacheTTL,
+		ErrorCacheTTL: DefaultRegistryErrorCacheTTL,
+		cache:         map[string]cachedLookup{},
+	}
+}
+
+// cachedLookup is the result of a registry lookup kept until it expires
+type cachedLookup struct {
+	version string
+	err     error
+	expires time.Time
+}
+
+// imageReference is a parsed container image reference
+type imageReference struct {
+	registry   string
+	repository string
+	reference  string // digest if present, tag otherwise
+}
+
+// parseImageReference splits an image reference into registry, repository and tag or digest
+func parseImageReference(image string) (imageReference, error) {
+	ref := imageReference{registry: defaultRegistry}
+
+	name := image
+	if n, digest, found := strings.Cut(image, "@"); found {
+		name, ref.reference = n, digest
+	}
+	// A ':' after the last '/' separates the tag, any other ':' belongs to the registry port
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		if ref.reference == "" {
+			ref.reference = name[i+1:]
+		}
+		name = name[:i]
+	}
+	if ref.reference == "" {
+		ref.reference = "latest"
+	}
+
+	if first, rest, found := strings.Cut(name, "/"); found &&
+		(strings.ContainsAny(first, ".:") || first == "localhost") {
+		ref.registry, name = first, rest
+	}
+	if ref.registry == defaultRegistry && !strings.Contains(name, "/") {
+		name = "library/" + name
+	}
+	if name == "" {
+		return imageReference{}, fmt.Errorf("missing repository in image reference %q", image)
+	}
+	ref.repository = name
+
+	return ref, nil
+}
+
+// ReleaseVersion implements ReleaseMetadataReader. A caller whose context ends stops waiting,
+// but a lookup shared with other callers runs to completion, bounded by Timeout.
+func (r *RegistryMetadataReader) ReleaseVersion(ctx context.Context, image string, pullSecret []byte) (string, error) {
+	secretHash := sha256.Sum256(pullSecret)
+	key := image + "|" + hex.EncodeToString(secretHash[:])
+
+	r.mu.Lock()
+	entry, cached := r.cache[key]
+	r.mu.Unlock()
+	if cached && r.clock().Before(entry.expires) {
+		return entry.version, entry.err
+	}
+
+	result := r.inFlight.DoChan(key, func() (any, error) {
+		version, err := r.lookup(context.WithoutCancel(ctx), image, pullSecret)
+		r.store(key, version, err)
+		return version, err
+	})
+	select {
+	case res := <-result:
+		if res.Err != nil {
+			return "", res.Err
+		}
+		return res.Val.(string), nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// store caches the result of a lookup for CacheTTL or ErrorCacheTTL, dropping expired entries
+func (r *RegistryMetadataReader) store(key, version string, err error) {
+	ttl := r.CacheTTL
+	if err != nil {
+		ttl = r.ErrorCacheTTL
+	}
+
+	now := r.clock()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, entry := range r.cache {
+		if !now.Before(entry.expires) {
+			delete(r.cache, k)
+		}
+	}
+	if ttl <= 0 {
+		return
+	}
+	if r.cache == nil {
+		r.cache = map[string]cachedLookup{}
+	}
+	r.cache[key] = cachedLookup{version: version, err: err, expires: now.Add(ttl)}
+}
+
+func (r *RegistryMetadataReader) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+// lookup reads the release version of the image from the registry
+func (r *RegistryMetadataReader) lookup(ctx context.Context, image string, pullSecret []byte) (string, error) {
+	session, err := r.newSession(image, pullSecret)
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	labels, err := session.imageLabels(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to read metadata of %s: %w", image, err)
+	}
+	version := labels[ReleaseVersionLabel]
+	if version == "" {
+		return "", fmt.Errorf("image %s has no %s label", image, ReleaseVersionLabel)
+	}
+
+	return version, nil
+}
+
+// newSession prepares the registry requests for an image, authenticated with the pull secret
+func (r *RegistryMetadataReader) newSession(image string, pullSecret []byte) (*registrySession, error) {
+	ref, err := parseImageReference(image)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &registrySession{
+		client:      r.HTTPClient,
+		ref:         ref,
+		credentials: registryCredentials(pullSecret, ref.registry),
+	}
+	if session.client == nil {
+		session.client = http.DefaultClient
+	}
+	return session, nil
+}
+
+// withTimeout bounds ctx by Timeout, if set
+func (r *RegistryMetadataReader) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if r.Timeout > 0 {
+		return context.WithTimeout(ctx, r.Timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// registryCredentials returns the base64 encoded user:password for the registry from a
+// .dockerconfigjson pull secret, or "" if there is none
+func registryCredentials(pullSecret []byte, registry string) string {
+	if len(pullSecret) == 0 {
+		return ""
+	}
+	var config struct {
+		Auths map[string]struct {
+			Auth     string `json:"auth"`
+			Username string `json:"username"`
+			Password string `json:"password"`
+		} `json:"auths"`
+	}
+	if err := json.Unmarshal(pullSecret, &config); err != nil {
+		return ""
+	}
+	for _, key := range []string{registry, "https://" + registry} {
+		if entry, found := config.Auths[key]; found {
+			if entry.Auth != "" {
+				return entry.Auth
+			}
+			if entry.Username != "" {
+				return base64.StdEncoding.EncodeToString([]byte(entry.Username + ":" + entry.Password))
+			}
+		}
+	}
+	return ""
+}
+
+// registrySession performs the registry requests for a single image, keeping the bearer token
+// obtained from the first authentication challenge
+type registrySession struct {
+	client      *http.Client
+	ref         imageReference
+	credentials string
+	token       string
+}
+
+// imageLabels returns the labels of the image config. For manifest lists the linux/amd64
+// image is used, or the first one if there is none; all images of a release carry the same labels.
+func (s *registrySession) imageLabels(ctx context.Context) (map[string]string, error) {
+	var manifest struct {
+		MediaType string `json:"mediaType"`
+		Config    struct {
+			Digest string `json:"digest"`
+		} `json:"config"`
+		Manifests []struct {
+			Digest   string `json:"digest"`
+			Platform struct {
+				OS           string `json:"os"`
+				Architecture string `json:"architecture"`
+			} `json:"platform"`
+		} `json:"manifests"`
+	}
+
+	if err := s.getJSON(ctx, "manifests/"+s.ref.reference, strings.Join(manifestMediaTypes, ", "), &manifest); err != nil {
+		return nil, err
+	}
+
+	if len(manifest.Manifests) > 0 {
+		digest := manifest.Manifests[0].Digest
+		for _, m := range manifest.Manifests {
+			if m.Platform.OS == "linux" && m.Platform.Architecture == "amd64" {
+				digest = m.Digest
+				break
+			}
+		}
+		manifest.Manifests = nil
+		if err := s.getJSON(ctx, "manifests/"+digest, strings.Join(manifestMediaTypes, ", "), &manifest); err != nil {
+			return nil, err
+		}
+	}
+
+	if manifest.Config.Digest == "" {
+		return nil, fmt.Errorf("manifest has no config")
+	}
+
+	var config struct {
+		Config struct {
+			Labels map[string]string `json:"Labels"`
+		} `json:"config"`
+	}
+	if err := s.getJSON(ctx, "blobs/"+manifest.Config.Digest, "", &config); err != nil {
+		return nil, err
+	}
+
+	return config.Config.Labels, nil
+}
+
+// getJSON fetches a registry API path of the repository and decodes the JSON response
+func (s *registrySession) getJSON(ctx context.Context, path, accept string, out any) error {
+	body, _, err := s.fetch(ctx, path, accept)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, out)
+}
+
+// fetch GETs a registry API path of the repository and returns the response body and headers,
+// authenticating once if the registry answers with a bearer challenge
+func (s *registrySession) fetch(ctx context.Context, path, accept string) ([]byte, http.Header, error) {
+	endpoint := fmt.Sprintf("https://%s/v2/%s/%s", s.ref.registry, s.ref.repository, path)
+
+	resp, err := s.get(ctx, endpoint, accept)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && s.token == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		_ = resp.Body.Close()
+		if err := s.authenticate(ctx, challenge); err != nil {
+			return nil, nil, err
+		}
+		if resp, err = s.get(ctx, endpoint, accept); err != nil {
+			return nil, nil, err
+		}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil, &registryNotFoundError{endpoint: endpoint}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("GET %s: unexpected status %s", endpoint, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return nil, nil, err
+	}
+	return body, resp.Header, nil
+}
+
+// registryNotFoundError is returned for registry paths that do not exist
+type registryNotFoundError struct {
+	endpoint string
+}
+
+func (e *registryNotFoundError) Error() string {
+	return fmt.Sprintf("GET %s: not found", e.endpoint)
+}
+
+func (s *registrySession) get(ctx context.Context, endpoint, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	switch {
+	case s.token != "":
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	case s.credentials != "":
+		req.Header.Set("Authorization", "Basic "+s.credentials)
+	}
+	return s.client.Do(req)
+}
+
+// authenticate obtains a bearer token as requested by a WWW-Authenticate challenge
+func (s *registrySession) authenticate(ctx context.Context, challenge string) error {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return fmt.Errorf("unsupported registry authentication challenge %q", challenge)
+	}
+
+	attrs := map[string]string{}
+	for _, param := range strings.Split(params, ",") {
+		if key, value, found := strings.Cut(strings.TrimSpace(param), "="); found {
+			attrs[key] = strings.Trim(value, "\"")
+		}
+	}
+	if attrs["realm"] == "" {
+		return fmt.Errorf("registry authentication challenge has no realm")
+	}
+
+	scope := attrs["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", s.ref.repository)
+	}
+	query := url.Values{"scope": {scope}}
+	if attrs["service"] != "" {
+		query.Set("service", attrs["service"])
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, attrs["realm"]+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	if s.credentials != "" {
+		req.Header.Set("Authorization", "Basic "+s.credentials)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("registry token request failed: %s", resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&token); err != nil {
+		return err
+	}
+	s.token = token.Token
+	if s.token == "" {
+		s.token = token.AccessToken
+	}
+	if s.token == "" {
+		return fmt.Errorf("registry token response has no token")
+	}
+	return nil
+}
@@ -0,0 +1,371 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bluefield
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// fakeMetadataReader returns a fixed release version or error
+type fakeMetadataReader struct {
+	version    string
+	err        error
+	pullSecret []byte
+}
+
+func (f *fakeMetadataReader) ReleaseVersion(_ context.Context, _ string, pullSecret []byte) (string, error) {
+	f.pullSecret = pullSecret
+	return f.version, f.err
+}
+
+// gatedTransport counts the registry requests and holds them until the gate is closed
+type gatedTransport struct {
+	next     http.RoundTripper
+	gate     chan struct{}
+	requests atomic.Int32
+}
+
+func (t *gatedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests.Add(1)
+	<-t.gate
+	return t.next.RoundTrip(req)
+}
+
+// newFakeRegistry serves a release image as a manifest list behind bearer token authentication
+func newFakeRegistry(release string) (*httptest.Server, *int) {
+	requests := 0
+	mux := http.NewServeMux()
+	var server *httptest.Server
+
+	writeJSON := func(w http.ResponseWriter, mediaType string, body any) {
+		w.Header().Set("Content-Type", mediaType)
+		_ = json.NewEncoder(w).Encode(body)
+	}
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope") != "repository:openshift-release-dev/ocp-release:pull" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, "application/json", map[string]string{"token": "secret-token"})
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("Authorization") != "Bearer secret-token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(
+				"Bearer realm=%q,service=\"registry\",scope=\"repository:openshift-release-dev/ocp-release:pull\"", server.URL+"/token"))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch strings.TrimPrefix(r.URL.Path, "/v2/openshift-release-dev/ocp-release/") {
+		case "manifests/sha256:index":
+			writeJSON(w, "application/vnd.oci.image.index.v1+json", map[string]any{
+				"mediaType": "application/vnd.oci.image.index.v1+json",
+				"manifests": []map[string]any{
+					{"digest": "sha256:arm64", "platform": map[string]string{"os": "linux", "architecture": "arm64"}},
+					{"digest": "sha256:amd64", "platform": map[string]string{"os": "linux", "architecture": "amd64"}},
+				},
+			})
+		case "manifests/sha256:amd64":
+			writeJSON(w, "application/vnd.oci.image.manifest.v1+json", map[string]any{
+				"mediaType": "application/vnd.oci.image.manifest.v1+json",
+				"config":    map[string]string{"digest": "sha256:config"},
+			})
+		case "blobs/sha256:config":
+			writeJSON(w, "application/octet-stream", map[string]any{
+				"config": map[string]any{"Labels": map[string]string{ReleaseVersionLabel: release}},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	server = httptest.NewTLSServer(mux)
+	return server, &requests
+}
+
+var _ = Describe("Release Metadata", func() {
+	Describe("Image Reference Parsing", func() {
+		DescribeTable("should split registry, repository and reference",
+			func(image, registry, repository, reference string) {
+				ref, err := parseImageReference(image)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ref).To(Equal(imageReference{registry: registry, repository: repository, reference: reference}))
+			},
+			Entry("tag", "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+				"quay.io", "openshift-release-dev/ocp-release", "4.19.0-multi"),
+			Entry("digest", "quay.io/openshift-release-dev/ocp-release@sha256:abc",
+				"quay.io", "openshift-release-dev/ocp-release", "sha256:abc"),
+			Entry("registry with port", "registry.local:5000/ocp/release:custom",
+				"registry.local:5000", "ocp/release", "custom"),
+			Entry("no tag", "registry.local:5000/ocp/release",
+				"registry.local:5000", "ocp/release", "latest"),
+			Entry("docker hub image", "busybox:1.36", "docker.io", "library/busybox", "1.36"),
+		)
+	})
+
+	Describe("Registry Credentials", func() {
+		It("should return the auth of the matching registry", func() {
+			pullSecret := []byte(`{"auths":{"quay.io":{"auth":"dXNlcjpwYXNz"},"other.io":{"auth":"b3RoZXI="}}}`)
+			Expect(registryCredentials(pullSecret, "quay.io")).To(Equal("dXNlcjpwYXNz"))
+		})
+
+		It("should encode username and password when auth is not set", func() {
+			pullSecret := []byte(`{"auths":{"quay.io":{"username":"user","password":"pass"}}}`)
+			Expect(registryCredentials(pullSecret, "quay.io")).To(Equal("dXNlcjpwYXNz"))
+		})
+
+		It("should return nothing for unknown registries or invalid pull secrets", func() {
+			Expect(registryCredentials([]byte(`{"auths":{"quay.io":{"auth":"dXNlcjpwYXNz"}}}`), "registry.local")).To(BeEmpty())
+			Expect(registryCredentials([]byte("not json"), "quay.io")).To(BeEmpty())
+			Expect(registryCredentials(nil, "quay.io")).To(BeEmpty())
+		})
+	})
+
+	Describe("RegistryMetadataReader", func() {
+		var (
+			server   *httptest.Server
+			requests *int
+			reader   *RegistryMetadataReader
+			image    string
+		)
+
+		BeforeEach(func() {
+			server, requests = newFakeRegistry("4.19.0-multi")
+			reader = NewRegistryMetadataReader()
+			reader.HTTPClient = server.Client()
+			image = strings.TrimPrefix(server.URL, "https://") + "/openshift-release-dev/ocp-release@sha256:index"
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should read the release label of a digest-referenced manifest list", func() {
+			version, err := reader.ReleaseVersion(context.Background(), image, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal("4.19.0-multi"))
+		})
+
+		It("should cache the release version per image", func() {
+			_, err := reader.ReleaseVersion(context.Background(), image, nil)
+			Expect(err).NotTo(HaveOccurred())
+			served := *requests
+
+			version, err := reader.ReleaseVersion(context.Background(), image, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal("4.19.0-multi"))
+			Expect(*requests).To(Equal(served))
+		})
+
+		It("should look the release version up again once the cache entry expires", func() {
+			now := time.Now()
+			reader.now = func() time.Time { return now }
+
+			_, err := reader.ReleaseVersion(context.Background(), image, nil)
+			Expect(err).NotTo(HaveOccurred())
+			served := *requests
+
+			now = now.Add(DefaultRegistryCacheTTL - time.Second)
+			_, err = reader.ReleaseVersion(context.Background(), image, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*requests).To(Equal(served))
+
+			now = now.Add(time.Second)
+			_, err = reader.ReleaseVersion(context.Background(), image, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*requests).To(Equal(2 * served))
+		})
+
+		It("should cache the release version per pull secret", func() {
+			_, err := reader.ReleaseVersion(context.Background(), image, nil)
+			Expect(err).NotTo(HaveOccurred())
+			served := *requests
+
+			_, err = reader.ReleaseVersion(context.Background(), image, []byte(`{"auths":{}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*requests).To(Equal(2 * served))
+		})
+
+		It("should cache failed lookups for the error TTL", func() {
+			now := time.Now()
+			reader.now = func() time.Time { return now }
+			unknown := strings.TrimPrefix(server.URL, "https://") + "/openshift-release-dev/ocp-release:unknown"
+
+			_, err := reader.ReleaseVersion(context.Background(), unknown, nil)
+			Expect(err).To(HaveOccurred())
+			served := *requests
+
+			_, err = reader.ReleaseVersion(context.Background(), unknown, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(*requests).To(Equal(served))
+
+			now = now.Add(DefaultRegistryErrorCacheTTL)
+			_, err = reader.ReleaseVersion(context.Background(), unknown, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(*requests).To(Equal(2 * served))
+		})
+
+		It("should not cache when the TTL is 0", func() {
+			reader.CacheTTL = 0
+
+			_, err := reader.ReleaseVersion(context.Background(), image, nil)
+			Expect(err).NotTo(HaveOccurred())
+			served := *requests
+
+			_, err = reader.ReleaseVersion(context.Background(), image, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*requests).To(Equal(2 * served))
+		})
+
+		Context("with concurrent lookups", func() {
+			var transport *gatedTransport
+
+			BeforeEach(func() {
+				transport = &gatedTransport{next: server.Client().Transport, gate: make(chan struct{})}
+				reader.HTTPClient = &http.Client{Transport: transport}
+			})
+
+			lookup := func(ctx context.Context) chan error {
+				done := make(chan error, 1)
+				go func() {
+					defer GinkgoRecover()
+					version, err := reader.ReleaseVersion(ctx, image, nil)
+					if err == nil {
+						Expect(version).To(Equal("4.19.0-multi"))
+					}
+					done <- err
+				}()
+				return done
+			}
+
+			It("should share one registry lookup between concurrent callers", func() {
+				first := lookup(context.Background())
+				Eventually(transport.requests.Load).Should(BeEquivalentTo(1))
+				second := lookup(context.Background())
+
+				// The second caller waits for the lookup in flight instead of sending its own requests
+				Consistently(second, "100ms").ShouldNot(Receive())
+				Expect(transport.requests.Load()).To(BeEquivalentTo(1))
+
+				close(transport.gate)
+				Eventually(first).Should(Receive(BeNil()))
+				Eventually(second).Should(Receive(BeNil()))
+			})
+
+			It("should stop waiting when the caller's context ends", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				first := lookup(ctx)
+				Eventually(transport.requests.Load).Should(BeEquivalentTo(1))
+				second := lookup(context.Background())
+
+				cancel()
+				Eventually(first).Should(Receive(MatchError(context.Canceled)))
+
+				// The shared lookup is not canceled with the first caller
+				close(transport.gate)
+				Eventually(second).Should(Receive(BeNil()))
+			})
+		})
+
+		It("should fail for unknown images", func() {
+			_, err := reader.ReleaseVersion(context.Background(),
+				strings.TrimPrefix(server.URL, "https://")+"/openshift-release-dev/ocp-release:unknown", nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("OCP Version Resolution", func() {
+		var (
+			resolver *ImageResolver
+			reader   *fakeMetadataReader
+			cr       *provisioningv1alpha1.DPFHCPBridge
+		)
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			pullSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: "default"},
+				Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+			}
+			cr = &provisioningv1alpha1.DPFHCPBridge{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default"},
+				Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+					OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+					PullSecretRef:   corev1.LocalObjectReference{Name: "pull-secret"},
+				},
+			}
+
+			reader = &fakeMetadataReader{}
+			resolver = NewImageResolver(fake.NewClientBuilder().WithScheme(scheme).WithObjects(pullSecret).Build(), nil)
+			resolver.MetadataReader = reader
+		})
+
+		It("should prefer the version from the release image metadata", func() {
+			cr.Spec.OCPReleaseImage = "registry.local/ocp/release:custom-build"
+			reader.version = "4.19.2-multi"
+
+			version, err := resolver.resolveOCPVersion(context.Background(), cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal("4.19.2"))
+			Expect(reader.pullSecret).To(Equal([]byte(`{"auths":{}}`)))
+		})
+
+		It("should fall back to parsing the image tag when the metadata cannot be read", func() {
+			reader.err = fmt.Errorf("registry unreachable")
+
+			version, err := resolver.resolveOCPVersion(context.Background(), cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal("4.19.0"))
+		})
+
+		It("should parse the image tag when no metadata reader is configured", func() {
+			resolver.MetadataReader = nil
+
+			version, err := resolver.resolveOCPVersion(context.Background(), cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal("4.19.0"))
+		})
+
+		It("should fail for digest-referenced images without readable metadata", func() {
+			cr.Spec.OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release@sha256:abc"
+			reader.err = fmt.Errorf("registry unreachable")
+
+			_, err := resolver.resolveOCPVersion(context.Background(), cr)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
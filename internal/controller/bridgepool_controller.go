@@ -0,0 +1,278 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/retry"
+)
+
+const (
+	// BridgePool event reasons
+	ReasonSpareCreated          = "SpareCreated"
+	ReasonSpareDeleted          = "SpareDeleted"
+	ReasonSpareClaimed          = "SpareClaimed"
+	ReasonNoVirtualIPsAvailable = "NoVirtualIPsAvailable"
+)
+
+// BridgePoolReconciler reconciles a BridgePool object
+type BridgePoolReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// ShardSelector, if set, restricts this instance to the BridgePools whose labels match it
+	ShardSelector labels.Selector
+
+	// RetryPolicies decide how reconcile errors are retried per error class; nil uses the defaults
+	RetryPolicies *retry.Policies
+}
+
+// +kubebuilder:rbac:groups=provisioning.dpu.hcp.io,resources=bridgepools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=provisioning.dpu.hcp.io,resources=bridgepools/status,verbs=get;update;patch
+
+// Reconcile keeps spec.replicas unclaimed spares of a BridgePool provisioned.
+//
+// Spares are DPFHCPBridges named <pool>-<n>, controlled by the pool and labelled with LabelBridgePool.
+// A spare is claimed by setting its dpuClusterRef, dpuClusterSelector or dpuClusterRefs; the pool then releases it
+// (drops its owner reference, so deleting the pool leaves claimed bridges alone) and provisions a
+// replacement.
+func (r *BridgePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	var pool provisioningv1alpha1.BridgePool
+	if err := r.Get(ctx, req.NamespacedName, &pool); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// Unclaimed spares are garbage collected through their owner reference
+	if !pool.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// All bridges of the namespace are listed, as spare names and virtual IPs must not collide with them
+	var bridges provisioningv1alpha1.DPFHCPBridgeList
+	if err := r.List(ctx, &bridges, client.InNamespace(pool.Namespace)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list DPFHCPBridges: %w", err)
+	}
+
+	usedNames := make(map[string]bool, len(bridges.Items))
+	usedVIPs := make(map[string]bool, len(bridges.Items))
+	var spares []*provisioningv1alpha1.DPFHCPBridge
+	var claimed int32
+	for i := range bridges.Items {
+		bridge := &bridges.Items[i]
+		usedNames[bridge.Name] = true
+		if bridge.Spec.VirtualIP != "" {
+			usedVIPs[bridge.Spec.VirtualIP] = true
+		}
+
+		if bridge.Labels[provisioningv1alpha1.LabelBridgePool] != pool.Name || !bridge.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		if bridge.IsSpare() {
+			spares = append(spares, bridge)
+			continue
+		}
+
+		claimed++
+		if err := r.releaseClaimedSpare(ctx, &pool, bridge); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	desired := int(pool.Spec.Replicas)
+	for len(spares) < desired {
+		spare, err := r.createSpare(ctx, &pool, usedNames, usedVIPs)
+		if apierrors.IsAlreadyExists(err) {
+			// The cache has not seen a spare created by a previous reconcile yet
+			log.V(1).Info("Spare already exists, retrying once the cache caught up")
+			return ctrl.Result{Requeue: true}, nil
+		}
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if spare == nil {
+			break
+		}
+		spares = append(spares, spare)
+	}
+
+	if len(spares) > desired {
+		// Delete the spares that are the furthest from being usable: not available first, newest first
+		sort.SliceStable(spares, func(i, j int) bool {
+			if iAvailable, jAvailable := spareAvailable(spares[i]), spareAvailable(spares[j]); iAvailable != jAvailable {
+				return !iAvailable
+			}
+			return spares[j].CreationTimestamp.Before(&spares[i].CreationTimestamp)
+		})
+		for _, spare := range spares[:len(spares)-desired] {
+			log.Info("Deleting excess spare", "bridgePool", pool.Name, "spare", spare.Name)
+			if err := r.Delete(ctx, spare); client.IgnoreNotFound(err) != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to delete spare %s: %w", spare.Name, err)
+			}
+			r.Recorder.Eventf(&pool, corev1.EventTypeNormal, ReasonSpareDeleted, "Deleted excess spare %s", spare.Name)
+		}
+		spares = spares[len(spares)-desired:]
+	}
+
+	status := provisioningv1alpha1.BridgePoolStatus{
+		Replicas:           int32(len(spares)),
+		Claimed:            claimed,
+		ObservedGeneration: pool.Generation,
+	}
+	for _, spare := range spares {
+		if spareAvailable(spare) {
+			status.AvailableReplicas++
+		}
+	}
+
+	if status != pool.Status {
+		pool.Status = status
+		if err := r.Status().Update(ctx, &pool); err != nil {
+			log.Error(err, "Failed to update BridgePool status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// createSpare creates the next spare of the pool, reserving its name and virtual IP.
+// Returns nil without error if the spare needs a virtual IP and none is free.
+func (r *BridgePoolReconciler) createSpare(ctx context.Context, pool *provisioningv1alpha1.BridgePool,
+	usedNames, usedVIPs map[string]bool) (*provisioningv1alpha1.DPFHCPBridge, error) {
+	log := logf.FromContext(ctx)
+
+	vip := ""
+	if len(pool.Spec.VirtualIPs) > 0 || pool.Spec.Template.Spec.ControlPlaneAvailabilityPolicy == hyperv1.HighlyAvailable {
+		for _, candidate := range pool.Spec.VirtualIPs {
+			if !usedVIPs[candidate] {
+				vip = candidate
+				break
+			}
+		}
+		if vip == "" {
+			r.Recorder.Event(pool, corev1.EventTypeWarning, ReasonNoVirtualIPsAvailable,
+				"All virtual IPs in spec.virtualIPs are in use, add more to provision further spares")
+			log.Info("No free virtual IP for a new spare", "bridgePool", pool.Name)
+			return nil, nil
+		}
+	}
+
+	name := ""
+	for i := 0; name == "" || usedNames[name]; i++ {
+		name = fmt.Sprintf("%s-%d", pool.Name, i)
+	}
+
+	spare := &provisioningv1alpha1.DPFHCPBridge{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: pool.Namespace,
+			Labels:    map[string]string{},
+		},
+		Spec: *pool.Spec.Template.Spec.DeepCopy(),
+	}
+	for key, value := range pool.Spec.Template.Labels {
+		spare.Labels[key] = value
+	}
+	spare.Labels[provisioningv1alpha1.LabelBridgePool] = pool.Name
+	spare.Spec.BridgePoolRef = &corev1.LocalObjectReference{Name: pool.Name}
+	spare.Spec.VirtualIP = vip
+
+	if err := controllerutil.SetControllerReference(pool, spare, r.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set owner reference on spare: %w", err)
+	}
+
+	if err := r.Create(ctx, spare); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create spare %s: %w", name, err)
+	}
+
+	usedNames[name] = true
+	if vip != "" {
+		usedVIPs[vip] = true
+	}
+	log.Info("Created spare", "bridgePool", pool.Name, "spare", name, "virtualIP", vip)
+	r.Recorder.Eventf(pool, corev1.EventTypeNormal, ReasonSpareCreated, "Created spare %s", name)
+	return spare, nil
+}
+
+// releaseClaimedSpare drops the pool's owner reference from a claimed spare, so that the claimed
+// bridge outlives the pool
+func (r *BridgePoolReconciler) releaseClaimedSpare(ctx context.Context, pool *provisioningv1alpha1.BridgePool, bridge *provisioningv1alpha1.DPFHCPBridge) error {
+	if !metav1.IsControlledBy(bridge, pool) {
+		return nil
+	}
+
+	if err := controllerutil.RemoveOwnerReference(pool, bridge, r.Scheme); err != nil {
+		return fmt.Errorf("failed to remove owner reference from claimed spare %s: %w", bridge.Name, err)
+	}
+	if err := r.Update(ctx, bridge); err != nil {
+		return fmt.Errorf("failed to release claimed spare %s: %w", bridge.Name, err)
+	}
+
+	logf.FromContext(ctx).Info("Spare claimed", "bridgePool", pool.Name, "spare", bridge.Name)
+	r.Recorder.Eventf(pool, corev1.EventTypeNormal, ReasonSpareClaimed, "Spare %s was claimed", bridge.Name)
+	return nil
+}
+
+// spareAvailable returns true if the spare's HostedCluster is available
+func spareAvailable(spare *provisioningv1alpha1.DPFHCPBridge) bool {
+	return meta.IsStatusConditionTrue(spare.Status.Conditions, provisioningv1alpha1.HostedClusterAvailable)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// Spares are watched through their owner reference; the update that claims a spare is still seen
+// as it happens before the pool releases the spare.
+func (r *BridgePoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	retrying := retry.NewReconciler(r, retryPoliciesOrDefault(r.RetryPolicies))
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&provisioningv1alpha1.BridgePool{}, builder.WithPredicates(predicate.NewPredicateFuncs(r.ownsShard))).
+		Owns(&provisioningv1alpha1.DPFHCPBridge{}).
+		Named("bridgepool").
+		WithOptions(controller.Options{RateLimiter: retrying.RateLimiter}).
+		Complete(retrying)
+}
+
+// ownsShard returns true if the object belongs to the shard of this operator instance
+func (r *BridgePoolReconciler) ownsShard(obj client.Object) bool {
+	return r.ShardSelector == nil || r.ShardSelector.Matches(labels.Set(obj.GetLabels()))
+}
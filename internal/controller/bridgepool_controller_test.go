@@ -0,0 +1,204 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("BridgePool Controller", func() {
+	const namespace = "pools"
+
+	var (
+		ctx      context.Context
+		pool     *provisioningv1alpha1.BridgePool
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = record.NewFakeRecorder(20)
+		pool = &provisioningv1alpha1.BridgePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "warm", Namespace: namespace, UID: "pool-uid", Generation: 1},
+			Spec: provisioningv1alpha1.BridgePoolSpec{
+				Replicas:   2,
+				VirtualIPs: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+				Template: provisioningv1alpha1.BridgePoolTemplate{
+					Labels: map[string]string{"shard": "a"},
+					Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+						BaseDomain:                     "clusters.example.com",
+						OCPReleaseImage:                "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+						SSHKeySecretRef:                corev1.LocalObjectReference{Name: "ssh-key"},
+						PullSecretRef:                  corev1.LocalObjectReference{Name: "pull-secret"},
+						ControlPlaneAvailabilityPolicy: hyperv1.HighlyAvailable,
+					},
+				},
+			},
+		}
+	})
+
+	newReconciler := func(objs ...client.Object) (*BridgePoolReconciler, client.Client) {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(append([]client.Object{pool}, objs...)...).
+			WithStatusSubresource(&provisioningv1alpha1.BridgePool{}).
+			Build()
+		return &BridgePoolReconciler{Client: c, Scheme: scheme.Scheme, Recorder: recorder}, c
+	}
+
+	reconcilePool := func(r *BridgePoolReconciler) {
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: pool.Name, Namespace: namespace}})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	getBridge := func(c client.Client, name string) *provisioningv1alpha1.DPFHCPBridge {
+		bridge := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, bridge)).To(Succeed())
+		return bridge
+	}
+
+	getPool := func(c client.Client) *provisioningv1alpha1.BridgePool {
+		updated := &provisioningv1alpha1.BridgePool{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: pool.Name, Namespace: namespace}, updated)).To(Succeed())
+		return updated
+	}
+
+	// newSpare returns a spare of the pool as the controller creates it
+	newSpare := func(name, vip string) *provisioningv1alpha1.DPFHCPBridge {
+		spare := &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{provisioningv1alpha1.LabelBridgePool: pool.Name},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: provisioningv1alpha1.GroupVersion.String(),
+					Kind:       "BridgePool",
+					Name:       pool.Name,
+					UID:        pool.UID,
+					Controller: ptr.To(true),
+				}},
+			},
+			Spec: *pool.Spec.Template.Spec.DeepCopy(),
+		}
+		spare.Spec.BridgePoolRef = &corev1.LocalObjectReference{Name: pool.Name}
+		spare.Spec.VirtualIP = vip
+		return spare
+	}
+
+	It("should provision the desired number of spares from the template", func() {
+		r, c := newReconciler()
+		reconcilePool(r)
+
+		for i, name := range []string{"warm-0", "warm-1"} {
+			spare := getBridge(c, name)
+			Expect(spare.IsSpare()).To(BeTrue())
+			Expect(spare.Spec.BridgePoolRef.Name).To(Equal(pool.Name))
+			Expect(spare.Spec.BaseDomain).To(Equal("clusters.example.com"))
+			Expect(spare.Spec.VirtualIP).To(Equal(pool.Spec.VirtualIPs[i]))
+			Expect(spare.Labels).To(HaveKeyWithValue(provisioningv1alpha1.LabelBridgePool, pool.Name))
+			Expect(spare.Labels).To(HaveKeyWithValue("shard", "a"))
+			Expect(metav1.IsControlledBy(spare, pool)).To(BeTrue())
+		}
+
+		status := getPool(c).Status
+		Expect(status.Replicas).To(Equal(int32(2)))
+		Expect(status.AvailableReplicas).To(BeZero())
+		Expect(status.ObservedGeneration).To(Equal(int64(1)))
+	})
+
+	It("should not reuse names or virtual IPs of other bridges in the namespace", func() {
+		other := &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "warm-0", Namespace: namespace},
+			Spec:       provisioningv1alpha1.DPFHCPBridgeSpec{VirtualIP: "10.0.0.1"},
+		}
+		r, c := newReconciler(other)
+		reconcilePool(r)
+
+		Expect(getBridge(c, "warm-1").Spec.VirtualIP).To(Equal("10.0.0.2"))
+		Expect(getBridge(c, "warm-2").Spec.VirtualIP).To(Equal("10.0.0.3"))
+		Expect(getBridge(c, "warm-0").Spec.BridgePoolRef).To(BeNil())
+	})
+
+	It("should stop provisioning HighlyAvailable spares once the virtual IPs run out", func() {
+		pool.Spec.VirtualIPs = []string{"10.0.0.1"}
+		r, c := newReconciler()
+		reconcilePool(r)
+
+		bridges := &provisioningv1alpha1.DPFHCPBridgeList{}
+		Expect(c.List(ctx, bridges)).To(Succeed())
+		Expect(bridges.Items).To(HaveLen(1))
+		Expect(getPool(c).Status.Replicas).To(Equal(int32(1)))
+		Expect(recorder.Events).To(Receive(ContainSubstring(ReasonSpareCreated)))
+		Expect(recorder.Events).To(Receive(ContainSubstring(ReasonNoVirtualIPsAvailable)))
+	})
+
+	It("should release a claimed spare and provision a replacement", func() {
+		claimed := newSpare("warm-0", "10.0.0.1")
+		claimed.Spec.DPUClusterRef = provisioningv1alpha1.DPUClusterReference{Name: "dpu", Namespace: "dpf"}
+		r, c := newReconciler(claimed, newSpare("warm-1", "10.0.0.2"))
+		reconcilePool(r)
+
+		released := getBridge(c, "warm-0")
+		Expect(released.OwnerReferences).To(BeEmpty())
+		Expect(released.Labels).To(HaveKeyWithValue(provisioningv1alpha1.LabelBridgePool, pool.Name))
+
+		replacement := getBridge(c, "warm-2")
+		Expect(replacement.IsSpare()).To(BeTrue())
+		Expect(replacement.Spec.VirtualIP).To(Equal("10.0.0.3"))
+
+		status := getPool(c).Status
+		Expect(status.Replicas).To(Equal(int32(2)))
+		Expect(status.Claimed).To(Equal(int32(1)))
+	})
+
+	It("should delete excess spares that are not available first", func() {
+		pool.Spec.Replicas = 1
+		available := newSpare("warm-0", "10.0.0.1")
+		meta.SetStatusCondition(&available.Status.Conditions, metav1.Condition{
+			Type:   provisioningv1alpha1.HostedClusterAvailable,
+			Status: metav1.ConditionTrue,
+			Reason: "AsExpected",
+		})
+		r, c := newReconciler(available, newSpare("warm-1", "10.0.0.2"))
+		reconcilePool(r)
+
+		Expect(getBridge(c, "warm-0")).NotTo(BeNil())
+		err := c.Get(ctx, types.NamespacedName{Name: "warm-1", Namespace: namespace}, &provisioningv1alpha1.DPFHCPBridge{})
+		Expect(client.IgnoreNotFound(err)).To(Succeed())
+		Expect(err).To(HaveOccurred())
+
+		status := getPool(c).Status
+		Expect(status.Replicas).To(Equal(int32(1)))
+		Expect(status.AvailableReplicas).To(Equal(int32(1)))
+	})
+})
@@ -0,0 +1,233 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/retry"
+)
+
+const (
+	// BridgeTemplate event reasons
+	ReasonTemplateBridgeCreated    = "BridgeCreated"
+	ReasonTemplateBridgeNotCreated = "BridgeNotCreated"
+)
+
+// BridgeTemplateReconciler reconciles a BridgeTemplate object
+type BridgeTemplateReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// ShardSelector, if set, restricts this instance to the BridgeTemplates whose labels match it
+	ShardSelector labels.Selector
+
+	// RetryPolicies decide how reconcile errors are retried per error class; nil uses the defaults
+	RetryPolicies *retry.Policies
+}
+
+// +kubebuilder:rbac:groups=provisioning.dpu.hcp.io,resources=bridgetemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=provisioning.dpu.hcp.io,resources=bridgetemplates/status,verbs=get;update;patch
+
+// Reconcile creates a DPFHCPBridge for every Ready DPUCluster labelled with LabelBridgeTemplate=<template>
+// that is not referenced by a bridge yet.
+//
+// Bridges are named after their DPUCluster and labelled with LabelBridgeTemplate. They are not owned by
+// the template: deleting the template or unlabelling a DPUCluster leaves existing bridges alone, while
+// a deleted bridge is created again as long as its DPUCluster stays labelled.
+func (r *BridgeTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	var template provisioningv1alpha1.BridgeTemplate
+	if err := r.Get(ctx, req.NamespacedName, &template); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !template.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	var dpuClusters dpuprovisioningv1alpha1.DPUClusterList
+	if err := r.List(ctx, &dpuClusters, client.MatchingLabels{provisioningv1alpha1.LabelBridgeTemplate: template.Name}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list DPUClusters: %w", err)
+	}
+
+	// All bridges are listed, as a DPUCluster may already be used by a hand-written bridge and
+	// bridge names must not collide with them
+	var bridges provisioningv1alpha1.DPFHCPBridgeList
+	if err := r.List(ctx, &bridges); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list DPFHCPBridges: %w", err)
+	}
+
+	usedNames := make(map[types.NamespacedName]bool, len(bridges.Items))
+	usedDPUClusters := make(map[types.NamespacedName]bool, len(bridges.Items))
+	status := provisioningv1alpha1.BridgeTemplateStatus{ObservedGeneration: template.Generation}
+	for i := range bridges.Items {
+		bridge := &bridges.Items[i]
+		usedNames[types.NamespacedName{Name: bridge.Name, Namespace: bridge.Namespace}] = true
+		for _, ref := range bridge.ResolvedDPUClusterRefs() {
+			usedDPUClusters[types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}] = true
+		}
+		if bridge.Labels[provisioningv1alpha1.LabelBridgeTemplate] == template.Name {
+			status.Bridges++
+		}
+	}
+
+	for i := range dpuClusters.Items {
+		dpuCluster := &dpuClusters.Items[i]
+		if usedDPUClusters[types.NamespacedName{Name: dpuCluster.Name, Namespace: dpuCluster.Namespace}] {
+			continue
+		}
+		if dpuCluster.Status.Phase != dpuprovisioningv1alpha1.PhaseReady || !dpuCluster.DeletionTimestamp.IsZero() {
+			status.PendingDPUClusters++
+			continue
+		}
+
+		created, err := r.createBridge(ctx, &template, dpuCluster, usedNames)
+		if apierrors.IsAlreadyExists(err) {
+			// The cache has not seen a bridge created by a previous reconcile yet
+			log.V(1).Info("Bridge already exists, retrying once the cache caught up", "dpuCluster", dpuCluster.Name)
+			return ctrl.Result{Requeue: true}, nil
+		}
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !created {
+			status.PendingDPUClusters++
+			continue
+		}
+		status.Bridges++
+	}
+
+	if status != template.Status {
+		template.Status = status
+		if err := r.Status().Update(ctx, &template); err != nil {
+			log.Error(err, "Failed to update BridgeTemplate status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// createBridge creates the DPFHCPBridge of a DPUCluster from the template.
+// Returns false without error if the bridge cannot be created from the template: its name is taken, or
+// the bridge is rejected by validation, e.g. because the DPUCluster has no default virtual IPs annotated.
+func (r *BridgeTemplateReconciler) createBridge(ctx context.Context, template *provisioningv1alpha1.BridgeTemplate,
+	dpuCluster *dpuprovisioningv1alpha1.DPUCluster, usedNames map[types.NamespacedName]bool) (bool, error) {
+	log := logf.FromContext(ctx)
+
+	namespace := template.Spec.Namespace
+	if namespace == "" {
+		namespace = dpuCluster.Namespace
+	}
+	name := types.NamespacedName{Name: dpuCluster.Name, Namespace: namespace}
+	if usedNames[name] {
+		r.Recorder.Eventf(template, corev1.EventTypeWarning, ReasonTemplateBridgeNotCreated,
+			"Cannot create a bridge for DPUCluster %s/%s: DPFHCPBridge %s already exists for another DPUCluster",
+			dpuCluster.Namespace, dpuCluster.Name, name)
+		return false, nil
+	}
+
+	bridge := &provisioningv1alpha1.DPFHCPBridge{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+			Labels:    map[string]string{},
+		},
+		Spec: *template.Spec.Bridge.DeepCopy(),
+	}
+	for key, value := range template.Spec.Labels {
+		bridge.Labels[key] = value
+	}
+	bridge.Labels[provisioningv1alpha1.LabelBridgeTemplate] = template.Name
+	bridge.Spec.DPUClusterRef = provisioningv1alpha1.DPUClusterReference{Name: dpuCluster.Name, Namespace: dpuCluster.Namespace}
+
+	if err := r.Create(ctx, bridge); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return false, err
+		}
+		if apierrors.IsInvalid(err) || apierrors.IsForbidden(err) {
+			log.Info("Bridge rejected", "bridgeTemplate", template.Name, "dpuCluster", dpuCluster.Name, "error", err.Error())
+			r.Recorder.Eventf(template, corev1.EventTypeWarning, ReasonTemplateBridgeNotCreated,
+				"Cannot create a bridge for DPUCluster %s/%s: %v", dpuCluster.Namespace, dpuCluster.Name, err)
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to create bridge %s: %w", name, err)
+	}
+
+	usedNames[name] = true
+	log.Info("Created bridge", "bridgeTemplate", template.Name, "bridge", name, "dpuCluster", dpuCluster.Name)
+	r.Recorder.Eventf(template, corev1.EventTypeNormal, ReasonTemplateBridgeCreated,
+		"Created bridge %s for DPUCluster %s/%s", name, dpuCluster.Namespace, dpuCluster.Name)
+	return true, nil
+}
+
+// templateLabelToRequests maps a DPUCluster or DPFHCPBridge to the BridgeTemplate named by its
+// LabelBridgeTemplate label
+func templateLabelToRequests(_ context.Context, obj client.Object) []reconcile.Request {
+	name := obj.GetLabels()[provisioningv1alpha1.LabelBridgeTemplate]
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name}}}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// DPUClusters are watched for becoming Ready and bridges for being deleted; both are mapped to their
+// template through LabelBridgeTemplate.
+func (r *BridgeTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	retrying := retry.NewReconciler(r, retryPoliciesOrDefault(r.RetryPolicies))
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&provisioningv1alpha1.BridgeTemplate{}, builder.WithPredicates(predicate.NewPredicateFuncs(r.ownsShard))).
+		Watches(
+			&dpuprovisioningv1alpha1.DPUCluster{},
+			handler.EnqueueRequestsFromMapFunc(templateLabelToRequests),
+		).
+		Watches(
+			&provisioningv1alpha1.DPFHCPBridge{},
+			handler.EnqueueRequestsFromMapFunc(templateLabelToRequests),
+		).
+		Named("bridgetemplate").
+		WithOptions(controller.Options{RateLimiter: retrying.RateLimiter}).
+		Complete(retrying)
+}
+
+// ownsShard returns true if the object belongs to the shard of this operator instance
+func (r *BridgeTemplateReconciler) ownsShard(obj client.Object) bool {
+	return r.ShardSelector == nil || r.ShardSelector.Matches(labels.Set(obj.GetLabels()))
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("BridgeTemplate Controller", func() {
+	const dpuNamespace = "dpf-operator-system"
+
+	var (
+		ctx      context.Context
+		template *provisioningv1alpha1.BridgeTemplate
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = record.NewFakeRecorder(20)
+		template = &provisioningv1alpha1.BridgeTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "site", Generation: 1},
+			Spec: provisioningv1alpha1.BridgeTemplateSpec{
+				Namespace: "bridges",
+				Labels:    map[string]string{"shard": "a"},
+				Bridge: provisioningv1alpha1.DPFHCPBridgeSpec{
+					BaseDomain:      "clusters.example.com",
+					OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+					SSHKeySecretRef: corev1.LocalObjectReference{Name: "ssh-key"},
+					PullSecretRef:   corev1.LocalObjectReference{Name: "pull-secret"},
+				},
+			},
+		}
+	})
+
+	// newDPUCluster returns a DPUCluster labelled with templateName, if set
+	newDPUCluster := func(name, templateName string, ready bool) *dpuprovisioningv1alpha1.DPUCluster {
+		dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: dpuNamespace},
+		}
+		dpuCluster.Status.Phase = dpuprovisioningv1alpha1.PhaseCreating
+		if ready {
+			dpuCluster.Status.Phase = dpuprovisioningv1alpha1.PhaseReady
+		}
+		if templateName != "" {
+			dpuCluster.Labels = map[string]string{provisioningv1alpha1.LabelBridgeTemplate: templateName}
+		}
+		return dpuCluster
+	}
+
+	newReconciler := func(funcs interceptor.Funcs, objs ...client.Object) (*BridgeTemplateReconciler, client.Client) {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(append([]client.Object{template}, objs...)...).
+			WithStatusSubresource(&provisioningv1alpha1.BridgeTemplate{}).
+			WithInterceptorFuncs(funcs).
+			Build()
+		return &BridgeTemplateReconciler{Client: c, Scheme: scheme.Scheme, Recorder: recorder}, c
+	}
+
+	reconcileTemplate := func(r *BridgeTemplateReconciler) {
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: template.Name}})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	listBridges := func(c client.Client) []provisioningv1alpha1.DPFHCPBridge {
+		var bridges provisioningv1alpha1.DPFHCPBridgeList
+		Expect(c.List(ctx, &bridges)).To(Succeed())
+		return bridges.Items
+	}
+
+	getTemplate := func(c client.Client) *provisioningv1alpha1.BridgeTemplate {
+		updated := &provisioningv1alpha1.BridgeTemplate{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: template.Name}, updated)).To(Succeed())
+		return updated
+	}
+
+	It("should create a bridge for every Ready labelled DPUCluster", func() {
+		r, c := newReconciler(interceptor.Funcs{},
+			newDPUCluster("dpu-a", template.Name, true),
+			newDPUCluster("dpu-b", template.Name, true),
+			newDPUCluster("dpu-c", "other", true),
+			newDPUCluster("dpu-d", "", true),
+		)
+		reconcileTemplate(r)
+
+		bridges := listBridges(c)
+		Expect(bridges).To(HaveLen(2))
+		for _, bridge := range bridges {
+			Expect(bridge.Namespace).To(Equal("bridges"))
+			Expect(bridge.Spec.DPUClusterRef).To(Equal(provisioningv1alpha1.DPUClusterReference{Name: bridge.Name, Namespace: dpuNamespace}))
+			Expect(bridge.Spec.BaseDomain).To(Equal("clusters.example.com"))
+			Expect(bridge.Labels).To(HaveKeyWithValue(provisioningv1alpha1.LabelBridgeTemplate, template.Name))
+			Expect(bridge.Labels).To(HaveKeyWithValue("shard", "a"))
+			Expect(bridge.OwnerReferences).To(BeEmpty())
+		}
+		Expect([]string{bridges[0].Name, bridges[1].Name}).To(ConsistOf("dpu-a", "dpu-b"))
+
+		status := getTemplate(c).Status
+		Expect(status.Bridges).To(Equal(int32(2)))
+		Expect(status.PendingDPUClusters).To(BeZero())
+		Expect(status.ObservedGeneration).To(Equal(int64(1)))
+	})
+
+	It("should create bridges in the namespace of their DPUCluster by default", func() {
+		template.Spec.Namespace = ""
+		r, c := newReconciler(interceptor.Funcs{}, newDPUCluster("dpu-a", template.Name, true))
+		reconcileTemplate(r)
+
+		bridges := listBridges(c)
+		Expect(bridges).To(HaveLen(1))
+		Expect(bridges[0].Namespace).To(Equal(dpuNamespace))
+	})
+
+	It("should wait for DPUClusters to become Ready", func() {
+		r, c := newReconciler(interceptor.Funcs{}, newDPUCluster("dpu-a", template.Name, false))
+		reconcileTemplate(r)
+
+		Expect(listBridges(c)).To(BeEmpty())
+		Expect(getTemplate(c).Status.PendingDPUClusters).To(Equal(int32(1)))
+	})
+
+	It("should not create a bridge for a DPUCluster that is already used", func() {
+		existing := &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "hand-written", Namespace: "bridges"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef: provisioningv1alpha1.DPUClusterReference{Name: "dpu-a", Namespace: dpuNamespace},
+			},
+		}
+		r, c := newReconciler(interceptor.Funcs{}, existing, newDPUCluster("dpu-a", template.Name, true))
+		reconcileTemplate(r)
+
+		Expect(listBridges(c)).To(HaveLen(1))
+		status := getTemplate(c).Status
+		Expect(status.Bridges).To(BeZero())
+		Expect(status.PendingDPUClusters).To(BeZero())
+	})
+
+	It("should not take over bridges named after a DPUCluster that use another DPUCluster", func() {
+		existing := &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "dpu-a", Namespace: "bridges"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef: provisioningv1alpha1.DPUClusterReference{Name: "dpu-z", Namespace: dpuNamespace},
+			},
+		}
+		r, c := newReconciler(interceptor.Funcs{}, existing, newDPUCluster("dpu-a", template.Name, true))
+		reconcileTemplate(r)
+
+		Expect(listBridges(c)).To(HaveLen(1))
+		Expect(getTemplate(c).Status.PendingDPUClusters).To(Equal(int32(1)))
+		Expect(recorder.Events).To(Receive(ContainSubstring(ReasonTemplateBridgeNotCreated)))
+	})
+
+	It("should report bridges rejected by validation and go on with the other DPUClusters", func() {
+		r, c := newReconciler(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if obj.GetName() == "dpu-a" {
+					return apierrors.NewInvalid(schema.GroupKind{Group: provisioningv1alpha1.GroupVersion.Group, Kind: "DPFHCPBridge"},
+						obj.GetName(), field.ErrorList{field.Required(field.NewPath("spec", "virtualIP"), "")})
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		},
+			newDPUCluster("dpu-a", template.Name, true),
+			newDPUCluster("dpu-b", template.Name, true),
+		)
+		reconcileTemplate(r)
+
+		bridges := listBridges(c)
+		Expect(bridges).To(HaveLen(1))
+		Expect(bridges[0].Name).To(Equal("dpu-b"))
+		status := getTemplate(c).Status
+		Expect(status.Bridges).To(Equal(int32(1)))
+		Expect(status.PendingDPUClusters).To(Equal(int32(1)))
+		Expect(recorder.Events).To(Receive(ContainSubstring("spec.virtualIP")))
+	})
+
+	It("should create a deleted bridge again", func() {
+		r, c := newReconciler(interceptor.Funcs{}, newDPUCluster("dpu-a", template.Name, true))
+		reconcileTemplate(r)
+		bridges := listBridges(c)
+		Expect(bridges).To(HaveLen(1))
+		Expect(c.Delete(ctx, &bridges[0])).To(Succeed())
+
+		reconcileTemplate(r)
+		Expect(listBridges(c)).To(HaveLen(1))
+	})
+})
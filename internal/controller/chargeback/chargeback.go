@@ -0,0 +1,157 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package chargeback derives the labels a cost pipeline uses to attribute management cluster usage
+// per tenant from the namespace and labels of a DPFHCPBridge.
+package chargeback
+
+import (
+	"fmt"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// LabelSpec is a chargeback label set on the HostedCluster and hosted control plane namespace of every
+// bridge. Its value is taken from exactly one source; if the source has no value, Default is used,
+// and without a Default the label is removed.
+type LabelSpec struct {
+	// Key is the label key, e.g. "cost.example.com/tenant"
+	Key string `json:"key"`
+
+	// FromBridgeNamespace uses the name of the bridge namespace as value
+	FromBridgeNamespace bool `json:"fromBridgeNamespace,omitempty"`
+
+	// FromBridgeLabel uses the value of this label of the DPFHCPBridge
+	FromBridgeLabel string `json:"fromBridgeLabel,omitempty"`
+
+	// FromNamespaceLabel uses the value of this label of the bridge namespace
+	FromNamespaceLabel string `json:"fromNamespaceLabel,omitempty"`
+
+	// Default is the value used when the source has none
+	Default string `json:"default,omitempty"`
+}
+
+// Config is the list of chargeback labels loaded from the operator configuration
+type Config struct {
+	Labels []LabelSpec `json:"labels"`
+}
+
+// LoadFile reads and validates the chargeback configuration from a YAML file
+func LoadFile(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chargeback labels: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses and validates the chargeback configuration
+func Parse(data []byte) (*Config, error) {
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse chargeback labels: %w", err)
+	}
+
+	seen := map[string]bool{}
+	for i, label := range config.Labels {
+		if errs := validation.IsQualifiedName(label.Key); len(errs) > 0 {
+			return nil, fmt.Errorf("chargeback label %d has an invalid key %q: %v", i, label.Key, errs)
+		}
+		if seen[label.Key] {
+			return nil, fmt.Errorf("duplicate chargeback label %s", label.Key)
+		}
+		seen[label.Key] = true
+
+		if err := label.validate(); err != nil {
+			return nil, fmt.Errorf("invalid chargeback label %s: %w", label.Key, err)
+		}
+	}
+
+	return config, nil
+}
+
+func (l *LabelSpec) validate() error {
+	sources := 0
+	if l.FromBridgeNamespace {
+		sources++
+	}
+	if l.FromBridgeLabel != "" {
+		sources++
+		if errs := validation.IsQualifiedName(l.FromBridgeLabel); len(errs) > 0 {
+			return fmt.Errorf("invalid fromBridgeLabel %q: %v", l.FromBridgeLabel, errs)
+		}
+	}
+	if l.FromNamespaceLabel != "" {
+		sources++
+		if errs := validation.IsQualifiedName(l.FromNamespaceLabel); len(errs) > 0 {
+			return fmt.Errorf("invalid fromNamespaceLabel %q: %v", l.FromNamespaceLabel, errs)
+		}
+	}
+	if sources != 1 {
+		return fmt.Errorf("expected exactly one of fromBridgeNamespace, fromBridgeLabel or fromNamespaceLabel")
+	}
+
+	if errs := validation.IsValidLabelValue(l.Default); len(errs) > 0 {
+		return fmt.Errorf("invalid default %q: %v", l.Default, errs)
+	}
+	return nil
+}
+
+// Keys returns the keys of all chargeback labels; nil-safe
+func (c *Config) Keys() []string {
+	if c == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(c.Labels))
+	for _, label := range c.Labels {
+		keys = append(keys, label.Key)
+	}
+	return keys
+}
+
+// Values returns the chargeback labels of a bridge in the given namespace, leaving out labels without
+// a value. Nil-safe: a nil Config has no labels.
+func (c *Config) Values(cr *provisioningv1alpha1.DPFHCPBridge, namespace *corev1.Namespace) map[string]string {
+	if c == nil {
+		return nil
+	}
+
+	values := map[string]string{}
+	for _, label := range c.Labels {
+		var value string
+		switch {
+		case label.FromBridgeNamespace:
+			value = cr.Namespace
+		case label.FromBridgeLabel != "":
+			value = cr.Labels[label.FromBridgeLabel]
+		case label.FromNamespaceLabel != "" && namespace != nil:
+			value = namespace.Labels[label.FromNamespaceLabel]
+		}
+		if value == "" {
+			value = label.Default
+		}
+		if value != "" {
+			values[label.Key] = value
+		}
+	}
+	return values
+}
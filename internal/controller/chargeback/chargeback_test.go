@@ -0,0 +1,112 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chargeback
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Chargeback Labels", func() {
+	Context("Parse", func() {
+		It("should accept labels with one source each", func() {
+			config, err := Parse([]byte(`
+labels:
+- key: cost.example.com/tenant
+  fromNamespaceLabel: tenant
+  default: unassigned
+- key: cost.example.com/bridge-namespace
+  fromBridgeNamespace: true
+- key: cost-center
+  fromBridgeLabel: example.com/cost-center
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Keys()).To(Equal([]string{"cost.example.com/tenant", "cost.example.com/bridge-namespace", "cost-center"}))
+		})
+
+		DescribeTable("should reject invalid labels",
+			func(data, message string) {
+				_, err := Parse([]byte(data))
+				Expect(err).To(MatchError(ContainSubstring(message)))
+			},
+			Entry("invalid key", "labels:\n- key: not a key\n  fromBridgeNamespace: true\n", "invalid key"),
+			Entry("duplicate key", "labels:\n- key: a\n  fromBridgeNamespace: true\n- key: a\n  fromBridgeLabel: b\n", "duplicate chargeback label a"),
+			Entry("no source", "labels:\n- key: a\n  default: b\n", "expected exactly one of"),
+			Entry("two sources", "labels:\n- key: a\n  fromBridgeNamespace: true\n  fromBridgeLabel: b\n", "expected exactly one of"),
+			Entry("invalid source label", "labels:\n- key: a\n  fromNamespaceLabel: not/a/label\n", "invalid fromNamespaceLabel"),
+			Entry("invalid default", "labels:\n- key: a\n  fromBridgeNamespace: true\n  default: not a value\n", "invalid default"),
+			Entry("unknown field", "labels:\n- key: a\n  fromBridgeName: true\n", "unknown field"),
+		)
+	})
+
+	Context("Values", func() {
+		var (
+			cr        *provisioningv1alpha1.DPFHCPBridge
+			namespace *corev1.Namespace
+			config    *Config
+		)
+
+		BeforeEach(func() {
+			cr = &provisioningv1alpha1.DPFHCPBridge{
+				ObjectMeta: metav1.ObjectMeta{Name: "bridge", Namespace: "tenant-a", Labels: map[string]string{"project": "edge"}},
+			}
+			namespace = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "tenant-a", Labels: map[string]string{"tenant": "acme"}},
+			}
+
+			var err error
+			config, err = Parse([]byte(`
+labels:
+- key: tenant
+  fromNamespaceLabel: tenant
+- key: namespace
+  fromBridgeNamespace: true
+- key: project
+  fromBridgeLabel: project
+  default: none
+`))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should derive values from the bridge and its namespace", func() {
+			Expect(config.Values(cr, namespace)).To(Equal(map[string]string{
+				"tenant":    "acme",
+				"namespace": "tenant-a",
+				"project":   "edge",
+			}))
+		})
+
+		It("should fall back to the default and leave out labels without a value", func() {
+			cr.Labels = nil
+			namespace.Labels = nil
+			Expect(config.Values(cr, namespace)).To(Equal(map[string]string{
+				"namespace": "tenant-a",
+				"project":   "none",
+			}))
+		})
+
+		It("should have no labels when not configured", func() {
+			var config *Config
+			Expect(config.Keys()).To(BeEmpty())
+			Expect(config.Values(cr, namespace)).To(BeEmpty())
+		})
+	})
+})
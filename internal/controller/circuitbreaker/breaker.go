@@ -0,0 +1,215 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/metrics"
+)
+
+const (
+	// DefaultFailureThreshold is the number of consecutive dependency failures that opens the circuit
+	DefaultFailureThreshold = 5
+
+	// DefaultOpenDuration is how long the circuit stays open before a single probe request is allowed
+	DefaultOpenDuration = 30 * time.Second
+)
+
+// State is the state of a circuit breaker
+type State string
+
+const (
+	// StateClosed lets all requests through
+	StateClosed State = "Closed"
+
+	// StateOpen rejects all requests until the open duration has passed
+	StateOpen State = "Open"
+
+	// StateHalfOpen lets a single probe request through to test whether the dependency recovered
+	StateHalfOpen State = "HalfOpen"
+)
+
+// Breaker is a circuit breaker shared by all DPFHCPBridge reconciles that talk to the same dependency.
+//
+// After FailureThreshold consecutive dependency failures (apiserver overloaded, webhook down,
+// timeouts) the circuit opens and every bridge backs off for OpenDuration instead of retrying
+// at full rate. Afterwards one probe request is let through: success closes the circuit,
+// failure opens it again. Errors that prove the dependency answered (NotFound, Conflict, ...)
+// count as successes.
+//
+// A nil Breaker is valid and never opens.
+type Breaker struct {
+	name             string
+	failureThreshold int
+	openDuration     time.Duration
+	now              func() time.Time
+
+	mu                  sync.Mutex
+	state               State
+	consecutiveFailures int
+	openedAt            time.Time
+	probeStartedAt      time.Time
+	lastError           error
+}
+
+// NewBreaker creates a closed circuit breaker for the named dependency
+func NewBreaker(name string, failureThreshold int, openDuration time.Duration) *Breaker {
+	metrics.DependencyCircuitOpen.WithLabelValues(name).Set(0)
+	return &Breaker{
+		name:             name,
+		failureThreshold: failureThreshold,
+		openDuration:     openDuration,
+		now:              time.Now,
+		state:            StateClosed,
+	}
+}
+
+// Allow reports whether a request to the dependency may be made.
+// When it returns false, the caller should requeue after the returned duration.
+func (b *Breaker) Allow(ctx context.Context) (bool, time.Duration) {
+	if b == nil {
+		return true, 0
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	switch b.state {
+	case StateOpen:
+		if wait := b.openDuration - now.Sub(b.openedAt); wait > 0 {
+			return false, wait
+		}
+		logf.FromContext(ctx).Info("Circuit half-open, probing dependency", "dependency", b.name)
+		b.state = StateHalfOpen
+		b.probeStartedAt = now
+		return true, 0
+	case StateHalfOpen:
+		// Only one probe at a time; allow a new one if the previous probe never reported back
+		if wait := b.openDuration - now.Sub(b.probeStartedAt); wait > 0 {
+			return false, wait
+		}
+		b.probeStartedAt = now
+		return true, 0
+	default:
+		return true, 0
+	}
+}
+
+// Record reports the outcome of a request to the dependency
+func (b *Breaker) Record(ctx context.Context, err error) {
+	if b == nil {
+		return
+	}
+
+	log := logf.FromContext(ctx)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !IsDependencyFailure(err) {
+		if b.state != StateClosed {
+			log.Info("Dependency recovered, closing circuit", "dependency", b.name)
+			metrics.DependencyCircuitOpen.WithLabelValues(b.name).Set(0)
+		}
+		b.state = StateClosed
+		b.consecutiveFailures = 0
+		b.lastError = nil
+		return
+	}
+
+	b.consecutiveFailures++
+	b.lastError = err
+
+	if b.state == StateHalfOpen || (b.state == StateClosed && b.consecutiveFailures >= b.failureThreshold) {
+		log.Info("Dependency failing, opening circuit",
+			"dependency", b.name,
+			"consecutiveFailures", b.consecutiveFailures,
+			"openDuration", b.openDuration,
+			"error", err.Error())
+		b.state = StateOpen
+		b.openedAt = b.now()
+		metrics.DependencyCircuitOpen.WithLabelValues(b.name).Set(1)
+	}
+}
+
+// State returns the current state of the circuit
+func (b *Breaker) State() State {
+	if b == nil {
+		return StateClosed
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Name returns the name of the dependency guarded by the breaker
+func (b *Breaker) Name() string {
+	if b == nil {
+		return ""
+	}
+	return b.name
+}
+
+// Err returns an error describing the outage while the circuit is not closed, or nil when it is closed
+func (b *Breaker) Err() error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == StateClosed {
+		return nil
+	}
+	return fmt.Errorf("%s circuit %s after %d consecutive failures: %v", b.name, b.state, b.consecutiveFailures, b.lastError)
+}
+
+// IsDependencyFailure returns true if err indicates the dependency itself is unhealthy
+// (overloaded, unreachable, timing out, or failing in an admission webhook), as opposed to
+// an error about the request such as NotFound or Conflict.
+func IsDependencyFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsInternalError(err) {
+		return true
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
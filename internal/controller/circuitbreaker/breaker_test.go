@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package circuitbreaker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/metrics"
+)
+
+var _ = Describe("Breaker", func() {
+	var (
+		ctx     context.Context
+		breaker *Breaker
+		now     time.Time
+	)
+
+	unavailable := apierrors.NewServiceUnavailable("apiserver overloaded")
+	hcResource := schema.GroupResource{Group: "hypershift.openshift.io", Resource: "hostedclusters"}
+
+	fail := func(times int) {
+		for i := 0; i < times; i++ {
+			breaker.Record(ctx, unavailable)
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Now()
+		breaker = NewBreaker("test", 3, 30*time.Second)
+		breaker.now = func() time.Time { return now }
+	})
+
+	It("should allow requests while closed", func() {
+		fail(2)
+		allowed, _ := breaker.Allow(ctx)
+		Expect(allowed).To(BeTrue())
+		Expect(breaker.State()).To(Equal(StateClosed))
+		Expect(breaker.Err()).NotTo(HaveOccurred())
+	})
+
+	It("should open after consecutive dependency failures and reject requests", func() {
+		fail(3)
+		Expect(breaker.State()).To(Equal(StateOpen))
+		Expect(testutil.ToFloat64(metrics.DependencyCircuitOpen.WithLabelValues("test"))).To(Equal(1.0))
+		Expect(breaker.Err()).To(MatchError(ContainSubstring("after 3 consecutive failures")))
+
+		now = now.Add(10 * time.Second)
+		allowed, retryAfter := breaker.Allow(ctx)
+		Expect(allowed).To(BeFalse())
+		Expect(retryAfter).To(Equal(20 * time.Second))
+	})
+
+	It("should reset the failure count on success", func() {
+		fail(2)
+		breaker.Record(ctx, nil)
+		fail(2)
+		Expect(breaker.State()).To(Equal(StateClosed))
+	})
+
+	It("should not count request errors as dependency failures", func() {
+		for i := 0; i < 5; i++ {
+			breaker.Record(ctx, apierrors.NewAlreadyExists(hcResource, "test"))
+			breaker.Record(ctx, apierrors.NewConflict(hcResource, "test", fmt.Errorf("conflict")))
+		}
+		Expect(breaker.State()).To(Equal(StateClosed))
+	})
+
+	It("should let a single probe through after the open duration", func() {
+		fail(3)
+		now = now.Add(30 * time.Second)
+
+		allowed, _ := breaker.Allow(ctx)
+		Expect(allowed).To(BeTrue())
+		Expect(breaker.State()).To(Equal(StateHalfOpen))
+
+		allowed, _ = breaker.Allow(ctx)
+		Expect(allowed).To(BeFalse(), "only one probe may be in flight")
+	})
+
+	It("should close when the probe succeeds", func() {
+		fail(3)
+		now = now.Add(30 * time.Second)
+		breaker.Allow(ctx)
+
+		breaker.Record(ctx, nil)
+		Expect(breaker.State()).To(Equal(StateClosed))
+		Expect(testutil.ToFloat64(metrics.DependencyCircuitOpen.WithLabelValues("test"))).To(Equal(0.0))
+		allowed, _ := breaker.Allow(ctx)
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("should reopen when the probe fails", func() {
+		fail(3)
+		now = now.Add(30 * time.Second)
+		breaker.Allow(ctx)
+
+		fail(1)
+		Expect(breaker.State()).To(Equal(StateOpen))
+		allowed, retryAfter := breaker.Allow(ctx)
+		Expect(allowed).To(BeFalse())
+		Expect(retryAfter).To(Equal(30 * time.Second))
+	})
+
+	It("should be a no-op when nil", func() {
+		var nilBreaker *Breaker
+		nilBreaker.Record(ctx, unavailable)
+		allowed, _ := nilBreaker.Allow(ctx)
+		Expect(allowed).To(BeTrue())
+		Expect(nilBreaker.State()).To(Equal(StateClosed))
+		Expect(nilBreaker.Err()).NotTo(HaveOccurred())
+	})
+})
+
+var _ = Describe("IsDependencyFailure", func() {
+	It("should classify errors", func() {
+		Expect(IsDependencyFailure(nil)).To(BeFalse())
+		Expect(IsDependencyFailure(apierrors.NewNotFound(schema.GroupResource{}, "x"))).To(BeFalse())
+		Expect(IsDependencyFailure(apierrors.NewServiceUnavailable("down"))).To(BeTrue())
+		Expect(IsDependencyFailure(apierrors.NewTooManyRequests("slow down", 1))).To(BeTrue())
+		Expect(IsDependencyFailure(apierrors.NewInternalError(fmt.Errorf("failed calling webhook")))).To(BeTrue())
+		Expect(IsDependencyFailure(fmt.Errorf("failed to create HostedCluster: %w", context.DeadlineExceeded))).To(BeTrue())
+	})
+})
@@ -0,0 +1,149 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// DefaultReportInterval is how often the StatusReporter publishes the circuit state on the bridges
+const DefaultReportInterval = 10 * time.Second
+
+// StatusReporter publishes the state of the operator-wide circuit breakers as the DependenciesAvailable
+// condition of every DPFHCPBridge. The condition is written from this single place rather than by each
+// bridge reconcile, which returns early while a circuit is open, and only when its status changes.
+type StatusReporter struct {
+	client.Client
+
+	// Breakers are the circuit breakers to report; the condition is False while any of them is not closed
+	Breakers []*Breaker
+
+	// Interval is how often the circuit state is published
+	Interval time.Duration
+
+	// ShardSelector, if set, restricts reporting to the bridges of this operator instance
+	ShardSelector labels.Selector
+}
+
+// NewStatusReporter creates a new StatusReporter for the given breakers
+func NewStatusReporter(c client.Client, breakers ...*Breaker) *StatusReporter {
+	return &StatusReporter{
+		Client:   c,
+		Breakers: breakers,
+		Interval: DefaultReportInterval,
+	}
+}
+
+// Start implements manager.Runnable. It publishes the circuit state until the context is cancelled.
+// Being a leader election runnable, it runs on the elected instance only.
+func (r *StatusReporter) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithValues("feature", "dependency-circuit-breaker")
+	ctx = logf.IntoContext(ctx, log)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.ReportAll(ctx); err != nil {
+			log.Error(err, "Failed to report dependency circuit state")
+		}
+	}, r.Interval)
+	return nil
+}
+
+// Condition returns the DependenciesAvailable condition for the current state of the breakers
+func (r *StatusReporter) Condition() metav1.Condition {
+	var outages []error
+	for _, breaker := range r.Breakers {
+		if err := breaker.Err(); err != nil {
+			outages = append(outages, err)
+		}
+	}
+
+	if len(outages) == 0 {
+		return metav1.Condition{
+			Type:    provisioningv1alpha1.DependenciesAvailable,
+			Status:  metav1.ConditionTrue,
+			Reason:  provisioningv1alpha1.ReasonCircuitClosed,
+			Message: "All dependency circuits are closed",
+		}
+	}
+	return metav1.Condition{
+		Type:    provisioningv1alpha1.DependenciesAvailable,
+		Status:  metav1.ConditionFalse,
+		Reason:  provisioningv1alpha1.ReasonCircuitOpen,
+		Message: fmt.Sprintf("All bridges back off from failing dependencies: %v", errors.Join(outages...)),
+	}
+}
+
+// ReportAll sets the DependenciesAvailable condition on every bridge whose condition does not match
+// the current circuit state yet
+func (r *StatusReporter) ReportAll(ctx context.Context) error {
+	opts := []client.ListOption{}
+	if r.ShardSelector != nil {
+		opts = append(opts, client.MatchingLabelsSelector{Selector: r.ShardSelector})
+	}
+	var bridges provisioningv1alpha1.DPFHCPBridgeList
+	if err := r.List(ctx, &bridges, opts...); err != nil {
+		return fmt.Errorf("failed to list DPFHCPBridges: %w", err)
+	}
+
+	condition := r.Condition()
+	var errs []error
+	for i := range bridges.Items {
+		bridge := &bridges.Items[i]
+		if !bridge.DeletionTimestamp.IsZero() || isReported(bridge, condition) {
+			continue
+		}
+		if err := r.report(ctx, bridge, condition); err != nil {
+			errs = append(errs, fmt.Errorf("%s/%s: %w", bridge.Namespace, bridge.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// report sets the condition on the bridge, retrying on conflicts with the bridge reconciler
+func (r *StatusReporter) report(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, condition metav1.Condition) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if isReported(cr, condition) {
+			return nil
+		}
+		condition.ObservedGeneration = cr.Generation
+		meta.SetStatusCondition(&cr.Status.Conditions, condition)
+		return r.Status().Update(ctx, cr)
+	})
+}
+
+// isReported returns true if the bridge already carries the condition
+func isReported(cr *provisioningv1alpha1.DPFHCPBridge, condition metav1.Condition) bool {
+	existing := meta.FindStatusCondition(cr.Status.Conditions, condition.Type)
+	return existing != nil && existing.Status == condition.Status &&
+		existing.Reason == condition.Reason && existing.Message == condition.Message
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package circuitbreaker
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("StatusReporter", func() {
+	var (
+		ctx      context.Context
+		c        client.Client
+		breaker  *Breaker
+		reporter *StatusReporter
+	)
+
+	newBridge := func(name, shard string) *provisioningv1alpha1.DPFHCPBridge {
+		return &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Generation: 1, Labels: map[string]string{"shard": shard}},
+		}
+	}
+
+	getCondition := func(name string) *metav1.Condition {
+		bridge := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, bridge)).To(Succeed())
+		return meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.DependenciesAvailable)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		c = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(newBridge("bridge-a", "a"), newBridge("bridge-b", "a"), newBridge("other-shard", "b")).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+
+		breaker = NewBreaker("reporter-test", 1, time.Minute)
+		reporter = NewStatusReporter(c, breaker)
+		reporter.ShardSelector = labels.SelectorFromSet(labels.Set{"shard": "a"})
+	})
+
+	It("should report available dependencies while the circuit is closed", func() {
+		Expect(reporter.ReportAll(ctx)).To(Succeed())
+
+		for _, name := range []string{"bridge-a", "bridge-b"} {
+			condition := getCondition(name)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonCircuitClosed))
+		}
+		Expect(getCondition("other-shard")).To(BeNil())
+	})
+
+	It("should report the outage on every bridge while the circuit is open and clear it on recovery", func() {
+		breaker.Record(ctx, apierrors.NewServiceUnavailable("webhook down"))
+		Expect(reporter.ReportAll(ctx)).To(Succeed())
+
+		for _, name := range []string{"bridge-a", "bridge-b"} {
+			condition := getCondition(name)
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonCircuitOpen))
+			Expect(condition.Message).To(ContainSubstring("reporter-test circuit Open"))
+			Expect(condition.Message).To(ContainSubstring("webhook down"))
+		}
+
+		breaker.Record(ctx, nil)
+		Expect(reporter.ReportAll(ctx)).To(Succeed())
+		Expect(getCondition("bridge-a").Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should not rewrite a condition that is already reported", func() {
+		Expect(reporter.ReportAll(ctx)).To(Succeed())
+		bridge := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "bridge-a", Namespace: "default"}, bridge)).To(Succeed())
+		resourceVersion := bridge.ResourceVersion
+
+		Expect(reporter.ReportAll(ctx)).To(Succeed())
+		Expect(c.Get(ctx, client.ObjectKey{Name: "bridge-a", Namespace: "default"}, bridge)).To(Succeed())
+		Expect(bridge.ResourceVersion).To(Equal(resourceVersion))
+	})
+})
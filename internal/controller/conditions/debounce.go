@@ -0,0 +1,120 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultDebounceWindow is the default time a condition status change must persist before it is recorded
+const DefaultDebounceWindow = 30 * time.Second
+
+// pendingTransition is a condition status change that has been observed but not yet recorded
+type pendingTransition struct {
+	status metav1.ConditionStatus
+	since  time.Time
+}
+
+// Debouncer suppresses quickly flapping condition status changes.
+//
+// A change of a condition's status is only written once the new status has been observed
+// continuously for the debounce window. Changes that revert within the window are dropped,
+// so neither status updates nor events are emitted for them. Reason and message updates that
+// keep the current status, as well as the first observation of a condition, are applied immediately.
+//
+// State is kept in memory: after an operator restart a pending change simply restarts its window.
+type Debouncer struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	pending map[string]pendingTransition
+}
+
+// NewDebouncer creates a Debouncer with the given window. A zero window disables debouncing.
+func NewDebouncer(window time.Duration) *Debouncer {
+	return &Debouncer{
+		window:  window,
+		now:     time.Now,
+		pending: make(map[string]pendingTransition),
+	}
+}
+
+// SetStatusCondition sets condition on conditions, debouncing status changes.
+//
+// Returns:
+// - changed: whether conditions was modified (same semantics as meta.SetStatusCondition)
+// - requeueAfter: when > 0, a status change is pending and the caller should re-evaluate after this duration
+func (d *Debouncer) SetStatusCondition(obj client.Object, conditions *[]metav1.Condition, condition metav1.Condition) (bool, time.Duration) {
+	if d == nil || d.window <= 0 {
+		return meta.SetStatusCondition(conditions, condition), 0
+	}
+
+	key := debounceKey(obj, condition.Type)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	existing := meta.FindStatusCondition(*conditions, condition.Type)
+	if existing == nil || existing.Status == condition.Status {
+		delete(d.pending, key)
+		return meta.SetStatusCondition(conditions, condition), 0
+	}
+
+	now := d.now()
+	pending, ok := d.pending[key]
+	if !ok || pending.status != condition.Status {
+		d.pending[key] = pendingTransition{status: condition.Status, since: now}
+		return false, d.window
+	}
+
+	if elapsed := now.Sub(pending.since); elapsed < d.window {
+		return false, d.window - elapsed
+	}
+
+	delete(d.pending, key)
+	return meta.SetStatusCondition(conditions, condition), 0
+}
+
+// Forget drops all pending transitions of an object, e.g. once it is deleted
+func (d *Debouncer) Forget(obj client.Object) {
+	if d == nil {
+		return
+	}
+
+	prefix := debounceKey(obj, "")
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for key := range d.pending {
+		if strings.HasPrefix(key, prefix) {
+			delete(d.pending, key)
+		}
+	}
+}
+
+// debounceKey identifies a condition of a specific object
+func debounceKey(obj client.Object, conditionType string) string {
+	return obj.GetNamespace() + "/" + obj.GetName() + "/" + conditionType
+}
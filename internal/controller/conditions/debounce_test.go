@@ -0,0 +1,113 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Debouncer", func() {
+	var (
+		debouncer *Debouncer
+		cr        *provisioningv1alpha1.DPFHCPBridge
+		now       time.Time
+	)
+
+	condition := func(status metav1.ConditionStatus) metav1.Condition {
+		return metav1.Condition{
+			Type:   provisioningv1alpha1.HostedClusterAvailable,
+			Status: status,
+			Reason: "Test",
+		}
+	}
+
+	currentStatus := func() metav1.ConditionStatus {
+		return meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.HostedClusterAvailable).Status
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+		debouncer = NewDebouncer(30 * time.Second)
+		debouncer.now = func() time.Time { return now }
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "test-ns"},
+		}
+		meta.SetStatusCondition(&cr.Status.Conditions, condition(metav1.ConditionTrue))
+	})
+
+	It("should apply the first observation of a condition immediately", func() {
+		cr.Status.Conditions = nil
+
+		changed, wait := debouncer.SetStatusCondition(cr, &cr.Status.Conditions, condition(metav1.ConditionFalse))
+		Expect(changed).To(BeTrue())
+		Expect(wait).To(BeZero())
+	})
+
+	It("should apply a status change only after it persisted for the window", func() {
+		changed, wait := debouncer.SetStatusCondition(cr, &cr.Status.Conditions, condition(metav1.ConditionFalse))
+		Expect(changed).To(BeFalse())
+		Expect(wait).To(Equal(30 * time.Second))
+		Expect(currentStatus()).To(Equal(metav1.ConditionTrue))
+
+		now = now.Add(10 * time.Second)
+		changed, wait = debouncer.SetStatusCondition(cr, &cr.Status.Conditions, condition(metav1.ConditionFalse))
+		Expect(changed).To(BeFalse())
+		Expect(wait).To(Equal(20 * time.Second))
+
+		now = now.Add(20 * time.Second)
+		changed, wait = debouncer.SetStatusCondition(cr, &cr.Status.Conditions, condition(metav1.ConditionFalse))
+		Expect(changed).To(BeTrue())
+		Expect(wait).To(BeZero())
+		Expect(currentStatus()).To(Equal(metav1.ConditionFalse))
+	})
+
+	It("should drop a status change that reverts within the window", func() {
+		debouncer.SetStatusCondition(cr, &cr.Status.Conditions, condition(metav1.ConditionFalse))
+
+		now = now.Add(10 * time.Second)
+		changed, wait := debouncer.SetStatusCondition(cr, &cr.Status.Conditions, condition(metav1.ConditionTrue))
+		Expect(changed).To(BeFalse())
+		Expect(wait).To(BeZero())
+
+		// A new flip starts a fresh window
+		now = now.Add(25 * time.Second)
+		_, wait = debouncer.SetStatusCondition(cr, &cr.Status.Conditions, condition(metav1.ConditionFalse))
+		Expect(wait).To(Equal(30 * time.Second))
+		Expect(currentStatus()).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should forget pending changes of an object", func() {
+		debouncer.SetStatusCondition(cr, &cr.Status.Conditions, condition(metav1.ConditionFalse))
+		debouncer.Forget(cr)
+		Expect(debouncer.pending).To(BeEmpty())
+	})
+
+	It("should not debounce when disabled", func() {
+		var disabled *Debouncer
+		changed, wait := disabled.SetStatusCondition(cr, &cr.Status.Conditions, condition(metav1.ConditionFalse))
+		Expect(changed).To(BeTrue())
+		Expect(wait).To(BeZero())
+	})
+})
@@ -0,0 +1,130 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/bluefield"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/dpucluster"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/releasecatalog"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/secrets"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/pkg/api"
+)
+
+// failureReasonCatalog maps condition Reason values that indicate a failure to their FailureReason.
+// Reason strings shared by several conditions are listed once.
+var failureReasonCatalog = map[string]provisioningv1alpha1.FailureReason{
+	// Secrets validation
+	secrets.ReasonSSHKeySecretMissing: provisioningv1alpha1.FailureReasonSecretMissing,
+	secrets.ReasonPullSecretMissing:   provisioningv1alpha1.FailureReasonSecretMissing,
+	secrets.ReasonSSHKeySecretInvalid: provisioningv1alpha1.FailureReasonSecretInvalid,
+	secrets.ReasonPullSecretInvalid:   provisioningv1alpha1.FailureReasonSecretInvalid,
+	secrets.ReasonSecretsAccessDenied: provisioningv1alpha1.FailureReasonAccessDenied,
+
+	// BlueField image resolution
+	// ConfigMapNotFound is also used by AdditionalManifestsApplied
+	bluefield.ReasonConfigMapNotFound:        provisioningv1alpha1.FailureReasonDependencyMissing,
+	bluefield.ReasonConfigMapTransientError:  provisioningv1alpha1.FailureReasonTransientError,
+	bluefield.ReasonConfigMapAccessDenied:    provisioningv1alpha1.FailureReasonAccessDenied,
+	bluefield.ReasonInvalidImageFormat:       provisioningv1alpha1.FailureReasonInvalidConfiguration,
+	bluefield.ReasonVersionNotFound:          provisioningv1alpha1.FailureReasonImageUnresolvable,
+	bluefield.ReasonInvalidBlueFieldImageURL: provisioningv1alpha1.FailureReasonImageUnresolvable,
+
+	// DPUCluster validation
+	dpucluster.ReasonDPUClusterNotFound:     provisioningv1alpha1.FailureReasonDependencyMissing,
+	dpucluster.ReasonDPUClusterDeleted:      provisioningv1alpha1.FailureReasonDependencyMissing,
+	dpucluster.ReasonDPUClusterAccessDenied: provisioningv1alpha1.FailureReasonAccessDenied,
+	dpucluster.ReasonClusterTypeUnsupported: provisioningv1alpha1.FailureReasonInvalidConfiguration,
+	dpucluster.ReasonDPUClusterInUse:        provisioningv1alpha1.FailureReasonConflict,
+
+	dpucluster.ReasonDPUClusterSelectorInvalid:   provisioningv1alpha1.FailureReasonInvalidConfiguration,
+	dpucluster.ReasonDPUClusterSelectorNoMatch:   provisioningv1alpha1.FailureReasonDependencyMissing,
+	dpucluster.ReasonDPUClusterSelectorAmbiguous: provisioningv1alpha1.FailureReasonInvalidConfiguration,
+	dpucluster.ReasonDPUClusterNotReady:          provisioningv1alpha1.FailureReasonDependencyNotReady,
+	dpucluster.ReasonReadinessTimeoutExpired:     provisioningv1alpha1.FailureReasonDependencyNotReady,
+
+	// Release catalog
+	releasecatalog.ReasonReleaseCatalogNotFound:  provisioningv1alpha1.FailureReasonDependencyMissing,
+	releasecatalog.ReasonReleaseNotInCatalog:     provisioningv1alpha1.FailureReasonImageUnresolvable,
+	releasecatalog.ReasonReleaseNotSupported:     provisioningv1alpha1.FailureReasonInvalidConfiguration,
+	releasecatalog.ReasonReleaseImageNotApproved: provisioningv1alpha1.FailureReasonInvalidConfiguration,
+
+	// Existing HostedCluster/NodePool conflicts
+	provisioningv1alpha1.ReasonHostedClusterConflict: provisioningv1alpha1.FailureReasonConflict,
+	provisioningv1alpha1.ReasonNodePoolConflict:      provisioningv1alpha1.FailureReasonConflict,
+
+	// Ready
+	provisioningv1alpha1.ReasonHostedClusterNotReady: provisioningv1alpha1.FailureReasonDependencyNotReady,
+	provisioningv1alpha1.ReasonKubeConfigNotInjected: provisioningv1alpha1.FailureReasonDependencyNotReady,
+
+	// Secret sync
+	provisioningv1alpha1.ReasonSecretsSyncFailed: provisioningv1alpha1.FailureReasonTransientError,
+
+	// Kubeconfig injection
+	// KubeconfigPending is also used by AdditionalManifestsApplied
+	provisioningv1alpha1.ReasonKubeConfigPending:         provisioningv1alpha1.FailureReasonDependencyNotReady,
+	provisioningv1alpha1.ReasonKubeConfigInjectionFailed: provisioningv1alpha1.FailureReasonTransientError,
+
+	// Lifecycle hooks
+	provisioningv1alpha1.ReasonHookFailed: provisioningv1alpha1.FailureReasonHookFailed,
+
+	// Additional manifests
+	provisioningv1alpha1.ReasonManifestsInvalid:     provisioningv1alpha1.FailureReasonInvalidConfiguration,
+	provisioningv1alpha1.ReasonManifestsApplyFailed: provisioningv1alpha1.FailureReasonTransientError,
+
+	// Upgrade revalidation
+	provisioningv1alpha1.ReasonPreflightsFailed: provisioningv1alpha1.FailureReasonInvalidConfiguration,
+
+	// Dependency circuit breaker
+	provisioningv1alpha1.ReasonCircuitOpen: provisioningv1alpha1.FailureReasonDependencyNotReady,
+}
+
+// mirroredConditions are the conditions copied from the HostedCluster, whose Reasons are owned by HyperShift
+var mirroredConditions = map[string]bool{
+	provisioningv1alpha1.HostedClusterAvailable:         true,
+	provisioningv1alpha1.HostedClusterDegraded:          true,
+	provisioningv1alpha1.ValidReleaseImage:              true,
+	provisioningv1alpha1.ValidReleaseInfo:               true,
+	provisioningv1alpha1.IgnitionEndpointAvailable:      true,
+	provisioningv1alpha1.IgnitionServerValidReleaseInfo: true,
+}
+
+// IsFailing returns true if the condition reports a failure; see api.IsConditionFailing
+func IsFailing(condition metav1.Condition) bool {
+	return api.IsConditionFailing(condition)
+}
+
+// FailureReasonFor returns the FailureReason of a failing condition, or "" if the condition is not failing.
+// Failing mirrored HostedCluster conditions map to DependencyNotReady; any other Reason missing
+// from the catalog maps to Unknown.
+func FailureReasonFor(condition metav1.Condition) provisioningv1alpha1.FailureReason {
+	if !IsFailing(condition) {
+		return ""
+	}
+
+	if reason, ok := failureReasonCatalog[condition.Reason]; ok {
+		return reason
+	}
+
+	if mirroredConditions[condition.Type] {
+		return provisioningv1alpha1.FailureReasonDependencyNotReady
+	}
+
+	return provisioningv1alpha1.FailureReasonUnknown
+}
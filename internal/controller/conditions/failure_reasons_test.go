@@ -0,0 +1,63 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/dpucluster"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/releasecatalog"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/secrets"
+)
+
+var _ = Describe("Failure reasons catalog", func() {
+	DescribeTable("FailureReasonFor",
+		func(condType string, status metav1.ConditionStatus, reason string, expected provisioningv1alpha1.FailureReason) {
+			condition := metav1.Condition{Type: condType, Status: status, Reason: reason}
+			Expect(FailureReasonFor(condition)).To(Equal(expected))
+		},
+		Entry("healthy condition", provisioningv1alpha1.SecretsValid, metav1.ConditionTrue, secrets.ReasonSecretsValid,
+			provisioningv1alpha1.FailureReason("")),
+		Entry("missing pull secret", provisioningv1alpha1.SecretsValid, metav1.ConditionFalse, secrets.ReasonPullSecretMissing,
+			provisioningv1alpha1.FailureReasonSecretMissing),
+		Entry("DPUCluster in use (True is failing)", provisioningv1alpha1.DPUClusterInUse, metav1.ConditionTrue, dpucluster.ReasonDPUClusterInUse,
+			provisioningv1alpha1.FailureReasonConflict),
+		Entry("DPUCluster not in use", provisioningv1alpha1.DPUClusterInUse, metav1.ConditionFalse, dpucluster.ReasonDPUClusterAvailable,
+			provisioningv1alpha1.FailureReason("")),
+		Entry("existing HostedCluster conflict (True is failing)", provisioningv1alpha1.ResourceConflict, metav1.ConditionTrue,
+			provisioningv1alpha1.ReasonHostedClusterConflict, provisioningv1alpha1.FailureReasonConflict),
+		Entry("release image not approved by a strict catalog", provisioningv1alpha1.ReleaseResolved, metav1.ConditionFalse,
+			releasecatalog.ReasonReleaseImageNotApproved, provisioningv1alpha1.FailureReasonInvalidConfiguration),
+		Entry("mirrored HostedCluster condition", provisioningv1alpha1.HostedClusterAvailable, metav1.ConditionFalse, "WaitingForAvailable",
+			provisioningv1alpha1.FailureReasonDependencyNotReady),
+		Entry("progressing is informational", provisioningv1alpha1.HostedClusterProgressing, metav1.ConditionFalse, "AsExpected",
+			provisioningv1alpha1.FailureReason("")),
+		Entry("hooks still running", provisioningv1alpha1.PostProvisionHooksCompleted, metav1.ConditionFalse, provisioningv1alpha1.ReasonHooksRunning,
+			provisioningv1alpha1.FailureReason("")),
+		Entry("BridgePool spare awaiting claim", provisioningv1alpha1.Ready, metav1.ConditionFalse, provisioningv1alpha1.ReasonAwaitingClaim,
+			provisioningv1alpha1.FailureReason("")),
+		Entry("secret sync failed", provisioningv1alpha1.SecretsSynced, metav1.ConditionFalse,
+			provisioningv1alpha1.ReasonSecretsSyncFailed, provisioningv1alpha1.FailureReasonTransientError),
+		Entry("dependency circuit open", provisioningv1alpha1.DependenciesAvailable, metav1.ConditionFalse,
+			provisioningv1alpha1.ReasonCircuitOpen, provisioningv1alpha1.FailureReasonDependencyNotReady),
+		Entry("uncatalogued reason", provisioningv1alpha1.KubeConfigInjected, metav1.ConditionFalse, "SomethingNew",
+			provisioningv1alpha1.FailureReasonUnknown),
+	)
+})
@@ -0,0 +1,370 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package crdcheck compares the installed DPFHCPBridge CRD with the API types of the running operator, so that a
+// partial upgrade does not silently drop the fields one of them does not know.
+package crdcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	provisioningv1beta1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1beta1"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get
+
+const (
+	// DefaultCheckInterval is how often the Checker compares the installed CRD again
+	DefaultCheckInterval = time.Minute
+
+	// MismatchRefuse stops reconciling bridges and fails the readiness check while the CRD does not match
+	MismatchRefuse = "refuse"
+	// MismatchWarn keeps reconciling bridges and only reports the mismatch
+	MismatchWarn = "warn"
+
+	// maxReportedProblems is the number of problems listed in the condition message
+	maxReportedProblems = 10
+)
+
+// DPFHCPBridgeVersions are the DPFHCPBridge API versions of the operator, with the Go type of their objects
+var DPFHCPBridgeVersions = map[string]reflect.Type{
+	provisioningv1alpha1.GroupVersion.Version: reflect.TypeFor[provisioningv1alpha1.DPFHCPBridge](),
+	provisioningv1beta1.GroupVersion.Version:  reflect.TypeFor[provisioningv1beta1.DPFHCPBridge](),
+}
+
+// Checker compares the installed DPFHCPBridge CRD with the API types of the operator on startup and every
+// Interval, and publishes the outcome as the CRDSchemaCompatible condition of every DPFHCPBridge.
+type Checker struct {
+	client.Client
+
+	// CRDName is the name of the checked CRD
+	CRDName string
+
+	// Versions are the API versions the CRD must serve, with the Go type of their objects
+	Versions map[string]reflect.Type
+
+	// Mismatch is MismatchRefuse or MismatchWarn and decides how the operator handles a CRD that does not match
+	Mismatch string
+
+	// Interval is how often the installed CRD is compared again
+	Interval time.Duration
+
+	// ShardSelector, if set, restricts reporting to the bridges of this operator instance
+	ShardSelector labels.Selector
+
+	// reader reads the CRD, which the cache of the manager does not hold
+	reader client.Reader
+
+	mu       sync.RWMutex
+	problems []string
+}
+
+// NewChecker creates a new Checker of the DPFHCPBridge CRD that refuses to reconcile while it does not match
+func NewChecker(c client.Client, reader client.Reader) *Checker {
+	return &Checker{
+		Client:   c,
+		CRDName:  "dpfhcpbridges." + provisioningv1alpha1.GroupVersion.Group,
+		Versions: DPFHCPBridgeVersions,
+		Mismatch: MismatchRefuse,
+		Interval: DefaultCheckInterval,
+		reader:   reader,
+	}
+}
+
+// Start implements manager.Runnable. It compares the CRD and publishes the outcome until the context is cancelled.
+func (c *Checker) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithValues("feature", "crd-schema-check")
+	ctx = logf.IntoContext(ctx, log)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.Check(ctx); err != nil {
+			log.Error(err, "Failed to check the installed CRD")
+			return
+		}
+		if err := c.ReportAll(ctx); err != nil {
+			log.Error(err, "Failed to report CRD schema compatibility")
+		}
+	}, c.Interval)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Every instance checks the CRD, so that its
+// readiness follows the CRD; the condition is only written when it changes.
+func (c *Checker) NeedLeaderElection() bool {
+	return false
+}
+
+// Check compares the installed CRD with the API types and records the problems found.
+// A change of the outcome is logged; an error is only returned if the CRD cannot be read.
+func (c *Checker) Check(ctx context.Context) error {
+	log := logf.FromContext(ctx)
+
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := c.reader.Get(ctx, client.ObjectKey{Name: c.CRDName}, crd); err != nil {
+		return fmt.Errorf("failed to get CRD %s: %w", c.CRDName, err)
+	}
+	problems := Compare(crd, c.Versions)
+
+	c.mu.Lock()
+	changed := c.problems == nil || !slices.Equal(problems, c.problems)
+	c.problems = problems
+	c.mu.Unlock()
+
+	if !changed {
+		return nil
+	}
+	if len(problems) == 0 {
+		log.Info("Installed CRD matches the API types of the operator", "crd", c.CRDName)
+	} else {
+		log.Info("Installed CRD does not match the API types of the operator, upgrade the CRD and the operator together",
+			"crd", c.CRDName, "problems", problems, "mismatch", c.Mismatch)
+	}
+	return nil
+}
+
+// Compatible returns true unless the last check found problems. It is true before the first check.
+func (c *Checker) Compatible() bool {
+	if c == nil {
+		return true
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.problems) == 0
+}
+
+// Refusing returns true if bridges must not be reconciled, as the CRD does not match in MismatchRefuse mode
+func (c *Checker) Refusing() bool {
+	return c != nil && c.Mismatch == MismatchRefuse && !c.Compatible()
+}
+
+// ReadyzCheck is a healthz.Checker failing while the checker refuses to reconcile, so that a rollout of the
+// operator against a CRD it does not match stalls visibly
+func (c *Checker) ReadyzCheck(_ *http.Request) error {
+	if !c.Refusing() {
+		return nil
+	}
+	return fmt.Errorf("installed CRD %s does not match this operator version", c.CRDName)
+}
+
+// Condition returns the CRDSchemaCompatible condition for the last check
+func (c *Checker) Condition() metav1.Condition {
+	c.mu.RLock()
+	problems := c.problems
+	c.mu.RUnlock()
+
+	if len(problems) == 0 {
+		return metav1.Condition{
+			Type:    provisioningv1alpha1.CRDSchemaCompatible,
+			Status:  metav1.ConditionTrue,
+			Reason:  provisioningv1alpha1.ReasonCRDSchemaMatches,
+			Message: fmt.Sprintf("CRD %s matches the API types of the operator", c.CRDName),
+		}
+	}
+
+	listed := problems
+	if len(listed) > maxReportedProblems {
+		listed = append(slices.Clip(listed[:maxReportedProblems]), fmt.Sprintf("and %d more", len(problems)-maxReportedProblems))
+	}
+	consequence := "bridges are not reconciled until the CRD and the operator are upgraded together"
+	if c.Mismatch != MismatchRefuse {
+		consequence = "fields only one of them knows may be dropped when bridges are updated"
+	}
+	return metav1.Condition{
+		Type:    provisioningv1alpha1.CRDSchemaCompatible,
+		Status:  metav1.ConditionFalse,
+		Reason:  provisioningv1alpha1.ReasonCRDSchemaMismatch,
+		Message: fmt.Sprintf("CRD %s does not match the API types of the operator, %s: %s", c.CRDName, consequence, strings.Join(listed, "; ")),
+	}
+}
+
+// ReportAll sets the CRDSchemaCompatible condition on every bridge whose condition does not match the last check yet
+func (c *Checker) ReportAll(ctx context.Context) error {
+	opts := []client.ListOption{}
+	if c.ShardSelector != nil {
+		opts = append(opts, client.MatchingLabelsSelector{Selector: c.ShardSelector})
+	}
+	var bridges provisioningv1alpha1.DPFHCPBridgeList
+	if err := c.List(ctx, &bridges, opts...); err != nil {
+		return fmt.Errorf("failed to list DPFHCPBridges: %w", err)
+	}
+
+	condition := c.Condition()
+	var errs []error
+	for i := range bridges.Items {
+		bridge := &bridges.Items[i]
+		if !bridge.DeletionTimestamp.IsZero() || isReported(bridge, condition) {
+			continue
+		}
+		if err := c.report(ctx, bridge, condition); err != nil {
+			errs = append(errs, fmt.Errorf("%s/%s: %w", bridge.Namespace, bridge.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// report sets the condition on the bridge, retrying on conflicts with the bridge reconciler
+func (c *Checker) report(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, condition metav1.Condition) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if isReported(cr, condition) {
+			return nil
+		}
+		condition.ObservedGeneration = cr.Generation
+		meta.SetStatusCondition(&cr.Status.Conditions, condition)
+		return c.Status().Update(ctx, cr)
+	})
+}
+
+// isReported returns true if the bridge already carries the condition
+func isReported(cr *provisioningv1alpha1.DPFHCPBridge, condition metav1.Condition) bool {
+	existing := meta.FindStatusCondition(cr.Status.Conditions, condition.Type)
+	return existing != nil && existing.Status == condition.Status &&
+		existing.Reason == condition.Reason && existing.Message == condition.Message
+}
+
+// Compare returns the problems of the CRD for the given API versions, in a stable order: versions that are not
+// served, fields of the Go types missing from the schema of their version and properties of the schema the Go
+// types have no field for
+func Compare(crd *apiextensionsv1.CustomResourceDefinition, versions map[string]reflect.Type) []string {
+	problems := []string{}
+	for _, name := range slices.Sorted(maps.Keys(versions)) {
+		i := slices.IndexFunc(crd.Spec.Versions, func(v apiextensionsv1.CustomResourceDefinitionVersion) bool {
+			return v.Name == name
+		})
+		if i < 0 || !crd.Spec.Versions[i].Served {
+			problems = append(problems, fmt.Sprintf("API version %s is not served", name))
+			continue
+		}
+		version := crd.Spec.Versions[i]
+		if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			problems = append(problems, fmt.Sprintf("API version %s has no schema", name))
+			continue
+		}
+
+		t := versions[name]
+		for _, problem := range schemaProblems(version.Schema.OpenAPIV3Schema, t, "", path.Dir(t.PkgPath())+"/") {
+			problems = append(problems, name+": "+problem)
+		}
+	}
+	return problems
+}
+
+// schemaProblems compares the schema with the Go type t, found at the given field path. Only the structs of the
+// API packages, below apiPkg, are compared field by field, so that the schemas of referenced Kubernetes and
+// HyperShift types are not held against their Go types.
+func schemaProblems(schema *apiextensionsv1.JSONSchemaProps, t reflect.Type, fieldPath, apiPkg string) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if schema == nil || ptr.Deref(schema.XPreserveUnknownFields, false) {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 || schema.Items == nil {
+			return nil
+		}
+		return schemaProblems(schema.Items.Schema, t.Elem(), fieldPath+"[]", apiPkg)
+	case reflect.Map:
+		if schema.AdditionalProperties == nil {
+			return nil
+		}
+		return schemaProblems(schema.AdditionalProperties.Schema, t.Elem(), fieldPath+"[*]", apiPkg)
+	case reflect.Struct:
+		if !strings.HasPrefix(t.PkgPath(), apiPkg) || schema.Type != "object" {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	fields := jsonFields(t)
+	var problems []string
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		property, ok := schema.Properties[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("field %s is missing from the CRD", joinPath(fieldPath, name)))
+			continue
+		}
+		problems = append(problems, schemaProblems(&property, fields[name], joinPath(fieldPath, name), apiPkg)...)
+	}
+	for _, name := range slices.Sorted(maps.Keys(schema.Properties)) {
+		if _, ok := fields[name]; !ok {
+			problems = append(problems, fmt.Sprintf("field %s is not known to the operator", joinPath(fieldPath, name)))
+		}
+	}
+	return problems
+}
+
+// jsonFields returns the Go types of the fields of struct t by JSON name, including those of inlined structs
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			maps.Copy(fields, jsonFields(embedded))
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// joinPath appends the field name to the path of its parent
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdcheck
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("CRD Schema Check", func() {
+	var crd *apiextensionsv1.CustomResourceDefinition
+
+	// versionSchema returns the schema of the named version of the CRD
+	versionSchema := func(name string) *apiextensionsv1.JSONSchemaProps {
+		for i := range crd.Spec.Versions {
+			if crd.Spec.Versions[i].Name == name {
+				return crd.Spec.Versions[i].Schema.OpenAPIV3Schema
+			}
+		}
+		Fail("version " + name + " not found")
+		return nil
+	}
+
+	BeforeEach(func() {
+		data, err := os.ReadFile(filepath.Join("..", "..", "..", "config", "crd", "bases", "provisioning.dpu.hcp.io_dpfhcpbridges.yaml"))
+		Expect(err).NotTo(HaveOccurred())
+		crd = &apiextensionsv1.CustomResourceDefinition{}
+		Expect(yaml.Unmarshal(data, crd)).To(Succeed())
+	})
+
+	Describe("Compare", func() {
+		It("should accept the generated CRD", func() {
+			Expect(Compare(crd, DPFHCPBridgeVersions)).To(BeEmpty())
+		})
+
+		It("should report versions that are not served", func() {
+			crd.Spec.Versions = crd.Spec.Versions[:1]
+			Expect(Compare(crd, DPFHCPBridgeVersions)).To(ContainElement(ContainSubstring("is not served")))
+		})
+
+		It("should report fields missing from an older CRD", func() {
+			spec := versionSchema("v1beta1").Properties["spec"]
+			delete(spec.Properties, "proxy")
+			versionSchema("v1beta1").Properties["spec"] = spec
+
+			Expect(Compare(crd, DPFHCPBridgeVersions)).To(Equal([]string{
+				"v1beta1: field spec.proxy is missing from the CRD",
+			}))
+		})
+
+		It("should report fields of a newer CRD the operator does not know", func() {
+			status := versionSchema("v1alpha1").Properties["status"]
+			status.Properties["futureField"] = apiextensionsv1.JSONSchemaProps{Type: "string"}
+			versionSchema("v1alpha1").Properties["status"] = status
+
+			Expect(Compare(crd, DPFHCPBridgeVersions)).To(Equal([]string{
+				"v1alpha1: field status.futureField is not known to the operator",
+			}))
+		})
+	})
+
+	Describe("Checker", func() {
+		var (
+			ctx      context.Context
+			c        client.Client
+			checker  *Checker
+			upgraded *apiextensionsv1.CustomResourceDefinition
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			scheme := runtime.NewScheme()
+			Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+			Expect(apiextensionsv1.AddToScheme(scheme)).To(Succeed())
+
+			// The installed CRD predates spec.nodePools
+			upgraded = crd.DeepCopy()
+			spec := versionSchema("v1alpha1").Properties["spec"]
+			delete(spec.Properties, "nodePools")
+			versionSchema("v1alpha1").Properties["spec"] = spec
+
+			bridge := &provisioningv1alpha1.DPFHCPBridge{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", Generation: 1},
+			}
+			c = fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(crd, bridge).
+				WithStatusSubresource(bridge).
+				Build()
+			checker = NewChecker(c, c)
+		})
+
+		getCondition := func() *metav1.Condition {
+			bridge := &provisioningv1alpha1.DPFHCPBridge{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge", Namespace: "default"}, bridge)).To(Succeed())
+			return meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.CRDSchemaCompatible)
+		}
+
+		It("should refuse to reconcile and report a mismatching CRD on the bridges", func() {
+			Expect(checker.Refusing()).To(BeFalse())
+
+			Expect(checker.Check(ctx)).To(Succeed())
+			Expect(checker.Refusing()).To(BeTrue())
+			Expect(checker.ReadyzCheck(nil)).To(HaveOccurred())
+
+			Expect(checker.ReportAll(ctx)).To(Succeed())
+			condition := getCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonCRDSchemaMismatch))
+			Expect(condition.Message).To(ContainSubstring("v1alpha1: field spec.nodePools is missing from the CRD"))
+		})
+
+		It("should only report a mismatching CRD in warn mode", func() {
+			checker.Mismatch = MismatchWarn
+
+			Expect(checker.Check(ctx)).To(Succeed())
+			Expect(checker.Compatible()).To(BeFalse())
+			Expect(checker.Refusing()).To(BeFalse())
+			Expect(checker.ReadyzCheck(nil)).To(Succeed())
+		})
+
+		It("should recover once the CRD is upgraded", func() {
+			Expect(checker.Check(ctx)).To(Succeed())
+			Expect(checker.ReportAll(ctx)).To(Succeed())
+
+			installed := &apiextensionsv1.CustomResourceDefinition{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: checker.CRDName}, installed)).To(Succeed())
+			installed.Spec.Versions = upgraded.Spec.Versions
+			Expect(c.Update(ctx, installed)).To(Succeed())
+
+			Expect(checker.Check(ctx)).To(Succeed())
+			Expect(checker.Refusing()).To(BeFalse())
+			Expect(checker.ReportAll(ctx)).To(Succeed())
+			Expect(getCondition().Status).To(Equal(metav1.ConditionTrue))
+		})
+	})
+})
@@ -18,20 +18,32 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"maps"
 	"os"
+	"strings"
+	"time"
 
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -40,12 +52,29 @@ import (
 
 	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/agentplatform"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/approval"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/bfb"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/bluefield"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/crdcheck"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/dpucluster"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/dpurollout"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/eventforward"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/finalizer"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/hooks"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/hostedcluster"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/kubeconfiginjection"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/manifests"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/metrics"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/releasecatalog"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/releasechannel"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/releasepin"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/render"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/retry"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/revalidation"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/secrets"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/specchecksum"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/throttle"
 )
 
 // DPFHCPBridgeReconciler reconciles a DPFHCPBridge object
@@ -56,12 +85,51 @@ type DPFHCPBridgeReconciler struct {
 	ImageResolver        *bluefield.ImageResolver
 	DPUClusterValidator  *dpucluster.Validator
 	SecretsValidator     *secrets.Validator
+	ConflictDetector     *hostedcluster.ConflictDetector
+	ChannelResolver      *releasechannel.Resolver
+	ReleaseResolver      *releasecatalog.Resolver
+	ReleasePinner        *releasepin.Pinner
 	SecretManager        *hostedcluster.SecretManager
 	HostedClusterManager *hostedcluster.HostedClusterManager
 	NodePoolManager      *hostedcluster.NodePoolManager
 	FinalizerManager     *finalizer.Manager
 	StatusSyncer         *hostedcluster.StatusSyncer
 	KubeconfigInjector   *kubeconfiginjection.KubeconfigInjector
+	PostProvisionManager *hooks.PostProvisionManager
+	ManifestApplier      *manifests.Applier
+
+	// EventForwarder, if set, mirrors bridge events into the hosted cluster of bridges that opt in
+	EventForwarder *eventforward.Forwarder
+
+	// AgentProvisioner, if set, discovers the DPU workers of bridges on the Agent platform through assisted-service
+	AgentProvisioner *agentplatform.Provisioner
+
+	// BFBPublisher, if set, creates the DPF BFB of each bridge from its resolved BlueField image
+	BFBPublisher *bfb.Publisher
+
+	// ApprovalGate, if set, holds the initial provisioning of each bridge back until an external system approved it
+	ApprovalGate *approval.Gate
+
+	// ManifestRenderer, if set, writes the manifests of bridges with the render-manifests annotation to a ConfigMap
+	ManifestRenderer *render.Renderer
+
+	// CRDChecker, if set, holds reconciliation back while the installed CRD does not match the API types
+	CRDChecker *crdcheck.Checker
+
+	// APIBudget, if set, throttles the management cluster API requests of each bridge on a budget of its own.
+	// It only applies if it also wraps the transport of the manager's config.
+	APIBudget *throttle.Budget
+
+	// ReadOnly is set when the operator only reports the status of the bridges and its other writes are dry-runs.
+	// The finalizer is then neither added nor run, as the dry-runs would never let it complete.
+	ReadOnly bool
+
+	// ShardSelector, if set, restricts this instance to the DPFHCPBridges whose labels match it,
+	// so that several operator instances can split a large fleet between them
+	ShardSelector labels.Selector
+
+	// RetryPolicies decide how reconcile errors are retried per error class; nil uses the defaults
+	RetryPolicies *retry.Policies
 }
 
 const (
@@ -72,46 +140,97 @@ const (
 // +kubebuilder:rbac:groups=provisioning.dpu.hcp.io,resources=dpfhcpbridges,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=provisioning.dpu.hcp.io,resources=dpfhcpbridges/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=provisioning.dpu.hcp.io,resources=dpfhcpbridges/finalizers,verbs=update
+// +kubebuilder:rbac:groups=provisioning.dpu.hcp.io,resources=releasecatalogs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=provisioning.dpu.hcp.io,resources=bluefieldimagesets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;list;patch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups=provisioning.dpu.nvidia.com,resources=dpuclusters,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=provisioning.dpu.nvidia.com,resources=bfbs,verbs=get;list;create;delete
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;patch
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=hypershift.openshift.io,resources=hostedclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=hypershift.openshift.io,resources=hostedclusters/status,verbs=get
 // +kubebuilder:rbac:groups=hypershift.openshift.io,resources=nodepools,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=hypershift.openshift.io,resources=nodepools/status,verbs=get
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments,verbs=get
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=agent-install.openshift.io,resources=infraenvs,verbs=get;create;update
+// +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=list;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.21.0/pkg/reconcile
-func (r *DPFHCPBridgeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *DPFHCPBridgeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reconcileErr error) {
 	log := logf.FromContext(ctx)
 	log.Info("Reconciling DPFHCPBridge", "namespace", req.Namespace, "name", req.Name)
 
+	// Charge the API requests of this reconcile to the budget of the bridge
+	ctx = throttle.WithBridge(ctx, req.NamespacedName)
+
 	// Fetch the DPFHCPBridge CR
 	var cr provisioningv1alpha1.DPFHCPBridge
 	if err := r.Get(ctx, req.NamespacedName, &cr); err != nil {
 		// CR not found - likely deleted
+		if apierrors.IsNotFound(err) {
+			r.APIBudget.Forget(req.NamespacedName)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	// Requests for bridges of other shards can still arrive through the secondary watches
+	if !r.ownsShard(&cr) {
+		log.V(1).Info("Skipping DPFHCPBridge owned by another shard", "shardSelector", r.ShardSelector.String())
+		return ctrl.Result{}, nil
+	}
+
+	// Writing a bridge through a CRD that does not match its API types would silently drop fields
+	if r.CRDChecker.Refusing() {
+		log.Info("Skipping DPFHCPBridge - the installed CRD does not match this operator version, see the CRDSchemaCompatible condition")
+		return ctrl.Result{RequeueAfter: r.CRDChecker.Interval}, nil
+	}
+
+	// Export failing conditions with their machine-readable failure reasons on every exit path,
+	// since most features return early when their condition fails
+	defer func() {
+		if cr.DeletionTimestamp.IsZero() {
+			metrics.RecordConditions(&cr)
+		} else {
+			metrics.ForgetBridge(&cr)
+		}
+	}()
+
+	// Surface the error the reconcile stops at in status.lastError, as tenants cannot read the
+	// operator logs; step names the feature that is running
+	step := ""
+	defer func() {
+		r.recordLastError(ctx, &cr, step, reconcileErr)
+	}()
+
 	// Compute phase from conditions at the start
 	// This ensures phase reflects the current state (including Deleting phase)
 	r.updatePhaseFromConditions(&cr)
 
+	// In read-only mode the phase and conditions below describe dry-run writes; say so next to them
+	r.syncReadOnlyCondition(&cr)
+
 	// Handle deletion - run finalizer cleanup
 	if !cr.DeletionTimestamp.IsZero() {
+		step = "Deletion"
+		if r.ReadOnly {
+			log.Info("Read-only mode - reporting the Deleting phase without running the finalizer cleanup")
+			return ctrl.Result{}, r.Status().Update(ctx, &cr)
+		}
 		return r.handleDeletion(ctx, &cr)
 	}
 
 	// Add finalizer if not present (Phase 1: Foundation)
-	if !controllerutil.ContainsFinalizer(&cr, FinalizerName) {
+	if !controllerutil.ContainsFinalizer(&cr, FinalizerName) && !r.ReadOnly {
 		log.Info("Adding finalizer to DPFHCPBridge", "finalizer", FinalizerName)
+		step = "Finalizer"
 		controllerutil.AddFinalizer(&cr, FinalizerName)
 		if err := r.Update(ctx, &cr); err != nil {
 			log.Error(err, "Failed to add finalizer")
@@ -121,35 +240,128 @@ func (r *DPFHCPBridgeReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// Feature: Cleanup Preview
+	// List what deleting the bridge would delete while the preview-cleanup annotation is set. It runs before
+	// the features below, so that bridges stuck in validation or provisioning can be previewed too.
+	step = "CleanupPreview"
+	if err := r.FinalizerManager.SyncCleanupPreview(ctx, &cr); err != nil {
+		log.Error(err, "Failed to publish cleanup preview")
+		return ctrl.Result{}, err
+	}
+
+	// The upgrade revalidation result only describes the spec it was run against; once the spec
+	// is edited the regular preflight checks below take over. The removal is persisted with their status updates.
+	if revalidation.ClearStaleCondition(&cr) {
+		log.V(1).Info("Dropping stale upgrade revalidation result", "generation", cr.Generation)
+	}
+
 	// Feature: DPUCluster Validation
-	log.V(1).Info("Running DPUCluster validation feature")
-	if result, err := r.DPUClusterValidator.ValidateDPUCluster(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
+	// Unclaimed BridgePool spares have no DPUCluster yet; it is validated once the spare is claimed
+	step = "DPUClusterValidation"
+	if cr.IsSpare() {
+		log.V(1).Info("Skipping DPUCluster validation - BridgePool spare not claimed yet", "bridgePool", cr.Spec.BridgePoolRef.Name)
+	} else if result, err := r.DPUClusterValidator.ValidateDPUCluster(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
 		if err != nil {
 			log.Error(err, "DPUCluster validation failed")
 		}
 		return result, err
 	}
 
+	// Feature: DPUCluster Readiness
+	// Report whether the DPUCluster is Ready, and gate the initial provisioning on it according to
+	// spec.dpuClusterReadinessPolicy
+	// A RequeueAfter result is when a WaitWithTimeout wait expires: keep reconciling and requeue at the end
+	log.V(1).Info("Running DPUCluster readiness feature")
+	step = "DPUClusterReadiness"
+	readinessResult, err := r.DPUClusterValidator.CheckReadiness(ctx, &cr)
+	if err != nil {
+		log.Error(err, "DPUCluster readiness check failed")
+		return readinessResult, err
+	}
+
 	// Feature: Secrets Validation
 	log.V(1).Info("Running secrets validation feature")
+	step = "SecretsValidation"
 	if result, err := r.SecretsValidator.ValidateSecrets(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
 		if err != nil {
 			log.Error(err, "Secrets validation failed")
 		}
 		return result, err
 	}
+	// Secrets of backends that are not watched are checked again periodically until they are valid
+	secretsRecheck := r.SecretsValidator.RecheckAfter(&cr)
+
+	// Feature: Resource Conflict Detection
+	// Only relevant until the HostedCluster has been created by (or adopted into) this bridge
+	if cr.Status.HostedClusterRef == nil && r.ConflictDetector != nil {
+		log.V(1).Info("Running resource conflict detection feature")
+		step = "ConflictDetection"
+		if result, err := r.ConflictDetector.CheckResourceConflicts(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
+			if err != nil {
+				log.Error(err, "Resource conflict detection failed")
+			}
+			return result, err
+		}
+	}
+
+	// Feature: Release Channel
+	// Resolve spec.channel to the latest release of the channel, and follow new z-stream releases
+	var channelRecheck time.Duration
+	if r.ChannelResolver != nil {
+		log.V(1).Info("Running release channel feature")
+		step = "ReleaseChannel"
+		if result, err := r.ChannelResolver.ResolveChannel(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
+			if err != nil {
+				log.Error(err, "Release channel resolution failed")
+			}
+			return result, err
+		}
+		// The update graph is not watched: it is checked again periodically for newer releases
+		channelRecheck = r.ChannelResolver.RecheckAfter(&cr)
+	}
+
+	// Feature: Release Catalog
+	// Resolve spec.releaseCatalogRef and check spec.ocpReleaseImage against strict ReleaseCatalogs, as a backstop
+	// for the admission webhook, which does not see channel releases and fails open
+	if r.ReleaseResolver != nil {
+		log.V(1).Info("Running release catalog feature")
+		step = "ReleaseCatalog"
+		if result, err := r.ReleaseResolver.ResolveRelease(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
+			if err != nil {
+				log.Error(err, "Release catalog resolution failed")
+			}
+			return result, err
+		}
+	}
+
+	// Feature: Release Image Pinning
+	// Pin the release image to a digest, verifying its signature if keys are configured, before it is rolled out
+	var pinRecheck time.Duration
+	if r.ReleasePinner != nil {
+		log.V(1).Info("Running release image pinning feature")
+		step = "ReleaseImagePinning"
+		if result, err := r.ReleasePinner.PinRelease(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
+			return result, err
+		}
+		// Release images that could not be pinned are tried again periodically
+		pinRecheck = r.ReleasePinner.RecheckAfter(&cr)
+	}
 
 	// Feature: Resolve BlueField Image
 	// Only validate image during initial creation/retry (Pending/Failed phases)
 	// Once cluster is provisioned (Provisioning/Ready), skip validation to avoid
-	// false failures when old OCP versions are removed from ConfigMap
+	// false failures when old OCP versions are removed from the image source
 	// Feature can be disabled via ENABLE_BLUEFIELD_VALIDATION env var (disabled by default until we implement an alternative way to manage the OCP-to-BlueField list instead of using the ConfigMap)
+	step = "BlueFieldImageResolution"
+	var imageRecheck time.Duration
 	if os.Getenv("ENABLE_BLUEFIELD_VALIDATION") == "true" {
 		if cr.Status.Phase == provisioningv1alpha1.PhasePending || cr.Status.Phase == provisioningv1alpha1.PhaseFailed {
 			log.V(1).Info("Running BlueField image resolution feature")
 			if result, err := r.ImageResolver.ResolveBlueFieldImage(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
 				return result, err
 			}
+			// Image sources that are not watched are looked up again periodically
+			imageRecheck = r.ImageResolver.RecheckAfter(&cr)
 		} else {
 			log.V(1).Info("Skipping BlueField image resolution - cluster already provisioned or being deleted", "phase", cr.Status.Phase)
 		}
@@ -173,26 +385,46 @@ func (r *DPFHCPBridgeReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		}
 	}
 
+	// Feature: External Approval
+	// Hold the initial provisioning back until an external system approved it through the approved annotation.
+	// Without the gate, a bridge left waiting by an earlier operator configuration is let through.
+	step = "ExternalApproval"
+	if r.ApprovalGate != nil {
+		log.V(1).Info("Running external approval feature")
+		if err := r.ApprovalGate.CheckApproval(ctx, &cr); err != nil {
+			log.Error(err, "External approval check failed")
+			return ctrl.Result{}, err
+		}
+	} else if approval.ClearStaleCondition(&cr) {
+		log.V(1).Info("Dropping stale external approval wait - external approval is no longer required")
+	}
+
 	// Recompute phase after validations to ensure HostedCluster creation only proceeds if all validations pass
 	r.updatePhaseFromConditions(&cr)
 
-	// Feature: Copy Secrets to clusters namespace
-	// Only run during Pending phase (all validations must pass first)
-	// Note: We only check for Pending (not Failed) to prevent secret operations when validations fail
-	if cr.Status.Phase == provisioningv1alpha1.PhasePending {
-		log.V(1).Info("Copying secrets to clusters namespace")
-		if result, err := r.SecretManager.CopySecrets(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
-			if err != nil {
-				log.Error(err, "Secret copying failed")
-			}
-			return result, err
+	// Feature: Manifest Rendering
+	// Write the HostedCluster, NodePools and built-in hosted cluster manifests to a ConfigMap for review while the
+	// render-manifests annotation is set. A bridge that was not provisioned yet waits in Pending meanwhile.
+	step = "ManifestRendering"
+	if r.ManifestRenderer != nil {
+		log.V(1).Info("Running manifest rendering feature")
+		if err := r.ManifestRenderer.SyncRenderedManifests(ctx, &cr); err != nil {
+			log.Error(err, "Manifest rendering failed")
+			return ctrl.Result{}, err
 		}
+	}
 
-		// Generate ETCD encryption key
-		log.V(1).Info("Generating ETCD encryption key")
-		if result, err := r.SecretManager.GenerateETCDEncryptionKey(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
+	// Feature: Secret Sync
+	// Copy the pull secret and SSH key and generate the ETCD encryption key concurrently
+	// Only run during Pending phase (all validations must pass first)
+	// Note: We only check for Pending (not Failed) to prevent secret operations when validations fail,
+	// and skip bridges that are Pending only because provisioning is held back (see provisioningHeld)
+	if cr.Status.Phase == provisioningv1alpha1.PhasePending && !provisioningHeld(&cr) {
+		log.V(1).Info("Syncing secrets for the HostedCluster")
+		step = "SecretSync"
+		if result, err := r.SecretManager.SyncSecrets(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
 			if err != nil {
-				log.Error(err, "ETCD key generation failed")
+				log.Error(err, "Secret sync failed")
 			}
 			return result, err
 		}
@@ -204,10 +436,11 @@ func (r *DPFHCPBridgeReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	// Only run during Pending phase (all validations must pass first)
 	// Note: We only check for Pending (not Failed) to prevent creation when validations fail
 	// If user fixes validation issues, phase will transition back to Pending and creation will proceed
-	if cr.Status.Phase == provisioningv1alpha1.PhasePending {
+	if cr.Status.Phase == provisioningv1alpha1.PhasePending && !provisioningHeld(&cr) {
 		log.V(1).Info("Creating HostedCluster and NodePool")
 
 		// Create or update HostedCluster
+		step = "HostedClusterCreation"
 		if result, err := r.HostedClusterManager.CreateOrUpdateHostedCluster(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
 			if err != nil {
 				log.Error(err, "HostedCluster creation failed")
@@ -215,8 +448,11 @@ func (r *DPFHCPBridgeReconciler) Reconcile(ctx context.Context, req ctrl.Request
 			return result, err
 		}
 
-		// Create NodePool
-		if result, err := r.NodePoolManager.CreateNodePool(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
+		// Create NodePool, unless the bridge is an unclaimed BridgePool spare (see BridgePool Claim below)
+		step = "NodePoolCreation"
+		if cr.IsSpare() {
+			log.V(1).Info("Skipping NodePool creation - BridgePool spare not claimed yet")
+		} else if result, err := r.NodePoolManager.CreateNodePool(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
 			if err != nil {
 				log.Error(err, "NodePool creation failed")
 			}
@@ -245,33 +481,236 @@ func (r *DPFHCPBridgeReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		}
 	}
 
+	// Feature: BridgePool Claim
+	// A claimed BridgePool spare already runs its HostedCluster: attach the NodePool once the DPUCluster
+	// it was bound to passed validation. CreateNodePool is a no-op once the NodePool exists.
+	if cr.Spec.BridgePoolRef != nil && !cr.IsSpare() && cr.Status.HostedClusterRef != nil &&
+		cr.Status.Phase != provisioningv1alpha1.PhaseFailed && !waitingForDPUCluster(&cr) {
+		log.V(1).Info("Ensuring NodePool of claimed BridgePool spare")
+		step = "NodePoolCreation"
+		if result, err := r.NodePoolManager.CreateNodePool(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
+			if err != nil {
+				log.Error(err, "NodePool creation for claimed spare failed")
+			}
+			return result, err
+		}
+	}
+
 	// Feature: HostedCluster Status Mirroring
 	// Sync status from HostedCluster to DPFHCPBridge
 	// This runs in all phases (Pending, Provisioning, Ready) to keep status up-to-date
 	// Only syncs if hostedClusterRef is set (after HostedCluster creation)
+	// A RequeueAfter result means a flapping condition change is being debounced: keep reconciling
+	// and requeue at the end so the change is re-evaluated once the debounce window has passed
 	log.V(1).Info("Syncing status from HostedCluster")
-	if result, err := r.StatusSyncer.SyncStatusFromHostedCluster(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
+	step = "StatusSync"
+	syncResult, err := r.StatusSyncer.SyncStatusFromHostedCluster(ctx, &cr)
+	if err != nil {
+		log.Error(err, "Status sync failed")
+		return syncResult, err
+	}
+
+	// Feature: HostedCluster Spec Sync
+	// Propagate bridge spec edits (release image, node selector, size profile) to the HostedCluster, rate limited per bridge
+	// A RequeueAfter result means edits are being coalesced: keep reconciling and requeue at the end
+	specResult := ctrl.Result{}
+	if cr.Status.Phase != provisioningv1alpha1.PhaseFailed {
+		log.V(1).Info("Syncing HostedCluster spec")
+		step = "HostedClusterSpecSync"
+		specResult, err = r.HostedClusterManager.SyncHostedClusterSpec(ctx, &cr)
 		if err != nil {
-			log.Error(err, "Status sync failed")
+			log.Error(err, "HostedCluster spec sync failed")
+			return specResult, err
+		}
+	}
+
+	// Feature: Pull Secret Rotation
+	// Copy a changed pull secret under a new name and switch the HostedCluster to it, so that HyperShift
+	// rolls it out to the nodes; the result requeues until the NodePools finished: keep reconciling and requeue at the end
+	pullSecretResult := ctrl.Result{}
+	if cr.Status.HostedClusterRef != nil && cr.Status.Phase != provisioningv1alpha1.PhaseFailed {
+		log.V(1).Info("Rotating pull secret")
+		step = "PullSecretRotation"
+		if err := r.SecretManager.RotatePullSecret(ctx, &cr); err != nil {
+			log.Error(err, "Pull secret rotation failed")
+			return ctrl.Result{}, err
+		}
+		pullSecretResult, err = r.HostedClusterManager.RolloutPullSecret(ctx, &cr)
+		if err != nil {
+			log.Error(err, "Pull secret rollout failed")
+			return pullSecretResult, err
+		}
+	}
+
+	// Feature: NodePort Failover
+	// Switch the HostedCluster services to the next of spec.nodePortAddresses once the node they are published on is not Ready
+	// This runs in all phases, as it keeps the control plane reachable
+	// The result requeues to check the node periodically: keep reconciling and requeue at the end
+	log.V(1).Info("Checking NodePort address")
+	step = "NodePortFailover"
+	nodePortResult, err := r.HostedClusterManager.SyncNodePortAddress(ctx, &cr)
+	if err != nil {
+		log.Error(err, "NodePort address check failed")
+		return nodePortResult, err
+	}
+
+	// Feature: Chargeback Labels
+	// Stamp the operator-configured chargeback labels on the HostedCluster and hosted control plane namespace
+	// A RequeueAfter result means the HyperShift circuit is open: keep reconciling and requeue at the end
+	chargebackResult := ctrl.Result{}
+	if cr.Status.HostedClusterRef != nil {
+		log.V(1).Info("Syncing chargeback labels")
+		step = "ChargebackLabels"
+		chargebackResult, err = r.HostedClusterManager.SyncChargebackLabels(ctx, &cr)
+		if err != nil {
+			log.Error(err, "Chargeback label sync failed")
+			return chargebackResult, err
+		}
+	}
+
+	// Feature: NodePool Scaling
+	// Propagate spec.nodePoolReplicas (written by the scale subresource) to the NodePool and report its replicas
+	// A RequeueAfter result means the HyperShift circuit is open: keep reconciling and requeue at the end
+	scaleResult := ctrl.Result{}
+	if cr.Status.HostedClusterRef != nil {
+		log.V(1).Info("Syncing NodePool replicas")
+		step = "NodePoolScaling"
+		scaleResult, err = r.NodePoolManager.SyncNodePoolReplicas(ctx, &cr)
+		if err != nil {
+			log.Error(err, "NodePool replica sync failed")
+			return scaleResult, err
+		}
+	}
+
+	// Feature: Additional NodePools
+	// Create, update and delete the NodePools listed in spec.nodePools and report their replicas
+	// Like the default NodePool, they wait until an unclaimed spare is claimed and its DPUCluster validated
+	nodePoolsResult := ctrl.Result{}
+	if cr.Status.HostedClusterRef != nil && !cr.IsSpare() &&
+		cr.Status.Phase != provisioningv1alpha1.PhaseFailed && !waitingForDPUCluster(&cr) {
+		log.V(1).Info("Syncing additional NodePools")
+		step = "AdditionalNodePools"
+		nodePoolsResult, err = r.NodePoolManager.SyncNodePools(ctx, &cr)
+		if err != nil {
+			log.Error(err, "Additional NodePool sync failed")
+			return nodePoolsResult, err
+		}
+	}
+
+	// Feature: Provisioning Timeouts
+	// Report the provisioning stages (HostedCluster Available, first node joined, NodePools Ready) that overran
+	// spec.provisioningTimeouts; runs after the NodePool features so their replicas are up-to-date
+	// The result requeues when the next stage times out: keep reconciling and requeue at the end
+	log.V(1).Info("Checking provisioning timeouts")
+	step = "ProvisioningTimeouts"
+	timeoutsResult, err := r.HostedClusterManager.CheckProvisioningTimeouts(ctx, &cr)
+	if err != nil {
+		log.Error(err, "Provisioning timeout check failed")
+		return timeoutsResult, err
+	}
+
+	// Feature: Ignition Publishing
+	// Report the NodePool user-data and ignition token Secrets for booting DPUs out-of-band,
+	// and copy them into the bridge namespace when spec.publishIgnitionSecret is set
+	if cr.Status.HostedClusterRef != nil {
+		log.V(1).Info("Syncing NodePool ignition")
+		step = "IgnitionPublishing"
+		if result, err := r.NodePoolManager.SyncIgnition(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
+			if err != nil {
+				log.Error(err, "NodePool ignition sync failed")
+			}
+			return result, err
+		}
+	}
+
+	// Feature: BFB Publishing
+	// Create the DPF BFB of the resolved BlueField image in the DPUCluster namespaces
+	// The result requeues until DPF downloaded it: keep reconciling and requeue at the end
+	bfbResult := ctrl.Result{}
+	if r.BFBPublisher != nil {
+		log.V(1).Info("Running BFB publishing feature")
+		step = "BFBPublishing"
+		bfbResult, err = r.BFBPublisher.ReconcileBFB(ctx, &cr)
+		if err != nil {
+			log.Error(err, "BFB publishing failed")
+			return bfbResult, err
+		}
+	}
+
+	// Feature: Agent Platform
+	// Create the InfraEnv DPU workers are discovered through and approve their Agents when spec.platform is Agent
+	// The result requeues periodically to approve DPUs discovered later: keep reconciling and requeue at the end
+	agentResult := ctrl.Result{}
+	if r.AgentProvisioner != nil {
+		log.V(1).Info("Running Agent platform feature")
+		step = "AgentPlatform"
+		agentResult, err = r.AgentProvisioner.ReconcileAgentPlatform(ctx, &cr)
+		if err != nil {
+			log.Error(err, "Agent platform reconciliation failed")
+			return agentResult, err
 		}
-		return result, err
 	}
 
 	// Feature: Kubeconfig Injection
 	// Inject HostedCluster kubeconfig into DPUCluster namespace and update DPUCluster CR
-	// Only runs after HostedCluster creation (hostedClusterRef is set)
-	if cr.Status.HostedClusterRef != nil {
+	// Only runs after HostedCluster creation (hostedClusterRef is set) and once there is a DPUCluster to inject into
+	if cr.Status.HostedClusterRef != nil && !cr.IsSpare() {
 		log.V(1).Info("Running kubeconfig injection feature")
+		step = "KubeconfigInjection"
 		if result, err := r.KubeconfigInjector.InjectKubeconfig(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
 			if err != nil {
 				log.Error(err, "Kubeconfig injection failed")
 			}
 			return result, err
 		}
+	} else if cr.IsSpare() {
+		log.V(1).Info("Skipping kubeconfig injection - BridgePool spare not claimed yet")
 	} else {
 		log.V(1).Info("Skipping kubeconfig injection - HostedCluster not created yet")
 	}
 
+	// Feature: Additional Manifests
+	// Apply day-1 manifests from referenced ConfigMaps into the hosted cluster once it is Available
+	log.V(1).Info("Running additional manifests feature")
+	step = "AdditionalManifests"
+	if result, err := r.ManifestApplier.ApplyAdditionalManifests(ctx, &cr); err != nil || result.Requeue || result.RequeueAfter > 0 {
+		if err != nil {
+			log.Error(err, "Additional manifests application failed")
+		}
+		return result, err
+	}
+
+	// Feature: Post-Provision Hooks
+	// Run user-defined Jobs once the HostedCluster is Available
+	// Hook completion is reported via the PostProvisionHooksCompleted condition and does not gate Ready
+	// Hooks of BridgePool spares run once the spare is claimed, as they usually depend on the DPUs
+	// A RequeueAfter result is when the running hook times out: keep reconciling and requeue at the end
+	step = "PostProvisionHooks"
+	hooksResult := ctrl.Result{}
+	if cr.IsSpare() {
+		log.V(1).Info("Skipping post-provision hooks - BridgePool spare not claimed yet")
+	} else {
+		hooksResult, err = r.PostProvisionManager.RunPostProvisionHooks(ctx, &cr)
+		if err != nil {
+			log.Error(err, "Post-provision hooks failed")
+			return hooksResult, err
+		}
+	}
+
+	// Feature: Event Forwarding
+	// Mirror the bridge events into the hosted cluster when spec.forwardEventsToHostedCluster is set
+	// Forwarding is best effort: failures are logged and retried, together with newer events, at the end
+	forwardResult := ctrl.Result{}
+	if r.EventForwarder != nil {
+		log.V(1).Info("Running event forwarding feature")
+		step = "EventForwarding"
+		forwardResult, err = r.EventForwarder.ForwardEvents(ctx, &cr)
+		if err != nil {
+			log.Error(err, "Event forwarding failed")
+			return forwardResult, err
+		}
+	}
+
 	// Compute Ready condition based on all operational requirements
 	// This must run AFTER all features have updated their conditions
 	// (HostedClusterAvailable, KubeConfigInjected, etc.)
@@ -281,25 +720,91 @@ func (r *DPFHCPBridgeReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	// This must run AFTER computeReadyCondition since it checks the Ready condition
 	r.updatePhaseFromConditions(&cr)
 
-	// Persist status with computed phase
+	// Persist status with computed phase; the reconcile succeeded, so the last error is cleared with it and
+	// the phase and Ready condition are reported for the current generation
+	step = "StatusUpdate"
+	cr.Status.LastError = nil
+	cr.Status.ObservedGeneration = cr.Generation
+	if checksum, err := specchecksum.Compute(&cr.Spec); err != nil {
+		log.Error(err, "Failed to compute spec checksum")
+	} else {
+		cr.Status.SpecChecksum = checksum
+	}
 	if err := r.Status().Update(ctx, &cr); err != nil {
 		log.Error(err, "Failed to update status with computed phase")
 		return ctrl.Result{}, err
 	}
 
 	log.Info("Reconciliation complete", "namespace", cr.Namespace, "name", cr.Name, "phase", cr.Status.Phase)
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: earliestRequeue(readinessResult.RequeueAfter, syncResult.RequeueAfter, specResult.RequeueAfter, chargebackResult.RequeueAfter, scaleResult.RequeueAfter, nodePoolsResult.RequeueAfter, secretsRecheck, channelRecheck, pinRecheck, imageRecheck, hooksResult.RequeueAfter, forwardResult.RequeueAfter, agentResult.RequeueAfter, bfbResult.RequeueAfter, pullSecretResult.RequeueAfter, nodePortResult.RequeueAfter, timeoutsResult.RequeueAfter)}, nil
+}
+
+// earliestRequeue returns the shortest non-zero requeue delay, or zero if none is set
+func earliestRequeue(delays ...time.Duration) time.Duration {
+	var earliest time.Duration
+	for _, d := range delays {
+		if d > 0 && (earliest == 0 || d < earliest) {
+			earliest = d
+		}
+	}
+	return earliest
+}
+
+// maxLastErrorLength caps the message of status.lastError, so that a long error chain does not bloat the status
+const maxLastErrorLength = 1024
+
+// recordLastError sets status.lastError to err and the step it occurred in, or clears it once a reconcile
+// succeeds. Conflicts are not recorded: they are retried right away and the next attempt usually succeeds.
+// The change is persisted with a patch of its own, since failed reconciles return before updating the status.
+func (r *DPFHCPBridgeReconciler) recordLastError(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, step string, err error) {
+	var lastError *provisioningv1alpha1.ReconcileError
+	if err != nil {
+		if retry.Classify(err) == retry.ClassConflict {
+			return
+		}
+		message := err.Error()
+		if len(message) > maxLastErrorLength {
+			message = strings.ToValidUTF8(message[:maxLastErrorLength], "") + "..."
+		}
+		lastError = &provisioningv1alpha1.ReconcileError{Step: step, Message: message, Time: metav1.Now()}
+	} else if cr.Status.LastError == nil {
+		return
+	}
+
+	base := cr.DeepCopy()
+	cr.Status.LastError = lastError
+	if patchErr := r.Status().Patch(ctx, cr, client.MergeFrom(base)); client.IgnoreNotFound(patchErr) != nil {
+		logf.FromContext(ctx).Error(patchErr, "Failed to update last error", "step", step)
+	}
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DPFHCPBridgeReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&provisioningv1alpha1.DPFHCPBridge{}).
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&provisioningv1alpha1.DPFHCPBridge{}, builder.WithPredicates(predicate.NewPredicateFuncs(r.ownsShard))).
+		Watches(
+			&provisioningv1alpha1.DPFHCPBridge{},
+			startupPriorityHandler{},
+			builder.WithPredicates(predicate.NewPredicateFuncs(r.ownsShard)),
+		).
+		Owns(&batchv1.Job{}).
 		Watches(
 			&corev1.ConfigMap{},
-			handler.EnqueueRequestsFromMapFunc(r.configMapToRequests),
+			handler.EnqueueRequestsFromMapFunc(r.imageSourceToRequests),
 			builder.WithPredicates(configMapPredicate()),
 		).
+		Watches(
+			&provisioningv1alpha1.BlueFieldImageSet{},
+			handler.EnqueueRequestsFromMapFunc(r.imageSourceToRequests),
+		).
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.manifestsConfigMapToRequests),
+		).
+		Watches(
+			&provisioningv1alpha1.ReleaseCatalog{},
+			handler.EnqueueRequestsFromMapFunc(r.releaseCatalogToRequests),
+		).
 		Watches(
 			&dpuprovisioningv1alpha1.DPUCluster{},
 			handler.EnqueueRequestsFromMapFunc(r.dpuClusterToRequests),
@@ -320,13 +825,122 @@ func (r *DPFHCPBridgeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			),
 			builder.WithPredicates(hostedClusterPredicate()),
 		).
+		Watches(
+			&hyperv1.NodePool{},
+			handler.EnqueueRequestForOwner(
+				mgr.GetScheme(),
+				mgr.GetRESTMapper(),
+				&provisioningv1alpha1.DPFHCPBridge{},
+				handler.OnlyControllerOwner(),
+			),
+			builder.WithPredicates(nodePoolPredicate()),
+		).
 		Watches(
 			&corev1.Secret{},
 			handler.EnqueueRequestsFromMapFunc(r.kubeconfigSecretToRequests),
 			builder.WithPredicates(kubeconfiginjection.IsHostedClusterKubeconfigSecretPredicate()),
 		).
-		Named("dpfhcpbridge").
-		Complete(r)
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(hostedcluster.FindBridgeForIgnitionSecret),
+			builder.WithPredicates(hostedcluster.IsIgnitionSecretPredicate()),
+		)
+
+	// Chargeback labels are derived from the bridge namespace and must be corrected on the hosted
+	// control plane namespace; only watch Namespaces when chargeback labels are configured
+	if len(r.HostedClusterManager.Chargeback.Keys()) > 0 {
+		b = b.Watches(
+			&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.namespaceToRequests),
+			builder.WithPredicates(namespacePredicate()),
+		)
+	}
+
+	// NodePool upgrades held back while DPU services roll out proceed as soon as the rollouts finish
+	if r.NodePoolManager.DPURollouts != nil {
+		for _, gvk := range dpurollout.Kinds {
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(gvk)
+			b = b.Watches(
+				obj,
+				handler.EnqueueRequestsFromMapFunc(r.dpuServiceToRequests),
+				builder.WithPredicates(dpurollout.RolloutChangedPredicate()),
+			)
+		}
+	}
+
+	retrying := retry.NewReconciler(r, retryPoliciesOrDefault(r.RetryPolicies))
+	return b.Named("dpfhcpbridge").
+		WithOptions(controller.Options{RateLimiter: retrying.RateLimiter, UsePriorityQueue: ptr.To(true)}).
+		Complete(retrying)
+}
+
+// retryPoliciesOrDefault returns policies, or the default retry policies if it is nil
+func retryPoliciesOrDefault(policies *retry.Policies) retry.Policies {
+	if policies == nil {
+		return retry.DefaultPolicies()
+	}
+	return *policies
+}
+
+// ownsShard returns true if the object belongs to the shard of this operator instance
+func (r *DPFHCPBridgeReconciler) ownsShard(obj client.Object) bool {
+	return r.ShardSelector == nil || r.ShardSelector.Matches(labels.Set(obj.GetLabels()))
+}
+
+// Priorities with which the DPFHCPBridges found by the initial list are reconciled after a restart.
+// They stay below the default priority of live events, which are not held up by the startup backlog.
+const (
+	startupPriorityHealthy   = handler.LowPriority
+	startupPriorityUnhealthy = handler.LowPriority + 1
+	startupPriorityDeleting  = handler.LowPriority + 2
+)
+
+// startupPriority returns the priority with which a DPFHCPBridge found by the initial list is reconciled:
+// deleting bridges first, then bridges that are not Ready, then Ready ones
+func startupPriority(bridge *provisioningv1alpha1.DPFHCPBridge) int {
+	switch {
+	case !bridge.DeletionTimestamp.IsZero():
+		return startupPriorityDeleting
+	case bridge.Status.Phase != provisioningv1alpha1.PhaseReady:
+		return startupPriorityUnhealthy
+	default:
+		return startupPriorityHealthy
+	}
+}
+
+// startupPriorityHandler raises the priority of the DPFHCPBridges found by the initial list to their
+// startupPriority, so that restarts during incidents converge on deleting and unhealthy bridges first.
+// Bridges of the same priority keep the order of the initial list. All other events are enqueued by For().
+// It is not built from handler.Funcs, which hides the priority queue behind a wrapper.
+type startupPriorityHandler struct{}
+
+var _ handler.EventHandler = startupPriorityHandler{}
+
+// Create enqueues a DPFHCPBridge found by the initial list with its startupPriority
+func (startupPriorityHandler) Create(_ context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	bridge, ok := e.Object.(*provisioningv1alpha1.DPFHCPBridge)
+	if !ok || !e.IsInInitialList {
+		return
+	}
+	priorityQueue, ok := q.(priorityqueue.PriorityQueue[reconcile.Request])
+	if !ok {
+		return
+	}
+	priorityQueue.AddWithOpts(priorityqueue.AddOpts{Priority: startupPriority(bridge)},
+		reconcile.Request{NamespacedName: client.ObjectKeyFromObject(bridge)})
+}
+
+// Update is a no-op, updates are enqueued by For()
+func (startupPriorityHandler) Update(context.Context, event.UpdateEvent, workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+}
+
+// Delete is a no-op, deletions are enqueued by For()
+func (startupPriorityHandler) Delete(context.Context, event.DeleteEvent, workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+}
+
+// Generic is a no-op, the controller has no generic DPFHCPBridge events
+func (startupPriorityHandler) Generic(context.Context, event.GenericEvent, workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 }
 
 // configMapPredicate filters ConfigMap events to only watch ocp-bluefield-images
@@ -347,20 +961,20 @@ func configMapPredicate() predicate.Predicate {
 	}
 }
 
-// configMapToRequests maps ConfigMap events to reconcile requests for DPFHCPBridge CRs
-// that need image resolution (Pending/Failed phases only)
-func (r *DPFHCPBridgeReconciler) configMapToRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+// imageSourceToRequests maps ocp-bluefield-images ConfigMap and BlueFieldImageSet events to reconcile
+// requests for DPFHCPBridge CRs that need image resolution (Pending/Failed phases only)
+func (r *DPFHCPBridgeReconciler) imageSourceToRequests(ctx context.Context, obj client.Object) []reconcile.Request {
 	log := logf.FromContext(ctx)
 
 	// List all DPFHCPBridge CRs cluster-wide
 	var bridgeList provisioningv1alpha1.DPFHCPBridgeList
 	if err := r.List(ctx, &bridgeList); err != nil {
-		log.Error(err, "Failed to list DPFHCPBridge CRs for ConfigMap watch")
+		log.Error(err, "Failed to list DPFHCPBridge CRs for BlueField image source watch")
 		return []reconcile.Request{}
 	}
 
-	// Filter to only CRs that need ConfigMap for image resolution
-	// (Pending: awaiting provisioning, Failed: retry after ConfigMap update)
+	// Filter to only CRs that need the image source for image resolution
+	// (Pending: awaiting provisioning, Failed: retry after image source update)
 	// Skip Ready/Provisioning/Deleting to avoid unnecessary reconciliations
 	requests := make([]reconcile.Request, 0)
 	for _, bridge := range bridgeList.Items {
@@ -377,14 +991,86 @@ func (r *DPFHCPBridgeReconciler) configMapToRequests(ctx context.Context, obj cl
 		}
 	}
 
-	log.Info("ConfigMap changed, reconciling DPFHCPBridge CRs that need image resolution",
-		"configMap", obj.GetName(),
+	log.Info("BlueField image source changed, reconciling DPFHCPBridge CRs that need image resolution",
+		"name", obj.GetName(),
 		"totalCRs", len(bridgeList.Items),
 		"reconcileCount", len(requests))
 
 	return requests
 }
 
+// releaseCatalogToRequests maps ReleaseCatalog events to reconcile requests for the DPFHCPBridge CRs
+// that reference the catalog, and for those not provisioned yet since strict mode applies to them
+func (r *DPFHCPBridgeReconciler) releaseCatalogToRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	var bridgeList provisioningv1alpha1.DPFHCPBridgeList
+	if err := r.List(ctx, &bridgeList); err != nil {
+		log.Error(err, "Failed to list DPFHCPBridge CRs for ReleaseCatalog watch")
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, bridge := range bridgeList.Items {
+		ref := bridge.Spec.ReleaseCatalogRef
+		if (ref != nil && ref.Name == obj.GetName()) || bridge.Status.HostedClusterRef == nil {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      bridge.Name,
+					Namespace: bridge.Namespace,
+				},
+			})
+		}
+	}
+
+	log.V(1).Info("ReleaseCatalog changed, reconciling affected DPFHCPBridge CRs",
+		"releaseCatalog", obj.GetName(),
+		"reconcileCount", len(requests))
+
+	return requests
+}
+
+// namespaceToRequests maps a Namespace to the DPFHCPBridges it holds, or whose hosted control plane runs in it
+func (r *DPFHCPBridgeReconciler) namespaceToRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	var bridgeList provisioningv1alpha1.DPFHCPBridgeList
+	if err := r.List(ctx, &bridgeList); err != nil {
+		log.Error(err, "Failed to list DPFHCPBridge CRs for Namespace watch")
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, bridge := range bridgeList.Items {
+		if bridge.Namespace == obj.GetName() || hostedcluster.ControlPlaneNamespace(&bridge) == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      bridge.Name,
+					Namespace: bridge.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// namespacePredicate filters Namespace events to creations and label changes, which may require
+// chargeback labels to be (re-)applied
+func namespacePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+	}
+}
+
 // dpuClusterPredicate filters DPUCluster events to watch for deletion and updates
 func dpuClusterPredicate() predicate.Predicate {
 	return predicate.Funcs{
@@ -424,10 +1110,11 @@ func (r *DPFHCPBridgeReconciler) dpuClusterToRequests(ctx context.Context, obj c
 	}
 
 	// Find the DPFHCPBridge CR that references this DPUCluster (should be at most one per 1:1 relationship)
+	// Bridges whose dpuClusterSelector is not resolved yet and matches the DPUCluster are reconciled
+	// too, so that they pick up newly created or relabelled DPUClusters
 	requests := make([]reconcile.Request, 0, 1)
 	for _, bridge := range bridgeList.Items {
-		if bridge.Spec.DPUClusterRef.Name == dpuCluster.Name &&
-			bridge.Spec.DPUClusterRef.Namespace == dpuCluster.Namespace {
+		if bridge.RefersToDPUCluster(dpuCluster.Name, dpuCluster.Namespace) || selectsDPUCluster(&bridge, dpuCluster) {
 			requests = append(requests, reconcile.Request{
 				NamespacedName: types.NamespacedName{
 					Name:      bridge.Name,
@@ -456,6 +1143,16 @@ func (r *DPFHCPBridgeReconciler) dpuClusterToRequests(ctx context.Context, obj c
 	return requests
 }
 
+// selectsDPUCluster returns true if the bridge has an unresolved dpuClusterSelector matching the DPUCluster
+// Invalid selectors also return true, so that the validation error is reported on the bridge.
+func selectsDPUCluster(bridge *provisioningv1alpha1.DPFHCPBridge, dpuCluster *dpuprovisioningv1alpha1.DPUCluster) bool {
+	if bridge.Spec.DPUClusterSelector == nil || bridge.Status.DPUClusterRef != nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(bridge.Spec.DPUClusterSelector)
+	return err != nil || selector.Matches(labels.Set(dpuCluster.Labels))
+}
+
 // secretPredicate filters Secret events to watch for changes to referenced secrets
 func secretPredicate() predicate.Predicate {
 	return predicate.Funcs{
@@ -546,8 +1243,11 @@ func hostedClusterPredicate() predicate.Predicate {
 				return false
 			}
 
-			// Compare status conditions to detect changes
-			return !conditionsEqual(oldHC.Status.Conditions, newHC.Status.Conditions)
+			// Compare status conditions and the ignition endpoint reported in status.ignition to detect changes,
+			// and labels so that drifted chargeback labels are corrected
+			return !conditionsEqual(oldHC.Status.Conditions, newHC.Status.Conditions) ||
+				oldHC.Status.IgnitionEndpoint != newHC.Status.IgnitionEndpoint ||
+				!maps.Equal(oldHC.Labels, newHC.Labels)
 		},
 		DeleteFunc: func(e event.DeleteEvent) bool {
 			// Watch deletion - reconcile to handle cleanup
@@ -556,12 +1256,46 @@ func hostedClusterPredicate() predicate.Predicate {
 	}
 }
 
+// nodePoolPredicate filters NodePool events to replica changes
+// Spec replica changes are watched so that edits made directly on the NodePool are reverted to spec.nodePoolReplicas
+func nodePoolPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNP, oldOK := e.ObjectOld.(*hyperv1.NodePool)
+			newNP, newOK := e.ObjectNew.(*hyperv1.NodePool)
+			if !oldOK || !newOK {
+				return false
+			}
+
+			return oldNP.Status.Replicas != newNP.Status.Replicas ||
+				ptr.Deref(oldNP.Spec.Replicas, 0) != ptr.Deref(newNP.Spec.Replicas, 0)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return true
+		},
+	}
+}
+
 // kubeconfigSecretToRequests maps HC kubeconfig secret events to reconcile requests for DPFHCPBridge CRs
 // Uses the kubeconfiginjection.FindBridgeForKubeconfigSecret function
 func (r *DPFHCPBridgeReconciler) kubeconfigSecretToRequests(ctx context.Context, obj client.Object) []reconcile.Request {
 	return kubeconfiginjection.FindBridgeForKubeconfigSecret(ctx, r.Client, obj)
 }
 
+// manifestsConfigMapToRequests maps ConfigMap events to reconcile requests for DPFHCPBridge CRs
+// that reference the ConfigMap in spec.additionalManifestsRefs
+func (r *DPFHCPBridgeReconciler) manifestsConfigMapToRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	return manifests.FindBridgesForManifestsConfigMap(ctx, r.Client, obj)
+}
+
+// dpuServiceToRequests maps a DPUDeployment or DPUService to the bridges with a DPUCluster in its namespace
+func (r *DPFHCPBridgeReconciler) dpuServiceToRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	return dpurollout.FindBridgesForDPUService(ctx, r.Client, obj)
+}
+
 // conditionsEqual compares two condition slices for equality
 func conditionsEqual(oldConds, newConds []metav1.Condition) bool {
 	if len(oldConds) != len(newConds) {
@@ -596,6 +1330,9 @@ func conditionsEqual(oldConds, newConds []metav1.Condition) bool {
 // 1. HostedCluster is available and healthy (HostedClusterAvailable=True)
 // 2. Kubeconfig successfully injected into DPUCluster (KubeConfigInjected=True)
 //
+// A bridge being deleted or failing a validation is never Ready; updatePhaseFromConditions rolls those up
+// into the Ready condition together with the phase.
+//
 // This function should be called AFTER all feature reconciliation completes, so that all
 // sub-conditions (HostedClusterAvailable, KubeConfigInjected, etc.) are up-to-date.
 //
@@ -603,30 +1340,34 @@ func conditionsEqual(oldConds, newConds []metav1.Condition) bool {
 func (r *DPFHCPBridgeReconciler) computeReadyCondition(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) {
 	log := logf.FromContext(ctx)
 
+	if !cr.DeletionTimestamp.IsZero() || failedValidation(cr) != nil {
+		return
+	}
+
 	// Requirement 1: HostedCluster must be available
 	// This is set by the StatusSyncer after mirroring HostedCluster status
 	hcAvailable := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.HostedClusterAvailable)
 	if hcAvailable == nil || hcAvailable.Status != metav1.ConditionTrue {
-		meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
-			Type:    provisioningv1alpha1.Ready,
-			Status:  metav1.ConditionFalse,
-			Reason:  provisioningv1alpha1.ReasonHostedClusterNotReady,
-			Message: "Waiting for HostedCluster to become available",
-		})
+		setReadyCondition(cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonHostedClusterNotReady,
+			"Waiting for HostedCluster to become available")
 		log.V(1).Info("Not ready: HostedCluster not available")
 		return
 	}
 
+	// An unclaimed BridgePool spare has no DPUCluster to inject the kubeconfig into and stays not Ready
+	if cr.IsSpare() {
+		setReadyCondition(cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonAwaitingClaim,
+			"Spare control plane is available, waiting to be claimed by setting dpuClusterRef or dpuClusterSelector")
+		log.V(1).Info("Not ready: BridgePool spare not claimed yet")
+		return
+	}
+
 	// Requirement 2: Kubeconfig must be injected
 	// This is set by the KubeconfigInjector after successful injection
 	kubeconfigInjected := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.KubeConfigInjected)
 	if kubeconfigInjected == nil || kubeconfigInjected.Status != metav1.ConditionTrue {
-		meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
-			Type:    provisioningv1alpha1.Ready,
-			Status:  metav1.ConditionFalse,
-			Reason:  provisioningv1alpha1.ReasonKubeConfigNotInjected,
-			Message: "Waiting for kubeconfig injection to DPUCluster",
-		})
+		setReadyCondition(cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonKubeConfigNotInjected,
+			"Waiting for kubeconfig injection to DPUCluster")
 		log.V(1).Info("Not ready: Kubeconfig not injected")
 		return
 	}
@@ -634,24 +1375,109 @@ func (r *DPFHCPBridgeReconciler) computeReadyCondition(ctx context.Context, cr *
 	// TODO: Add additional requirement checks here for future features
 
 	// All requirements met - set Ready to True
+	setReadyCondition(cr, metav1.ConditionTrue, provisioningv1alpha1.ReasonAllComponentsOperational,
+		"All required components are operational")
+	log.Info("DPFHCPBridge is ready")
+}
+
+// setReadyCondition sets the Ready condition for the current generation of the DPFHCPBridge
+func setReadyCondition(cr *provisioningv1alpha1.DPFHCPBridge, status metav1.ConditionStatus, reason, message string) {
 	meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
-		Type:    provisioningv1alpha1.Ready,
-		Status:  metav1.ConditionTrue,
-		Reason:  provisioningv1alpha1.ReasonAllComponentsOperational,
-		Message: "All required components are operational",
+		Type:               provisioningv1alpha1.Ready,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: cr.Generation,
+	})
+}
+
+// syncReadOnlyCondition sets the ReadOnly condition in read-only mode, so that a "created" condition or a
+// Provisioning phase is not mistaken for a change of the cluster, and drops it once the mode is turned off
+func (r *DPFHCPBridgeReconciler) syncReadOnlyCondition(cr *provisioningv1alpha1.DPFHCPBridge) {
+	if !r.ReadOnly {
+		meta.RemoveStatusCondition(&cr.Status.Conditions, provisioningv1alpha1.ReadOnly)
+		return
+	}
+	meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+		Type:   provisioningv1alpha1.ReadOnly,
+		Status: metav1.ConditionTrue,
+		Reason: provisioningv1alpha1.ReasonDryRun,
+		Message: "The operator runs in read-only mode: its writes other than this status are dry-runs, " +
+			"so the phase and conditions describe what it would have done",
+		ObservedGeneration: cr.Generation,
 	})
-	log.Info("DPFHCPBridge is ready")
 }
 
 // updatePhaseFromConditions computes the phase based on all conditions
+// A bridge being deleted or failing a validation is also marked not Ready here, so that the Ready
+// condition agrees with the phase on the status updates of features that stop the reconcile early.
 func (r *DPFHCPBridgeReconciler) updatePhaseFromConditions(cr *provisioningv1alpha1.DPFHCPBridge) {
 	// Phase 1: Check for deletion (highest priority)
 	if !cr.DeletionTimestamp.IsZero() {
-		cr.Status.Phase = provisioningv1alpha1.PhaseDeleting
+		setReadyCondition(cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonDeleting, "DPFHCPBridge is being deleted")
+		r.setPhase(cr, provisioningv1alpha1.PhaseDeleting)
+		return
+	}
+
+	// Phase 2: All validation conditions must pass before provisioning
+	if failed := failedValidation(cr); failed != nil {
+		setReadyCondition(cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonValidationFailed,
+			fmt.Sprintf("%s: %s", failed.Type, failed.Message))
+		r.setPhase(cr, provisioningv1alpha1.PhaseFailed)
+		return
+	}
+
+	// Bridges and DPUClusters can be applied in any order: until the DPUCluster is created (or Ready,
+	// depending on the readiness policy) the bridge stays Pending, and the DPUCluster watch wakes it up
+	if waitingForDPUCluster(cr) {
+		r.setPhase(cr, provisioningv1alpha1.PhasePending)
+		return
+	}
+
+	// Likewise, a bridge that was not approved yet stays Pending until the approved annotation is set
+	if approval.WaitingForApproval(cr) {
+		r.setPhase(cr, provisioningv1alpha1.PhasePending)
+		return
+	}
+
+	// Phase 3: Check for Ready condition (HostedCluster is operational)
+	readyCond := meta.FindStatusCondition(cr.Status.Conditions, "Ready")
+	if readyCond != nil && readyCond.Status == metav1.ConditionTrue {
+		r.setPhase(cr, provisioningv1alpha1.PhaseReady)
+		return
+	}
+
+	// Phase 4: Check if HostedCluster provisioning has started
+	if cr.Status.HostedClusterRef != nil {
+		r.setPhase(cr, provisioningv1alpha1.PhaseProvisioning)
 		return
 	}
 
-	// Phase 2: List of validation conditions that must pass before provisioning
+	// Phase 5: All validations passed, waiting for provisioning to start
+	r.setPhase(cr, provisioningv1alpha1.PhasePending)
+}
+
+// setPhase moves the DPFHCPBridge to phase and records the transition in a PhaseChanged event,
+// a Warning when the bridge fails
+func (r *DPFHCPBridgeReconciler) setPhase(cr *provisioningv1alpha1.DPFHCPBridge, phase provisioningv1alpha1.DPFHCPBridgePhase) {
+	previous := cr.Status.Phase
+	if previous == phase {
+		return
+	}
+	cr.Status.Phase = phase
+	if previous == "" || r.Recorder == nil {
+		return
+	}
+
+	eventType := corev1.EventTypeNormal
+	if phase == provisioningv1alpha1.PhaseFailed {
+		eventType = corev1.EventTypeWarning
+	}
+	r.Recorder.Eventf(cr, eventType, "PhaseChanged", "Phase changed from %s to %s", previous, phase)
+}
+
+// failedValidation returns the first validation condition that reports a failure, or nil if all pass
+func failedValidation(cr *provisioningv1alpha1.DPFHCPBridge) *metav1.Condition {
 	// Order matters: check critical validations first
 	validationChecks := []struct {
 		condType string
@@ -661,6 +1487,10 @@ func (r *DPFHCPBridgeReconciler) updatePhaseFromConditions(cr *provisioningv1alp
 		{"ClusterTypeValid", false},       // False = type invalid = bad
 		{"DPUClusterInUse", true},         // True = cluster already in use = bad
 		{"SecretsValid", false},           // False = secrets invalid = bad
+		{"ResourceConflict", true},        // True = HostedCluster/NodePool owned by someone else = bad
+		{"ReleaseChannelResolved", false}, // False = release channel not resolved = bad
+		{"ReleaseResolved", false},        // False = release not resolved or not approved = bad
+		{"ReleaseImagePinned", false},     // False = release image not pinned or not signed = bad
 		{"BlueFieldImageResolved", false}, // False = image not resolved = bad
 	}
 
@@ -672,6 +1502,16 @@ func (r *DPFHCPBridgeReconciler) updatePhaseFromConditions(cr *provisioningv1alp
 			continue
 		}
 
+		// A DPUCluster that does not exist yet is waited for rather than failed (see updatePhaseFromConditions)
+		if check.condType == provisioningv1alpha1.DPUClusterMissing && waitingForDPUCluster(cr) {
+			continue
+		}
+
+		// A channel resolved before keeps its release while the update graph is unavailable
+		if check.condType == provisioningv1alpha1.ReleaseChannelResolved && cr.Status.ChannelRelease != nil {
+			continue
+		}
+
 		// Determine if this condition represents a failure
 		// For negative conditions: True = bad (e.g., DPUClusterMissing=True means missing)
 		// For positive conditions: False = bad (e.g., ClusterTypeValid=False means invalid)
@@ -679,26 +1519,28 @@ func (r *DPFHCPBridgeReconciler) updatePhaseFromConditions(cr *provisioningv1alp
 			(!check.negative && cond.Status == metav1.ConditionFalse)
 
 		if isFailed {
-			cr.Status.Phase = provisioningv1alpha1.PhaseFailed
-			return
+			return cond
 		}
 	}
+	return nil
+}
 
-	// Phase 3: Check for Ready condition (HostedCluster is operational)
-	readyCond := meta.FindStatusCondition(cr.Status.Conditions, "Ready")
-	if readyCond != nil && readyCond.Status == metav1.ConditionTrue {
-		cr.Status.Phase = provisioningv1alpha1.PhaseReady
-		return
-	}
-
-	// Phase 4: Check if HostedCluster provisioning has started
-	if cr.Status.HostedClusterRef != nil {
-		cr.Status.Phase = provisioningv1alpha1.PhaseProvisioning
-		return
+// waitingForDPUCluster returns true if the referenced DPUCluster has not been created yet, or if the
+// initial provisioning waits for it to become Ready (see spec.dpuClusterReadinessPolicy)
+// A DPUCluster that was found before and is gone since (DPUClusterDeleted) is a failure instead.
+func waitingForDPUCluster(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	if dpucluster.WaitingForReadiness(cr) {
+		return true
 	}
+	cond := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.DPUClusterMissing)
+	return cond != nil && cond.Status == metav1.ConditionTrue &&
+		(cond.Reason == dpucluster.ReasonDPUClusterNotFound || cond.Reason == dpucluster.ReasonDPUClusterSelectorNoMatch)
+}
 
-	// Phase 5: All validations passed, waiting for provisioning to start
-	cr.Status.Phase = provisioningv1alpha1.PhasePending
+// provisioningHeld returns true if the bridge is Pending only because its initial provisioning is held back:
+// its DPUCluster does not exist yet, it is not approved yet or its rendered manifests are being reviewed
+func provisioningHeld(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	return waitingForDPUCluster(cr) || approval.WaitingForApproval(cr) || render.HoldsProvisioning(cr)
 }
 
 // handleDeletion handles the deletion of a DPFHCPBridge CR by running finalizer cleanup
@@ -740,6 +1582,10 @@ func (r *DPFHCPBridgeReconciler) handleDeletion(ctx context.Context, cr *provisi
 		return ctrl.Result{}, err
 	}
 
+	// Drop any debounced condition changes kept for this CR
+	r.StatusSyncer.Debouncer.Forget(cr)
+	r.EventForwarder.Forget(cr)
+
 	log.Info("Finalizer removed, DPFHCPBridge will be deleted")
 	return ctrl.Result{}, nil
 }
@@ -372,6 +372,31 @@ var _ = Describe("DPFHCPBridge CRD Schema Validation Tests", func() {
 			Expect(err).NotTo(HaveOccurred(), "Should accept SingleReplica with VIP")
 			_ = k8sClient.Delete(ctx, bridge)
 		})
+
+		It("should reject nodePortAddresses with VIP", func() {
+			bridge := &provisioningv1alpha1.DPFHCPBridge{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "single-with-vip-and-node-ports",
+					Namespace: "default",
+				},
+				Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+					DPUClusterRef: provisioningv1alpha1.DPUClusterReference{
+						Name:      "test-dpu",
+						Namespace: "default",
+					},
+					BaseDomain:                     "test.example.com",
+					OCPReleaseImage:                "quay.io/openshift-release-dev/ocp-release:4.19.0-ec.5-multi",
+					SSHKeySecretRef:                corev1.LocalObjectReference{Name: "test-ssh-key"},
+					PullSecretRef:                  corev1.LocalObjectReference{Name: "test-pull-secret"},
+					ControlPlaneAvailabilityPolicy: hyperv1.SingleReplica,
+					VirtualIP:                      "192.168.1.100",
+					NodePortAddresses:              []string{"10.0.0.1", "10.0.0.2"},
+				},
+			}
+
+			err := k8sClient.Create(ctx, bridge)
+			Expect(err).To(MatchError(ContainSubstring("nodePortAddresses cannot be set with virtualIP")))
+		})
 	})
 
 	Context("Field Immutability Validation", func() {
@@ -492,8 +517,7 @@ var _ = Describe("DPFHCPBridge CRD Schema Validation Tests", func() {
 			}, time.Second*5, time.Millisecond*100).Should(MatchError(ContainSubstring("controlPlaneAvailabilityPolicy is immutable")))
 		})
 
-		It("should reject updates to virtualIP", func() {
-			// First set the VIP - wrap in Eventually to handle race with controller
+		It("should reject adding a virtualIP after creation", func() {
 			Eventually(func() error {
 				fresh := &provisioningv1alpha1.DPFHCPBridge{}
 				if err := k8sClient.Get(ctx, types.NamespacedName{Name: "immutability-test", Namespace: "default"}, fresh); err != nil {
@@ -502,18 +526,50 @@ var _ = Describe("DPFHCPBridge CRD Schema Validation Tests", func() {
 				updated := fresh.DeepCopy()
 				updated.Spec.VirtualIP = "192.168.1.100"
 				return k8sClient.Update(ctx, updated)
-			}, time.Second*5, time.Millisecond*100).Should(Succeed())
+			}, time.Second*5, time.Millisecond*100).Should(MatchError(ContainSubstring("virtualIP cannot be added or removed")))
+		})
+
+		It("should reject updates to virtualIP and its removal", func() {
+			withVIP := bridge.DeepCopy()
+			withVIP.ObjectMeta = metav1.ObjectMeta{Name: "immutability-test-vip", Namespace: "default"}
+			withVIP.Spec.VirtualIP = "192.168.1.100"
+			Expect(k8sClient.Create(ctx, withVIP)).To(Succeed())
+			DeferCleanup(func() {
+				_ = k8sClient.Delete(ctx, withVIP)
+			})
 
-			// Now try to change it - should fail with immutability error
+			// Wrap in Eventually to handle race with controller
 			Eventually(func() error {
-				fresh2 := &provisioningv1alpha1.DPFHCPBridge{}
-				if err := k8sClient.Get(ctx, types.NamespacedName{Name: "immutability-test", Namespace: "default"}, fresh2); err != nil {
+				fresh := &provisioningv1alpha1.DPFHCPBridge{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Name: "immutability-test-vip", Namespace: "default"}, fresh); err != nil {
 					return err
 				}
-				updated2 := fresh2.DeepCopy()
-				updated2.Spec.VirtualIP = "192.168.1.101"
-				return k8sClient.Update(ctx, updated2)
+				updated := fresh.DeepCopy()
+				updated.Spec.VirtualIP = "192.168.1.101"
+				return k8sClient.Update(ctx, updated)
 			}, time.Second*5, time.Millisecond*100).Should(MatchError(ContainSubstring("virtualIP is immutable")))
+
+			Eventually(func() error {
+				fresh := &provisioningv1alpha1.DPFHCPBridge{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Name: "immutability-test-vip", Namespace: "default"}, fresh); err != nil {
+					return err
+				}
+				updated := fresh.DeepCopy()
+				updated.Spec.VirtualIP = ""
+				return k8sClient.Update(ctx, updated)
+			}, time.Second*5, time.Millisecond*100).Should(MatchError(ContainSubstring("virtualIP cannot be added or removed")))
+		})
+
+		It("should reject setting the platform after creation", func() {
+			Eventually(func() error {
+				fresh := &provisioningv1alpha1.DPFHCPBridge{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Name: "immutability-test", Namespace: "default"}, fresh); err != nil {
+					return err
+				}
+				updated := fresh.DeepCopy()
+				updated.Spec.Platform = provisioningv1alpha1.PlatformAgent
+				return k8sClient.Update(ctx, updated)
+			}, time.Second*5, time.Millisecond*100).Should(MatchError(ContainSubstring("platform cannot be added or removed")))
 		})
 
 		It("should allow updates to ocpReleaseImage (mutable)", func() {
@@ -529,4 +585,69 @@ var _ = Describe("DPFHCPBridge CRD Schema Validation Tests", func() {
 			}, time.Second*5, time.Millisecond*100).Should(Succeed())
 		})
 	})
+
+	Context("BridgePool Spares", func() {
+		newSpare := func(name string) *provisioningv1alpha1.DPFHCPBridge {
+			return &provisioningv1alpha1.DPFHCPBridge{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "default",
+				},
+				Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+					BaseDomain:                     "test.example.com",
+					OCPReleaseImage:                "quay.io/openshift-release-dev/ocp-release:4.19.0-ec.5-multi",
+					SSHKeySecretRef:                corev1.LocalObjectReference{Name: "test-ssh-key"},
+					PullSecretRef:                  corev1.LocalObjectReference{Name: "test-pull-secret"},
+					ControlPlaneAvailabilityPolicy: hyperv1.SingleReplica,
+					BridgePoolRef:                  &corev1.LocalObjectReference{Name: "warm"},
+				},
+			}
+		}
+
+		It("should reject a bridge without DPUCluster that is not a spare", func() {
+			bridge := newSpare("no-dpucluster-test")
+			bridge.Spec.BridgePoolRef = nil
+			err := k8sClient.Create(ctx, bridge)
+			Expect(err).To(MatchError(ContainSubstring("exactly one of dpuClusterRef and dpuClusterSelector must be set")))
+		})
+
+		It("should allow claiming a spare once but not switching how it is bound", func() {
+			spare := newSpare("spare-claim-test")
+			Expect(k8sClient.Create(ctx, spare)).To(Succeed())
+			key := types.NamespacedName{Name: spare.Name, Namespace: spare.Namespace}
+
+			Eventually(func() error {
+				fresh := &provisioningv1alpha1.DPFHCPBridge{}
+				if err := k8sClient.Get(ctx, key, fresh); err != nil {
+					return err
+				}
+				fresh.Spec.DPUClusterRef = provisioningv1alpha1.DPUClusterReference{Name: "test-dpu", Namespace: "default"}
+				return k8sClient.Update(ctx, fresh)
+			}, time.Second*5, time.Millisecond*100).Should(Succeed())
+
+			Eventually(func() error {
+				fresh := &provisioningv1alpha1.DPFHCPBridge{}
+				if err := k8sClient.Get(ctx, key, fresh); err != nil {
+					return err
+				}
+				fresh.Spec.DPUClusterRef = provisioningv1alpha1.DPUClusterReference{}
+				fresh.Spec.DPUClusterSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"site": "lab-1"}}
+				return k8sClient.Update(ctx, fresh)
+			}, time.Second*5, time.Millisecond*100).Should(MatchError(ContainSubstring("cannot switch between dpuClusterRef and dpuClusterSelector")))
+		})
+
+		It("should reject removing bridgePoolRef", func() {
+			spare := newSpare("spare-pool-ref-test")
+			Expect(k8sClient.Create(ctx, spare)).To(Succeed())
+
+			Eventually(func() error {
+				fresh := &provisioningv1alpha1.DPFHCPBridge{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Name: spare.Name, Namespace: spare.Namespace}, fresh); err != nil {
+					return err
+				}
+				fresh.Spec.BridgePoolRef = nil
+				return k8sClient.Update(ctx, fresh)
+			}, time.Second*5, time.Millisecond*100).Should(MatchError(ContainSubstring("bridgePoolRef cannot be added or removed")))
+		})
+	})
 })
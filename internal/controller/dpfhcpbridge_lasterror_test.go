@@ -0,0 +1,114 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("DPFHCPBridge Last Error", func() {
+	var (
+		ctx       context.Context
+		bridge    *provisioningv1alpha1.DPFHCPBridge
+		key       types.NamespacedName
+		updateErr error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "last-error", Namespace: "default"},
+		}
+		key = types.NamespacedName{Name: bridge.Name, Namespace: bridge.Namespace}
+		updateErr = nil
+	})
+
+	// reconcileBridge runs a reconcile that stops once the finalizer is added, failing with updateErr
+	reconcileBridge := func() (client.Client, error) {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(bridge).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					if updateErr != nil {
+						return updateErr
+					}
+					return c.Update(ctx, obj, opts...)
+				},
+			}).
+			Build()
+		r := &DPFHCPBridgeReconciler{Client: c}
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		return c, err
+	}
+
+	getLastError := func(c client.Client) *provisioningv1alpha1.ReconcileError {
+		updated := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, key, updated)).To(Succeed())
+		return updated.Status.LastError
+	}
+
+	It("should record the error and step of a failed reconcile", func() {
+		updateErr = errors.New("etcdserver: request timed out")
+		c, err := reconcileBridge()
+		Expect(err).To(MatchError(updateErr))
+
+		lastError := getLastError(c)
+		Expect(lastError).NotTo(BeNil())
+		Expect(lastError.Step).To(Equal("Finalizer"))
+		Expect(lastError.Message).To(Equal("etcdserver: request timed out"))
+		Expect(lastError.Time.IsZero()).To(BeFalse())
+	})
+
+	It("should clear the last error once a reconcile succeeds", func() {
+		bridge.Status.LastError = &provisioningv1alpha1.ReconcileError{Step: "Finalizer", Message: "etcdserver: request timed out", Time: metav1.Now()}
+		c, err := reconcileBridge()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getLastError(c)).To(BeNil())
+	})
+
+	It("should not record conflicts", func() {
+		updateErr = apierrors.NewConflict(schema.GroupResource{Group: provisioningv1alpha1.GroupVersion.Group, Resource: "dpfhcpbridges"},
+			bridge.Name, errors.New("the object has been modified"))
+		c, err := reconcileBridge()
+		Expect(apierrors.IsConflict(err)).To(BeTrue())
+		Expect(getLastError(c)).To(BeNil())
+	})
+
+	It("should truncate long messages", func() {
+		updateErr = errors.New(strings.Repeat("x", 2*maxLastErrorLength))
+		c, _ := reconcileBridge()
+		Expect(getLastError(c).Message).To(HaveLen(maxLastErrorLength + len("...")))
+	})
+})
@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
@@ -281,8 +282,8 @@ var _ = Describe("DPFHCPBridge Phase Transitions", func() {
 			}, timeout, interval).Should(BeTrue())
 		})
 
-		It("should transition to Failed when DPUCluster is missing", func() {
-			// Create DPFHCPBridge referencing non-existent DPUCluster
+		It("should wait in Pending until a missing DPUCluster is created", func() {
+			// Create DPFHCPBridge referencing a DPUCluster that does not exist yet
 			bridge := &provisioningv1alpha1.DPFHCPBridge{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "phase-test-missing-dpu",
@@ -290,7 +291,7 @@ var _ = Describe("DPFHCPBridge Phase Transitions", func() {
 				},
 				Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
 					DPUClusterRef: provisioningv1alpha1.DPUClusterReference{
-						Name:      "non-existent-dpu",
+						Name:      "late-dpu",
 						Namespace: testNamespace,
 					},
 					BaseDomain:                     "test-cluster.example.com",
@@ -303,16 +304,41 @@ var _ = Describe("DPFHCPBridge Phase Transitions", func() {
 			}
 			Expect(k8sClient.Create(ctx, bridge)).To(Succeed())
 
-			// Controller should set phase to Failed
-			Eventually(func() provisioningv1alpha1.DPFHCPBridgePhase {
+			// Controller should set DPUClusterMissing=True with reason DPUClusterNotFound and stay Pending
+			Eventually(func() bool {
 				err := k8sClient.Get(ctx, types.NamespacedName{Name: "phase-test-missing-dpu", Namespace: testNamespace}, bridge)
 				if err != nil {
-					return ""
+					return false
 				}
-				return bridge.Status.Phase
-			}, timeout, interval).Should(Equal(provisioningv1alpha1.PhaseFailed))
 
-			// Verify DPUClusterMissing condition is set
+				missingCond := meta.FindStatusCondition(bridge.Status.Conditions, "DPUClusterMissing")
+				return missingCond != nil && missingCond.Status == metav1.ConditionTrue &&
+					missingCond.Reason == "DPUClusterNotFound"
+			}, timeout, interval).Should(BeTrue())
+			Expect(bridge.Status.Phase).To(Equal(provisioningv1alpha1.PhasePending))
+
+			// Nothing is provisioned while waiting
+			Consistently(func() bool {
+				hc := &hyperv1.HostedCluster{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: "phase-test-missing-dpu", Namespace: testNamespace}, hc)
+				return apierrors.IsNotFound(err)
+			}, 3*time.Second, interval).Should(BeTrue())
+
+			// Creating the DPUCluster wakes the bridge up
+			lateDPUCluster := &dpuprovisioningv1alpha1.DPUCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "late-dpu",
+					Namespace: testNamespace,
+				},
+				Spec: dpuprovisioningv1alpha1.DPUClusterSpec{
+					Type: "bf3",
+				},
+			}
+			Expect(k8sClient.Create(ctx, lateDPUCluster)).To(Succeed())
+			DeferCleanup(func() {
+				_ = k8sClient.Delete(ctx, lateDPUCluster)
+			})
+
 			Eventually(func() bool {
 				err := k8sClient.Get(ctx, types.NamespacedName{Name: "phase-test-missing-dpu", Namespace: testNamespace}, bridge)
 				if err != nil {
@@ -320,7 +346,7 @@ var _ = Describe("DPFHCPBridge Phase Transitions", func() {
 				}
 
 				missingCond := meta.FindStatusCondition(bridge.Status.Conditions, "DPUClusterMissing")
-				return missingCond != nil && missingCond.Status == metav1.ConditionTrue
+				return missingCond != nil && missingCond.Status == metav1.ConditionFalse
 			}, timeout, interval).Should(BeTrue())
 		})
 
@@ -527,3 +553,87 @@ var _ = Describe("DPFHCPBridge Phase Transitions", func() {
 		})
 	})
 })
+
+var _ = Describe("DPFHCPBridge phase state machine", func() {
+	var (
+		recorder   *record.FakeRecorder
+		reconciler *DPFHCPBridgeReconciler
+		cr         *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+		reconciler = &DPFHCPBridgeReconciler{Recorder: recorder}
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", Generation: 3},
+		}
+	})
+
+	It("should record a PhaseChanged event on a phase transition", func() {
+		cr.Status.Phase = provisioningv1alpha1.PhasePending
+		cr.Status.HostedClusterRef = &corev1.ObjectReference{Name: "test-bridge", Namespace: "default"}
+
+		reconciler.updatePhaseFromConditions(cr)
+
+		Expect(cr.Status.Phase).To(Equal(provisioningv1alpha1.PhaseProvisioning))
+		Expect(recorder.Events).To(Receive(Equal("Normal PhaseChanged Phase changed from Pending to Provisioning")))
+	})
+
+	It("should not record an event for the initial phase or an unchanged phase", func() {
+		reconciler.updatePhaseFromConditions(cr)
+		reconciler.updatePhaseFromConditions(cr)
+
+		Expect(cr.Status.Phase).To(Equal(provisioningv1alpha1.PhasePending))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should roll a failed validation up into the Ready condition and a Warning event", func() {
+		cr.Status.Phase = provisioningv1alpha1.PhaseReady
+		meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+			Type: provisioningv1alpha1.Ready, Status: metav1.ConditionTrue, Reason: provisioningv1alpha1.ReasonAllComponentsOperational,
+		})
+		meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+			Type: "SecretsValid", Status: metav1.ConditionFalse, Reason: "SecretNotFound", Message: "pull secret not found",
+		})
+
+		reconciler.computeReadyCondition(context.Background(), cr)
+		reconciler.updatePhaseFromConditions(cr)
+
+		Expect(cr.Status.Phase).To(Equal(provisioningv1alpha1.PhaseFailed))
+		ready := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.Ready)
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(provisioningv1alpha1.ReasonValidationFailed))
+		Expect(ready.Message).To(Equal("SecretsValid: pull secret not found"))
+		Expect(ready.ObservedGeneration).To(Equal(int64(3)))
+		Expect(recorder.Events).To(Receive(Equal("Warning PhaseChanged Phase changed from Ready to Failed")))
+	})
+
+	It("should mark a bridge being deleted not Ready", func() {
+		now := metav1.Now()
+		cr.DeletionTimestamp = &now
+		cr.Status.Phase = provisioningv1alpha1.PhaseReady
+
+		reconciler.updatePhaseFromConditions(cr)
+
+		Expect(cr.Status.Phase).To(Equal(provisioningv1alpha1.PhaseDeleting))
+		ready := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.Ready)
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(provisioningv1alpha1.ReasonDeleting))
+	})
+
+	It("should report read-only mode in the ReadOnly condition and drop it once the mode is off", func() {
+		reconciler.ReadOnly = true
+		reconciler.syncReadOnlyCondition(cr)
+
+		readOnly := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.ReadOnly)
+		Expect(readOnly).NotTo(BeNil())
+		Expect(readOnly.Status).To(Equal(metav1.ConditionTrue))
+		Expect(readOnly.Reason).To(Equal(provisioningv1alpha1.ReasonDryRun))
+		Expect(readOnly.ObservedGeneration).To(Equal(int64(3)))
+
+		reconciler.ReadOnly = false
+		reconciler.syncReadOnlyCondition(cr)
+
+		Expect(meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.ReadOnly)).To(BeNil())
+	})
+})
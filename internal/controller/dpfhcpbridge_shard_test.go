@@ -0,0 +1,75 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("DPFHCPBridge Sharding", func() {
+	newBridge := func(name string, lbls map[string]string) *provisioningv1alpha1.DPFHCPBridge {
+		return &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: lbls},
+		}
+	}
+
+	It("should own every bridge when no shard selector is set", func() {
+		r := &DPFHCPBridgeReconciler{}
+		Expect(r.ownsShard(newBridge("a", nil))).To(BeTrue())
+		Expect(r.ownsShard(newBridge("b", map[string]string{"shard": "b"}))).To(BeTrue())
+	})
+
+	It("should only own bridges matching the shard selector", func() {
+		selector, err := labels.Parse("shard=a")
+		Expect(err).NotTo(HaveOccurred())
+		r := &DPFHCPBridgeReconciler{ShardSelector: selector}
+
+		Expect(r.ownsShard(newBridge("a", map[string]string{"shard": "a"}))).To(BeTrue())
+		Expect(r.ownsShard(newBridge("b", map[string]string{"shard": "b"}))).To(BeFalse())
+		Expect(r.ownsShard(newBridge("c", nil))).To(BeFalse())
+	})
+
+	It("should leave bridges of other shards untouched", func() {
+		ctx := context.Background()
+		bridge := newBridge("other-shard", map[string]string{"shard": "b"})
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(bridge).Build()
+
+		selector, err := labels.Parse("shard=a")
+		Expect(err).NotTo(HaveOccurred())
+		r := &DPFHCPBridgeReconciler{Client: c, ShardSelector: selector}
+
+		key := types.NamespacedName{Name: bridge.Name, Namespace: bridge.Namespace}
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		updated := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, key, updated)).To(Succeed())
+		Expect(updated.Finalizers).To(BeEmpty())
+	})
+})
@@ -0,0 +1,95 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Startup reconcile order", func() {
+	var queue priorityqueue.PriorityQueue[reconcile.Request]
+
+	BeforeEach(func() {
+		queue = priorityqueue.New[reconcile.Request]("dpfhcpbridge-startup-test")
+		DeferCleanup(queue.ShutDown)
+	})
+
+	newBridge := func(name string, phase provisioningv1alpha1.DPFHCPBridgePhase, deleting bool) *provisioningv1alpha1.DPFHCPBridge {
+		bridge := &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status:     provisioningv1alpha1.DPFHCPBridgeStatus{Phase: phase},
+		}
+		if deleting {
+			bridge.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			bridge.Finalizers = []string{"test"}
+		}
+		return bridge
+	}
+
+	// list enqueues bridges the way the controller does for its initial list
+	list := func(bridges ...*provisioningv1alpha1.DPFHCPBridge) {
+		ctx := context.Background()
+		for _, bridge := range bridges {
+			e := event.CreateEvent{Object: bridge, IsInInitialList: true}
+			(&handler.EnqueueRequestForObject{}).Create(ctx, e, queue)
+			startupPriorityHandler{}.Create(ctx, e, queue)
+		}
+	}
+
+	drain := func() []string {
+		var names []string
+		for queue.Len() > 0 {
+			request, _ := queue.Get()
+			names = append(names, request.Name)
+			queue.Done(request)
+		}
+		return names
+	}
+
+	It("should reconcile deleting bridges first, then unhealthy ones, then healthy ones", func() {
+		list(
+			newBridge("a-ready", provisioningv1alpha1.PhaseReady, false),
+			newBridge("b-failed", provisioningv1alpha1.PhaseFailed, false),
+			newBridge("c-deleting", provisioningv1alpha1.PhaseReady, true),
+			newBridge("d-provisioning", provisioningv1alpha1.PhaseProvisioning, false),
+			newBridge("e-ready", provisioningv1alpha1.PhaseReady, false),
+			newBridge("f-deleting", provisioningv1alpha1.PhaseDeleting, true),
+		)
+
+		Expect(drain()).To(Equal([]string{"c-deleting", "f-deleting", "b-failed", "d-provisioning", "a-ready", "e-ready"}))
+	})
+
+	It("should keep live events ahead of the startup backlog", func() {
+		list(newBridge("deleting", provisioningv1alpha1.PhaseDeleting, true))
+		live := event.CreateEvent{Object: newBridge("created", "", false)}
+		(&handler.EnqueueRequestForObject{}).Create(context.Background(), live, queue)
+		startupPriorityHandler{}.Create(context.Background(), live, queue)
+
+		Expect(drain()).To(Equal([]string{"created", "deleting"}))
+	})
+})
@@ -0,0 +1,75 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("BlueField image ConfigMap watch", func() {
+	imageConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "ocp-bluefield-images", Namespace: "dpf-hcp-bridge-system"},
+		Data:       map[string]string{"4.19.1": "quay.io/example/bluefield:4.19.1"},
+	}
+
+	It("should only pass events of the ocp-bluefield-images ConfigMap", func() {
+		p := configMapPredicate()
+		Expect(p.Create(event.CreateEvent{Object: imageConfigMap})).To(BeTrue())
+		Expect(p.Update(event.UpdateEvent{ObjectOld: imageConfigMap, ObjectNew: imageConfigMap})).To(BeTrue())
+		Expect(p.Delete(event.DeleteEvent{Object: imageConfigMap})).To(BeTrue())
+
+		other := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "ocp-bluefield-images", Namespace: "default"}}
+		Expect(p.Create(event.CreateEvent{Object: other})).To(BeFalse())
+		Expect(p.Update(event.UpdateEvent{ObjectOld: other, ObjectNew: other})).To(BeFalse())
+	})
+
+	It("should re-reconcile the bridges waiting for a BlueField image", func() {
+		newBridge := func(name string, phase provisioningv1alpha1.DPFHCPBridgePhase) *provisioningv1alpha1.DPFHCPBridge {
+			return &provisioningv1alpha1.DPFHCPBridge{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Status:     provisioningv1alpha1.DPFHCPBridgeStatus{Phase: phase},
+			}
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+			newBridge("new", ""),
+			newBridge("pending", provisioningv1alpha1.PhasePending),
+			newBridge("image-not-found", provisioningv1alpha1.PhaseFailed),
+			newBridge("ready", provisioningv1alpha1.PhaseReady),
+			newBridge("provisioning", provisioningv1alpha1.PhaseProvisioning),
+		).Build()
+		r := &DPFHCPBridgeReconciler{Client: c}
+
+		requests := r.imageSourceToRequests(context.Background(), imageConfigMap)
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "new", Namespace: "default"}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "pending", Namespace: "default"}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "image-not-found", Namespace: "default"}},
+		))
+	})
+})
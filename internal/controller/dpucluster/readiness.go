@@ -0,0 +1,174 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dpucluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const (
+	// DefaultReadinessTimeout is how long the WaitWithTimeout readiness policy waits by default
+	DefaultReadinessTimeout = 30 * time.Minute
+
+	// DPUClusterReady condition reasons
+	ReasonDPUClusterReady         = "DPUClusterReady"
+	ReasonDPUClusterNotReady      = "DPUClusterNotReady"
+	ReasonReadinessTimeoutExpired = "ReadinessTimeoutExpired"
+)
+
+// CheckReadiness sets the DPUClusterReady condition according to spec.dpuClusterReadinessPolicy.
+// The condition is reported with every policy, so that the bridge shows when its DPUCluster degrades;
+// unless the policy is Ignore it gates the initial provisioning while its reason is DPUClusterNotReady
+// (see WaitingForReadiness). With spec.dpuClusterRefs, every DPUCluster must be Ready and the condition
+// reports the first one that is not.
+//
+// A RequeueAfter result is when a WaitWithTimeout wait expires; it does not indicate that
+// reconciliation should stop. DPUCluster status changes are picked up by the DPUCluster watch.
+func (v *Validator) CheckReadiness(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithValues("feature", "dpucluster-readiness")
+
+	policy := cr.Spec.DPUClusterReadinessPolicy
+	if policy == "" {
+		policy = provisioningv1alpha1.DPUClusterReadinessIgnore
+	}
+
+	refs := cr.ResolvedDPUClusterRefs()
+	if len(refs) == 0 {
+		// dpuClusterSelector not resolved yet, reported by ValidateDPUCluster
+		return ctrl.Result{}, nil
+	}
+
+	dpuClusters := make([]dpuprovisioningv1alpha1.DPUCluster, len(refs))
+	for i, ref := range refs {
+		if err := v.client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, &dpuClusters[i]); err != nil {
+			if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+				// Reported by ValidateDPUCluster
+				return ctrl.Result{}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("failed to get DPUCluster for readiness check: %w", err)
+		}
+	}
+
+	// Report the first DPUCluster that is not Ready, if any
+	dpuCluster := dpuClusters[0]
+	for _, candidate := range dpuClusters {
+		if candidate.Status.Phase != dpuprovisioningv1alpha1.PhaseReady {
+			dpuCluster = candidate
+			break
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:               provisioningv1alpha1.DPUClusterReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonDPUClusterReady,
+		Message:            fmt.Sprintf("DPUCluster '%s/%s' is Ready", dpuCluster.Namespace, dpuCluster.Name),
+		LastTransitionTime: metav1.NewTime(v.clock()),
+		ObservedGeneration: cr.Generation,
+	}
+	if len(dpuClusters) > 1 {
+		condition.Message = fmt.Sprintf("DPUClusters %s are Ready", describeDPUClusters(dpuClusters))
+	}
+
+	var requeueAfter time.Duration
+	if dpuCluster.Status.Phase != dpuprovisioningv1alpha1.PhaseReady {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonDPUClusterNotReady
+		condition.Message = fmt.Sprintf("DPUCluster '%s/%s' is not Ready (phase %q)", dpuCluster.Namespace, dpuCluster.Name, dpuCluster.Status.Phase)
+		if cr.Status.HostedClusterRef == nil && policy != provisioningv1alpha1.DPUClusterReadinessIgnore {
+			condition.Message += ", waiting for it before provisioning the HostedCluster"
+		}
+
+		if policy == provisioningv1alpha1.DPUClusterReadinessWaitWithTimeout && cr.Status.HostedClusterRef == nil {
+			remaining := v.readinessWaitRemaining(cr, readinessTimeout(cr))
+			if remaining <= 0 {
+				condition.Reason = ReasonReadinessTimeoutExpired
+				condition.Message = fmt.Sprintf("DPUCluster '%s/%s' is not Ready (phase %q) after %s, provisioning anyway",
+					dpuCluster.Namespace, dpuCluster.Name, dpuCluster.Status.Phase, readinessTimeout(cr))
+			} else {
+				requeueAfter = remaining
+			}
+		}
+	}
+
+	if changed := meta.SetStatusCondition(&cr.Status.Conditions, condition); !changed {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	eventType := corev1.EventTypeNormal
+	if condition.Status == metav1.ConditionFalse {
+		eventType = corev1.EventTypeWarning
+	}
+	v.recorder.Event(cr, eventType, condition.Reason, condition.Message)
+	log.Info("DPUCluster readiness changed", "reason", condition.Reason, "policy", policy)
+
+	if err := v.client.Status().Update(ctx, cr); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// WaitingForReadiness returns true if the initial provisioning must wait for the DPUCluster to become Ready
+func WaitingForReadiness(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	policy := cr.Spec.DPUClusterReadinessPolicy
+	if cr.Status.HostedClusterRef != nil || policy == "" || policy == provisioningv1alpha1.DPUClusterReadinessIgnore {
+		return false
+	}
+	cond := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.DPUClusterReady)
+	return cond != nil && cond.Status == metav1.ConditionFalse && cond.Reason == ReasonDPUClusterNotReady
+}
+
+// readinessWaitRemaining returns how much longer the bridge waits for the DPUCluster to become Ready.
+// The wait starts when the DPUClusterReady condition last turned False, or now if it is not False yet.
+func (v *Validator) readinessWaitRemaining(cr *provisioningv1alpha1.DPFHCPBridge, timeout time.Duration) time.Duration {
+	now := v.clock()
+	since := now
+	if cond := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.DPUClusterReady); cond != nil && cond.Status == metav1.ConditionFalse {
+		since = cond.LastTransitionTime.Time
+	}
+	return timeout - now.Sub(since)
+}
+
+// readinessTimeout returns spec.dpuClusterReadinessTimeout, or DefaultReadinessTimeout if unset
+func readinessTimeout(cr *provisioningv1alpha1.DPFHCPBridge) time.Duration {
+	if cr.Spec.DPUClusterReadinessTimeout == nil {
+		return DefaultReadinessTimeout
+	}
+	return cr.Spec.DPUClusterReadinessTimeout.Duration
+}
+
+func (v *Validator) clock() time.Time {
+	if v.now == nil {
+		return time.Now()
+	}
+	return v.now()
+}
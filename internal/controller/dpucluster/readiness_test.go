@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dpucluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("DPUCluster readiness policy", func() {
+	var (
+		ctx        context.Context
+		scheme     *runtime.Scheme
+		bridge     *provisioningv1alpha1.DPFHCPBridge
+		dpuCluster *dpuprovisioningv1alpha1.DPUCluster
+		now        time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(dpuprovisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+		dpuCluster = &dpuprovisioningv1alpha1.DPUCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dpu", Namespace: "dpu-system"},
+			Status:     dpuprovisioningv1alpha1.DPUClusterStatus{Phase: dpuprovisioningv1alpha1.PhaseCreating},
+		}
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", Generation: 1},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef: provisioningv1alpha1.DPUClusterReference{Name: "test-dpu", Namespace: "dpu-system"},
+			},
+		}
+	})
+
+	check := func() (time.Duration, *metav1.Condition) {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(dpuCluster, bridge).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+		v := NewValidator(c, record.NewFakeRecorder(10))
+		v.now = func() time.Time { return now }
+
+		result, err := v.CheckReadiness(ctx, bridge)
+		Expect(err).ToNot(HaveOccurred())
+
+		var updated provisioningv1alpha1.DPFHCPBridge
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(bridge), &updated)).To(Succeed())
+		bridge.Status = updated.Status
+		return result.RequeueAfter, meta.FindStatusCondition(updated.Status.Conditions, provisioningv1alpha1.DPUClusterReady)
+	}
+
+	It("should report readiness without gating provisioning with the default Ignore policy", func() {
+		requeueAfter, cond := check()
+		Expect(requeueAfter).To(BeZero())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(ReasonDPUClusterNotReady))
+		Expect(cond.Message).NotTo(ContainSubstring("waiting for it"))
+		Expect(WaitingForReadiness(bridge)).To(BeFalse())
+
+		dpuCluster.Status.Phase = dpuprovisioningv1alpha1.PhaseReady
+		_, cond = check()
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should report a DPUCluster that degrades after provisioning", func() {
+		bridge.Status.HostedClusterRef = &corev1.ObjectReference{Name: "test-bridge", Namespace: "default"}
+		dpuCluster.Status.Phase = dpuprovisioningv1alpha1.PhaseReady
+		_, cond := check()
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+
+		dpuCluster.Status.Phase = "NotReady"
+		_, cond = check()
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Message).To(ContainSubstring(`phase "NotReady"`))
+		Expect(WaitingForReadiness(bridge)).To(BeFalse())
+	})
+
+	It("should wait for a not Ready DPUCluster with the Require policy", func() {
+		bridge.Spec.DPUClusterReadinessPolicy = provisioningv1alpha1.DPUClusterReadinessRequire
+
+		requeueAfter, cond := check()
+		Expect(requeueAfter).To(BeZero())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(ReasonDPUClusterNotReady))
+		Expect(WaitingForReadiness(bridge)).To(BeTrue())
+
+		dpuCluster.Status.Phase = dpuprovisioningv1alpha1.PhaseReady
+		_, cond = check()
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(WaitingForReadiness(bridge)).To(BeFalse())
+	})
+
+	It("should only gate the initial provisioning", func() {
+		bridge.Spec.DPUClusterReadinessPolicy = provisioningv1alpha1.DPUClusterReadinessRequire
+		bridge.Status.HostedClusterRef = &corev1.ObjectReference{Name: "test-bridge", Namespace: "default"}
+
+		_, cond := check()
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(WaitingForReadiness(bridge)).To(BeFalse())
+	})
+
+	It("should stop waiting once the WaitWithTimeout timeout expires", func() {
+		bridge.Spec.DPUClusterReadinessPolicy = provisioningv1alpha1.DPUClusterReadinessWaitWithTimeout
+		bridge.Spec.DPUClusterReadinessTimeout = &metav1.Duration{Duration: 10 * time.Minute}
+
+		requeueAfter, cond := check()
+		Expect(requeueAfter).To(Equal(10 * time.Minute))
+		Expect(cond.Reason).To(Equal(ReasonDPUClusterNotReady))
+		Expect(WaitingForReadiness(bridge)).To(BeTrue())
+
+		now = now.Add(4 * time.Minute)
+		requeueAfter, _ = check()
+		Expect(requeueAfter).To(Equal(6 * time.Minute))
+
+		now = now.Add(6 * time.Minute)
+		requeueAfter, cond = check()
+		Expect(requeueAfter).To(BeZero())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(ReasonReadinessTimeoutExpired))
+		Expect(WaitingForReadiness(bridge)).To(BeFalse())
+	})
+})
@@ -19,6 +19,9 @@ package dpucluster
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -44,12 +47,19 @@ const (
 	ReasonClusterTypeValid       = "ClusterTypeValid"
 	ReasonDPUClusterInUse        = "DPUClusterInUse"
 	ReasonDPUClusterAvailable    = "DPUClusterAvailable"
+
+	ReasonDPUClusterSelectorInvalid   = "DPUClusterSelectorInvalid"
+	ReasonDPUClusterSelectorNoMatch   = "DPUClusterSelectorNoMatch"
+	ReasonDPUClusterSelectorAmbiguous = "DPUClusterSelectorAmbiguous"
 )
 
 // Validator validates DPUCluster references and updates status accordingly
 type Validator struct {
 	client   client.Client
 	recorder record.EventRecorder
+
+	// now returns the current time; overridden in tests
+	now func() time.Time
 }
 
 // NewValidator creates a new DPUCluster validator
@@ -60,70 +70,174 @@ func NewValidator(client client.Client, recorder record.EventRecorder) *Validato
 	}
 }
 
-// ValidateDPUCluster validates that the referenced DPUCluster exists
+// ValidateDPUCluster validates that the referenced DPUClusters exist.
+// A bridge with spec.dpuClusterRefs is only valid if every DPUCluster is; the conditions report the
+// first one that is not.
 func (v *Validator) ValidateDPUCluster(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
 	log := logf.FromContext(ctx).WithValues("feature", "dpucluster-validation")
 
-	// Get reference to DPUCluster
-	dpuClusterRef := cr.Spec.DPUClusterRef
-	log.V(1).Info("Validating DPUCluster reference",
-		"dpuClusterName", dpuClusterRef.Name,
-		"dpuClusterNamespace", dpuClusterRef.Namespace)
+	// Resolve spec.dpuClusterSelector to a single DPUCluster first
+	if cr.Spec.DPUClusterSelector != nil && cr.Status.DPUClusterRef == nil {
+		if resolved, err := v.resolveDPUClusterSelector(ctx, cr); err != nil || !resolved {
+			return ctrl.Result{}, err
+		}
+	}
 
-	// Attempt to fetch the DPUCluster
-	var dpuCluster dpuprovisioningv1alpha1.DPUCluster
-	err := v.client.Get(ctx, types.NamespacedName{
-		Name:      dpuClusterRef.Name,
-		Namespace: dpuClusterRef.Namespace,
-	}, &dpuCluster)
+	dpuClusterRefs := cr.ResolvedDPUClusterRefs()
+	if len(dpuClusterRefs) == 0 {
+		// Rejected by admission; reported as a missing DPUCluster
+		dpuClusterRefs = []provisioningv1alpha1.DPUClusterReference{cr.ResolvedDPUClusterRef()}
+	}
+	dpuClusters := make([]dpuprovisioningv1alpha1.DPUCluster, len(dpuClusterRefs))
+	for i, dpuClusterRef := range dpuClusterRefs {
+		log.V(1).Info("Validating DPUCluster reference",
+			"dpuClusterName", dpuClusterRef.Name,
+			"dpuClusterNamespace", dpuClusterRef.Namespace)
 
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			// DPUCluster not found - set DPUClusterMissing=True
-			return v.handleDPUClusterMissing(ctx, cr, dpuClusterRef)
+		// Attempt to fetch the DPUCluster
+		err := v.client.Get(ctx, types.NamespacedName{
+			Name:      dpuClusterRef.Name,
+			Namespace: dpuClusterRef.Namespace,
+		}, &dpuClusters[i])
+
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// DPUCluster not found - set DPUClusterMissing=True
+				return v.handleDPUClusterMissing(ctx, cr, dpuClusterRef)
+			}
+
+			if apierrors.IsForbidden(err) {
+				// RBAC permission denied - permanent error
+				return v.handleDPUClusterAccessDenied(ctx, cr, dpuClusterRef, err)
+			}
+
+			// Other transient errors (network timeout, API server error)
+			log.V(1).Info("Transient error fetching DPUCluster, will retry",
+				"error", err.Error())
+			return ctrl.Result{Requeue: true}, err
 		}
+	}
+
+	// DPUClusters exist - validate cluster types are not kamaji
+	if result, err := v.validateClusterType(ctx, cr, dpuClusters); err != nil || result.Requeue || result.RequeueAfter > 0 {
+		return result, err
+	}
+
+	// DPUClusters exist and types are valid - validate exclusivity (not in use by another DPFHCPBridge)
+	if result, err := v.validateDPUClusterExclusivity(ctx, cr, dpuClusters); err != nil || result.Requeue || result.RequeueAfter > 0 {
+		return result, err
+	}
+
+	// DPUClusters exist, types are valid, and not in use - set DPUClusterMissing=False
+	return v.handleDPUClusterFound(ctx, cr, dpuClusters)
+}
 
+// describeDPUClusters returns the DPUClusters as a quoted, comma-separated list of namespace/name
+func describeDPUClusters(dpuClusters []dpuprovisioningv1alpha1.DPUCluster) string {
+	names := make([]string, 0, len(dpuClusters))
+	for _, dpuCluster := range dpuClusters {
+		names = append(names, fmt.Sprintf("'%s/%s'", dpuCluster.Namespace, dpuCluster.Name))
+	}
+	return strings.Join(names, ", ")
+}
+
+// resolveDPUClusterSelector looks up the DPUCluster matching spec.dpuClusterSelector and records it in
+// status.dpuClusterRef, so that the bridge keeps referring to it even if more DPUClusters match later.
+// Returns false if the selector does not match exactly one DPUCluster; DPUClusterMissing is then set
+// and reconciliation is not requeued, the DPUCluster watch triggers it again.
+func (v *Validator) resolveDPUClusterSelector(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (bool, error) {
+	log := logf.FromContext(ctx).WithValues("feature", "dpucluster-validation")
+
+	selector, err := metav1.LabelSelectorAsSelector(cr.Spec.DPUClusterSelector)
+	if err != nil {
+		return false, v.handleDPUClusterSelectorUnresolved(ctx, cr, ReasonDPUClusterSelectorInvalid,
+			fmt.Sprintf("Invalid dpuClusterSelector: %v", err))
+	}
+
+	var dpuClusters dpuprovisioningv1alpha1.DPUClusterList
+	if err := v.client.List(ctx, &dpuClusters, client.MatchingLabelsSelector{Selector: selector}); err != nil {
 		if apierrors.IsForbidden(err) {
-			// RBAC permission denied - permanent error
-			return v.handleDPUClusterAccessDenied(ctx, cr, dpuClusterRef, err)
+			return false, v.handleDPUClusterSelectorUnresolved(ctx, cr, ReasonDPUClusterAccessDenied,
+				fmt.Sprintf("Operator lacks RBAC permissions to list DPUClusters: %v", err))
 		}
+		return false, fmt.Errorf("failed to list DPUClusters matching dpuClusterSelector: %w", err)
+	}
 
-		// Other transient errors (network timeout, API server error)
-		log.V(1).Info("Transient error fetching DPUCluster, will retry",
-			"error", err.Error())
-		return ctrl.Result{Requeue: true}, err
+	switch len(dpuClusters.Items) {
+	case 0:
+		return false, v.handleDPUClusterSelectorUnresolved(ctx, cr, ReasonDPUClusterSelectorNoMatch,
+			fmt.Sprintf("No DPUCluster matches dpuClusterSelector '%s', waiting for one to be created", selector))
+	case 1:
+		dpuCluster := dpuClusters.Items[0]
+		cr.Status.DPUClusterRef = &provisioningv1alpha1.DPUClusterReference{
+			Name:      dpuCluster.Name,
+			Namespace: dpuCluster.Namespace,
+		}
+		log.Info("Resolved dpuClusterSelector",
+			"dpuClusterName", dpuCluster.Name,
+			"dpuClusterNamespace", dpuCluster.Namespace)
+		return true, nil
+	default:
+		names := make([]string, 0, len(dpuClusters.Items))
+		for _, dpuCluster := range dpuClusters.Items {
+			names = append(names, dpuCluster.Namespace+"/"+dpuCluster.Name)
+		}
+		sort.Strings(names)
+		return false, v.handleDPUClusterSelectorUnresolved(ctx, cr, ReasonDPUClusterSelectorAmbiguous,
+			fmt.Sprintf("dpuClusterSelector '%s' matches %d DPUClusters (%s), it must match exactly one",
+				selector, len(names), strings.Join(names, ", ")))
 	}
+}
 
-	// DPUCluster exists - validate cluster type is not kamaji
-	if result, err := v.validateClusterType(ctx, cr, &dpuCluster); err != nil || result.Requeue || result.RequeueAfter > 0 {
-		return result, err
+// handleDPUClusterSelectorUnresolved sets DPUClusterMissing=True when spec.dpuClusterSelector cannot be resolved
+func (v *Validator) handleDPUClusterSelectorUnresolved(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, reason, message string) error {
+	log := logf.FromContext(ctx).WithValues("feature", "dpucluster-validation")
+
+	condition := metav1.Condition{
+		Type:               provisioningv1alpha1.DPUClusterMissing,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: cr.Generation,
 	}
 
-	// DPUCluster exists and type is valid - validate exclusivity (not in use by another DPFHCPBridge)
-	if result, err := v.validateDPUClusterExclusivity(ctx, cr, &dpuCluster); err != nil || result.Requeue || result.RequeueAfter > 0 {
-		return result, err
+	// Emit event only if condition changed
+	if changed := meta.SetStatusCondition(&cr.Status.Conditions, condition); changed {
+		v.recorder.Event(cr, corev1.EventTypeWarning, reason, message)
+		log.Info("dpuClusterSelector could not be resolved", "reason", reason)
+	}
+
+	// Update status
+	if err := v.client.Status().Update(ctx, cr); err != nil {
+		log.Error(err, "Failed to update status")
+		return err
 	}
 
-	// DPUCluster exists, type is valid, and not in use - set DPUClusterMissing=False
-	return v.handleDPUClusterFound(ctx, cr, &dpuCluster)
+	// Do NOT requeue - the DPUCluster watch re-triggers resolution when DPUClusters or their labels change
+	return nil
 }
 
-// validateClusterType validates that DPUCluster.Spec.Type is not kamaji
+// validateClusterType validates that DPUCluster.Spec.Type is not kamaji for every DPUCluster
 // This operator only supports non-Kamaji cluster types
-func (v *Validator) validateClusterType(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, dpuCluster *dpuprovisioningv1alpha1.DPUCluster) (ctrl.Result, error) {
+func (v *Validator) validateClusterType(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, dpuClusters []dpuprovisioningv1alpha1.DPUCluster) (ctrl.Result, error) {
 	log := logf.FromContext(ctx).WithValues("feature", "dpucluster-validation")
 
-	log.V(1).Info("Validating cluster type",
-		"dpuClusterType", dpuCluster.Spec.Type)
-
-	if dpuCluster.Spec.Type == string(dpuprovisioningv1alpha1.KamajiCluster) {
-		log.Error(nil, "Kamaji cluster type is not supported",
+	for i := range dpuClusters {
+		dpuCluster := &dpuClusters[i]
+		log.V(1).Info("Validating cluster type",
+			"dpuCluster", dpuCluster.Namespace+"/"+dpuCluster.Name,
 			"dpuClusterType", dpuCluster.Spec.Type)
-		return v.handleClusterTypeInvalid(ctx, cr, dpuCluster)
+
+		if dpuCluster.Spec.Type == string(dpuprovisioningv1alpha1.KamajiCluster) {
+			log.Error(nil, "Kamaji cluster type is not supported",
+				"dpuClusterType", dpuCluster.Spec.Type)
+			return v.handleClusterTypeInvalid(ctx, cr, dpuCluster)
+		}
 	}
 
-	// Type is valid (not kamaji) - set ClusterTypeValid=True
-	return v.handleClusterTypeValid(ctx, cr, dpuCluster)
+	// Types are valid (not kamaji) - set ClusterTypeValid=True
+	return v.handleClusterTypeValid(ctx, cr, dpuClusters)
 }
 
 // handleClusterTypeInvalid handles the case when DPUCluster type is kamaji (unsupported)
@@ -161,13 +275,17 @@ func (v *Validator) handleClusterTypeInvalid(ctx context.Context, cr *provisioni
 	return ctrl.Result{}, nil
 }
 
-// handleClusterTypeValid handles the case when DPUCluster type is valid (not kamaji)
-func (v *Validator) handleClusterTypeValid(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, dpuCluster *dpuprovisioningv1alpha1.DPUCluster) (ctrl.Result, error) {
+// handleClusterTypeValid handles the case when the DPUCluster types are valid (not kamaji)
+func (v *Validator) handleClusterTypeValid(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, dpuClusters []dpuprovisioningv1alpha1.DPUCluster) (ctrl.Result, error) {
 	log := logf.FromContext(ctx).WithValues("feature", "dpucluster-validation")
 
 	// Note: Phase will be computed from conditions by the reconciler
 
+	dpuCluster := &dpuClusters[0]
 	message := fmt.Sprintf("DPUCluster type '%s' is supported", dpuCluster.Spec.Type)
+	if len(dpuClusters) > 1 {
+		message = fmt.Sprintf("DPUClusters %s have supported types", describeDPUClusters(dpuClusters))
+	}
 
 	// Set condition and check if it changed
 	condition := metav1.Condition{
@@ -196,15 +314,11 @@ func (v *Validator) handleClusterTypeValid(ctx context.Context, cr *provisioning
 	return ctrl.Result{}, nil
 }
 
-// validateDPUClusterExclusivity validates that the DPUCluster is not already in use by another DPFHCPBridge
-// Ensures 1:1 relationship between DPFHCPBridge and DPUCluster
-func (v *Validator) validateDPUClusterExclusivity(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, dpuCluster *dpuprovisioningv1alpha1.DPUCluster) (ctrl.Result, error) {
+// validateDPUClusterExclusivity validates that the DPUClusters are not already in use by another DPFHCPBridge
+// Ensures each DPUCluster backs a single DPFHCPBridge
+func (v *Validator) validateDPUClusterExclusivity(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, dpuClusters []dpuprovisioningv1alpha1.DPUCluster) (ctrl.Result, error) {
 	log := logf.FromContext(ctx).WithValues("feature", "dpucluster-exclusivity")
 
-	log.V(1).Info("Validating DPUCluster exclusivity",
-		"dpuClusterName", dpuCluster.Name,
-		"dpuClusterNamespace", dpuCluster.Namespace)
-
 	// List all DPFHCPBridge resources in the cluster
 	var bridgeList provisioningv1alpha1.DPFHCPBridgeList
 	if err := v.client.List(ctx, &bridgeList); err != nil {
@@ -212,23 +326,29 @@ func (v *Validator) validateDPUClusterExclusivity(ctx context.Context, cr *provi
 		return ctrl.Result{Requeue: true}, err
 	}
 
-	// Check if any OTHER DPFHCPBridge references the same DPUCluster
-	for _, bridge := range bridgeList.Items {
-		// Skip the current DPFHCPBridge (compare by namespace/name)
-		if bridge.Namespace == cr.Namespace && bridge.Name == cr.Name {
-			continue
-		}
+	for i := range dpuClusters {
+		dpuCluster := &dpuClusters[i]
+		log.V(1).Info("Validating DPUCluster exclusivity",
+			"dpuClusterName", dpuCluster.Name,
+			"dpuClusterNamespace", dpuCluster.Namespace)
 
-		// Check if this bridge references the same DPUCluster
-		if bridge.Spec.DPUClusterRef.Name == dpuCluster.Name &&
-			bridge.Spec.DPUClusterRef.Namespace == dpuCluster.Namespace {
-			// Found another DPFHCPBridge using this DPUCluster
-			return v.handleDPUClusterInUse(ctx, cr, dpuCluster, &bridge)
+		// Check if any OTHER DPFHCPBridge references the same DPUCluster
+		for _, bridge := range bridgeList.Items {
+			// Skip the current DPFHCPBridge (compare by namespace/name)
+			if bridge.Namespace == cr.Namespace && bridge.Name == cr.Name {
+				continue
+			}
+
+			// Check if this bridge references the same DPUCluster
+			if bridge.RefersToDPUCluster(dpuCluster.Name, dpuCluster.Namespace) {
+				// Found another DPFHCPBridge using this DPUCluster
+				return v.handleDPUClusterInUse(ctx, cr, dpuCluster, &bridge)
+			}
 		}
 	}
 
-	// No other DPFHCPBridge is using this DPUCluster - it's available
-	return v.handleDPUClusterAvailable(ctx, cr, dpuCluster)
+	// No other DPFHCPBridge is using these DPUClusters - they are available
+	return v.handleDPUClusterAvailable(ctx, cr, dpuClusters)
 }
 
 // handleDPUClusterInUse handles the case when DPUCluster is already in use by another DPFHCPBridge
@@ -268,12 +388,16 @@ func (v *Validator) handleDPUClusterInUse(ctx context.Context, cr *provisioningv
 	return ctrl.Result{}, nil
 }
 
-// handleDPUClusterAvailable handles the case when DPUCluster is available (not in use)
-func (v *Validator) handleDPUClusterAvailable(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, dpuCluster *dpuprovisioningv1alpha1.DPUCluster) (ctrl.Result, error) {
+// handleDPUClusterAvailable handles the case when the DPUClusters are available (not in use)
+func (v *Validator) handleDPUClusterAvailable(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, dpuClusters []dpuprovisioningv1alpha1.DPUCluster) (ctrl.Result, error) {
 	log := logf.FromContext(ctx).WithValues("feature", "dpucluster-exclusivity")
 
-	message := fmt.Sprintf("DPUCluster '%s/%s' is available (not in use by another DPFHCPBridge)",
-		dpuCluster.Namespace, dpuCluster.Name)
+	message := fmt.Sprintf("DPUCluster %s is available (not in use by another DPFHCPBridge)",
+		describeDPUClusters(dpuClusters))
+	if len(dpuClusters) > 1 {
+		message = fmt.Sprintf("DPUClusters %s are available (not in use by another DPFHCPBridge)",
+			describeDPUClusters(dpuClusters))
+	}
 
 	// Set condition and check if it changed
 	condition := metav1.Condition{
@@ -289,8 +413,7 @@ func (v *Validator) handleDPUClusterAvailable(ctx context.Context, cr *provision
 	if changed := meta.SetStatusCondition(&cr.Status.Conditions, condition); changed {
 		v.recorder.Event(cr, corev1.EventTypeNormal, ReasonDPUClusterAvailable, message)
 		log.Info("DPUCluster is available",
-			"dpuClusterName", dpuCluster.Name,
-			"dpuClusterNamespace", dpuCluster.Namespace)
+			"dpuClusters", describeDPUClusters(dpuClusters))
 	}
 
 	// Update status
@@ -319,8 +442,8 @@ func (v *Validator) handleDPUClusterMissing(ctx context.Context, cr *provisionin
 			dpuClusterRef.Name, dpuClusterRef.Namespace)
 		reason = ReasonDPUClusterDeleted
 	} else {
-		// DPUCluster never existed or still missing
-		message = fmt.Sprintf("Referenced DPUCluster '%s' not found in namespace '%s'",
+		// DPUCluster never existed or still missing - the bridge waits in Pending for it to be created
+		message = fmt.Sprintf("Referenced DPUCluster '%s' not found in namespace '%s', waiting for it to be created",
 			dpuClusterRef.Name, dpuClusterRef.Namespace)
 		reason = ReasonDPUClusterNotFound
 	}
@@ -350,8 +473,8 @@ func (v *Validator) handleDPUClusterMissing(ctx context.Context, cr *provisionin
 		return ctrl.Result{}, err
 	}
 
-	// Do NOT requeue - this is a permanent error requiring manual intervention
-	// User must either create the DPUCluster or delete the DPFHCPBridge
+	// Do NOT requeue - the DPUCluster watch triggers reconciliation once the DPUCluster is created
+	// A deleted DPUCluster is a permanent error: the user must delete the DPFHCPBridge
 	return ctrl.Result{}, nil
 }
 
@@ -390,12 +513,15 @@ func (v *Validator) handleDPUClusterAccessDenied(ctx context.Context, cr *provis
 	return ctrl.Result{}, nil
 }
 
-// handleDPUClusterFound handles the case when DPUCluster is found
-func (v *Validator) handleDPUClusterFound(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, dpuCluster *dpuprovisioningv1alpha1.DPUCluster) (ctrl.Result, error) {
+// handleDPUClusterFound handles the case when the DPUClusters are found
+func (v *Validator) handleDPUClusterFound(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, dpuClusters []dpuprovisioningv1alpha1.DPUCluster) (ctrl.Result, error) {
 	log := logf.FromContext(ctx).WithValues("feature", "dpucluster-validation")
 
 	message := fmt.Sprintf("DPUCluster '%s' found in namespace '%s'",
-		dpuCluster.Name, dpuCluster.Namespace)
+		dpuClusters[0].Name, dpuClusters[0].Namespace)
+	if len(dpuClusters) > 1 {
+		message = fmt.Sprintf("DPUClusters %s found", describeDPUClusters(dpuClusters))
+	}
 
 	// Set condition and check if it changed
 	condition := metav1.Condition{
@@ -411,8 +537,7 @@ func (v *Validator) handleDPUClusterFound(ctx context.Context, cr *provisioningv
 	if changed := meta.SetStatusCondition(&cr.Status.Conditions, condition); changed {
 		v.recorder.Event(cr, corev1.EventTypeNormal, ReasonDPUClusterFound, message)
 		log.Info("DPUCluster found",
-			"dpuClusterName", dpuCluster.Name,
-			"dpuClusterNamespace", dpuCluster.Namespace)
+			"dpuClusters", describeDPUClusters(dpuClusters))
 	}
 
 	// Update status
@@ -987,6 +987,176 @@ var _ = Describe("DPUCluster Validator", func() {
 				Consistently(recorder.Events, "500ms").ShouldNot(Receive())
 			})
 		})
+
+		Context("when the DPUCluster is selected by label", func() {
+			var bridge *provisioningv1alpha1.DPFHCPBridge
+
+			labelledDPUCluster := func(name, namespace string) *dpuprovisioningv1alpha1.DPUCluster {
+				return &dpuprovisioningv1alpha1.DPUCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: namespace,
+						Labels:    map[string]string{"dpf.example.com/site": "lab-1"},
+					},
+				}
+			}
+
+			validate := func(objs ...client.Object) *metav1.Condition {
+				fakeClient = fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(append(objs, bridge)...).
+					WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+					Build()
+				validator = NewValidator(fakeClient, recorder)
+
+				result, err := validator.ValidateDPUCluster(ctx, bridge)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Requeue).To(BeFalse())
+				Expect(result.RequeueAfter).To(BeZero())
+
+				var updatedBridge provisioningv1alpha1.DPFHCPBridge
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(bridge), &updatedBridge)).To(Succeed())
+				bridge.Status = updatedBridge.Status
+				return meta.FindStatusCondition(updatedBridge.Status.Conditions, provisioningv1alpha1.DPUClusterMissing)
+			}
+
+			BeforeEach(func() {
+				bridge = &provisioningv1alpha1.DPFHCPBridge{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "test-bridge",
+						Namespace:  "default",
+						Generation: 1,
+					},
+					Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+						DPUClusterSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"dpf.example.com/site": "lab-1"},
+						},
+					},
+				}
+			})
+
+			It("should resolve a single matching DPUCluster and record it in status", func() {
+				condition := validate(labelledDPUCluster("dpu-x7k2p", "dpu-system"))
+
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(condition.Reason).To(Equal(ReasonDPUClusterFound))
+				Expect(bridge.Status.DPUClusterRef).To(Equal(&provisioningv1alpha1.DPUClusterReference{
+					Name: "dpu-x7k2p", Namespace: "dpu-system",
+				}))
+				Expect(bridge.ResolvedDPUClusterRef().Name).To(Equal("dpu-x7k2p"))
+			})
+
+			It("should set DPUClusterMissing=True when no DPUCluster matches", func() {
+				condition := validate()
+
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(ReasonDPUClusterSelectorNoMatch))
+				Expect(bridge.Status.DPUClusterRef).To(BeNil())
+				Eventually(recorder.Events).Should(Receive(ContainSubstring(ReasonDPUClusterSelectorNoMatch)))
+			})
+
+			It("should set DPUClusterMissing=True when several DPUClusters match", func() {
+				condition := validate(labelledDPUCluster("dpu-a", "dpu-system"), labelledDPUCluster("dpu-b", "dpu-other"))
+
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(ReasonDPUClusterSelectorAmbiguous))
+				Expect(condition.Message).To(ContainSubstring("dpu-other/dpu-b, dpu-system/dpu-a"))
+				Expect(bridge.Status.DPUClusterRef).To(BeNil())
+			})
+
+			It("should keep the resolved DPUCluster when more DPUClusters match later", func() {
+				bridge.Status.DPUClusterRef = &provisioningv1alpha1.DPUClusterReference{Name: "dpu-a", Namespace: "dpu-system"}
+
+				condition := validate(labelledDPUCluster("dpu-a", "dpu-system"), labelledDPUCluster("dpu-b", "dpu-system"))
+
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(bridge.Status.DPUClusterRef.Name).To(Equal("dpu-a"))
+			})
+		})
+
+		Context("when the bridge references several DPUClusters", func() {
+			var bridge *provisioningv1alpha1.DPFHCPBridge
+
+			dpuCluster := func(name, namespace, clusterType string) *dpuprovisioningv1alpha1.DPUCluster {
+				return &dpuprovisioningv1alpha1.DPUCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec:       dpuprovisioningv1alpha1.DPUClusterSpec{Type: clusterType},
+				}
+			}
+
+			validate := func(objs ...client.Object) []metav1.Condition {
+				fakeClient = fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(append(objs, bridge)...).
+					WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+					Build()
+				validator = NewValidator(fakeClient, recorder)
+
+				_, err := validator.ValidateDPUCluster(ctx, bridge)
+				Expect(err).ToNot(HaveOccurred())
+
+				var updatedBridge provisioningv1alpha1.DPFHCPBridge
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(bridge), &updatedBridge)).To(Succeed())
+				return updatedBridge.Status.Conditions
+			}
+
+			BeforeEach(func() {
+				bridge = &provisioningv1alpha1.DPFHCPBridge{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "test-bridge",
+						Namespace:  "default",
+						Generation: 1,
+					},
+					Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+						DPUClusterRefs: []provisioningv1alpha1.DPUClusterReference{
+							{Name: "dpu-east", Namespace: "dpf-east"},
+							{Name: "dpu-west", Namespace: "dpf-west"},
+						},
+					},
+				}
+			})
+
+			It("should validate every DPUCluster", func() {
+				conditions := validate(dpuCluster("dpu-east", "dpf-east", "static"), dpuCluster("dpu-west", "dpf-west", "static"))
+
+				missing := meta.FindStatusCondition(conditions, provisioningv1alpha1.DPUClusterMissing)
+				Expect(missing.Status).To(Equal(metav1.ConditionFalse))
+				Expect(missing.Message).To(Equal("DPUClusters 'dpf-east/dpu-east', 'dpf-west/dpu-west' found"))
+				Expect(meta.IsStatusConditionTrue(conditions, provisioningv1alpha1.ClusterTypeValid)).To(BeTrue())
+				Expect(meta.IsStatusConditionFalse(conditions, provisioningv1alpha1.DPUClusterInUse)).To(BeTrue())
+			})
+
+			It("should report a missing DPUCluster that is not the first", func() {
+				conditions := validate(dpuCluster("dpu-east", "dpf-east", "static"))
+
+				missing := meta.FindStatusCondition(conditions, provisioningv1alpha1.DPUClusterMissing)
+				Expect(missing.Status).To(Equal(metav1.ConditionTrue))
+				Expect(missing.Reason).To(Equal(ReasonDPUClusterNotFound))
+				Expect(missing.Message).To(ContainSubstring("'dpu-west' not found in namespace 'dpf-west'"))
+			})
+
+			It("should reject an unsupported DPUCluster type", func() {
+				conditions := validate(dpuCluster("dpu-east", "dpf-east", "static"), dpuCluster("dpu-west", "dpf-west", "kamaji"))
+
+				clusterType := meta.FindStatusCondition(conditions, provisioningv1alpha1.ClusterTypeValid)
+				Expect(clusterType.Status).To(Equal(metav1.ConditionFalse))
+				Expect(clusterType.Message).To(ContainSubstring("DPUCluster 'dpu-west'"))
+			})
+
+			It("should report a DPUCluster used by another bridge", func() {
+				other := &provisioningv1alpha1.DPFHCPBridge{
+					ObjectMeta: metav1.ObjectMeta{Name: "other-bridge", Namespace: "default"},
+					Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+						DPUClusterRef: provisioningv1alpha1.DPUClusterReference{Name: "dpu-west", Namespace: "dpf-west"},
+					},
+				}
+				conditions := validate(dpuCluster("dpu-east", "dpf-east", "static"), dpuCluster("dpu-west", "dpf-west", "static"), other)
+
+				inUse := meta.FindStatusCondition(conditions, provisioningv1alpha1.DPUClusterInUse)
+				Expect(inUse.Status).To(Equal(metav1.ConditionTrue))
+				Expect(inUse.Message).To(ContainSubstring("'dpf-west/dpu-west' is already in use by DPFHCPBridge 'default/other-bridge'"))
+			})
+		})
 	})
 })
 
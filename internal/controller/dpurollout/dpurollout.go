@@ -0,0 +1,182 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dpurollout holds back NodePool upgrades while DPF rolls out DPUDeployments and DPUServices to the
+// DPUs of a bridge, so that the DPU workers are not replaced while their DPU services are being updated.
+package dpurollout
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=svc.dpu.nvidia.com,resources=dpudeployments;dpuservices,verbs=get;list;watch
+
+var (
+	// DPUDeploymentGVK is the DPF DPUDeployment kind. DPUDeployments and DPUServices are handled as unstructured
+	// objects, so that the operator does not depend on the DPF service API module.
+	DPUDeploymentGVK = schema.GroupVersionKind{Group: "svc.dpu.nvidia.com", Version: "v1alpha1", Kind: "DPUDeployment"}
+
+	// DPUServiceGVK is the DPF DPUService kind, one per service deployed to the DPUs
+	DPUServiceGVK = schema.GroupVersionKind{Group: "svc.dpu.nvidia.com", Version: "v1alpha1", Kind: "DPUService"}
+
+	// Kinds are the DPF kinds whose rollouts hold back NodePool upgrades
+	Kinds = []schema.GroupVersionKind{DPUDeploymentGVK, DPUServiceGVK}
+)
+
+// DefaultRecheckInterval is how often a held back NodePool upgrade is retried, besides the DPF object watches
+const DefaultRecheckInterval = time.Minute
+
+// readyCondition is the condition DPF reports on DPUDeployments and DPUServices once they are rolled out
+const readyCondition = "Ready"
+
+// Guard reports the DPUDeployments and DPUServices in the namespaces of the DPUClusters of a bridge that are
+// rolling out. The NodePoolManager defers NodePool release image changes while there are any.
+type Guard struct {
+	client client.Client
+
+	// Selector, if set, restricts the DPUDeployments and DPUServices holding back upgrades to the critical ones
+	// whose labels match it
+	Selector labels.Selector
+
+	// RecheckInterval is how often a held back NodePool upgrade is retried
+	RecheckInterval time.Duration
+}
+
+// NewGuard creates a new Guard holding back NodePool upgrades during the rollout of any DPUDeployment or DPUService
+func NewGuard(c client.Client) *Guard {
+	return &Guard{
+		client:          c,
+		RecheckInterval: DefaultRecheckInterval,
+	}
+}
+
+// Rollouts returns the DPUDeployments and DPUServices in the namespaces of the DPUClusters of the bridge that are
+// rolling out, as "<kind> <namespace>/<name>". Kinds the management cluster does not serve are skipped.
+// It returns nil if g is nil.
+func (g *Guard) Rollouts(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) ([]string, error) {
+	if g == nil {
+		return nil, nil
+	}
+	log := logf.FromContext(ctx)
+
+	opts := []client.ListOption{}
+	if g.Selector != nil {
+		opts = append(opts, client.MatchingLabelsSelector{Selector: g.Selector})
+	}
+
+	var rollouts []string
+	for _, namespace := range dpuClusterNamespaces(cr) {
+		for _, gvk := range Kinds {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+			if err := g.client.List(ctx, list, append(opts, client.InNamespace(namespace))...); err != nil {
+				if meta.IsNoMatchError(err) {
+					log.V(1).Info("DPF service API not installed, not checking its rollouts", "kind", gvk.Kind)
+					continue
+				}
+				return nil, fmt.Errorf("failed to list %s objects in namespace %s: %w", gvk.Kind, namespace, err)
+			}
+			for i := range list.Items {
+				if RollingOut(&list.Items[i]) {
+					rollouts = append(rollouts, fmt.Sprintf("%s %s/%s", gvk.Kind, namespace, list.Items[i].GetName()))
+				}
+			}
+		}
+	}
+	return rollouts, nil
+}
+
+// RollingOut returns true if DPF has not finished rolling out the DPUDeployment or DPUService: the latest
+// generation was not observed yet, or it is not Ready. Objects being deleted are not rolling out.
+func RollingOut(obj *unstructured.Unstructured) bool {
+	if obj.GetDeletionTimestamp() != nil {
+		return false
+	}
+	if observed, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration"); found && observed < obj.GetGeneration() {
+		return true
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if ok && condition["type"] == readyCondition {
+			return condition["status"] != "True"
+		}
+	}
+	// Not reconciled by DPF yet
+	return true
+}
+
+// dpuClusterNamespaces returns the namespaces of the DPUClusters of the bridge, without duplicates
+func dpuClusterNamespaces(cr *provisioningv1alpha1.DPFHCPBridge) []string {
+	var namespaces []string
+	for _, ref := range cr.ResolvedDPUClusterRefs() {
+		if !slices.Contains(namespaces, ref.Namespace) {
+			namespaces = append(namespaces, ref.Namespace)
+		}
+	}
+	return namespaces
+}
+
+// FindBridgesForDPUService maps a DPUDeployment or DPUService to the bridges with a DPUCluster in its
+// namespace, so that a held back NodePool upgrade proceeds as soon as the rollout finishes
+func FindBridgesForDPUService(ctx context.Context, c client.Client, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	var bridges provisioningv1alpha1.DPFHCPBridgeList
+	if err := c.List(ctx, &bridges); err != nil {
+		log.Error(err, "Failed to list DPFHCPBridges for DPU service watch")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range bridges.Items {
+		if slices.Contains(dpuClusterNamespaces(&bridges.Items[i]), obj.GetNamespace()) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      bridges.Items[i].Name,
+				Namespace: bridges.Items[i].Namespace,
+			}})
+		}
+	}
+	return requests
+}
+
+// RolloutChangedPredicate only lets through the DPUDeployment and DPUService events that start or finish a rollout
+func RolloutChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldObj, okOld := e.ObjectOld.(*unstructured.Unstructured)
+			newObj, okNew := e.ObjectNew.(*unstructured.Unstructured)
+			return !okOld || !okNew || RollingOut(oldObj) != RollingOut(newObj)
+		},
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dpurollout
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// newDPUService returns a DPUService of the given generation, Ready as given or not reconciled by DPF yet
+func newDPUService(name, namespace string, generation, observed int64, ready string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	obj.SetGroupVersionKind(DPUServiceGVK)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	obj.SetGeneration(generation)
+	if ready != "" {
+		obj.Object["status"] = map[string]interface{}{
+			"observedGeneration": observed,
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": ready},
+			},
+		}
+	}
+	return obj
+}
+
+var _ = Describe("DPU Rollout Guard", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		cr     *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef: provisioningv1alpha1.DPUClusterReference{Name: "dpu-x7k2p", Namespace: "dpu-system"},
+			},
+		}
+	})
+
+	Describe("RollingOut", func() {
+		It("should report a rolled out DPUService as not rolling out", func() {
+			Expect(RollingOut(newDPUService("ovn", "dpu-system", 2, 2, "True"))).To(BeFalse())
+		})
+
+		It("should report a DPUService as rolling out until its generation is observed and Ready", func() {
+			Expect(RollingOut(newDPUService("ovn", "dpu-system", 3, 2, "True"))).To(BeTrue())
+			Expect(RollingOut(newDPUService("ovn", "dpu-system", 2, 2, "False"))).To(BeTrue())
+			Expect(RollingOut(newDPUService("ovn", "dpu-system", 1, 0, ""))).To(BeTrue())
+		})
+
+		It("should not report a DPUService being deleted as rolling out", func() {
+			obj := newDPUService("ovn", "dpu-system", 3, 2, "False")
+			obj.SetDeletionTimestamp(ptr.To(metav1.Now()))
+			Expect(RollingOut(obj)).To(BeFalse())
+		})
+	})
+
+	Describe("Rollouts", func() {
+		It("should list the rollouts in the namespaces of the DPUClusters of the bridge", func() {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newDPUService("ovn", "dpu-system", 3, 2, "True"),
+				newDPUService("hbn", "dpu-system", 1, 1, "True"),
+				newDPUService("doca-telemetry", "other-dpus", 1, 1, "False"),
+			).Build()
+
+			rollouts, err := NewGuard(c).Rollouts(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollouts).To(ConsistOf("DPUService dpu-system/ovn"))
+		})
+
+		It("should only consider the critical DPU services matching the selector", func() {
+			critical := newDPUService("ovn", "dpu-system", 3, 2, "True")
+			critical.SetLabels(map[string]string{"tier": "critical"})
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				critical,
+				newDPUService("doca-telemetry", "dpu-system", 1, 1, "False"),
+			).Build()
+
+			guard := NewGuard(c)
+			guard.Selector = labels.SelectorFromSet(labels.Set{"tier": "critical"})
+			rollouts, err := guard.Rollouts(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollouts).To(ConsistOf("DPUService dpu-system/ovn"))
+		})
+
+		It("should skip the DPF service API when it is not installed", func() {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					return &meta.NoKindMatchError{GroupKind: DPUServiceGVK.GroupKind(), SearchedVersions: []string{DPUServiceGVK.Version}}
+				},
+			}).Build()
+
+			rollouts, err := NewGuard(c).Rollouts(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollouts).To(BeEmpty())
+		})
+
+		It("should report no rollouts when disabled", func() {
+			var guard *Guard
+			rollouts, err := guard.Rollouts(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollouts).To(BeNil())
+		})
+	})
+
+	It("should map a DPU service to the bridges with a DPUCluster in its namespace", func() {
+		other := cr.DeepCopy()
+		other.Name = "other-bridge"
+		other.Spec.DPUClusterRef.Namespace = "other-dpus"
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cr, other).Build()
+
+		requests := FindBridgesForDPUService(ctx, c, newDPUService("ovn", "dpu-system", 1, 1, "True"))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Name).To(Equal("test-bridge"))
+	})
+
+	It("should only let through the updates that start or finish a rollout", func() {
+		p := RolloutChangedPredicate()
+		rolledOut := newDPUService("ovn", "dpu-system", 2, 2, "True")
+		Expect(p.Update(event.UpdateEvent{ObjectOld: rolledOut, ObjectNew: newDPUService("ovn", "dpu-system", 3, 2, "True")})).To(BeTrue())
+		Expect(p.Update(event.UpdateEvent{ObjectOld: rolledOut, ObjectNew: rolledOut.DeepCopy()})).To(BeFalse())
+	})
+})
@@ -0,0 +1,287 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventforward
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/manifests"
+)
+
+const (
+	// ConfigMapName is the name of the ConfigMap the bridge events are forwarded to in the hosted cluster
+	ConfigMapName = "dpf-hcp-bridge-events"
+
+	// ConfigMapNamespace is the hosted cluster namespace of the forwarded events ConfigMap
+	ConfigMapNamespace = "openshift-config"
+
+	// DefaultMaxEvents is the number of most recent events forwarded per bridge
+	DefaultMaxEvents = 20
+
+	// DefaultInterval is how often the events of a bridge are forwarded again. Events are not watched,
+	// so events recorded after a reconcile are only forwarded on a later one.
+	DefaultInterval = 5 * time.Minute
+
+	// InvolvedObjectUIDField is the Event field selector used to list the events of a bridge
+	InvolvedObjectUIDField = "involvedObject.uid"
+
+	// hostedClusterKubeconfigSuffix is the suffix of the HostedCluster admin kubeconfig secret
+	hostedClusterKubeconfigSuffix = "-admin-kubeconfig"
+
+	// hostedClusterKubeconfigKey is the key holding the kubeconfig in the admin kubeconfig secret
+	hostedClusterKubeconfigKey = "kubeconfig"
+)
+
+// Forwarder mirrors the events of a DPFHCPBridge into a ConfigMap in the hosted cluster when
+// spec.forwardEventsToHostedCluster is set, so that admins working inside the hosted cluster can
+// see the management-side provisioning and upgrade context.
+//
+// A ConfigMap is used rather than Events, as Events expire after an hour and would need an
+// involved object in the hosted cluster. Forwarding is best effort: failures are logged and
+// retried after Interval without affecting the bridge status.
+type Forwarder struct {
+	client client.Client
+
+	// events lists Events directly from the API server, so that the operator does not cache every
+	// Event of the cluster
+	events client.Reader
+
+	// NewHostedClusterClient builds the hosted cluster client; defaults to manifests.NewHostedClusterClient
+	NewHostedClusterClient manifests.HostedClusterClientFunc
+
+	// MaxEvents is the number of most recent events forwarded; defaults to DefaultMaxEvents
+	MaxEvents int
+
+	// Interval is how often events are forwarded again; defaults to DefaultInterval
+	Interval time.Duration
+
+	mu sync.Mutex
+	// forwarded holds the hash of the ConfigMap data last written per bridge, so that the hosted
+	// cluster is only contacted when there is something new to forward
+	forwarded map[types.UID]string
+}
+
+// NewForwarder creates a new event Forwarder reading Events through the given uncached reader
+func NewForwarder(c client.Client, events client.Reader) *Forwarder {
+	return &Forwarder{
+		client:                 c,
+		events:                 events,
+		NewHostedClusterClient: manifests.NewHostedClusterClient,
+		MaxEvents:              DefaultMaxEvents,
+		Interval:               DefaultInterval,
+		forwarded:              map[types.UID]string{},
+	}
+}
+
+// ForwardEvents writes the most recent events of the bridge to the dpf-hcp-bridge-events ConfigMap
+// in the hosted cluster once the HostedCluster is Available.
+//
+// The ConfigMap is left in place when forwarding is disabled again.
+//
+// Returns ctrl.Result and error for reconciliation flow
+func (f *Forwarder) ForwardEvents(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if !cr.Spec.ForwardEventsToHostedCluster {
+		f.Forget(cr)
+		return ctrl.Result{}, nil
+	}
+
+	if !meta.IsStatusConditionTrue(cr.Status.Conditions, provisioningv1alpha1.HostedClusterAvailable) {
+		log.V(1).Info("Skipping event forwarding - HostedCluster not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	data, err := f.render(ctx, cr)
+	if err != nil {
+		log.Error(err, "Failed to list bridge events for forwarding")
+		return ctrl.Result{RequeueAfter: f.Interval}, nil
+	}
+
+	hash := dataHash(data)
+	if f.lastForwarded(cr.UID) == hash {
+		log.V(1).Info("Bridge events already forwarded to hosted cluster")
+		return ctrl.Result{RequeueAfter: f.Interval}, nil
+	}
+
+	if err := f.write(ctx, cr, data); err != nil {
+		log.Error(err, "Failed to forward bridge events to hosted cluster")
+		return ctrl.Result{RequeueAfter: f.Interval}, nil
+	}
+
+	log.Info("Forwarded bridge events to hosted cluster",
+		"configMap", ConfigMapNamespace+"/"+ConfigMapName)
+	f.setForwarded(cr.UID, hash)
+	return ctrl.Result{RequeueAfter: f.Interval}, nil
+}
+
+// render builds the ConfigMap data from the bridge phase and its most recent events, newest first
+func (f *Forwarder) render(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (map[string]string, error) {
+	events := &corev1.EventList{}
+	if err := f.events.List(ctx, events, client.InNamespace(cr.Namespace),
+		client.MatchingFields{InvolvedObjectUIDField: string(cr.UID)}); err != nil {
+		return nil, fmt.Errorf("failed to list events of DPFHCPBridge %s/%s: %w", cr.Namespace, cr.Name, err)
+	}
+
+	items := events.Items
+	sort.SliceStable(items, func(i, j int) bool {
+		return eventTime(items[i]).After(eventTime(items[j]))
+	})
+	if len(items) > f.MaxEvents {
+		items = items[:f.MaxEvents]
+	}
+
+	var lines strings.Builder
+	for _, event := range items {
+		fmt.Fprintf(&lines, "%s %s %s", eventTime(event).UTC().Format(time.RFC3339), event.Type, event.Reason)
+		if event.Count > 1 {
+			fmt.Fprintf(&lines, " (x%d)", event.Count)
+		}
+		fmt.Fprintf(&lines, ": %s\n", event.Message)
+	}
+
+	return map[string]string{
+		"bridge": cr.Namespace + "/" + cr.Name,
+		"phase":  string(cr.Status.Phase),
+		"events": lines.String(),
+	}, nil
+}
+
+// write creates or updates the events ConfigMap in the hosted cluster
+func (f *Forwarder) write(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, data map[string]string) error {
+	kubeconfig, err := f.hostedClusterKubeconfig(ctx, cr)
+	if err != nil {
+		return fmt.Errorf("failed to get hosted cluster admin kubeconfig: %w", err)
+	}
+
+	hcClient, err := f.NewHostedClusterClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	cm := &corev1.ConfigMap{}
+	err = hcClient.Get(ctx, types.NamespacedName{Name: ConfigMapName, Namespace: ConfigMapNamespace}, cm)
+	if apierrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ConfigMapName,
+				Namespace: ConfigMapNamespace,
+				Labels:    common.ComponentOwnerLabels(cr, common.ComponentEventForwarding),
+			},
+			Data: data,
+		}
+		if err := hcClient.Create(ctx, cm); err != nil {
+			return fmt.Errorf("failed to create ConfigMap %s/%s: %w", ConfigMapNamespace, ConfigMapName, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get ConfigMap %s/%s: %w", ConfigMapNamespace, ConfigMapName, err)
+	}
+
+	common.SetOwnerLabels(cm, cr, common.ComponentEventForwarding)
+	cm.Data = data
+	if err := hcClient.Update(ctx, cm); err != nil {
+		return fmt.Errorf("failed to update ConfigMap %s/%s: %w", ConfigMapNamespace, ConfigMapName, err)
+	}
+	return nil
+}
+
+// hostedClusterKubeconfig reads the HostedCluster admin kubeconfig created by HyperShift
+func (f *Forwarder) hostedClusterKubeconfig(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) ([]byte, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Name: cr.Name + hostedClusterKubeconfigSuffix, Namespace: cr.Namespace}
+	if err := f.client.Get(ctx, key, secret); err != nil {
+		return nil, err
+	}
+
+	kubeconfig, ok := secret.Data[hostedClusterKubeconfigKey]
+	if !ok || len(kubeconfig) == 0 {
+		return nil, apierrors.NewNotFound(corev1.Resource("secrets"), key.Name)
+	}
+	return kubeconfig, nil
+}
+
+func (f *Forwarder) lastForwarded(uid types.UID) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.forwarded[uid]
+}
+
+func (f *Forwarder) setForwarded(uid types.UID, hash string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.forwarded[uid] = hash
+}
+
+// Forget drops what is known about the events forwarded for an object, e.g. once it is deleted
+func (f *Forwarder) Forget(obj client.Object) {
+	if f == nil {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.forwarded, obj.GetUID())
+}
+
+// eventTime returns when an event last occurred, whichever of its timestamps is set
+func eventTime(event corev1.Event) time.Time {
+	switch {
+	case event.Series != nil:
+		return event.Series.LastObservedTime.Time
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// dataHash returns a stable hash of ConfigMap data
+func dataHash(data map[string]string) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hasher := sha256.New()
+	for _, key := range keys {
+		fmt.Fprintf(hasher, "%s\x00%s\x00", key, data[key])
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
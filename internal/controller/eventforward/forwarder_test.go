@@ -0,0 +1,240 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+var _ = Describe("Event Forwarder", func() {
+	var (
+		ctx           context.Context
+		scheme        *runtime.Scheme
+		fakeClient    client.Client
+		hostedClient  client.Client
+		hostedErr     error
+		hostedClients int
+		forwarder     *Forwarder
+		bridge        *provisioningv1alpha1.DPFHCPBridge
+		kubeconfig    *corev1.Secret
+		now           time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		now = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-bridge",
+				Namespace: "test-ns",
+				UID:       types.UID("bridge-uid"),
+			},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				ForwardEventsToHostedCluster: true,
+			},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				Phase: provisioningv1alpha1.PhaseReady,
+			},
+		}
+		meta.SetStatusCondition(&bridge.Status.Conditions, metav1.Condition{
+			Type:   provisioningv1alpha1.HostedClusterAvailable,
+			Status: metav1.ConditionTrue,
+			Reason: "AsExpected",
+		})
+
+		kubeconfig = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge-admin-kubeconfig", Namespace: "test-ns"},
+			Data:       map[string][]byte{"kubeconfig": []byte("fake-kubeconfig-data")},
+		}
+
+		hostedClient = fake.NewClientBuilder().WithScheme(scheme).Build()
+		hostedErr = nil
+		hostedClients = 0
+	})
+
+	event := func(name, reason, message string, uid types.UID, at time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: "DPFHCPBridge", Name: "test-bridge", Namespace: "test-ns", UID: uid},
+			Type:           corev1.EventTypeNormal,
+			Reason:         reason,
+			Message:        message,
+			Count:          1,
+			LastTimestamp:  metav1.NewTime(at),
+		}
+	}
+
+	buildForwarder := func(objs ...client.Object) {
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(append([]client.Object{bridge}, objs...)...).
+			WithIndex(&corev1.Event{}, InvolvedObjectUIDField, func(obj client.Object) []string {
+				return []string{string(obj.(*corev1.Event).InvolvedObject.UID)}
+			}).
+			Build()
+		forwarder = NewForwarder(fakeClient, fakeClient)
+		forwarder.NewHostedClusterClient = func(_ []byte) (client.Client, error) {
+			hostedClients++
+			return hostedClient, hostedErr
+		}
+	}
+
+	forwardedConfigMap := func() *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{}
+		Expect(hostedClient.Get(ctx, types.NamespacedName{Name: ConfigMapName, Namespace: ConfigMapNamespace}, cm)).To(Succeed())
+		return cm
+	}
+
+	It("should do nothing when forwarding is not enabled", func() {
+		bridge.Spec.ForwardEventsToHostedCluster = false
+		buildForwarder(kubeconfig, event("created", "HostedClusterCreated", "created", bridge.UID, now))
+
+		result, err := forwarder.ForwardEvents(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(hostedClients).To(BeZero())
+	})
+
+	It("should skip when the HostedCluster is not available", func() {
+		bridge.Status.Conditions = nil
+		buildForwarder(kubeconfig, event("created", "HostedClusterCreated", "created", bridge.UID, now))
+
+		result, err := forwarder.ForwardEvents(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(hostedClients).To(BeZero())
+	})
+
+	It("should forward the events of the bridge newest first", func() {
+		repeated := event("flapping", "DPUClusterNotReady", "DPUCluster is not ready", bridge.UID, now.Add(-time.Minute))
+		repeated.Type = corev1.EventTypeWarning
+		repeated.Count = 3
+		buildForwarder(kubeconfig,
+			event("created", "HostedClusterCreated", "Created HostedCluster test-ns/test-bridge", bridge.UID, now.Add(-time.Hour)),
+			repeated,
+			event("other", "HostedClusterCreated", "Created HostedCluster test-ns/other", types.UID("other-uid"), now))
+
+		result, err := forwarder.ForwardEvents(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(DefaultInterval))
+
+		cm := forwardedConfigMap()
+		Expect(cm.Labels).To(HaveKeyWithValue(common.LabelComponent, common.ComponentEventForwarding))
+		Expect(cm.Data).To(HaveKeyWithValue("bridge", "test-ns/test-bridge"))
+		Expect(cm.Data).To(HaveKeyWithValue("phase", string(provisioningv1alpha1.PhaseReady)))
+		Expect(cm.Data["events"]).To(Equal(
+			"2026-10-16T11:59:00Z Warning DPUClusterNotReady (x3): DPUCluster is not ready\n" +
+				"2026-10-16T11:00:00Z Normal HostedClusterCreated: Created HostedCluster test-ns/test-bridge\n"))
+	})
+
+	It("should only forward the most recent events", func() {
+		var objs []client.Object
+		for i := range 5 {
+			objs = append(objs, event(fmt.Sprintf("event-%d", i), fmt.Sprintf("Reason%d", i), "message", bridge.UID, now.Add(time.Duration(i)*time.Minute)))
+		}
+		buildForwarder(append(objs, kubeconfig)...)
+		forwarder.MaxEvents = 2
+
+		_, err := forwarder.ForwardEvents(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(forwardedConfigMap().Data["events"]).To(Equal(
+			"2026-10-16T12:04:00Z Normal Reason4: message\n" +
+				"2026-10-16T12:03:00Z Normal Reason3: message\n"))
+	})
+
+	It("should only contact the hosted cluster when there is something new to forward", func() {
+		buildForwarder(kubeconfig, event("created", "HostedClusterCreated", "created", bridge.UID, now))
+
+		_, err := forwarder.ForwardEvents(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = forwarder.ForwardEvents(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hostedClients).To(Equal(1))
+
+		Expect(fakeClient.Create(ctx, event("upgraded", "HostedClusterUpdated", "upgraded", bridge.UID, now.Add(time.Minute)))).To(Succeed())
+		_, err = forwarder.ForwardEvents(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hostedClients).To(Equal(2))
+		Expect(forwardedConfigMap().Data["events"]).To(HavePrefix("2026-10-16T12:01:00Z Normal HostedClusterUpdated: upgraded\n"))
+
+		// Forgotten bridges are forwarded again
+		forwarder.Forget(bridge)
+		_, err = forwarder.ForwardEvents(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hostedClients).To(Equal(3))
+	})
+
+	It("should update a ConfigMap left by a previous operator instance", func() {
+		Expect(hostedClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: ConfigMapNamespace},
+			Data:       map[string]string{"events": "stale"},
+		})).To(Succeed())
+		buildForwarder(kubeconfig, event("created", "HostedClusterCreated", "created", bridge.UID, now))
+
+		_, err := forwarder.ForwardEvents(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		cm := forwardedConfigMap()
+		Expect(cm.Data["events"]).To(Equal("2026-10-16T12:00:00Z Normal HostedClusterCreated: created\n"))
+		Expect(cm.Labels).To(HaveKeyWithValue(common.LabelOwnedBy, "test-bridge"))
+	})
+
+	It("should retry later without failing when the hosted cluster is unreachable", func() {
+		buildForwarder(kubeconfig, event("created", "HostedClusterCreated", "created", bridge.UID, now))
+		hostedErr = errors.New("connection refused")
+
+		result, err := forwarder.ForwardEvents(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(DefaultInterval))
+
+		// Nothing was recorded as forwarded, so the next attempt contacts the hosted cluster again
+		hostedErr = nil
+		_, err = forwarder.ForwardEvents(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hostedClients).To(Equal(2))
+		Expect(forwardedConfigMap().Data).To(HaveKey("events"))
+	})
+
+	It("should retry later when the admin kubeconfig does not exist yet", func() {
+		buildForwarder(event("created", "HostedClusterCreated", "created", bridge.UID, now))
+
+		result, err := forwarder.ForwardEvents(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(DefaultInterval))
+		Expect(hostedClients).To(BeZero())
+	})
+})
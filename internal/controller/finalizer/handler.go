@@ -19,6 +19,8 @@ package finalizer
 import (
 	"context"
 
+	ctrl "sigs.k8s.io/controller-runtime"
+
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
 )
 
@@ -26,8 +28,8 @@ import (
 // during finalizer cleanup when a DPFHCPBridge CR is deleted.
 //
 // Handlers are executed in the order they are registered with the Manager.
-// Each handler should clean up resources it created and return an error if
-// cleanup should be retried.
+// Each handler should clean up resources it created, request a requeue while
+// cleanup is still in progress, and return an error if cleanup failed.
 type CleanupHandler interface {
 	// Name returns the handler name for logging and identification purposes.
 	// Should be a short, descriptive name like "hostedcluster" or "kubeconfig-injection".
@@ -37,10 +39,12 @@ type CleanupHandler interface {
 	// It should clean up all resources created by the corresponding feature.
 	//
 	// Returns:
-	// - nil if cleanup succeeded or resources are already gone
+	// - ctrl.Result{}, nil if cleanup succeeded or resources are already gone
+	// - ctrl.Result{RequeueAfter: duration}, nil if cleanup is still in progress (e.g. waiting
+	//   for a resource to be deleted) and should be checked again; this is not a failure
 	// - error if cleanup failed and should be retried
 	//
 	// The handler should be idempotent - calling Cleanup multiple times should
 	// be safe and result in the same final state.
-	Cleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) error
+	Cleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error)
 }
@@ -79,7 +79,8 @@ func (m *Manager) HandleFinalizerCleanup(ctx context.Context, cr *provisioningv1
 		handlerLog.Info("Executing cleanup handler")
 
 		// Execute handler cleanup
-		if err := handler.Cleanup(ctx, cr); err != nil {
+		result, err := handler.Cleanup(ctx, cr)
+		if err != nil {
 			handlerLog.Error(err, "Cleanup handler failed")
 			m.recorder.Eventf(cr, "Warning", "CleanupHandlerFailed",
 				"Cleanup handler '%s' failed: %v", handler.Name(), err)
@@ -88,6 +89,12 @@ func (m *Manager) HandleFinalizerCleanup(ctx context.Context, cr *provisioningv1
 			return ctrl.Result{}, err
 		}
 
+		// Cleanup in progress: later handlers must not run until this one has finished
+		if result.Requeue || result.RequeueAfter > 0 {
+			handlerLog.Info("Cleanup handler in progress, will requeue", "requeueAfter", result.RequeueAfter)
+			return result, nil
+		}
+
 		handlerLog.Info("Cleanup handler completed successfully")
 	}
 
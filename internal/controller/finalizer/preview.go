@@ -0,0 +1,144 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package finalizer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+// CleanupPreviewer is implemented by cleanup handlers that delete resources, to list the resources
+// their Cleanup would delete without deleting them
+type CleanupPreviewer interface {
+	// PreviewCleanup returns the resources Cleanup would delete for the bridge now, in the order it deletes them
+	PreviewCleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) ([]provisioningv1alpha1.CleanupResource, error)
+}
+
+// CleanupResources returns the cleanup resources identifying objs, which are of the given kind
+func CleanupResources(kind string, objs []client.Object) []provisioningv1alpha1.CleanupResource {
+	resources := make([]provisioningv1alpha1.CleanupResource, 0, len(objs))
+	for _, obj := range objs {
+		resources = append(resources, provisioningv1alpha1.CleanupResource{
+			Kind:      kind,
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+		})
+	}
+	return resources
+}
+
+// PreviewCleanup returns the steps HandleFinalizerCleanup would run for the bridge now, in registration
+// order, with the resources each of them would delete. Handlers that do not implement CleanupPreviewer
+// are listed without resources. A resource is only listed in the first step deleting it.
+func (m *Manager) PreviewCleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) ([]provisioningv1alpha1.CleanupPreviewStep, error) {
+	seen := map[provisioningv1alpha1.CleanupResource]bool{}
+	steps := make([]provisioningv1alpha1.CleanupPreviewStep, 0, len(m.handlers))
+	for _, handler := range m.handlers {
+		step := provisioningv1alpha1.CleanupPreviewStep{Handler: handler.Name()}
+
+		if previewer, ok := handler.(CleanupPreviewer); ok {
+			resources, err := previewer.PreviewCleanup(ctx, cr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to preview cleanup handler '%s': %w", handler.Name(), err)
+			}
+			for _, resource := range resources {
+				if !seen[resource] {
+					seen[resource] = true
+					step.Resources = append(step.Resources, resource)
+				}
+			}
+		}
+
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// SyncCleanupPreview publishes the cleanup preview of the bridge in status.cleanupPreview while the
+// provisioning.dpu.hcp.io/preview-cleanup annotation is "true", and removes it once the annotation is
+// removed. A CleanupPreview event summarizes the preview whenever it changes.
+// The preview is patched, so that it does not conflict with other status writers.
+func (m *Manager) SyncCleanupPreview(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) error {
+	log := logf.FromContext(ctx)
+
+	if cr.Annotations[provisioningv1alpha1.AnnotationPreviewCleanup] != "true" {
+		if cr.Status.CleanupPreview == nil {
+			return nil
+		}
+		base := cr.DeepCopy()
+		cr.Status.CleanupPreview = nil
+		if err := common.PatchStatus(ctx, m.client, cr, base); err != nil {
+			return fmt.Errorf("failed to remove cleanup preview: %w", err)
+		}
+		return nil
+	}
+
+	steps, err := m.PreviewCleanup(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(steps, cr.Status.CleanupPreview) {
+		return nil
+	}
+
+	base := cr.DeepCopy()
+	cr.Status.CleanupPreview = steps
+	if err := common.PatchStatus(ctx, m.client, cr, base); err != nil {
+		return fmt.Errorf("failed to update cleanup preview: %w", err)
+	}
+
+	log.Info("Published cleanup preview", "steps", len(steps))
+	m.recorder.Eventf(cr, corev1.EventTypeNormal, "CleanupPreview",
+		"Deleting the DPFHCPBridge runs, in order: %s. The resources are listed in status.cleanupPreview", summarizeCleanupPreview(steps))
+	return nil
+}
+
+// summarizeCleanupPreview returns the steps with the number of resources of each kind they delete,
+// e.g. "hostedcluster (1 HostedCluster, 1 NodePool, 2 Secret)"
+func summarizeCleanupPreview(steps []provisioningv1alpha1.CleanupPreviewStep) string {
+	summaries := make([]string, 0, len(steps))
+	for _, step := range steps {
+		var kinds []string
+		counts := map[string]int{}
+		for _, resource := range step.Resources {
+			if counts[resource.Kind] == 0 {
+				kinds = append(kinds, resource.Kind)
+			}
+			counts[resource.Kind]++
+		}
+
+		if len(kinds) == 0 {
+			summaries = append(summaries, step.Handler+" (no resources)")
+			continue
+		}
+		parts := make([]string, 0, len(kinds))
+		for _, kind := range kinds {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[kind], kind))
+		}
+		summaries = append(summaries, fmt.Sprintf("%s (%s)", step.Handler, strings.Join(parts, ", ")))
+	}
+	return strings.Join(summaries, "; ")
+}
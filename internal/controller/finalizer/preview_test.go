@@ -0,0 +1,155 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package finalizer
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// previewHandler is a cleanup handler deleting a fixed list of resources
+type previewHandler struct {
+	name      string
+	resources []provisioningv1alpha1.CleanupResource
+}
+
+func (h *previewHandler) Name() string {
+	return h.name
+}
+
+func (h *previewHandler) Cleanup(context.Context, *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	return ctrl.Result{}, nil
+}
+
+func (h *previewHandler) PreviewCleanup(context.Context, *provisioningv1alpha1.DPFHCPBridge) ([]provisioningv1alpha1.CleanupResource, error) {
+	return h.resources, nil
+}
+
+// hookHandler is a cleanup handler that deletes nothing, like the pre-delete hooks
+type hookHandler struct{}
+
+func (hookHandler) Name() string {
+	return "pre-delete-hooks"
+}
+
+func (hookHandler) Cleanup(context.Context, *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	return ctrl.Result{}, nil
+}
+
+var _ = Describe("Cleanup Preview", func() {
+	var (
+		ctx      context.Context
+		cr       *provisioningv1alpha1.DPFHCPBridge
+		recorder *record.FakeRecorder
+		c        client.Client
+		manager  *Manager
+	)
+
+	secret := provisioningv1alpha1.CleanupResource{Kind: "Secret", Namespace: "dpf-system", Name: "test-bridge-kubeconfig"}
+	hostedCluster := provisioningv1alpha1.CleanupResource{Kind: "HostedCluster", Namespace: "clusters", Name: "test-bridge"}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-bridge",
+				Namespace:   "clusters",
+				Annotations: map[string]string{provisioningv1alpha1.AnnotationPreviewCleanup: "true"},
+			},
+		}
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	JustBeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(cr).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).Build()
+
+		manager = NewManager(c, recorder)
+		manager.RegisterHandler(hookHandler{})
+		manager.RegisterHandler(&previewHandler{name: "kubeconfig-injection", resources: []provisioningv1alpha1.CleanupResource{secret}})
+		manager.RegisterHandler(&previewHandler{name: "hostedcluster", resources: []provisioningv1alpha1.CleanupResource{hostedCluster, secret}})
+	})
+
+	getPreview := func() []provisioningv1alpha1.CleanupPreviewStep {
+		updated := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: cr.Name, Namespace: cr.Namespace}, updated)).To(Succeed())
+		return updated.Status.CleanupPreview
+	}
+
+	It("should publish the steps in order with the resources only in the first step deleting them", func() {
+		Expect(manager.SyncCleanupPreview(ctx, cr)).To(Succeed())
+
+		Expect(getPreview()).To(Equal([]provisioningv1alpha1.CleanupPreviewStep{
+			{Handler: "pre-delete-hooks"},
+			{Handler: "kubeconfig-injection", Resources: []provisioningv1alpha1.CleanupResource{secret}},
+			{Handler: "hostedcluster", Resources: []provisioningv1alpha1.CleanupResource{hostedCluster}},
+		}))
+		Expect(recorder.Events).To(Receive(Equal("Normal CleanupPreview Deleting the DPFHCPBridge runs, in order: " +
+			"pre-delete-hooks (no resources); kubeconfig-injection (1 Secret); hostedcluster (1 HostedCluster). " +
+			"The resources are listed in status.cleanupPreview")))
+	})
+
+	It("should not emit another event while the preview is unchanged", func() {
+		Expect(manager.SyncCleanupPreview(ctx, cr)).To(Succeed())
+		Expect(recorder.Events).To(Receive())
+
+		Expect(manager.SyncCleanupPreview(ctx, cr)).To(Succeed())
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should publish the preview from a stale copy of the bridge", func() {
+		stale := cr.DeepCopy()
+		Expect(c.Get(ctx, types.NamespacedName{Name: cr.Name, Namespace: cr.Namespace}, stale)).To(Succeed())
+		latest := stale.DeepCopy()
+		latest.Status.Phase = provisioningv1alpha1.PhaseProvisioning
+		Expect(c.Status().Update(ctx, latest)).To(Succeed())
+
+		Expect(manager.SyncCleanupPreview(ctx, stale)).To(Succeed())
+
+		updated := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: cr.Name, Namespace: cr.Namespace}, updated)).To(Succeed())
+		Expect(updated.Status.CleanupPreview).To(HaveLen(3))
+		Expect(updated.Status.Phase).To(Equal(provisioningv1alpha1.PhaseProvisioning))
+	})
+
+	Context("without the annotation", func() {
+		BeforeEach(func() {
+			cr.Annotations = nil
+			cr.Status.CleanupPreview = []provisioningv1alpha1.CleanupPreviewStep{{Handler: "hostedcluster"}}
+		})
+
+		It("should remove a published preview", func() {
+			Expect(manager.SyncCleanupPreview(ctx, cr)).To(Succeed())
+
+			Expect(getPreview()).To(BeNil())
+			Expect(recorder.Events).NotTo(Receive())
+		})
+	})
+})
@@ -0,0 +1,150 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+// PreDeleteCleanupHandler runs the pre-delete hooks of a DPFHCPBridge during finalizer cleanup.
+//
+// It must be registered before the HostedCluster cleanup handler so that hooks targeting
+// the hosted cluster still have a running control plane to talk to.
+type PreDeleteCleanupHandler struct {
+	client client.Client
+	runner *Runner
+}
+
+// NewPreDeleteCleanupHandler creates a new pre-delete hooks cleanup handler
+func NewPreDeleteCleanupHandler(client client.Client, runner *Runner) *PreDeleteCleanupHandler {
+	return &PreDeleteCleanupHandler{
+		client: client,
+		runner: runner,
+	}
+}
+
+// Name returns the handler name for logging
+func (h *PreDeleteCleanupHandler) Name() string {
+	return "pre-delete-hooks"
+}
+
+// Cleanup runs the pre-delete hooks sequentially and reports their progress in status.
+//
+// Hooks targeting the HostedCluster are skipped when the bridge has no HostedCluster, e.g. because
+// it failed validation before provisioning, since they have no cluster to operate on.
+//
+// Returns:
+// - ctrl.Result{}, nil once all hooks have finished (succeeded, or failed/timed out with failurePolicy Ignore)
+// - ctrl.Result{RequeueAfter: duration}, nil while a hook is still running
+// - error when a hook with failurePolicy Fail did not succeed, or an API call failed
+func (h *PreDeleteCleanupHandler) Cleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithValues(
+		"handler", h.Name(),
+		common.DPFHCPBridgeName, fmt.Sprintf("%s/%s", cr.Namespace, cr.Name),
+	)
+
+	if len(cr.Spec.PreDeleteHooks) == 0 {
+		log.V(1).Info("No pre-delete hooks configured")
+		return ctrl.Result{}, nil
+	}
+
+	hooks, err := h.runnableHooks(ctx, cr)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	base := cr.DeepCopy()
+	done, runErr := h.runner.Run(ctx, cr, HookTypePreDelete, hooks, &cr.Status.PreDeleteHooks)
+
+	// Patched, so that the hook status is not lost on a conflict with other status writers
+	if !equality.Semantic.DeepEqual(base.Status.PreDeleteHooks, cr.Status.PreDeleteHooks) {
+		if err := common.PatchStatus(ctx, h.client, cr, base); err != nil {
+			log.Error(err, "Failed to update pre-delete hook status")
+			return ctrl.Result{}, fmt.Errorf("failed to update pre-delete hook status: %w", err)
+		}
+	}
+
+	if runErr != nil {
+		return ctrl.Result{}, runErr
+	}
+
+	if !done {
+		requeueAfter := HookPollInterval
+		if remaining := RemainingTimeout(hooks, cr.Status.PreDeleteHooks); remaining > 0 && remaining < requeueAfter {
+			requeueAfter = remaining
+		}
+		log.Info("Pre-delete hooks in progress, will requeue", "requeueAfter", requeueAfter)
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	log.Info("All pre-delete hooks completed")
+	return ctrl.Result{}, nil
+}
+
+// runnableHooks returns the pre-delete hooks to run: all of them if the HostedCluster exists,
+// otherwise only those targeting the management cluster
+func (h *PreDeleteCleanupHandler) runnableHooks(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) ([]provisioningv1alpha1.LifecycleHook, error) {
+	exists, err := h.hostedClusterExists(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return cr.Spec.PreDeleteHooks, nil
+	}
+
+	hooks := make([]provisioningv1alpha1.LifecycleHook, 0, len(cr.Spec.PreDeleteHooks))
+	for _, hook := range cr.Spec.PreDeleteHooks {
+		if hook.Target == provisioningv1alpha1.HookTargetHostedCluster {
+			logf.FromContext(ctx).Info("Skipping pre-delete hook, HostedCluster does not exist", "hook", hook.Name)
+			h.runner.recorder.Eventf(cr, corev1.EventTypeNormal, "HookSkipped",
+				"Skipped pre-delete hook %s: the bridge has no HostedCluster", hook.Name)
+			continue
+		}
+		hooks = append(hooks, hook)
+	}
+	return hooks, nil
+}
+
+// hostedClusterExists returns true if the HostedCluster of the bridge exists
+func (h *PreDeleteCleanupHandler) hostedClusterExists(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (bool, error) {
+	if cr.Status.HostedClusterRef == nil {
+		return false, nil
+	}
+
+	err := h.client.Get(ctx, types.NamespacedName{Name: cr.Status.HostedClusterRef.Name, Namespace: cr.Status.HostedClusterRef.Namespace}, &hyperv1.HostedCluster{})
+	if err == nil {
+		return true, nil
+	}
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return false, nil
+	}
+	return false, fmt.Errorf("failed to get HostedCluster: %w", err)
+}
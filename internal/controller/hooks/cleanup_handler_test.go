@@ -0,0 +1,156 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Pre-Delete Hooks Cleanup Handler", func() {
+	var (
+		ctx        context.Context
+		scheme     *runtime.Scheme
+		recorder   *record.FakeRecorder
+		fakeClient client.Client
+		handler    *PreDeleteCleanupHandler
+		bridge     *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	newHook := func(name string, target provisioningv1alpha1.HookTarget) provisioningv1alpha1.LifecycleHook {
+		return provisioningv1alpha1.LifecycleHook{
+			Name:          name,
+			Target:        target,
+			FailurePolicy: provisioningv1alpha1.HookFailurePolicyFail,
+			Template: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "hook", Image: "busybox"}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		recorder = record.NewFakeRecorder(100)
+
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-bridge",
+				Namespace: "test-ns",
+				UID:       "test-uid",
+			},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				PreDeleteHooks: []provisioningv1alpha1.LifecycleHook{
+					newHook("drain", provisioningv1alpha1.HookTargetHostedCluster),
+					newHook("deregister", provisioningv1alpha1.HookTargetManagementCluster),
+				},
+			},
+		}
+	})
+
+	buildHandler := func(objs ...client.Object) {
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(append([]client.Object{bridge}, objs...)...).
+			WithStatusSubresource(bridge, &batchv1.Job{}).
+			Build()
+		handler = NewPreDeleteCleanupHandler(fakeClient, NewRunner(fakeClient, scheme, recorder))
+	}
+
+	jobExists := func(name string) bool {
+		err := fakeClient.Get(ctx, types.NamespacedName{Name: name, Namespace: "test-ns"}, &batchv1.Job{})
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return true
+	}
+
+	It("should requeue instead of failing while a hook is running", func() {
+		bridge.Spec.PreDeleteHooks = bridge.Spec.PreDeleteHooks[1:]
+		buildHandler()
+
+		result, err := handler.Cleanup(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(jobExists("test-bridge-predelete-deregister")).To(BeTrue())
+	})
+
+	It("should skip HostedCluster hooks when the bridge never got a HostedCluster", func() {
+		buildHandler()
+
+		_, err := handler.Cleanup(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(jobExists("test-bridge-predelete-drain")).To(BeFalse())
+		Expect(jobExists("test-bridge-predelete-deregister")).To(BeTrue())
+		Expect(bridge.Status.PreDeleteHooks).To(HaveLen(1))
+		Expect(bridge.Status.PreDeleteHooks[0].Name).To(Equal("deregister"))
+		Eventually(recorder.Events).Should(Receive(ContainSubstring("HookSkipped")))
+	})
+
+	It("should skip HostedCluster hooks when the HostedCluster is already gone", func() {
+		bridge.Status.HostedClusterRef = &corev1.ObjectReference{Name: "test-bridge", Namespace: "test-ns"}
+		bridge.Spec.PreDeleteHooks = bridge.Spec.PreDeleteHooks[:1]
+		buildHandler()
+
+		result, err := handler.Cleanup(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(jobExists("test-bridge-predelete-drain")).To(BeFalse())
+	})
+
+	It("should run HostedCluster hooks while the HostedCluster exists", func() {
+		bridge.Status.HostedClusterRef = &corev1.ObjectReference{Name: "test-bridge", Namespace: "test-ns"}
+		bridge.Spec.PreDeleteHooks = bridge.Spec.PreDeleteHooks[:1]
+		buildHandler(
+			&hyperv1.HostedCluster{ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "test-ns"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test-bridge-admin-kubeconfig", Namespace: "test-ns"}},
+		)
+
+		result, err := handler.Cleanup(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(jobExists("test-bridge-predelete-drain")).To(BeTrue())
+	})
+})
@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// PostProvisionManager runs the post-provision hooks of a DPFHCPBridge once its HostedCluster is Available
+type PostProvisionManager struct {
+	client   client.Client
+	runner   *Runner
+	recorder record.EventRecorder
+}
+
+// NewPostProvisionManager creates a new PostProvisionManager
+func NewPostProvisionManager(client client.Client, runner *Runner, recorder record.EventRecorder) *PostProvisionManager {
+	return &PostProvisionManager{
+		client:   client,
+		runner:   runner,
+		recorder: recorder,
+	}
+}
+
+// RunPostProvisionHooks runs the configured post-provision hooks and reports their progress
+// in status and in the PostProvisionHooksCompleted condition.
+//
+// Hooks only start once the HostedClusterAvailable condition is True. Each hook runs until it
+// succeeds or exhausts its retries; a successful hook is never re-run. Progress is driven by
+// the controller's watch on owned Jobs; while a hook is running a requeue is requested for when
+// its timeout expires, since the Job template may set a longer ActiveDeadlineSeconds.
+// A hook with failurePolicy Fail that exhausted its retries stops the remaining hooks and is
+// reported through the condition rather than as a reconcile error.
+func (m *PostProvisionManager) RunPostProvisionHooks(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if len(cr.Spec.PostProvisionHooks) == 0 && len(cr.Status.PostProvisionHooks) == 0 {
+		log.V(1).Info("No post-provision hooks configured")
+		return ctrl.Result{}, nil
+	}
+
+	if !meta.IsStatusConditionTrue(cr.Status.Conditions, provisioningv1alpha1.HostedClusterAvailable) {
+		log.V(1).Info("Skipping post-provision hooks - HostedCluster not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	before := cr.Status.DeepCopy()
+	done, runErr := m.runner.Run(ctx, cr, HookTypePostProvision, cr.Spec.PostProvisionHooks, &cr.Status.PostProvisionHooks)
+	if runErr != nil && !errors.Is(runErr, ErrHookFailed) {
+		log.Error(runErr, "Failed to run post-provision hooks")
+		return ctrl.Result{}, runErr
+	}
+
+	condition := metav1.Condition{
+		Type:               provisioningv1alpha1.PostProvisionHooksCompleted,
+		ObservedGeneration: cr.Generation,
+	}
+	switch {
+	case runErr != nil:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = provisioningv1alpha1.ReasonHookFailed
+		condition.Message = runErr.Error()
+	case done:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = provisioningv1alpha1.ReasonHooksSucceeded
+		condition.Message = "All post-provision hooks completed"
+	default:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = provisioningv1alpha1.ReasonHooksRunning
+		condition.Message = "Post-provision hooks are running"
+	}
+
+	if len(cr.Spec.PostProvisionHooks) == 0 {
+		// All hooks were removed from the spec - drop the condition along with their status
+		meta.RemoveStatusCondition(&cr.Status.Conditions, provisioningv1alpha1.PostProvisionHooksCompleted)
+	} else if changed := meta.SetStatusCondition(&cr.Status.Conditions, condition); changed && condition.Reason != provisioningv1alpha1.ReasonHooksRunning {
+		eventType := corev1.EventTypeNormal
+		if condition.Status == metav1.ConditionFalse {
+			eventType = corev1.EventTypeWarning
+		}
+		m.recorder.Event(cr, eventType, condition.Reason, condition.Message)
+	}
+
+	if !equality.Semantic.DeepEqual(before, &cr.Status) {
+		if err := m.client.Status().Update(ctx, cr); err != nil {
+			log.Error(err, "Failed to update post-provision hook status")
+			return ctrl.Result{}, fmt.Errorf("failed to update post-provision hook status: %w", err)
+		}
+	}
+
+	if !done && runErr == nil {
+		return ctrl.Result{RequeueAfter: RemainingTimeout(cr.Spec.PostProvisionHooks, cr.Status.PostProvisionHooks)}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Post-Provision Hooks", func() {
+	var (
+		ctx        context.Context
+		scheme     *runtime.Scheme
+		recorder   *record.FakeRecorder
+		fakeClient client.Client
+		manager    *PostProvisionManager
+		bridge     *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	const jobName = "test-bridge-postprovision-register"
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
+
+		recorder = record.NewFakeRecorder(100)
+
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-bridge",
+				Namespace: "test-ns",
+				UID:       "test-uid",
+			},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				PostProvisionHooks: []provisioningv1alpha1.LifecycleHook{
+					{
+						Name:          "register",
+						Target:        provisioningv1alpha1.HookTargetManagementCluster,
+						FailurePolicy: provisioningv1alpha1.HookFailurePolicyIgnore,
+						Template: batchv1.JobTemplateSpec{
+							Spec: batchv1.JobSpec{
+								Template: corev1.PodTemplateSpec{
+									Spec: corev1.PodSpec{
+										Containers: []corev1.Container{{Name: "register", Image: "busybox"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	buildManager := func() {
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(bridge).
+			WithStatusSubresource(bridge, &batchv1.Job{}).
+			Build()
+		manager = NewPostProvisionManager(fakeClient, NewRunner(fakeClient, scheme, recorder), recorder)
+	}
+
+	setAvailable := func() {
+		meta.SetStatusCondition(&bridge.Status.Conditions, metav1.Condition{
+			Type:   provisioningv1alpha1.HostedClusterAvailable,
+			Status: metav1.ConditionTrue,
+			Reason: "AsExpected",
+		})
+	}
+
+	It("should not run hooks before the HostedCluster is available", func() {
+		buildManager()
+
+		result, err := manager.RunPostProvisionHooks(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		err = fakeClient.Get(ctx, types.NamespacedName{Name: jobName, Namespace: "test-ns"}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(bridge.Status.PostProvisionHooks).To(BeEmpty())
+	})
+
+	It("should run hooks and report completion once the HostedCluster is available", func() {
+		setAvailable()
+		buildManager()
+
+		_, err := manager.RunPostProvisionHooks(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		updated := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Name: "test-bridge", Namespace: "test-ns"}, updated)).To(Succeed())
+		Expect(updated.Status.PostProvisionHooks).To(HaveLen(1))
+		Expect(updated.Status.PostProvisionHooks[0].Phase).To(Equal(provisioningv1alpha1.HookPhaseRunning))
+		cond := meta.FindStatusCondition(updated.Status.Conditions, provisioningv1alpha1.PostProvisionHooksCompleted)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonHooksRunning))
+
+		job := &batchv1.Job{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Name: jobName, Namespace: "test-ns"}, job)).To(Succeed())
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+		Expect(fakeClient.Status().Update(ctx, job)).To(Succeed())
+
+		_, err = manager.RunPostProvisionHooks(ctx, updated)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Status.PostProvisionHooks[0].Phase).To(Equal(provisioningv1alpha1.HookPhaseSucceeded))
+		Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, provisioningv1alpha1.PostProvisionHooksCompleted)).To(BeTrue())
+	})
+
+	It("should requeue for the hook timeout when the Job template sets a longer deadline", func() {
+		bridge.Spec.PostProvisionHooks[0].TimeoutSeconds = ptr.To(int32(60))
+		bridge.Spec.PostProvisionHooks[0].Template.Spec.ActiveDeadlineSeconds = ptr.To(int64(3600))
+		setAvailable()
+		buildManager()
+
+		result, err := manager.RunPostProvisionHooks(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 50*time.Second))
+		Expect(result.RequeueAfter).To(BeNumerically("<=", time.Minute))
+
+		// When the requeue fires after the hook timeout, the runner times the hook out
+		started := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		bridge.Status.PostProvisionHooks[0].StartTime = &started
+
+		result, err = manager.RunPostProvisionHooks(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(bridge.Status.PostProvisionHooks[0].Phase).To(Equal(provisioningv1alpha1.HookPhaseTimedOut))
+		err = fakeClient.Get(ctx, types.NamespacedName{Name: jobName, Namespace: "test-ns"}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should report a blocking hook failure through the condition", func() {
+		bridge.Spec.PostProvisionHooks[0].FailurePolicy = provisioningv1alpha1.HookFailurePolicyFail
+		bridge.Status.PostProvisionHooks = []provisioningv1alpha1.HookStatus{
+			{Name: "register", Phase: provisioningv1alpha1.HookPhaseFailed, Attempts: 1, Message: "Hook Job failed"},
+		}
+		setAvailable()
+		buildManager()
+
+		_, err := manager.RunPostProvisionHooks(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		cond := meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.PostProvisionHooksCompleted)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonHookFailed))
+	})
+})
@@ -0,0 +1,463 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+const (
+	// DefaultHookTimeout is the timeout applied when a hook does not specify timeoutSeconds
+	DefaultHookTimeout = 10 * time.Minute
+
+	// HookPollInterval is how often the pre-delete hooks are checked while one is in progress
+	HookPollInterval = 10 * time.Second
+
+	// HookKubeconfigMountPath is the directory where the hosted cluster kubeconfig is mounted
+	// for hooks targeting the HostedCluster
+	HookKubeconfigMountPath = "/etc/hook"
+
+	// LabelHookName is the label key carrying the hook name on hook Jobs
+	LabelHookName = "dpf-hcp-bridge-operator/hook"
+
+	// LabelHookType is the label key carrying the hook type on hook Jobs
+	LabelHookType = "dpf-hcp-bridge-operator/hook-type"
+
+	// LabelOwnedBy is the label key for ownership tracking
+	LabelOwnedBy = common.LabelOwnedBy
+
+	// HookTypePreDelete identifies hooks run before HostedCluster deletion
+	HookTypePreDelete = "predelete"
+
+	// HookTypePostProvision identifies hooks run once the HostedCluster is Available
+	HookTypePostProvision = "postprovision"
+
+	// hostedClusterKubeconfigSuffix is the suffix of the HostedCluster admin kubeconfig secret
+	hostedClusterKubeconfigSuffix = "-admin-kubeconfig"
+
+	// hookKubeconfigVolumeName is the name of the volume holding the hosted cluster kubeconfig
+	hookKubeconfigVolumeName = "hosted-cluster-kubeconfig"
+)
+
+// ErrHookFailed indicates a hook with failurePolicy Fail failed or timed out after exhausting its retries
+var ErrHookFailed = errors.New("lifecycle hook failed")
+
+// errKubeconfigUnavailable indicates a HostedCluster-targeted hook cannot run because
+// the hosted cluster admin kubeconfig does not exist
+var errKubeconfigUnavailable = errors.New("hosted cluster kubeconfig not available")
+
+// Runner creates lifecycle hook Jobs and tracks their execution in DPFHCPBridge status
+type Runner struct {
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+}
+
+// NewRunner creates a new lifecycle hook Runner
+func NewRunner(client client.Client, scheme *runtime.Scheme, recorder record.EventRecorder) *Runner {
+	return &Runner{
+		client:   client,
+		scheme:   scheme,
+		recorder: recorder,
+	}
+}
+
+// Run executes the given hooks sequentially, one Job at a time.
+// statuses is updated in place to reflect the state of each hook; entries for hooks
+// no longer present in the spec are dropped.
+//
+// A failed or timed out hook is re-run until it has been attempted RetryLimit+1 times,
+// after which its FailurePolicy applies.
+//
+// Returns:
+// - (true, nil) when every hook reached a terminal phase that allows progress
+// - (false, nil) when a hook Job is still running or waiting to be retried
+// - (false, ErrHookFailed) when a hook with failurePolicy Fail failed or timed out
+// - (false, error) when an API call failed
+func (r *Runner) Run(
+	ctx context.Context,
+	cr *provisioningv1alpha1.DPFHCPBridge,
+	hookType string,
+	hooks []provisioningv1alpha1.LifecycleHook,
+	statuses *[]provisioningv1alpha1.HookStatus,
+) (bool, error) {
+	log := logf.FromContext(ctx).WithValues("hookType", hookType)
+
+	pruneHookStatuses(statuses, hooks)
+
+	for i := range hooks {
+		hook := &hooks[i]
+		status := findHookStatus(*statuses, hook.Name)
+
+		if status != nil {
+			switch status.Phase {
+			case provisioningv1alpha1.HookPhaseSucceeded:
+				continue
+			case provisioningv1alpha1.HookPhaseFailed, provisioningv1alpha1.HookPhaseTimedOut:
+				if hook.FailurePolicy == provisioningv1alpha1.HookFailurePolicyFail {
+					return false, fmt.Errorf("%w: %s hook %q %s: %s", ErrHookFailed, hookType, hook.Name, status.Phase, status.Message)
+				}
+				continue
+			}
+		}
+
+		jobName := HookJobName(cr.Name, hookType, hook.Name)
+		job := &batchv1.Job{}
+		err := r.client.Get(ctx, types.NamespacedName{Name: jobName, Namespace: cr.Namespace}, job)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to get %s hook job %s: %w", hookType, jobName, err)
+		}
+
+		if err == nil && status != nil && status.Phase == provisioningv1alpha1.HookPhasePending {
+			// Previous attempt's Job is still being removed
+			log.V(1).Info("Waiting for previous hook job to be deleted before retry", "hook", hook.Name, "job", jobName)
+			return false, nil
+		}
+
+		if apierrors.IsNotFound(err) {
+			if status != nil && status.Phase == provisioningv1alpha1.HookPhaseRunning {
+				if done, err := r.fail(ctx, cr, hookType, hook, nil, statuses, provisioningv1alpha1.HookPhaseFailed, "Hook Job was deleted before completion"); !done || err != nil {
+					return done, err
+				}
+				continue
+			}
+
+			if err := r.createJob(ctx, cr, hookType, hook, jobName); err != nil {
+				if !errors.Is(err, errKubeconfigUnavailable) {
+					return false, err
+				}
+				r.finish(cr, statuses, hook.Name, provisioningv1alpha1.HookPhaseFailed, err.Error())
+				if done, err := r.afterTerminal(hook, hookType, statuses); !done || err != nil {
+					return done, err
+				}
+				continue
+			}
+
+			attempts := int32(1)
+			if status != nil {
+				attempts = status.Attempts + 1
+			}
+			now := metav1.Now()
+			setHookStatus(statuses, provisioningv1alpha1.HookStatus{
+				Name:      hook.Name,
+				Phase:     provisioningv1alpha1.HookPhaseRunning,
+				JobName:   jobName,
+				Attempts:  attempts,
+				StartTime: &now,
+				Message:   fmt.Sprintf("Hook Job created (attempt %d)", attempts),
+			})
+			log.Info("Started lifecycle hook", "hook", hook.Name, "job", jobName, "attempt", attempts)
+			r.recorder.Eventf(cr, corev1.EventTypeNormal, "HookStarted", "Started %s hook %s (job %s)", hookType, hook.Name, jobName)
+			return false, nil
+		}
+
+		// Job exists - evaluate its state
+		if jobConditionTrue(job, batchv1.JobComplete) {
+			r.finish(cr, statuses, hook.Name, provisioningv1alpha1.HookPhaseSucceeded, "Hook Job completed successfully")
+			continue
+		}
+
+		if jobConditionTrue(job, batchv1.JobFailed) {
+			if done, err := r.fail(ctx, cr, hookType, hook, job, statuses, provisioningv1alpha1.HookPhaseFailed, "Hook Job failed"); !done || err != nil {
+				return done, err
+			}
+			continue
+		}
+
+		startTime := job.CreationTimestamp.Time
+		if status != nil && status.StartTime != nil {
+			startTime = status.StartTime.Time
+		}
+		if time.Since(startTime) > hookTimeout(hook) {
+			log.Info("Lifecycle hook timed out, deleting job", "hook", hook.Name, "job", jobName)
+			if err := r.deleteJob(ctx, job); err != nil {
+				return false, fmt.Errorf("failed to delete timed out %s hook job %s: %w", hookType, jobName, err)
+			}
+			if done, err := r.fail(ctx, cr, hookType, hook, nil, statuses, provisioningv1alpha1.HookPhaseTimedOut,
+				fmt.Sprintf("Hook Job did not complete within %s", hookTimeout(hook))); !done || err != nil {
+				return done, err
+			}
+			continue
+		}
+
+		// Still running
+		log.V(1).Info("Lifecycle hook still running", "hook", hook.Name, "job", jobName)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// RemainingTimeout returns how long the running hook in statuses has left before it times out,
+// or zero if no hook is running. The Job's own ActiveDeadlineSeconds may be larger than the hook
+// timeout, so callers requeue after this duration to enforce the timeout.
+func RemainingTimeout(hooks []provisioningv1alpha1.LifecycleHook, statuses []provisioningv1alpha1.HookStatus) time.Duration {
+	for i := range hooks {
+		status := findHookStatus(statuses, hooks[i].Name)
+		if status == nil || status.Phase != provisioningv1alpha1.HookPhaseRunning || status.StartTime == nil {
+			continue
+		}
+		remaining := hookTimeout(&hooks[i]) - time.Since(status.StartTime.Time)
+		if remaining < time.Second {
+			// Already past the timeout: check again right away
+			return time.Second
+		}
+		return remaining
+	}
+	return 0
+}
+
+// fail handles a failed or timed out hook attempt.
+// If the hook has retries left, the Job (if any) is deleted and the hook is marked Pending
+// so a new Job is created once the old one is gone. Otherwise the terminal phase is recorded.
+func (r *Runner) fail(
+	ctx context.Context,
+	cr *provisioningv1alpha1.DPFHCPBridge,
+	hookType string,
+	hook *provisioningv1alpha1.LifecycleHook,
+	job *batchv1.Job,
+	statuses *[]provisioningv1alpha1.HookStatus,
+	phase provisioningv1alpha1.HookPhase,
+	message string,
+) (bool, error) {
+	status := findHookStatus(*statuses, hook.Name)
+	if status != nil && status.Attempts > 0 && status.Attempts <= hook.RetryLimit {
+		if job != nil {
+			if err := r.deleteJob(ctx, job); err != nil {
+				return false, fmt.Errorf("failed to delete %s hook job %s for retry: %w", hookType, job.Name, err)
+			}
+		}
+		status.Phase = provisioningv1alpha1.HookPhasePending
+		status.Message = fmt.Sprintf("Attempt %d %s: %s; retrying", status.Attempts, phase, message)
+		logf.FromContext(ctx).Info("Retrying lifecycle hook", "hookType", hookType, "hook", hook.Name, "attempt", status.Attempts, "retryLimit", hook.RetryLimit)
+		r.recorder.Eventf(cr, corev1.EventTypeWarning, "HookRetrying", "Hook %s attempt %d %s, retrying: %s", hook.Name, status.Attempts, phase, message)
+		return false, nil
+	}
+
+	r.finish(cr, statuses, hook.Name, phase, message)
+	return r.afterTerminal(hook, hookType, statuses)
+}
+
+// afterTerminal decides whether processing may continue after a hook failed or timed out
+func (r *Runner) afterTerminal(hook *provisioningv1alpha1.LifecycleHook, hookType string, statuses *[]provisioningv1alpha1.HookStatus) (bool, error) {
+	if hook.FailurePolicy == provisioningv1alpha1.HookFailurePolicyFail {
+		status := findHookStatus(*statuses, hook.Name)
+		return false, fmt.Errorf("%w: %s hook %q %s: %s", ErrHookFailed, hookType, hook.Name, status.Phase, status.Message)
+	}
+	return true, nil
+}
+
+// deleteJob deletes a hook Job along with its pods
+func (r *Runner) deleteJob(ctx context.Context, job *batchv1.Job) error {
+	if err := r.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// finish records a terminal phase for a hook and emits an event
+func (r *Runner) finish(cr *provisioningv1alpha1.DPFHCPBridge, statuses *[]provisioningv1alpha1.HookStatus, name string, phase provisioningv1alpha1.HookPhase, message string) {
+	now := metav1.Now()
+	status := provisioningv1alpha1.HookStatus{Name: name}
+	if existing := findHookStatus(*statuses, name); existing != nil {
+		status = *existing
+	}
+	status.Phase = phase
+	status.Message = message
+	status.CompletionTime = &now
+	setHookStatus(statuses, status)
+
+	eventType := corev1.EventTypeNormal
+	if phase != provisioningv1alpha1.HookPhaseSucceeded {
+		eventType = corev1.EventTypeWarning
+	}
+	r.recorder.Eventf(cr, eventType, "Hook"+string(phase), "Hook %s %s: %s", name, phase, message)
+}
+
+// createJob builds and creates the Job for a hook
+func (r *Runner) createJob(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, hookType string, hook *provisioningv1alpha1.LifecycleHook, jobName string) error {
+	job, err := r.buildJob(ctx, cr, hookType, hook, jobName)
+	if err != nil {
+		return err
+	}
+
+	if err := controllerutil.SetControllerReference(cr, job, r.scheme); err != nil {
+		return fmt.Errorf("failed to set owner reference on hook job: %w", err)
+	}
+
+	if err := r.client.Create(ctx, job); err != nil {
+		return fmt.Errorf("failed to create hook job %s: %w", jobName, err)
+	}
+
+	return nil
+}
+
+// buildJob constructs the Job for a hook from its template
+func (r *Runner) buildJob(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, hookType string, hook *provisioningv1alpha1.LifecycleHook, jobName string) (*batchv1.Job, error) {
+	template := hook.Template.DeepCopy()
+
+	labels := map[string]string{}
+	for k, v := range template.Labels {
+		labels[k] = v
+	}
+	labels[LabelOwnedBy] = cr.Name
+	labels[LabelHookName] = hook.Name
+	labels[LabelHookType] = hookType
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        jobName,
+			Namespace:   cr.Namespace,
+			Labels:      labels,
+			Annotations: template.Annotations,
+		},
+		Spec: template.Spec,
+	}
+
+	if job.Spec.Template.Spec.RestartPolicy == "" {
+		job.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+	}
+
+	if job.Spec.ActiveDeadlineSeconds == nil {
+		deadline := int64(hookTimeout(hook).Seconds())
+		job.Spec.ActiveDeadlineSeconds = &deadline
+	}
+
+	if hook.Target == provisioningv1alpha1.HookTargetHostedCluster {
+		kubeconfigSecret := cr.Name + hostedClusterKubeconfigSuffix
+		if err := r.client.Get(ctx, types.NamespacedName{Name: kubeconfigSecret, Namespace: cr.Namespace}, &corev1.Secret{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("%w: secret %s not found", errKubeconfigUnavailable, kubeconfigSecret)
+			}
+			return nil, fmt.Errorf("failed to get hosted cluster kubeconfig secret: %w", err)
+		}
+		mountHostedClusterKubeconfig(&job.Spec.Template.Spec, kubeconfigSecret)
+	}
+
+	return job, nil
+}
+
+// mountHostedClusterKubeconfig mounts the hosted cluster kubeconfig into all containers and sets KUBECONFIG
+func mountHostedClusterKubeconfig(podSpec *corev1.PodSpec, secretName string) {
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: hookKubeconfigVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+			},
+		},
+	})
+
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      hookKubeconfigVolumeName,
+			MountPath: HookKubeconfigMountPath,
+			ReadOnly:  true,
+		})
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  "KUBECONFIG",
+			Value: HookKubeconfigMountPath + "/kubeconfig",
+		})
+	}
+}
+
+// HookJobName returns the Job name for a hook, shortened with a hash suffix if it exceeds 63 characters
+func HookJobName(bridgeName, hookType, hookName string) string {
+	name := fmt.Sprintf("%s-%s-%s", bridgeName, hookType, hookName)
+	if len(name) <= 63 {
+		return name
+	}
+	sum := sha256.Sum256([]byte(name))
+	return name[:54] + "-" + hex.EncodeToString(sum[:])[:8]
+}
+
+// hookTimeout returns the configured timeout for a hook, or DefaultHookTimeout
+func hookTimeout(hook *provisioningv1alpha1.LifecycleHook) time.Duration {
+	if hook.TimeoutSeconds != nil {
+		return time.Duration(*hook.TimeoutSeconds) * time.Second
+	}
+	return DefaultHookTimeout
+}
+
+// jobConditionTrue returns true if the Job has the given condition with status True
+func jobConditionTrue(job *batchv1.Job, condType batchv1.JobConditionType) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == condType && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// findHookStatus returns the status entry for the named hook, or nil
+func findHookStatus(statuses []provisioningv1alpha1.HookStatus, name string) *provisioningv1alpha1.HookStatus {
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
+
+// pruneHookStatuses drops status entries for hooks that are no longer configured
+func pruneHookStatuses(statuses *[]provisioningv1alpha1.HookStatus, hooks []provisioningv1alpha1.LifecycleHook) {
+	if len(*statuses) == 0 {
+		return
+	}
+	names := make(map[string]struct{}, len(hooks))
+	for _, hook := range hooks {
+		names[hook.Name] = struct{}{}
+	}
+	kept := (*statuses)[:0]
+	for _, status := range *statuses {
+		if _, ok := names[status.Name]; ok {
+			kept = append(kept, status)
+		}
+	}
+	*statuses = kept
+}
+
+// setHookStatus adds or replaces the status entry for a hook
+func setHookStatus(statuses *[]provisioningv1alpha1.HookStatus, status provisioningv1alpha1.HookStatus) {
+	for i := range *statuses {
+		if (*statuses)[i].Name == status.Name {
+			(*statuses)[i] = status
+			return
+		}
+	}
+	*statuses = append(*statuses, status)
+}
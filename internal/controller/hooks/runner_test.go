@@ -0,0 +1,306 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Lifecycle Hook Runner", func() {
+	var (
+		ctx        context.Context
+		scheme     *runtime.Scheme
+		recorder   *record.FakeRecorder
+		fakeClient client.Client
+		runner     *Runner
+		bridge     *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	newHook := func(name string) provisioningv1alpha1.LifecycleHook {
+		return provisioningv1alpha1.LifecycleHook{
+			Name:          name,
+			Target:        provisioningv1alpha1.HookTargetManagementCluster,
+			FailurePolicy: provisioningv1alpha1.HookFailurePolicyIgnore,
+			Template: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "hook", Image: "busybox"}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	setJobCondition := func(name string, condType batchv1.JobConditionType) {
+		job := &batchv1.Job{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Name: name, Namespace: bridge.Namespace}, job)).To(Succeed())
+		job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{Type: condType, Status: corev1.ConditionTrue})
+		Expect(fakeClient.Status().Update(ctx, job)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
+
+		recorder = record.NewFakeRecorder(100)
+
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-bridge",
+				Namespace: "test-ns",
+				UID:       "test-uid",
+			},
+		}
+	})
+
+	buildClient := func(objs ...client.Object) {
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(append([]client.Object{bridge}, objs...)...).
+			WithStatusSubresource(bridge, &batchv1.Job{}).
+			Build()
+		runner = NewRunner(fakeClient, scheme, recorder)
+	}
+
+	Describe("Run", func() {
+		It("should create the first hook job and wait for it", func() {
+			buildClient()
+			hooks := []provisioningv1alpha1.LifecycleHook{newHook("first"), newHook("second")}
+			var statuses []provisioningv1alpha1.HookStatus
+
+			done, err := runner.Run(ctx, bridge, HookTypePreDelete, hooks, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeFalse())
+
+			Expect(statuses).To(HaveLen(1))
+			Expect(statuses[0].Name).To(Equal("first"))
+			Expect(statuses[0].Phase).To(Equal(provisioningv1alpha1.HookPhaseRunning))
+			Expect(statuses[0].JobName).To(Equal("test-bridge-predelete-first"))
+
+			job := &batchv1.Job{}
+			Expect(fakeClient.Get(ctx, types.NamespacedName{Name: "test-bridge-predelete-first", Namespace: "test-ns"}, job)).To(Succeed())
+			Expect(job.Labels).To(HaveKeyWithValue(LabelOwnedBy, "test-bridge"))
+			Expect(job.Labels).To(HaveKeyWithValue(LabelHookName, "first"))
+			Expect(job.Labels).To(HaveKeyWithValue(LabelHookType, HookTypePreDelete))
+			Expect(job.Spec.Template.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+			Expect(job.Spec.ActiveDeadlineSeconds).To(Equal(ptr.To(int64(DefaultHookTimeout.Seconds()))))
+			Expect(job.OwnerReferences).To(HaveLen(1))
+
+			// Second hook must not start before the first finishes
+			err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-bridge-predelete-second", Namespace: "test-ns"}, &batchv1.Job{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should run hooks sequentially and report completion", func() {
+			buildClient()
+			hooks := []provisioningv1alpha1.LifecycleHook{newHook("first"), newHook("second")}
+			var statuses []provisioningv1alpha1.HookStatus
+
+			_, err := runner.Run(ctx, bridge, HookTypePreDelete, hooks, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			setJobCondition("test-bridge-predelete-first", batchv1.JobComplete)
+
+			done, err := runner.Run(ctx, bridge, HookTypePreDelete, hooks, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeFalse())
+			Expect(statuses).To(HaveLen(2))
+			Expect(statuses[0].Phase).To(Equal(provisioningv1alpha1.HookPhaseSucceeded))
+			Expect(statuses[0].CompletionTime).NotTo(BeNil())
+			Expect(statuses[1].Phase).To(Equal(provisioningv1alpha1.HookPhaseRunning))
+
+			setJobCondition("test-bridge-predelete-second", batchv1.JobComplete)
+
+			done, err = runner.Run(ctx, bridge, HookTypePreDelete, hooks, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+			Expect(statuses[1].Phase).To(Equal(provisioningv1alpha1.HookPhaseSucceeded))
+		})
+
+		It("should continue past a failed hook with failurePolicy Ignore", func() {
+			buildClient()
+			hooks := []provisioningv1alpha1.LifecycleHook{newHook("first"), newHook("second")}
+			var statuses []provisioningv1alpha1.HookStatus
+
+			_, err := runner.Run(ctx, bridge, HookTypePreDelete, hooks, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			setJobCondition("test-bridge-predelete-first", batchv1.JobFailed)
+
+			done, err := runner.Run(ctx, bridge, HookTypePreDelete, hooks, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeFalse())
+			Expect(statuses[0].Phase).To(Equal(provisioningv1alpha1.HookPhaseFailed))
+			Expect(statuses[1].Phase).To(Equal(provisioningv1alpha1.HookPhaseRunning))
+		})
+
+		It("should block on a failed hook with failurePolicy Fail", func() {
+			buildClient()
+			hook := newHook("first")
+			hook.FailurePolicy = provisioningv1alpha1.HookFailurePolicyFail
+			hooks := []provisioningv1alpha1.LifecycleHook{hook, newHook("second")}
+			var statuses []provisioningv1alpha1.HookStatus
+
+			_, err := runner.Run(ctx, bridge, HookTypePreDelete, hooks, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			setJobCondition("test-bridge-predelete-first", batchv1.JobFailed)
+
+			done, err := runner.Run(ctx, bridge, HookTypePreDelete, hooks, &statuses)
+			Expect(err).To(HaveOccurred())
+			Expect(done).To(BeFalse())
+			Expect(statuses).To(HaveLen(1))
+			Expect(statuses[0].Phase).To(Equal(provisioningv1alpha1.HookPhaseFailed))
+		})
+
+		It("should retry a failed hook up to its retry limit", func() {
+			buildClient()
+			hook := newHook("flaky")
+			hook.RetryLimit = 1
+			hook.FailurePolicy = provisioningv1alpha1.HookFailurePolicyFail
+			hooks := []provisioningv1alpha1.LifecycleHook{hook}
+			var statuses []provisioningv1alpha1.HookStatus
+
+			_, err := runner.Run(ctx, bridge, HookTypePostProvision, hooks, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses[0].Attempts).To(Equal(int32(1)))
+			setJobCondition("test-bridge-postprovision-flaky", batchv1.JobFailed)
+
+			// First failure: job is deleted and the hook waits for a retry
+			done, err := runner.Run(ctx, bridge, HookTypePostProvision, hooks, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeFalse())
+			Expect(statuses[0].Phase).To(Equal(provisioningv1alpha1.HookPhasePending))
+
+			// Retry creates a new job
+			done, err = runner.Run(ctx, bridge, HookTypePostProvision, hooks, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeFalse())
+			Expect(statuses[0].Phase).To(Equal(provisioningv1alpha1.HookPhaseRunning))
+			Expect(statuses[0].Attempts).To(Equal(int32(2)))
+			setJobCondition("test-bridge-postprovision-flaky", batchv1.JobFailed)
+
+			// Retries exhausted: failure policy applies
+			done, err = runner.Run(ctx, bridge, HookTypePostProvision, hooks, &statuses)
+			Expect(err).To(MatchError(ErrHookFailed))
+			Expect(done).To(BeFalse())
+			Expect(statuses[0].Phase).To(Equal(provisioningv1alpha1.HookPhaseFailed))
+		})
+
+		It("should drop status entries for hooks removed from the spec", func() {
+			buildClient()
+			statuses := []provisioningv1alpha1.HookStatus{
+				{Name: "removed", Phase: provisioningv1alpha1.HookPhaseSucceeded},
+			}
+
+			done, err := runner.Run(ctx, bridge, HookTypePostProvision, nil, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+			Expect(statuses).To(BeEmpty())
+		})
+
+		It("should mark a hook as timed out and delete its job", func() {
+			buildClient()
+			hook := newHook("slow")
+			hook.TimeoutSeconds = ptr.To(int32(30))
+			hooks := []provisioningv1alpha1.LifecycleHook{hook}
+			var statuses []provisioningv1alpha1.HookStatus
+
+			_, err := runner.Run(ctx, bridge, HookTypePreDelete, hooks, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+
+			started := metav1.NewTime(time.Now().Add(-time.Minute))
+			statuses[0].StartTime = &started
+
+			done, err := runner.Run(ctx, bridge, HookTypePreDelete, hooks, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+			Expect(statuses[0].Phase).To(Equal(provisioningv1alpha1.HookPhaseTimedOut))
+
+			err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-bridge-predelete-slow", Namespace: "test-ns"}, &batchv1.Job{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should mount the hosted cluster kubeconfig for HostedCluster targets", func() {
+			kubeconfigSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-bridge-admin-kubeconfig", Namespace: "test-ns"},
+				Data:       map[string][]byte{"kubeconfig": []byte("fake-kubeconfig-data")},
+			}
+			buildClient(kubeconfigSecret)
+			hook := newHook("drain")
+			hook.Target = provisioningv1alpha1.HookTargetHostedCluster
+			var statuses []provisioningv1alpha1.HookStatus
+
+			_, err := runner.Run(ctx, bridge, HookTypePreDelete, []provisioningv1alpha1.LifecycleHook{hook}, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+
+			job := &batchv1.Job{}
+			Expect(fakeClient.Get(ctx, types.NamespacedName{Name: "test-bridge-predelete-drain", Namespace: "test-ns"}, job)).To(Succeed())
+			podSpec := job.Spec.Template.Spec
+			Expect(podSpec.Volumes).To(HaveLen(1))
+			Expect(podSpec.Volumes[0].Secret.SecretName).To(Equal("test-bridge-admin-kubeconfig"))
+			Expect(podSpec.Containers[0].VolumeMounts[0].MountPath).To(Equal(HookKubeconfigMountPath))
+			Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "KUBECONFIG", Value: HookKubeconfigMountPath + "/kubeconfig"}))
+		})
+
+		It("should fail a HostedCluster hook when the kubeconfig is missing", func() {
+			buildClient()
+			hook := newHook("drain")
+			hook.Target = provisioningv1alpha1.HookTargetHostedCluster
+			var statuses []provisioningv1alpha1.HookStatus
+
+			done, err := runner.Run(ctx, bridge, HookTypePreDelete, []provisioningv1alpha1.LifecycleHook{hook}, &statuses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+			Expect(statuses[0].Phase).To(Equal(provisioningv1alpha1.HookPhaseFailed))
+		})
+	})
+
+	Describe("HookJobName", func() {
+		It("should keep short names unchanged", func() {
+			Expect(HookJobName("bridge", HookTypePreDelete, "hook")).To(Equal("bridge-predelete-hook"))
+		})
+
+		It("should truncate long names to a valid length deterministically", func() {
+			long := strings.Repeat("a", 60)
+			name := HookJobName(long, HookTypePreDelete, "hook")
+			Expect(len(name)).To(BeNumerically("<=", 63))
+			Expect(HookJobName(long, HookTypePreDelete, "hook")).To(Equal(name))
+			Expect(HookJobName(long, HookTypePreDelete, "other")).NotTo(Equal(name))
+		})
+	})
+})
@@ -0,0 +1,395 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// maxSanitizeAttempts is how many times a rejected HostedCluster is sanitized and created again
+const maxSanitizeAttempts = 3
+
+// protectedFields are the HostedCluster fields that are never dropped to get the spec admitted,
+// as the hosted cluster cannot work as the bridge describes it without them
+var protectedFields = []string{
+	"metadata",
+	"spec.release",
+	"spec.pullSecret",
+	"spec.sshKey",
+	"spec.dns",
+	"spec.platform",
+	"spec.networking",
+	"spec.etcd",
+	"spec.infraID",
+	"spec.services",
+}
+
+var (
+	// fieldErrorPattern matches the field errors admission webhooks list in their message,
+	// e.g. spec.configuration.apiServer: Invalid value: ...
+	fieldErrorPattern = regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9]*(?:\[\d+\])*(?:\.[a-zA-Z][a-zA-Z0-9]*(?:\[\d+\])*)+): (?:Invalid value|Unsupported value|Forbidden|Not supported)`)
+
+	// unknownFieldPattern matches the fields strict field validation rejects, e.g. unknown field "spec.foo"
+	unknownFieldPattern = regexp.MustCompile(`unknown field "([^"]+)"`)
+
+	// subjectAltNamePattern matches rejections of the hostnames the API server certificate is issued for
+	subjectAltNamePattern = regexp.MustCompile(`(?i:subject alternative name)|\bSANs?\b`)
+
+	// pathElementPattern matches an element of a field path, e.g. namedCertificates[0]
+	pathElementPattern = regexp.MustCompile(`^([a-zA-Z][a-zA-Z0-9]*)((?:\[\d+\])*)$`)
+)
+
+// rejection is a HostedCluster admission rejection classified by its cause
+type rejection struct {
+	// reason is the HostedClusterAdmitted condition reason of the rejection class
+	reason string
+	// fields are the rejected field paths, e.g. spec.configuration.apiServer.servingCerts.namedCertificates[0].names[1]
+	fields  []string
+	message string
+}
+
+// classifyRejection returns the rejection err reports if it is a HostedCluster admission rejection, or nil.
+// Rejections of hostnames the API server certificate is issued for are InvalidSubjectAltName, rejections of
+// fields or values the HyperShift version does not support are UnsupportedField, and any other rejection is
+// AdmissionRejected.
+func classifyRejection(err error) *rejection {
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) {
+		return nil
+	}
+	message := status.Status().Message
+	if !apierrors.IsInvalid(err) && !apierrors.IsBadRequest(err) &&
+		!(apierrors.IsForbidden(err) && strings.Contains(message, "denied the request")) {
+		return nil
+	}
+
+	rej := &rejection{reason: provisioningv1alpha1.ReasonAdmissionRejected, message: message}
+	unsupported := strings.Contains(message, "unknown field") || strings.Contains(message, "field not declared in schema")
+	if details := status.Status().Details; details != nil {
+		for _, cause := range details.Causes {
+			if cause.Field != "" {
+				rej.fields = append(rej.fields, strings.TrimPrefix(cause.Field, "."))
+			}
+			unsupported = unsupported || cause.Type == metav1.CauseTypeFieldValueNotSupported
+		}
+	}
+	for _, match := range fieldErrorPattern.FindAllStringSubmatch(message, -1) {
+		rej.fields = append(rej.fields, match[1])
+	}
+	for _, match := range unknownFieldPattern.FindAllStringSubmatch(message, -1) {
+		rej.fields = append(rej.fields, strings.TrimPrefix(match[1], "."))
+	}
+	slices.Sort(rej.fields)
+	rej.fields = slices.Compact(rej.fields)
+
+	lower := strings.ToLower(message)
+	switch {
+	case subjectAltNamePattern.MatchString(message) || slices.ContainsFunc(rej.fields, isSubjectAltNameField):
+		rej.reason = provisioningv1alpha1.ReasonInvalidSubjectAltName
+	case unsupported || strings.Contains(lower, "not supported") || strings.Contains(lower, "unsupported"):
+		rej.reason = provisioningv1alpha1.ReasonUnsupportedField
+	}
+	return rej
+}
+
+// isSubjectAltNameField returns true if the field holds hostnames the API server certificate is issued for
+func isSubjectAltNameField(field string) bool {
+	return strings.Contains(field, "servingCerts") || strings.Contains(field, "kubeAPIServerDNSName")
+}
+
+// createHostedCluster creates hc. When HostedCluster admission rejects the rendered spec with a known cause,
+// the rejected fields are sanitized, or dropped if the HyperShift version does not support them, and the
+// HostedCluster is created again. The HostedClusterAdmitted condition reports the sanitized fields, or the
+// rejection once the spec cannot be sanitized; retrying does not change the spec, so a terminal error is returned.
+func (hm *HostedClusterManager) createHostedCluster(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, hc *hyperv1.HostedCluster) error {
+	log := logf.FromContext(ctx)
+
+	var sanitized []string
+	for attempt := 0; ; attempt++ {
+		err := hm.Create(ctx, hc)
+		hm.Breaker.Record(ctx, err)
+		if err == nil {
+			break
+		}
+		rej := classifyRejection(err)
+		if rej == nil {
+			return fmt.Errorf("failed to create HostedCluster: %w", err)
+		}
+
+		var changes []string
+		if attempt < maxSanitizeAttempts {
+			hc, changes = sanitizeHostedCluster(hc, rej)
+		}
+		if len(changes) == 0 {
+			message := "HostedCluster admission rejected the spec: " + rej.message
+			log.Info("HostedCluster admission rejected the spec", "reason", rej.reason, "fields", rej.fields)
+			setAdmittedCondition(cr, metav1.ConditionFalse, rej.reason, message)
+			if hm.Recorder != nil {
+				hm.Recorder.Event(cr, corev1.EventTypeWarning, "HostedClusterRejected", message)
+			}
+			if updateErr := hm.Status().Update(ctx, cr); updateErr != nil {
+				return fmt.Errorf("failed to update status: %w", updateErr)
+			}
+			return reconcile.TerminalError(fmt.Errorf("failed to create HostedCluster: %w", err))
+		}
+
+		log.Info("HostedCluster admission rejected the spec, creating it again with the rejected fields sanitized",
+			"reason", rej.reason, "changes", changes)
+		sanitized = append(sanitized, changes...)
+	}
+
+	if len(sanitized) > 0 {
+		message := "HostedCluster created after sanitizing the spec admission rejected: " + strings.Join(sanitized, "; ")
+		setAdmittedCondition(cr, metav1.ConditionTrue, provisioningv1alpha1.ReasonHostedClusterSpecSanitized, message)
+		if hm.Recorder != nil {
+			hm.Recorder.Event(cr, corev1.EventTypeWarning, "HostedClusterSpecSanitized", message)
+		}
+	} else if meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.HostedClusterAdmitted) != nil {
+		setAdmittedCondition(cr, metav1.ConditionTrue, provisioningv1alpha1.ReasonHostedClusterAdmitted, "HostedCluster created")
+	}
+	return nil
+}
+
+// sanitizeHostedCluster returns a copy of hc with the fields of the rejection sanitized, and the changes made.
+// Invalid hostnames are normalized; fields the HyperShift version does not support are dropped unless they are
+// protected. No changes are returned if any rejected field cannot be sanitized, as creating the HostedCluster
+// again would be rejected anyway.
+func sanitizeHostedCluster(hc *hyperv1.HostedCluster, rej *rejection) (*hyperv1.HostedCluster, []string) {
+	if len(rej.fields) == 0 || rej.reason == provisioningv1alpha1.ReasonAdmissionRejected {
+		return hc, nil
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(hc)
+	if err != nil {
+		return hc, nil
+	}
+
+	var changes []string
+	for _, field := range rej.fields {
+		value, found := getField(obj, field)
+		if !found {
+			return hc, nil
+		}
+		switch rej.reason {
+		case provisioningv1alpha1.ReasonInvalidSubjectAltName:
+			sanitizedValue, ok := sanitizeSubjectAltNames(value)
+			if !ok || !setField(obj, field, sanitizedValue) {
+				return hc, nil
+			}
+			changes = append(changes, fmt.Sprintf("%s set to %v", field, sanitizedValue))
+		case provisioningv1alpha1.ReasonUnsupportedField:
+			if slices.ContainsFunc(protectedFields, func(protected string) bool {
+				return field == protected || strings.HasPrefix(field, protected+".") || strings.HasPrefix(field, protected+"[")
+			}) || !removeField(obj, field) {
+				return hc, nil
+			}
+			changes = append(changes, field+" dropped")
+		}
+	}
+
+	sanitized := &hyperv1.HostedCluster{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, sanitized); err != nil {
+		return hc, nil
+	}
+	return sanitized, changes
+}
+
+// sanitizeSubjectAltNames normalizes a hostname, or a list of hostnames, the API server certificate is issued for:
+// the scheme, port, path and trailing dot are removed and the name is lowercased. Returns false if a name is still
+// invalid, or if no name changed.
+func sanitizeSubjectAltNames(value any) (any, bool) {
+	switch v := value.(type) {
+	case string:
+		name := sanitizeSubjectAltName(v)
+		return name, name != v && isValidSubjectAltName(name)
+	case []any:
+		names := make([]any, len(v))
+		changed := false
+		for i, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			name := sanitizeSubjectAltName(s)
+			if !isValidSubjectAltName(name) {
+				return nil, false
+			}
+			names[i] = name
+			changed = changed || name != s
+		}
+		return names, changed
+	}
+	return nil, false
+}
+
+// sanitizeSubjectAltName normalizes a hostname, e.g. https://API.Example.com:6443/ to api.example.com
+func sanitizeSubjectAltName(name string) string {
+	name = strings.TrimSpace(name)
+	if _, rest, found := strings.Cut(name, "://"); found {
+		name = rest
+	}
+	name, _, _ = strings.Cut(name, "/")
+	if host, _, err := net.SplitHostPort(name); err == nil {
+		name = host
+	}
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+// isValidSubjectAltName returns true if name is an IP address or a DNS name, optionally a wildcard
+func isValidSubjectAltName(name string) bool {
+	if net.ParseIP(name) != nil {
+		return true
+	}
+	return len(validation.IsDNS1123Subdomain(strings.TrimPrefix(name, "*."))) == 0
+}
+
+// pathElement is an element of a field path: a map key followed by any number of list indexes
+type pathElement struct {
+	key     string
+	indexes []int
+}
+
+// parseFieldPath parses a field path such as spec.configuration.apiServer.servingCerts.namedCertificates[0].names
+func parseFieldPath(path string) ([]pathElement, bool) {
+	var elements []pathElement
+	for _, part := range strings.Split(path, ".") {
+		match := pathElementPattern.FindStringSubmatch(part)
+		if match == nil {
+			return nil, false
+		}
+		element := pathElement{key: match[1]}
+		for _, index := range strings.Split(strings.Trim(match[2], "[]"), "][") {
+			if index == "" {
+				continue
+			}
+			i, err := strconv.Atoi(index)
+			if err != nil {
+				return nil, false
+			}
+			element.indexes = append(element.indexes, i)
+		}
+		elements = append(elements, element)
+	}
+	return elements, len(elements) > 0
+}
+
+// fieldParent walks obj to the container of the last element of path and returns it with the key or
+// index of the field in it
+func fieldParent(obj map[string]any, path string) (parent any, key string, index int, ok bool) {
+	elements, ok := parseFieldPath(path)
+	if !ok {
+		return nil, "", 0, false
+	}
+
+	var current any = obj
+	for i, element := range elements {
+		m, isMap := current.(map[string]any)
+		if !isMap {
+			return nil, "", 0, false
+		}
+		last := i == len(elements)-1
+		if last && len(element.indexes) == 0 {
+			return m, element.key, -1, true
+		}
+		current = m[element.key]
+		for j, idx := range element.indexes {
+			list, isList := current.([]any)
+			if !isList || idx < 0 || idx >= len(list) {
+				return nil, "", 0, false
+			}
+			if last && j == len(element.indexes)-1 {
+				return list, "", idx, true
+			}
+			current = list[idx]
+		}
+	}
+	return nil, "", 0, false
+}
+
+// getField returns the value at path in obj
+func getField(obj map[string]any, path string) (any, bool) {
+	parent, key, index, ok := fieldParent(obj, path)
+	if !ok {
+		return nil, false
+	}
+	if m, isMap := parent.(map[string]any); isMap {
+		value, found := m[key]
+		return value, found
+	}
+	return parent.([]any)[index], true
+}
+
+// setField sets the value at path in obj, which must exist
+func setField(obj map[string]any, path string, value any) bool {
+	parent, key, index, ok := fieldParent(obj, path)
+	if !ok {
+		return false
+	}
+	if m, isMap := parent.(map[string]any); isMap {
+		m[key] = value
+		return true
+	}
+	parent.([]any)[index] = value
+	return true
+}
+
+// removeField removes the map key at path from obj; list items are not removed, as that would shift
+// the indexes of the other rejected fields
+func removeField(obj map[string]any, path string) bool {
+	parent, key, _, ok := fieldParent(obj, path)
+	if !ok {
+		return false
+	}
+	m, isMap := parent.(map[string]any)
+	if !isMap {
+		return false
+	}
+	if _, found := m[key]; !found {
+		return false
+	}
+	delete(m, key)
+	return true
+}
+
+// setAdmittedCondition sets the HostedClusterAdmitted condition
+func setAdmittedCondition(cr *provisioningv1alpha1.DPFHCPBridge, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+		Type:               provisioningv1alpha1.HostedClusterAdmitted,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: cr.Generation,
+	})
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("HostedCluster admission rejections", func() {
+	var (
+		ctx      context.Context
+		cr       *provisioningv1alpha1.DPFHCPBridge
+		hc       *hyperv1.HostedCluster
+		c        client.Client
+		hm       *HostedClusterManager
+		recorder *record.FakeRecorder
+		// rejections are returned by the Create calls in turn, nil once the HostedCluster is admitted
+		rejections []error
+	)
+
+	hostedClusters := schema.GroupResource{Group: "hypershift.openshift.io", Resource: "hostedclusters"}
+	namesPath := field.NewPath("spec", "configuration", "apiServer", "servingCerts", "namedCertificates").Index(0).Child("names")
+
+	webhookDenial := func(message string) error {
+		return apierrors.NewForbidden(hostedClusters, "test-bridge",
+			errors.New(`admission webhook "hostedclusters.hypershift.openshift.io" denied the request: `+message))
+	}
+
+	invalid := func(errs ...*field.Error) error {
+		return apierrors.NewInvalid(schema.GroupKind{Group: "hypershift.openshift.io", Kind: "HostedCluster"}, "test-bridge", errs)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = record.NewFakeRecorder(10)
+		rejections = nil
+
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", Generation: 1},
+		}
+		hc = &hyperv1.HostedCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default"},
+			Spec: hyperv1.HostedClusterSpec{
+				Configuration: &hyperv1.ClusterConfiguration{
+					APIServer: &configv1.APIServerSpec{
+						ServingCerts: configv1.APIServerServingCerts{
+							NamedCertificates: []configv1.APIServerNamedServingCert{{Names: []string{"https://API.Example.com:6443"}}},
+						},
+					},
+					Ingress: &configv1.IngressSpec{Domain: "apps.test-bridge.example.com"},
+				},
+			},
+		}
+		c = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(cr).
+			WithStatusSubresource(cr).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if _, ok := obj.(*hyperv1.HostedCluster); ok && len(rejections) > 0 {
+						err := rejections[0]
+						rejections = rejections[1:]
+						if err != nil {
+							return err
+						}
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			}).
+			Build()
+		hm = NewHostedClusterManager(c, scheme)
+		hm.Recorder = recorder
+	})
+
+	created := func() *hyperv1.HostedCluster {
+		stored := &hyperv1.HostedCluster{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "test-bridge", Namespace: "default"}, stored)).To(Succeed())
+		return stored
+	}
+
+	It("should classify admission rejections by their cause", func() {
+		rej := classifyRejection(invalid(field.Invalid(namesPath.Index(0), "https://API.Example.com:6443", "must be a valid DNS name")))
+		Expect(rej.reason).To(Equal(provisioningv1alpha1.ReasonInvalidSubjectAltName))
+		Expect(rej.fields).To(Equal([]string{"spec.configuration.apiServer.servingCerts.namedCertificates[0].names[0]"}))
+
+		rej = classifyRejection(webhookDenial("spec.configuration.ingress: Forbidden: is not supported for release 4.17"))
+		Expect(rej.reason).To(Equal(provisioningv1alpha1.ReasonUnsupportedField))
+		Expect(rej.fields).To(Equal([]string{"spec.configuration.ingress"}))
+
+		rej = classifyRejection(webhookDenial("spec.release.image: Invalid value: cannot pull the release image"))
+		Expect(rej.reason).To(Equal(provisioningv1alpha1.ReasonAdmissionRejected))
+
+		Expect(classifyRejection(apierrors.NewForbidden(hostedClusters, "test-bridge", errors.New("RBAC denied")))).To(BeNil())
+		Expect(classifyRejection(apierrors.NewServerTimeout(hostedClusters, "create", 1))).To(BeNil())
+	})
+
+	It("should sanitize a rejected certificate hostname and create the HostedCluster again", func() {
+		rejections = []error{invalid(field.Invalid(namesPath.Index(0), "https://API.Example.com:6443", "must be a valid DNS name"))}
+
+		Expect(hm.createHostedCluster(ctx, cr, hc)).To(Succeed())
+
+		Expect(created().Spec.Configuration.APIServer.ServingCerts.NamedCertificates[0].Names).To(Equal([]string{"api.example.com"}))
+		cond := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.HostedClusterAdmitted)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonHostedClusterSpecSanitized))
+		Expect(recorder.Events).To(Receive(ContainSubstring("HostedClusterSpecSanitized")))
+	})
+
+	It("should drop a field the HyperShift version does not support", func() {
+		rejections = []error{webhookDenial("spec.configuration.ingress: Forbidden: is not supported for release 4.17")}
+
+		Expect(hm.createHostedCluster(ctx, cr, hc)).To(Succeed())
+
+		Expect(created().Spec.Configuration.Ingress).To(BeNil())
+		Expect(meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.HostedClusterAdmitted).Message).To(
+			ContainSubstring("spec.configuration.ingress dropped"))
+	})
+
+	It("should report a rejection that cannot be sanitized in a condition and stop retrying", func() {
+		rejections = []error{webhookDenial("spec.release.image: Unsupported value: \"4.12\" is not supported")}
+
+		err := hm.createHostedCluster(ctx, cr, hc)
+
+		Expect(errors.Is(err, reconcile.TerminalError(nil))).To(BeTrue())
+		stored := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(cr), stored)).To(Succeed())
+		cond := meta.FindStatusCondition(stored.Status.Conditions, provisioningv1alpha1.HostedClusterAdmitted)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonUnsupportedField))
+		Expect(recorder.Events).To(Receive(ContainSubstring("HostedClusterRejected")))
+	})
+
+	It("should give up once a sanitized field is rejected again", func() {
+		denial := webhookDenial("spec.configuration.ingress: Forbidden: is not supported for release 4.17")
+		rejections = []error{
+			invalid(field.Invalid(namesPath.Index(0), "https://API.Example.com:6443", "invalid SAN")),
+			denial, denial, denial,
+		}
+
+		err := hm.createHostedCluster(ctx, cr, hc)
+
+		Expect(errors.Is(err, reconcile.TerminalError(nil))).To(BeTrue())
+		Expect(meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.HostedClusterAdmitted).Status).To(
+			Equal(metav1.ConditionFalse))
+	})
+
+	It("should return other create errors for the regular retries", func() {
+		rejections = []error{apierrors.NewServerTimeout(hostedClusters, "create", 1)}
+
+		err := hm.createHostedCluster(ctx, cr, hc)
+
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, reconcile.TerminalError(nil))).To(BeFalse())
+		Expect(meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.HostedClusterAdmitted)).To(BeNil())
+	})
+})
@@ -0,0 +1,94 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+// adoptIfOrphaned makes the DPFHCPBridge the controller of an existing object when the bridge
+// is in adoption mode and the object has no controller yet (e.g. a hand-created HostedCluster
+// being migrated). Returns true if the object was adopted; objects controlled by anything
+// else are never taken over. HostedClusters and NodePools that differ from the bridge in fields
+// HyperShift does not allow to change are not adopted either, and reported in the error.
+//
+// Once adopted, the mutable fields of the HostedCluster are reconciled like those of a HostedCluster
+// the bridge created (see SyncHostedClusterSpec).
+func adoptIfOrphaned(ctx context.Context, c client.Client, scheme *runtime.Scheme, cr *provisioningv1alpha1.DPFHCPBridge, obj client.Object) (bool, error) {
+	if !cr.AdoptsExisting() || metav1.GetControllerOf(obj) != nil {
+		return false, nil
+	}
+
+	if mismatches := adoptionMismatches(cr, obj); len(mismatches) > 0 {
+		return false, fmt.Errorf("cannot adopt %s/%s, it does not match the DPFHCPBridge: %s",
+			obj.GetNamespace(), obj.GetName(), strings.Join(mismatches, "; "))
+	}
+
+	if err := controllerutil.SetControllerReference(cr, obj, scheme); err != nil {
+		return false, fmt.Errorf("failed to set owner reference on %s: %w", obj.GetName(), err)
+	}
+	setBackReference(obj, cr)
+	if _, isSecret := obj.(*corev1.Secret); isSecret {
+		common.SetOwnerLabels(obj, cr, common.ComponentHostedClusterSecrets)
+	}
+	if err := c.Update(ctx, obj); err != nil {
+		return false, fmt.Errorf("failed to adopt %s: %w", obj.GetName(), err)
+	}
+
+	logf.FromContext(ctx).Info("Adopted existing resource",
+		"kind", fmt.Sprintf("%T", obj),
+		"name", obj.GetName(),
+		"namespace", obj.GetNamespace())
+	return true, nil
+}
+
+// adoptionMismatches returns the fields of an existing HostedCluster or NodePool that differ from what the
+// DPFHCPBridge would create and that cannot be reconciled, because HyperShift does not allow to change them
+// or because they tie the object to another cluster.
+func adoptionMismatches(cr *provisioningv1alpha1.DPFHCPBridge, obj client.Object) []string {
+	var mismatches []string
+	mismatch := func(field, got, want string) {
+		if got != want {
+			mismatches = append(mismatches, fmt.Sprintf("%s is %q, expected %q", field, got, want))
+		}
+	}
+
+	switch o := obj.(type) {
+	case *hyperv1.HostedCluster:
+		mismatch("spec.dns.baseDomain", o.Spec.DNS.BaseDomain, cr.Spec.BaseDomain)
+		mismatch("spec.platform.type", string(o.Spec.Platform.Type), string(getPlatform(cr).Type))
+		mismatch("spec.controllerAvailabilityPolicy", string(o.Spec.ControllerAvailabilityPolicy),
+			string(cr.Spec.ControlPlaneAvailabilityPolicy))
+		mismatch("spec.etcd.managementType", string(o.Spec.Etcd.ManagementType), string(hyperv1.Managed))
+	case *hyperv1.NodePool:
+		mismatch("spec.clusterName", o.Spec.ClusterName, cr.Name)
+	}
+	return mismatches
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Adoption of existing resources", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		cr     *provisioningv1alpha1.DPFHCPBridge
+		c      client.Client
+	)
+
+	npKey := types.NamespacedName{Name: "test-bridge", Namespace: "default"}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", UID: "bridge-uid"},
+		}
+		orphan := &hyperv1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{Name: npKey.Name, Namespace: npKey.Namespace},
+			Spec:       hyperv1.NodePoolSpec{ClusterName: "test-bridge"},
+		}
+		c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(orphan).Build()
+	})
+
+	It("should report a conflict for an orphaned NodePool without adoption mode", func() {
+		_, err := NewNodePoolManager(c, scheme).CreateNodePool(ctx, cr)
+		Expect(err).To(MatchError(ContainSubstring("owned by different DPFHCPBridge")))
+	})
+
+	It("should adopt an orphaned NodePool in adoption mode", func() {
+		cr.Annotations = map[string]string{provisioningv1alpha1.AnnotationAdoptExisting: "true"}
+
+		_, err := NewNodePoolManager(c, scheme).CreateNodePool(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		np := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, npKey, np)).To(Succeed())
+		Expect(metav1.IsControlledBy(np, cr)).To(BeTrue())
+	})
+
+	It("should not take over a NodePool controlled by another object", func() {
+		other := &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", UID: "other-uid"},
+		}
+		np := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, npKey, np)).To(Succeed())
+		np.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(other, provisioningv1alpha1.GroupVersion.WithKind("DPFHCPBridge"))}
+		Expect(c.Update(ctx, np)).To(Succeed())
+
+		cr.Annotations = map[string]string{provisioningv1alpha1.AnnotationAdoptExisting: "true"}
+		_, err := NewNodePoolManager(c, scheme).CreateNodePool(ctx, cr)
+		Expect(err).To(MatchError(ContainSubstring("owned by different DPFHCPBridge")))
+	})
+
+	It("should not adopt a NodePool of another HostedCluster", func() {
+		np := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, npKey, np)).To(Succeed())
+		np.Spec.ClusterName = "hand-made"
+		Expect(c.Update(ctx, np)).To(Succeed())
+
+		cr.Annotations = map[string]string{provisioningv1alpha1.AnnotationAdoptExisting: "true"}
+		_, err := NewNodePoolManager(c, scheme).CreateNodePool(ctx, cr)
+		Expect(err).To(MatchError(ContainSubstring(`spec.clusterName is "hand-made", expected "test-bridge"`)))
+
+		Expect(c.Get(ctx, npKey, np)).To(Succeed())
+		Expect(metav1.GetControllerOf(np)).To(BeNil())
+	})
+
+	Context("HostedCluster", func() {
+		var hc *hyperv1.HostedCluster
+
+		BeforeEach(func() {
+			cr.Annotations = map[string]string{provisioningv1alpha1.AnnotationAdoptExisting: "true"}
+			cr.Spec.BaseDomain = "example.com"
+			cr.Spec.ControlPlaneAvailabilityPolicy = hyperv1.HighlyAvailable
+
+			hc = &hyperv1.HostedCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default"},
+				Spec: hyperv1.HostedClusterSpec{
+					DNS:                          hyperv1.DNSSpec{BaseDomain: "example.com"},
+					Platform:                     hyperv1.PlatformSpec{Type: hyperv1.NonePlatform},
+					ControllerAvailabilityPolicy: hyperv1.HighlyAvailable,
+					Etcd:                         hyperv1.EtcdSpec{ManagementType: hyperv1.Managed},
+				},
+			}
+			Expect(c.Create(ctx, hc)).To(Succeed())
+		})
+
+		It("should adopt a HostedCluster matching the bridge", func() {
+			adopted, err := adoptIfOrphaned(ctx, c, scheme, cr, hc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adopted).To(BeTrue())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(hc), hc)).To(Succeed())
+			Expect(metav1.IsControlledBy(hc, cr)).To(BeTrue())
+			Expect(verifyBackReference(hc, cr)).To(Succeed())
+		})
+
+		It("should not adopt a HostedCluster differing in fields that cannot be changed", func() {
+			hc.Spec.DNS.BaseDomain = "lab.example.com"
+			hc.Spec.ControllerAvailabilityPolicy = hyperv1.SingleReplica
+
+			adopted, err := adoptIfOrphaned(ctx, c, scheme, cr, hc)
+			Expect(adopted).To(BeFalse())
+			Expect(err).To(MatchError(And(
+				ContainSubstring(`spec.dns.baseDomain is "lab.example.com", expected "example.com"`),
+				ContainSubstring(`spec.controllerAvailabilityPolicy is "SingleReplica", expected "HighlyAvailable"`),
+			)))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(hc), hc)).To(Succeed())
+			Expect(metav1.GetControllerOf(hc)).To(BeNil())
+		})
+	})
+})
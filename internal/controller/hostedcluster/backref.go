@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const (
+	// AnnotationBridgeName is the annotation key pointing back to the owning DPFHCPBridge name
+	AnnotationBridgeName = "dpf-hcp-bridge-operator/bridge-name"
+
+	// AnnotationBridgeNamespace is the annotation key pointing back to the owning DPFHCPBridge namespace
+	AnnotationBridgeNamespace = "dpf-hcp-bridge-operator/bridge-namespace"
+
+	// AnnotationBridgeUID is the annotation key pointing back to the owning DPFHCPBridge UID
+	AnnotationBridgeUID = "dpf-hcp-bridge-operator/bridge-uid"
+)
+
+// setBackReference stamps the object with annotations pointing back to the DPFHCPBridge.
+// Returns true if the annotations changed.
+func setBackReference(obj client.Object, cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	want := map[string]string{
+		AnnotationBridgeName:      cr.Name,
+		AnnotationBridgeNamespace: cr.Namespace,
+		AnnotationBridgeUID:       string(cr.UID),
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, len(want))
+	}
+
+	changed := false
+	for k, v := range want {
+		if annotations[k] != v {
+			annotations[k] = v
+			changed = true
+		}
+	}
+	obj.SetAnnotations(annotations)
+	return changed
+}
+
+// clearBackReference removes the annotations pointing back to the DPFHCPBridge from the object
+func clearBackReference(obj client.Object) {
+	annotations := obj.GetAnnotations()
+	for _, k := range []string{AnnotationBridgeName, AnnotationBridgeNamespace, AnnotationBridgeUID} {
+		delete(annotations, k)
+	}
+	obj.SetAnnotations(annotations)
+}
+
+// verifyBackReference checks that the back-reference annotations of the object, if present,
+// point to the DPFHCPBridge. Objects without them (created before back-references were
+// introduced, or hand-created ones being adopted) pass, so that they can be stamped.
+func verifyBackReference(obj client.Object, cr *provisioningv1alpha1.DPFHCPBridge) error {
+	annotations := obj.GetAnnotations()
+
+	checks := []struct {
+		key  string
+		want string
+	}{
+		{AnnotationBridgeName, cr.Name},
+		{AnnotationBridgeNamespace, cr.Namespace},
+		{AnnotationBridgeUID, string(cr.UID)},
+	}
+	for _, c := range checks {
+		if got, ok := annotations[c.key]; ok && got != c.want {
+			return fmt.Errorf("%s/%s belongs to another DPFHCPBridge (%s=%q, expected %q)",
+				obj.GetNamespace(), obj.GetName(), c.key, got, c.want)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Back-reference annotations", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		cr     *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	key := types.NamespacedName{Name: "test-bridge", Namespace: "default"}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace, UID: "bridge-uid"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+			},
+		}
+	})
+
+	It("should stamp new NodePools with a back-reference to the bridge", func() {
+		c := newClient()
+		_, err := NewNodePoolManager(c, scheme).CreateNodePool(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		np := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, key, np)).To(Succeed())
+		Expect(np.Annotations).To(HaveKeyWithValue(AnnotationBridgeName, "test-bridge"))
+		Expect(np.Annotations).To(HaveKeyWithValue(AnnotationBridgeNamespace, "default"))
+		Expect(np.Annotations).To(HaveKeyWithValue(AnnotationBridgeUID, "bridge-uid"))
+	})
+
+	It("should backfill the back-reference on owned NodePools created without it", func() {
+		np := &hyperv1.NodePool{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		np.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(cr, provisioningv1alpha1.GroupVersion.WithKind("DPFHCPBridge"))}
+		c := newClient(np)
+
+		_, err := NewNodePoolManager(c, scheme).CreateNodePool(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Get(ctx, key, np)).To(Succeed())
+		Expect(np.Annotations).To(HaveKeyWithValue(AnnotationBridgeUID, "bridge-uid"))
+	})
+
+	It("should refuse to touch a NodePool pointing to another bridge", func() {
+		np := &hyperv1.NodePool{ObjectMeta: metav1.ObjectMeta{
+			Name:        key.Name,
+			Namespace:   key.Namespace,
+			Annotations: map[string]string{AnnotationBridgeUID: "previous-bridge-uid"},
+		}}
+		np.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(cr, provisioningv1alpha1.GroupVersion.WithKind("DPFHCPBridge"))}
+		c := newClient(np)
+
+		_, err := NewNodePoolManager(c, scheme).CreateNodePool(ctx, cr)
+		Expect(err).To(MatchError(ContainSubstring("belongs to another DPFHCPBridge")))
+	})
+
+	It("should not delete a HostedCluster pointing to another bridge during cleanup", func() {
+		hc := &hyperv1.HostedCluster{ObjectMeta: metav1.ObjectMeta{
+			Name:        key.Name,
+			Namespace:   key.Namespace,
+			Annotations: map[string]string{AnnotationBridgeNamespace: "elsewhere"},
+		}}
+		c := newClient(hc)
+		handler := NewCleanupHandler(c, record.NewFakeRecorder(10))
+
+		deleted, err := handler.deleteResource(ctx, cr, &hyperv1.HostedCluster{}, "HostedCluster")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+		Expect(c.Get(ctx, key, &hyperv1.HostedCluster{})).To(Succeed())
+	})
+
+	It("should delete a HostedCluster pointing to this bridge during cleanup", func() {
+		hc := &hyperv1.HostedCluster{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		setBackReference(hc, cr)
+		c := newClient(hc)
+		handler := NewCleanupHandler(c, record.NewFakeRecorder(10))
+
+		_, err := handler.deleteResource(ctx, cr, &hyperv1.HostedCluster{}, "HostedCluster")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apierrors.IsNotFound(c.Get(ctx, key, &hyperv1.HostedCluster{}))).To(BeTrue())
+	})
+})
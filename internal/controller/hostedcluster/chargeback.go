@@ -0,0 +1,134 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// ControlPlaneNamespace returns the namespace HyperShift runs the hosted control plane of a bridge in
+func ControlPlaneNamespace(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	return cr.Namespace + "-" + cr.Name
+}
+
+// SyncChargebackLabels stamps the configured chargeback labels on the HostedCluster and the hosted
+// control plane namespace of the bridge, and corrects them when they drift: labels whose value changed
+// are reset, and chargeback labels that no longer have a value are removed. Other labels are left alone.
+//
+// The hosted control plane namespace is created by HyperShift; it is labeled once it exists.
+func (hm *HostedClusterManager) SyncChargebackLabels(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	keys := hm.Chargeback.Keys()
+	if len(keys) == 0 || cr.Status.HostedClusterRef == nil {
+		return ctrl.Result{}, nil
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := hm.Get(ctx, types.NamespacedName{Name: cr.Namespace}, namespace); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get namespace %s for chargeback labels: %w", cr.Namespace, err)
+	}
+	desired := hm.Chargeback.Values(cr, namespace)
+
+	hc := &hyperv1.HostedCluster{}
+	if err := hm.Get(ctx, types.NamespacedName{Name: cr.Name, Namespace: cr.Namespace}, hc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get HostedCluster for chargeback labels: %w", err)
+	}
+	if !metav1.IsControlledBy(hc, cr) || !hc.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	if err := verifyBackReference(hc, cr); err != nil {
+		return ctrl.Result{}, fmt.Errorf("hostedCluster ownership check failed: %w", err)
+	}
+
+	if patch, ok := labelPatch(hc, keys, desired); ok {
+		if ok, retryAfter := hm.Breaker.Allow(ctx); !ok {
+			log.V(1).Info("HyperShift circuit open, deferring HostedCluster chargeback labels", "retryAfter", retryAfter)
+			return ctrl.Result{RequeueAfter: retryAfter}, nil
+		}
+
+		log.Info("Updating HostedCluster chargeback labels", "hostedCluster", hc.Name, "labels", desired)
+		err := hm.Patch(ctx, hc, patch)
+		hm.Breaker.Record(ctx, err)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update HostedCluster chargeback labels: %w", err)
+		}
+	}
+
+	controlPlaneNamespace := &corev1.Namespace{}
+	if err := hm.Get(ctx, types.NamespacedName{Name: ControlPlaneNamespace(cr)}, controlPlaneNamespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The namespace watch triggers a new reconcile once HyperShift creates it
+			log.V(1).Info("Hosted control plane namespace not created yet", "namespace", ControlPlaneNamespace(cr))
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get hosted control plane namespace for chargeback labels: %w", err)
+	}
+	if !controlPlaneNamespace.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	if patch, ok := labelPatch(controlPlaneNamespace, keys, desired); ok {
+		log.Info("Updating hosted control plane namespace chargeback labels",
+			"namespace", controlPlaneNamespace.Name, "labels", desired)
+		if err := hm.Patch(ctx, controlPlaneNamespace, patch); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update hosted control plane namespace chargeback labels: %w", err)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// labelPatch sets the desired values of the given label keys on obj, removing keys without a desired
+// value, and returns the patch to persist them. Returns false if the labels already match.
+func labelPatch(obj client.Object, keys []string, desired map[string]string) (client.Patch, bool) {
+	current := obj.GetLabels()
+	labels := maps.Clone(current)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for _, key := range keys {
+		if value, ok := desired[key]; ok {
+			labels[key] = value
+		} else {
+			delete(labels, key)
+		}
+	}
+	if maps.Equal(labels, current) {
+		return nil, false
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	obj.SetLabels(labels)
+	return patch, true
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/chargeback"
+)
+
+var _ = Describe("Chargeback Labels", func() {
+	var (
+		ctx       context.Context
+		scheme    *runtime.Scheme
+		cr        *provisioningv1alpha1.DPFHCPBridge
+		hc        *hyperv1.HostedCluster
+		namespace *corev1.Namespace
+		c         client.Client
+		hm        *HostedClusterManager
+	)
+
+	key := types.NamespacedName{Name: "test-bridge", Namespace: "tenant-a"}
+
+	currentLabels := func(obj client.Object, name types.NamespacedName) map[string]string {
+		Expect(c.Get(ctx, name, obj)).To(Succeed())
+		return obj.GetLabels()
+	}
+
+	build := func(objs ...client.Object) {
+		c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(append([]client.Object{hc, namespace}, objs...)...).Build()
+		hm = NewHostedClusterManager(c, scheme)
+		var err error
+		hm.Chargeback, err = chargeback.Parse([]byte(`
+labels:
+- key: cost.example.com/tenant
+  fromNamespaceLabel: tenant
+  default: unassigned
+- key: cost.example.com/bridge-namespace
+  fromBridgeNamespace: true
+- key: cost.example.com/project
+  fromBridgeLabel: project
+`))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				UID:       "bridge-uid",
+				Labels:    map[string]string{"project": "edge"},
+			},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				HostedClusterRef: &corev1.ObjectReference{Name: key.Name, Namespace: key.Namespace},
+			},
+		}
+
+		hc = &hyperv1.HostedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    map[string]string{"other": "kept"},
+			},
+		}
+		hc.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(cr, provisioningv1alpha1.GroupVersion.WithKind("DPFHCPBridge"))}
+		setBackReference(hc, cr)
+
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Namespace, Labels: map[string]string{"tenant": "acme"}},
+		}
+	})
+
+	It("should do nothing without chargeback labels", func() {
+		build()
+		hm.Chargeback = nil
+
+		_, err := hm.SyncChargebackLabels(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(currentLabels(&hyperv1.HostedCluster{}, key)).To(Equal(map[string]string{"other": "kept"}))
+	})
+
+	It("should label the HostedCluster and the hosted control plane namespace", func() {
+		build(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ControlPlaneNamespace(cr)}})
+
+		_, err := hm.SyncChargebackLabels(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		expected := map[string]string{
+			"cost.example.com/tenant":           "acme",
+			"cost.example.com/bridge-namespace": "tenant-a",
+			"cost.example.com/project":          "edge",
+		}
+		Expect(currentLabels(&hyperv1.HostedCluster{}, key)).To(Equal(map[string]string{
+			"other":                             "kept",
+			"cost.example.com/tenant":           "acme",
+			"cost.example.com/bridge-namespace": "tenant-a",
+			"cost.example.com/project":          "edge",
+		}))
+		Expect(currentLabels(&corev1.Namespace{}, types.NamespacedName{Name: "tenant-a-test-bridge"})).To(Equal(expected))
+	})
+
+	It("should correct drifted labels and remove labels that lost their value", func() {
+		hc.Labels["cost.example.com/tenant"] = "someone-else"
+		hc.Labels["cost.example.com/project"] = "old"
+		delete(cr.Labels, "project")
+		delete(namespace.Labels, "tenant")
+		build()
+
+		_, err := hm.SyncChargebackLabels(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(currentLabels(&hyperv1.HostedCluster{}, key)).To(Equal(map[string]string{
+			"other":                             "kept",
+			"cost.example.com/tenant":           "unassigned",
+			"cost.example.com/bridge-namespace": "tenant-a",
+		}))
+	})
+
+	It("should not patch labels that are already correct", func() {
+		build()
+		_, err := hm.SyncChargebackLabels(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		hc := &hyperv1.HostedCluster{}
+		Expect(c.Get(ctx, key, hc)).To(Succeed())
+		resourceVersion := hc.ResourceVersion
+
+		_, err = hm.SyncChargebackLabels(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, key, hc)).To(Succeed())
+		Expect(hc.ResourceVersion).To(Equal(resourceVersion))
+	})
+
+	It("should wait for HyperShift to create the hosted control plane namespace", func() {
+		build()
+
+		result, err := hm.SyncChargebackLabels(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(currentLabels(&hyperv1.HostedCluster{}, key)).To(HaveKeyWithValue("cost.example.com/tenant", "acme"))
+	})
+
+	It("should not label a HostedCluster the bridge does not control", func() {
+		hc.OwnerReferences = nil
+		build()
+
+		_, err := hm.SyncChargebackLabels(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(currentLabels(&hyperv1.HostedCluster{}, key)).To(Equal(map[string]string{"other": "kept"}))
+	})
+})
@@ -19,18 +19,23 @@ package hostedcluster
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/finalizer"
 )
 
 const (
@@ -47,7 +52,8 @@ const (
 // This handler is responsible for:
 // 1. Deleting HostedCluster CR and waiting for full deletion
 // 2. Deleting NodePool CR and waiting for full deletion
-// 3. Deleting copied/generated secrets (pull-secret, ssh-key, etcd-encryption-key)
+// 3. Deleting copied/generated secrets (pull-secret, ssh-key, etcd-encryption-key), except the
+// ones marked retain-on-delete, which are released
 type CleanupHandler struct {
 	client   client.Client
 	recorder record.EventRecorder
@@ -75,12 +81,13 @@ func (h *CleanupHandler) Name() string {
 // 5. Deleting copied/generated secrets
 //
 // Returns:
-// - nil if cleanup succeeded or resources are already gone
+// - ctrl.Result{}, nil if cleanup succeeded or resources are already gone
+// - ctrl.Result{RequeueAfter: DeletionRequeueInterval}, nil while a resource is being deleted
 // - error if cleanup failed and should be retried
 //
 // Note: This handler does NOT enforce timeout. The finalizer manager
 // is responsible for timeout handling if needed.
-func (h *CleanupHandler) Cleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) error {
+func (h *CleanupHandler) Cleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
 	log := logf.FromContext(ctx).WithValues(
 		"handler", h.Name(),
 		"dpfhcpbridge", fmt.Sprintf("%s/%s", cr.Namespace, cr.Name),
@@ -91,13 +98,13 @@ func (h *CleanupHandler) Cleanup(ctx context.Context, cr *provisioningv1alpha1.D
 	hcDeleted, err := h.deleteResource(ctx, cr, &hyperv1.HostedCluster{}, "HostedCluster")
 	if err != nil {
 		log.Error(err, "Failed to delete HostedCluster")
-		return err
+		return ctrl.Result{}, err
 	}
 
 	if !hcDeleted {
-		// HostedCluster still exists, return error to trigger requeue
-		log.Info("HostedCluster deletion in progress, will retry")
-		return fmt.Errorf("waiting for HostedCluster deletion")
+		// HostedCluster still exists, check again later
+		log.Info("HostedCluster deletion in progress, will requeue")
+		return ctrl.Result{RequeueAfter: DeletionRequeueInterval}, nil
 	}
 
 	// Step 2: Delete NodePool and wait for it to be fully removed
@@ -105,27 +112,41 @@ func (h *CleanupHandler) Cleanup(ctx context.Context, cr *provisioningv1alpha1.D
 	npDeleted, err := h.deleteResource(ctx, cr, &hyperv1.NodePool{}, "NodePool")
 	if err != nil {
 		log.Error(err, "Failed to delete NodePool")
-		return err
+		return ctrl.Result{}, err
 	}
 
 	if !npDeleted {
-		// NodePool still exists, return error to trigger requeue
-		log.Info("NodePool deletion in progress, will retry")
-		return fmt.Errorf("waiting for NodePool deletion")
+		// NodePool still exists, check again later
+		log.Info("NodePool deletion in progress, will requeue")
+		return ctrl.Result{RequeueAfter: DeletionRequeueInterval}, nil
+	}
+
+	// Delete the NodePools listed in spec.nodePools the same way
+	for _, pool := range cr.Spec.NodePools {
+		name := AdditionalNodePoolName(cr, pool.Name)
+		deleted, err := h.deleteNamedResource(ctx, cr, name, &hyperv1.NodePool{}, "NodePool")
+		if err != nil {
+			log.Error(err, "Failed to delete NodePool", "nodePool", name)
+			return ctrl.Result{}, err
+		}
+		if !deleted {
+			log.Info("NodePool deletion in progress, will requeue", "nodePool", name)
+			return ctrl.Result{RequeueAfter: DeletionRequeueInterval}, nil
+		}
 	}
 
 	// Step 3: Delete secrets
 	log.Info("NodePool deleted, deleting secrets")
 	if err := h.deleteSecrets(ctx, cr); err != nil {
 		log.Error(err, "Failed to delete secrets")
-		return err
+		return ctrl.Result{}, err
 	}
 
 	log.Info("HostedCluster cleanup completed successfully")
 	h.recorder.Event(cr, "Normal", "HostedClusterCleanupSucceeded",
 		"HostedCluster, NodePool, and secrets deleted successfully")
 
-	return nil
+	return ctrl.Result{}, nil
 }
 
 // deleteResource is a generic function to delete a Kubernetes resource and wait for deletion
@@ -135,12 +156,24 @@ func (h *CleanupHandler) deleteResource(
 	cr *provisioningv1alpha1.DPFHCPBridge,
 	obj client.Object,
 	resourceKind string,
+) (bool, error) {
+	// HostedCluster and the default NodePool use the same name as the CR
+	return h.deleteNamedResource(ctx, cr, cr.Name, obj, resourceKind)
+}
+
+// deleteNamedResource deletes the named resource in the CR namespace and waits for deletion
+// Returns true when resource is fully deleted (NotFound), false if still exists
+func (h *CleanupHandler) deleteNamedResource(
+	ctx context.Context,
+	cr *provisioningv1alpha1.DPFHCPBridge,
+	name string,
+	obj client.Object,
+	resourceKind string,
 ) (bool, error) {
 	log := logf.FromContext(ctx)
 
-	// Construct the resource key from CR name (HostedCluster and NodePool use same name as CR)
 	key := types.NamespacedName{
-		Name:      cr.Name,
+		Name:      name,
 		Namespace: cr.Namespace,
 	}
 
@@ -163,6 +196,15 @@ func (h *CleanupHandler) deleteResource(
 		return false, fmt.Errorf("failed to get %s: %w", resourceKind, err)
 	}
 
+	// Never delete a resource whose back-reference annotations point to another DPFHCPBridge
+	if err := verifyBackReference(obj, cr); err != nil {
+		log.Info(fmt.Sprintf("%s belongs to another DPFHCPBridge, skipping deletion", resourceKind),
+			resourceKind, key.Name,
+			"namespace", key.Namespace,
+			"reason", err.Error())
+		return true, nil
+	}
+
 	// Resource still exists
 	deletionTimestamp := obj.GetDeletionTimestamp()
 	if deletionTimestamp == nil {
@@ -194,70 +236,168 @@ func (h *CleanupHandler) deleteResource(
 	return false, nil
 }
 
-// deleteSecrets deletes all copied/generated secrets
+// deleteSecrets deletes every secret labelled as owned by this DPFHCPBridge in the namespaces
+// the operator writes to (the bridge namespace and the DPUCluster namespaces). It runs last,
+// once the HostedCluster is gone, so it also sweeps secrets synced by other features.
+// Secrets created before the ownership labels were introduced still carry an OwnerReference
+// and are removed by Kubernetes garbage collection once the DPFHCPBridge is gone.
+// Secrets marked with the retain-on-delete annotation are released instead (see releaseRetainedSecrets),
+// and so are the shared pull secret copies, which are deleted once no other bridge references them.
 func (h *CleanupHandler) deleteSecrets(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) error {
 	log := logf.FromContext(ctx)
 
-	secretNames := []string{
-		fmt.Sprintf("%s-pull-secret", cr.Name),
-		fmt.Sprintf("%s-ssh-key", cr.Name),
-		fmt.Sprintf("%s-etcd-encryption-key", cr.Name),
-	}
+	namespaces := h.secretNamespaces(cr)
+	total := 0
+	for _, namespace := range namespaces {
+		if err := h.releaseRetainedSecrets(ctx, cr, namespace); err != nil {
+			log.Error(err, "Failed to release retained secrets", "namespace", namespace)
+			return fmt.Errorf("failed to release retained secrets in %s: %w", namespace, err)
+		}
 
-	for _, secretName := range secretNames {
-		if err := h.deleteSecret(ctx, cr.Namespace, secretName); err != nil {
-			log.Error(err, "Failed to delete secret",
-				"secret", secretName,
-				"namespace", cr.Namespace)
-			return err
+		deleted, err := common.DeleteOwnedObjects(ctx, h.client, cr, &corev1.SecretList{}, namespace)
+		if err != nil {
+			log.Error(err, "Failed to delete secrets", "namespace", namespace)
+			return fmt.Errorf("failed to delete secrets in %s: %w", namespace, err)
 		}
+		total += deleted
+	}
+
+	released, err := releaseSharedPullSecrets(ctx, h.client, cr, "")
+	if err != nil {
+		log.Error(err, "Failed to release shared pull secrets")
+		return err
 	}
 
 	log.Info("All secrets deleted successfully",
-		"count", len(secretNames),
-		"namespace", cr.Namespace)
+		"count", total,
+		"namespaces", namespaces,
+		"sharedPullSecretsReleased", len(released))
 
 	return nil
 }
 
-// deleteSecret deletes a single secret
-func (h *CleanupHandler) deleteSecret(ctx context.Context, namespace, secretName string) error {
+// releaseRetainedSecrets hands the secrets owned by this DPFHCPBridge in the namespace that carry the
+// retain-on-delete annotation back to whoever manages them: the ownership labels, back-references and
+// owner reference to the bridge are removed, so that neither the label-based cleanup nor garbage collection
+// deletes them once the bridge is gone.
+func (h *CleanupHandler) releaseRetainedSecrets(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, namespace string) error {
 	log := logf.FromContext(ctx)
 
-	secret := &corev1.Secret{}
-	secretKey := types.NamespacedName{
-		Name:      secretName,
-		Namespace: namespace,
+	secrets, err := common.ListOwnedObjects(ctx, h.client, cr, &corev1.SecretList{}, namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, secret := range secrets {
+		if !provisioningv1alpha1.RetainedOnDelete(secret) {
+			continue
+		}
+
+		labels := secret.GetLabels()
+		for _, k := range []string{common.LabelOwnedBy, common.LabelNamespace, common.LabelComponent} {
+			delete(labels, k)
+		}
+		secret.SetLabels(labels)
+		clearBackReference(secret)
+		secret.SetOwnerReferences(slices.DeleteFunc(secret.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+			return ref.UID == cr.UID
+		}))
+
+		if err := h.client.Update(ctx, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to release secret %s/%s: %w", secret.GetNamespace(), secret.GetName(), err)
+		}
+
+		log.Info("Released secret marked retain-on-delete",
+			"secret", secret.GetName(),
+			"namespace", secret.GetNamespace())
+		h.recorder.Eventf(cr, corev1.EventTypeNormal, "SecretRetained",
+			"Secret %s/%s is marked %s and was released instead of deleted",
+			secret.GetNamespace(), secret.GetName(), provisioningv1alpha1.AnnotationRetainOnDelete)
+	}
+
+	return nil
+}
+
+// secretNamespaces returns the namespaces the operator writes secrets to: the bridge namespace and
+// the DPUCluster namespaces
+func (h *CleanupHandler) secretNamespaces(cr *provisioningv1alpha1.DPFHCPBridge) []string {
+	namespaces := []string{cr.Namespace}
+	for _, ref := range cr.ResolvedDPUClusterRefs() {
+		if !slices.Contains(namespaces, ref.Namespace) {
+			namespaces = append(namespaces, ref.Namespace)
+		}
 	}
+	return namespaces
+}
+
+// PreviewCleanup returns the resources Cleanup would delete: the HostedCluster, the NodePools and the secrets
+// owned by the bridge, including the shared pull secret copies no other bridge references. Resources that do not
+// exist or belong to another DPFHCPBridge, and secrets marked retain-on-delete, are left out.
+func (h *CleanupHandler) PreviewCleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) ([]provisioningv1alpha1.CleanupResource, error) {
+	var resources []provisioningv1alpha1.CleanupResource
 
-	err := h.client.Get(ctx, secretKey, secret)
+	hostedCluster, err := h.previewNamedResource(ctx, cr, cr.Name, &hyperv1.HostedCluster{}, "HostedCluster")
 	if err != nil {
-		if apierrors.IsNotFound(err) {
-			// Secret already deleted
-			log.V(1).Info("Secret already deleted",
-				"secret", secretName,
-				"namespace", namespace)
-			return nil
+		return nil, err
+	}
+	resources = append(resources, hostedCluster...)
+
+	nodePoolNames := []string{cr.Name}
+	for _, pool := range cr.Spec.NodePools {
+		nodePoolNames = append(nodePoolNames, AdditionalNodePoolName(cr, pool.Name))
+	}
+	for _, name := range nodePoolNames {
+		nodePool, err := h.previewNamedResource(ctx, cr, name, &hyperv1.NodePool{}, "NodePool")
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("failed to get secret %s: %w", secretName, err)
+		resources = append(resources, nodePool...)
 	}
 
-	// Delete secret
-	log.V(1).Info("Deleting secret",
-		"secret", secretName,
-		"namespace", namespace)
+	for _, namespace := range h.secretNamespaces(cr) {
+		secrets, err := common.ListOwnedObjects(ctx, h.client, cr, &corev1.SecretList{}, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list secrets in %s: %w", namespace, err)
+		}
+		secrets = slices.DeleteFunc(secrets, func(secret client.Object) bool {
+			return provisioningv1alpha1.RetainedOnDelete(secret)
+		})
+		resources = append(resources, finalizer.CleanupResources("Secret", secrets)...)
+	}
 
-	if err := h.client.Delete(ctx, secret); err != nil {
-		if apierrors.IsNotFound(err) {
-			// Already deleted (race condition)
-			return nil
+	// Shared pull secret copies are deleted with their last referencing bridge
+	shared, err := listSharedPullSecrets(ctx, h.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	for i := range shared {
+		if sharedPullSecretReferences(&shared[i]) == 1 {
+			resources = append(resources, finalizer.CleanupResources("Secret", []client.Object{&shared[i]})...)
 		}
-		return fmt.Errorf("failed to delete secret %s: %w", secretName, err)
 	}
 
-	log.Info("Secret deleted successfully",
-		"secret", secretName,
-		"namespace", namespace)
+	return resources, nil
+}
 
-	return nil
+// previewNamedResource returns the named resource in the CR namespace if deleteNamedResource would delete it
+func (h *CleanupHandler) previewNamedResource(
+	ctx context.Context,
+	cr *provisioningv1alpha1.DPFHCPBridge,
+	name string,
+	obj client.Object,
+	resourceKind string,
+) ([]provisioningv1alpha1.CleanupResource, error) {
+	if err := h.client.Get(ctx, types.NamespacedName{Name: name, Namespace: cr.Namespace}, obj); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get %s: %w", resourceKind, err)
+	}
+	if verifyBackReference(obj, cr) != nil {
+		return nil, nil
+	}
+	return finalizer.CleanupResources(resourceKind, []client.Object{obj}), nil
 }
@@ -0,0 +1,166 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+var _ = Describe("HostedCluster Cleanup Handler", func() {
+	It("should delete all secrets labelled as owned by the bridge in the relevant namespaces", func() {
+		ctx := context.Background()
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		cr := &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "clusters"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef: provisioningv1alpha1.DPUClusterReference{Name: "dpu", Namespace: "dpf-system"},
+			},
+		}
+
+		secret := func(name, namespace string, labels map[string]string) *corev1.Secret {
+			return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}}
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			secret("test-bridge-pull-secret", "clusters", common.ComponentOwnerLabels(cr, common.ComponentHostedClusterSecrets)),
+			secret("renamed-etcd-key", "clusters", common.ComponentOwnerLabels(cr, common.ComponentHostedClusterSecrets)),
+			secret("synced-extra", "dpf-system", common.OwnerLabels(cr)),
+			secret("other-namespace", "elsewhere", common.OwnerLabels(cr)),
+			secret("user-secret", "clusters", nil),
+		).Build()
+
+		result, err := NewCleanupHandler(c, record.NewFakeRecorder(10)).Cleanup(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		secrets := &corev1.SecretList{}
+		Expect(c.List(ctx, secrets)).To(Succeed())
+		var names []string
+		for _, s := range secrets.Items {
+			names = append(names, s.Name)
+		}
+		Expect(names).To(ConsistOf("other-namespace", "user-secret"))
+	})
+
+	It("should release secrets marked retain-on-delete instead of deleting them", func() {
+		ctx := context.Background()
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		cr := &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "clusters", UID: "bridge-uid"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef: provisioningv1alpha1.DPUClusterReference{Name: "dpu", Namespace: "dpf-system"},
+			},
+		}
+
+		labels := common.ComponentOwnerLabels(cr, common.ComponentHostedClusterSecrets)
+		labels["app"] = "vault"
+		retained := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-bridge-pull-secret",
+			Namespace: "clusters",
+			Labels:    labels,
+			Annotations: map[string]string{
+				provisioningv1alpha1.AnnotationRetainOnDelete: "true",
+				AnnotationBridgeUID:                           "bridge-uid",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: provisioningv1alpha1.GroupVersion.String(), Kind: "DPFHCPBridge", Name: "test-bridge", UID: "bridge-uid"},
+				{APIVersion: "external-secrets.io/v1", Kind: "ExternalSecret", Name: "pull-secret", UID: "es-uid"},
+			},
+		}}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			retained,
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test-bridge-etcd-encryption-key", Namespace: "clusters",
+				Labels: common.ComponentOwnerLabels(cr, common.ComponentHostedClusterSecrets)}},
+		).Build()
+
+		handler := NewCleanupHandler(c, record.NewFakeRecorder(10))
+		resources, err := handler.PreviewCleanup(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(Equal([]provisioningv1alpha1.CleanupResource{
+			{Kind: "Secret", Namespace: "clusters", Name: "test-bridge-etcd-encryption-key"},
+		}))
+
+		_, err = handler.Cleanup(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge-etcd-encryption-key", Namespace: "clusters"}, &corev1.Secret{})).NotTo(Succeed())
+		released := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(retained), released)).To(Succeed())
+		Expect(released.Labels).To(Equal(map[string]string{"app": "vault"}))
+		Expect(released.Annotations).To(Equal(map[string]string{provisioningv1alpha1.AnnotationRetainOnDelete: "true"}))
+		Expect(released.OwnerReferences).To(HaveLen(1))
+		Expect(released.OwnerReferences[0].Kind).To(Equal("ExternalSecret"))
+	})
+
+	It("should preview the resources it deletes without deleting them", func() {
+		ctx := context.Background()
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		cr := &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "clusters", UID: "bridge-uid"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef: provisioningv1alpha1.DPUClusterReference{Name: "dpu", Namespace: "dpf-system"},
+				NodePools:     []provisioningv1alpha1.NodePoolSpec{{Name: "canary"}, {Name: "bulk"}},
+			},
+		}
+
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&hyperv1.HostedCluster{ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "clusters"}},
+			&hyperv1.NodePool{ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "clusters"}},
+			&hyperv1.NodePool{ObjectMeta: metav1.ObjectMeta{Name: AdditionalNodePoolName(cr, "canary"), Namespace: "clusters",
+				Annotations: map[string]string{AnnotationBridgeUID: "other-uid"}}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test-bridge-pull-secret", Namespace: "clusters",
+				Labels: common.ComponentOwnerLabels(cr, common.ComponentHostedClusterSecrets)}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "synced-extra", Namespace: "dpf-system",
+				Labels: common.OwnerLabels(cr)}},
+		).Build()
+
+		resources, err := NewCleanupHandler(c, record.NewFakeRecorder(10)).PreviewCleanup(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(Equal([]provisioningv1alpha1.CleanupResource{
+			{Kind: "HostedCluster", Namespace: "clusters", Name: "test-bridge"},
+			{Kind: "NodePool", Namespace: "clusters", Name: "test-bridge"},
+			{Kind: "Secret", Namespace: "clusters", Name: "test-bridge-pull-secret"},
+			{Kind: "Secret", Namespace: "dpf-system", Name: "synced-extra"},
+		}))
+
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge", Namespace: "clusters"}, &hyperv1.HostedCluster{})).To(Succeed())
+	})
+})
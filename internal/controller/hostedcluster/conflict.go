@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// ConflictDetector reports HostedClusters and NodePools that already exist with the bridge's name
+// but are not owned by it, before the operator tries to create them
+type ConflictDetector struct {
+	client   client.Client
+	recorder record.EventRecorder
+}
+
+// NewConflictDetector creates a new ConflictDetector
+func NewConflictDetector(c client.Client, recorder record.EventRecorder) *ConflictDetector {
+	return &ConflictDetector{
+		client:   c,
+		recorder: recorder,
+	}
+}
+
+// CheckResourceConflicts sets the ResourceConflict condition.
+// A conflict is terminal: the phase becomes Failed and reconciliation is not requeued until the
+// DPFHCPBridge is edited, e.g. to opt into adoption of a resource that has no controller.
+// Objects the bridge is about to adopt are not reported as conflicts, unless they differ from the
+// bridge in fields that cannot be reconciled after adoption.
+func (d *ConflictDetector) CheckResourceConflicts(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithValues("feature", "resource-conflict")
+
+	type candidate struct {
+		kind   string
+		reason string
+		name   string
+		obj    client.Object
+	}
+	candidates := []candidate{
+		{"HostedCluster", provisioningv1alpha1.ReasonHostedClusterConflict, cr.Name, &hyperv1.HostedCluster{}},
+		{"NodePool", provisioningv1alpha1.ReasonNodePoolConflict, cr.Name, &hyperv1.NodePool{}},
+	}
+	for _, pool := range cr.Spec.NodePools {
+		candidates = append(candidates, candidate{"NodePool", provisioningv1alpha1.ReasonNodePoolConflict,
+			AdditionalNodePoolName(cr, pool.Name), &hyperv1.NodePool{}})
+	}
+
+	condition := metav1.Condition{
+		Type:               provisioningv1alpha1.ResourceConflict,
+		Status:             metav1.ConditionFalse,
+		Reason:             provisioningv1alpha1.ReasonNoResourceConflict,
+		Message:            "No conflicting HostedCluster or NodePool exists",
+		ObservedGeneration: cr.Generation,
+	}
+
+	for _, candidate := range candidates {
+		key := types.NamespacedName{Name: candidate.name, Namespace: cr.Namespace}
+		if err := d.client.Get(ctx, key, candidate.obj); err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				continue
+			}
+			return ctrl.Result{}, fmt.Errorf("failed to check for existing %s: %w", candidate.kind, err)
+		}
+
+		owner, conflict := conflictingOwner(candidate.obj, cr)
+		switch {
+		case conflict:
+			condition.Message = fmt.Sprintf("%s %s/%s already exists and is owned by %s. "+
+				"Delete or rename it, or, if it has no controller, set the %s=true annotation on this DPFHCPBridge to adopt it",
+				candidate.kind, key.Namespace, key.Name, owner, provisioningv1alpha1.AnnotationAdoptExisting)
+		case metav1.GetControllerOf(candidate.obj) == nil:
+			// About to be adopted, unless it differs from the bridge in fields that cannot be reconciled
+			mismatches := adoptionMismatches(cr, candidate.obj)
+			if len(mismatches) == 0 {
+				continue
+			}
+			condition.Message = fmt.Sprintf("%s %s/%s already exists but cannot be adopted, it does not match this DPFHCPBridge: %s. "+
+				"Recreate the DPFHCPBridge to match it, or delete or rename it",
+				candidate.kind, key.Namespace, key.Name, strings.Join(mismatches, "; "))
+		default:
+			continue
+		}
+
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = candidate.reason
+		break
+	}
+
+	if changed := meta.SetStatusCondition(&cr.Status.Conditions, condition); !changed {
+		return ctrl.Result{}, nil
+	}
+
+	if condition.Status == metav1.ConditionTrue {
+		d.recorder.Event(cr, corev1.EventTypeWarning, condition.Reason, condition.Message)
+		log.Info("Existing resource conflicts with DPFHCPBridge", "reason", condition.Reason, "message", condition.Message)
+	}
+
+	if err := d.client.Status().Update(ctx, cr); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// conflictingOwner returns a description of whoever owns obj if that is not the bridge.
+// Objects controlled by the bridge, and objects the bridge will adopt, are not conflicts.
+func conflictingOwner(obj client.Object, cr *provisioningv1alpha1.DPFHCPBridge) (string, bool) {
+	if err := verifyBackReference(obj, cr); err != nil {
+		annotations := obj.GetAnnotations()
+		return fmt.Sprintf("DPFHCPBridge %s/%s", annotations[AnnotationBridgeNamespace], annotations[AnnotationBridgeName]), true
+	}
+
+	controller := metav1.GetControllerOf(obj)
+	switch {
+	case controller == nil && cr.AdoptsExisting():
+		return "", false
+	case controller == nil:
+		return "no controller", true
+	case controller.UID == cr.UID:
+		return "", false
+	default:
+		return fmt.Sprintf("%s %s", controller.Kind, controller.Name), true
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Resource conflict detection", func() {
+	var (
+		ctx      context.Context
+		scheme   *runtime.Scheme
+		cr       *provisioningv1alpha1.DPFHCPBridge
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", UID: "bridge-uid"},
+		}
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	check := func(objs ...client.Object) *metav1.Condition {
+		c := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(append([]client.Object{cr}, objs...)...).
+			WithStatusSubresource(cr).Build()
+
+		result, err := NewConflictDetector(c, recorder).CheckResourceConflicts(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Requeue).To(BeFalse())
+		Expect(result.RequeueAfter).To(BeZero())
+		return meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.ResourceConflict)
+	}
+
+	It("should report no conflict when nothing exists yet", func() {
+		cond := check()
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonNoResourceConflict))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should report a HostedCluster controlled by another object with its owner", func() {
+		hc := &hyperv1.HostedCluster{ObjectMeta: metav1.ObjectMeta{Name: cr.Name, Namespace: cr.Namespace}}
+		hc.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "example.com/v1", Kind: "ClusterClaim", Name: "claim-a", UID: "claim-uid", Controller: ptr.To(true),
+		}}
+
+		cond := check(hc)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonHostedClusterConflict))
+		Expect(cond.Message).To(ContainSubstring("ClusterClaim claim-a"))
+		Expect(recorder.Events).To(Receive(ContainSubstring(provisioningv1alpha1.ReasonHostedClusterConflict)))
+	})
+
+	It("should suggest adoption for a NodePool without a controller", func() {
+		np := &hyperv1.NodePool{ObjectMeta: metav1.ObjectMeta{Name: cr.Name, Namespace: cr.Namespace}}
+
+		cond := check(np)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonNodePoolConflict))
+		Expect(cond.Message).To(ContainSubstring("no controller"))
+		Expect(cond.Message).To(ContainSubstring(provisioningv1alpha1.AnnotationAdoptExisting))
+	})
+
+	It("should not report a resource the bridge is about to adopt", func() {
+		cr.Annotations = map[string]string{provisioningv1alpha1.AnnotationAdoptExisting: "true"}
+		np := &hyperv1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{Name: cr.Name, Namespace: cr.Namespace},
+			Spec:       hyperv1.NodePoolSpec{ClusterName: cr.Name},
+		}
+
+		cond := check(np)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+	})
+
+	It("should report a resource that does not match the bridge it would be adopted by", func() {
+		cr.Annotations = map[string]string{provisioningv1alpha1.AnnotationAdoptExisting: "true"}
+		cr.Spec.BaseDomain = "example.com"
+		cr.Spec.ControlPlaneAvailabilityPolicy = hyperv1.HighlyAvailable
+		hc := &hyperv1.HostedCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: cr.Name, Namespace: cr.Namespace},
+			Spec: hyperv1.HostedClusterSpec{
+				DNS:                          hyperv1.DNSSpec{BaseDomain: "lab.example.com"},
+				Platform:                     hyperv1.PlatformSpec{Type: hyperv1.NonePlatform},
+				ControllerAvailabilityPolicy: hyperv1.HighlyAvailable,
+				Etcd:                         hyperv1.EtcdSpec{ManagementType: hyperv1.Managed},
+			},
+		}
+
+		cond := check(hc)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonHostedClusterConflict))
+		Expect(cond.Message).To(ContainSubstring("cannot be adopted"))
+		Expect(cond.Message).To(ContainSubstring(`spec.dns.baseDomain is "lab.example.com", expected "example.com"`))
+	})
+
+	It("should report a resource stamped for another DPFHCPBridge", func() {
+		np := &hyperv1.NodePool{ObjectMeta: metav1.ObjectMeta{
+			Name: cr.Name, Namespace: cr.Namespace,
+			Annotations: map[string]string{
+				AnnotationBridgeName:      cr.Name,
+				AnnotationBridgeNamespace: cr.Namespace,
+				AnnotationBridgeUID:       "previous-uid",
+			},
+		}}
+		cr.Annotations = map[string]string{provisioningv1alpha1.AnnotationAdoptExisting: "true"}
+
+		cond := check(np)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Message).To(ContainSubstring("DPFHCPBridge default/test-bridge"))
+	})
+
+	It("should not report resources controlled by the bridge", func() {
+		hc := &hyperv1.HostedCluster{ObjectMeta: metav1.ObjectMeta{Name: cr.Name, Namespace: cr.Namespace}}
+		hc.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(cr, provisioningv1alpha1.GroupVersion.WithKind("DPFHCPBridge"))}
+
+		cond := check(hc)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+	})
+})
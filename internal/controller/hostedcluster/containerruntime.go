@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"fmt"
+	"path"
+
+	"sigs.k8s.io/yaml"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+const (
+	// ContainerRuntimeConfigMapSuffix is appended to the bridge name to name the NodePool config ConfigMap
+	// holding the ContainerRuntimeConfig
+	ContainerRuntimeConfigMapSuffix = "-containerruntime"
+
+	// RegistriesConfigMapSuffix is appended to the bridge name to name the NodePool config ConfigMap
+	// holding the registries.conf drop-ins MachineConfig
+	RegistriesConfigMapSuffix = "-registries"
+
+	// registriesConfDir is the directory containers/image reads registries.conf drop-ins from
+	registriesConfDir = "/etc/containers/registries.conf.d"
+)
+
+// containerRuntimeConfigSource renders the CRI-O settings of spec.containerRuntime into the NodePool config.
+// The machine config operator translates the ContainerRuntimeConfig into the CRI-O and kubelet settings
+// of the release the DPU workers run.
+var containerRuntimeConfigSource = nodePoolConfigSource{
+	description:   "container runtime",
+	component:     common.ComponentContainerRuntime,
+	configMapName: ContainerRuntimeConfigMapName,
+	enabled: func(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+		runtime := cr.Spec.ContainerRuntime
+		return runtime != nil && (runtime.PidsLimit != nil || runtime.LogSizeMax != nil)
+	},
+	render: renderContainerRuntimeConfig,
+}
+
+// registriesConfigSource renders the registries.conf drop-ins of spec.containerRuntime into the NodePool config.
+// HyperShift takes a single manifest per ConfigMap, hence the drop-ins get a ConfigMap of their own.
+var registriesConfigSource = nodePoolConfigSource{
+	description:   "registries",
+	component:     common.ComponentRegistries,
+	configMapName: RegistriesConfigMapName,
+	enabled: func(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+		return cr.Spec.ContainerRuntime != nil && len(cr.Spec.ContainerRuntime.RegistriesConfDropIns) > 0
+	},
+	render: renderRegistriesMachineConfig,
+}
+
+// ContainerRuntimeConfigMapName returns the name of the NodePool config ConfigMap of the CRI-O settings
+func ContainerRuntimeConfigMapName(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	return cr.Name + ContainerRuntimeConfigMapSuffix
+}
+
+// RegistriesConfigMapName returns the name of the NodePool config ConfigMap of the registries.conf drop-ins
+func RegistriesConfigMapName(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	return cr.Name + RegistriesConfigMapSuffix
+}
+
+// renderContainerRuntimeConfig returns the ContainerRuntimeConfig with the pids limit and log size of the DPU workers
+func renderContainerRuntimeConfig(cr *provisioningv1alpha1.DPFHCPBridge) (string, error) {
+	settings := map[string]any{}
+	if limit := cr.Spec.ContainerRuntime.PidsLimit; limit != nil {
+		settings["pidsLimit"] = *limit
+	}
+	if size := cr.Spec.ContainerRuntime.LogSizeMax; size != nil {
+		settings["logSizeMax"] = size.String()
+	}
+
+	containerRuntimeConfig := map[string]any{
+		"apiVersion": "machineconfiguration.openshift.io/v1",
+		"kind":       "ContainerRuntimeConfig",
+		"metadata": map[string]any{
+			"name": "dpf-hcp-bridge-container-runtime",
+		},
+		"spec": map[string]any{
+			"containerRuntimeConfig": settings,
+		},
+	}
+	data, err := yaml.Marshal(containerRuntimeConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to render ContainerRuntimeConfig: %w", err)
+	}
+	return string(data), nil
+}
+
+// renderRegistriesMachineConfig returns the MachineConfig writing the registries.conf drop-ins on the DPU workers
+func renderRegistriesMachineConfig(cr *provisioningv1alpha1.DPFHCPBridge) (string, error) {
+	files := make([]any, 0, len(cr.Spec.ContainerRuntime.RegistriesConfDropIns))
+	for _, dropIn := range cr.Spec.ContainerRuntime.RegistriesConfDropIns {
+		files = append(files, machineConfigFile(path.Join(registriesConfDir, dropIn.Name+".conf"), dropIn.Content))
+	}
+
+	return renderMachineConfig("50-dpf-hcp-bridge-registries", map[string]any{
+		"config": map[string]any{
+			"ignition": map[string]any{"version": "3.2.0"},
+			"storage":  map[string]any{"files": files},
+		},
+	})
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Container runtime configuration", func() {
+	var cr *provisioningv1alpha1.DPFHCPBridge
+
+	BeforeEach(func() {
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", UID: "bridge-uid"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+				ContainerRuntime: &provisioningv1alpha1.ContainerRuntimeSpec{
+					PidsLimit:  ptr.To(int64(4096)),
+					LogSizeMax: ptr.To(resource.MustParse("50Mi")),
+					RegistriesConfDropIns: []provisioningv1alpha1.RegistriesConfDropIn{
+						{Name: "50-blocked", Content: "[[registry]]\nlocation = \"docker.io\"\nblocked = true\n"},
+					},
+				},
+			},
+		}
+	})
+
+	It("should render the CRI-O settings into a ContainerRuntimeConfig", func() {
+		rendered, err := renderContainerRuntimeConfig(cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		var containerRuntimeConfig struct {
+			Kind string `json:"kind"`
+			Spec struct {
+				ContainerRuntimeConfig map[string]any `json:"containerRuntimeConfig"`
+			} `json:"spec"`
+		}
+		Expect(yaml.Unmarshal([]byte(rendered), &containerRuntimeConfig)).To(Succeed())
+		Expect(containerRuntimeConfig.Kind).To(Equal("ContainerRuntimeConfig"))
+		Expect(containerRuntimeConfig.Spec.ContainerRuntimeConfig).To(Equal(map[string]any{
+			"pidsLimit":  float64(4096),
+			"logSizeMax": "50Mi",
+		}))
+	})
+
+	It("should write the registries.conf drop-ins with a MachineConfig", func() {
+		rendered, err := renderRegistriesMachineConfig(cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		var machineConfig struct {
+			Spec struct {
+				Config struct {
+					Storage struct {
+						Files []struct {
+							Path     string `json:"path"`
+							Contents struct {
+								Source string `json:"source"`
+							} `json:"contents"`
+						} `json:"files"`
+					} `json:"storage"`
+				} `json:"config"`
+			} `json:"spec"`
+		}
+		Expect(yaml.Unmarshal([]byte(rendered), &machineConfig)).To(Succeed())
+		files := machineConfig.Spec.Config.Storage.Files
+		Expect(files).To(HaveLen(1))
+		Expect(files[0].Path).To(Equal("/etc/containers/registries.conf.d/50-blocked.conf"))
+		encoded, ok := strings.CutPrefix(files[0].Contents.Source, "data:text/plain;charset=utf-8;base64,")
+		Expect(ok).To(BeTrue())
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(decoded)).To(Equal(cr.Spec.ContainerRuntime.RegistriesConfDropIns[0].Content))
+	})
+
+	It("should only reference the ConfigMaps of the settings that are set", func() {
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		ctx := context.Background()
+
+		cr.Spec.ContainerRuntime.PidsLimit = nil
+		cr.Spec.ContainerRuntime.LogSizeMax = nil
+		_, err := NewNodePoolManager(c, scheme).CreateNodePool(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		np := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "test-bridge", Namespace: "default"}, np)).To(Succeed())
+		Expect(np.Spec.Config).To(Equal([]corev1.LocalObjectReference{{Name: "test-bridge-registries"}}))
+		Expect(c.Get(ctx, types.NamespacedName{Name: "test-bridge-registries", Namespace: "default"}, &corev1.ConfigMap{})).To(Succeed())
+	})
+})
@@ -19,6 +19,7 @@ package hostedcluster
 import (
 	"context"
 	"fmt"
+	"time"
 
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	"github.com/openshift/hypershift/api/util/ipnet"
@@ -29,26 +30,62 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/blackout"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/chargeback"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/circuitbreaker"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/overlays"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/versionskew"
 )
 
 // HostedClusterManager manages HostedCluster resources
 type HostedClusterManager struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Breaker, if set, is shared by all bridges and backs off writes while the HyperShift API is failing
+	Breaker *circuitbreaker.Breaker
+
+	// UpdateInterval is the minimum time between two spec updates of the same HostedCluster;
+	// changes made in between are coalesced into a single update. Zero disables rate limiting.
+	UpdateInterval time.Duration
+
+	// Overlays, if set, holds per-OCP-minor defaults applied to new HostedClusters
+	Overlays *overlays.Config
+
+	// OIDCPublishing is one of OIDCPublishingAuto, OIDCPublishingAlways or OIDCPublishingNever and
+	// decides whether new HostedClusters publish the OIDC service
+	OIDCPublishing string
+
+	// Blackout, if set, holds the operator-wide windows during which spec updates are deferred
+	Blackout *blackout.Config
+
+	// Chargeback, if set, holds the labels stamped on HostedClusters and hosted control plane namespaces
+	Chargeback *chargeback.Config
+
+	// Recorder, if set, receives an event for every switch of the node address the services are published on
+	// and for every provisioning stage that times out
+	Recorder record.EventRecorder
+
+	now func() time.Time
 }
 
 // NewHostedClusterManager creates a new HostedClusterManager
 func NewHostedClusterManager(c client.Client, scheme *runtime.Scheme) *HostedClusterManager {
 	return &HostedClusterManager{
-		Client: c,
-		Scheme: scheme,
+		Client:         c,
+		Scheme:         scheme,
+		UpdateInterval: DefaultUpdateInterval,
+		OIDCPublishing: OIDCPublishingAuto,
+		now:            time.Now,
 	}
 }
 
@@ -63,6 +100,11 @@ func NewHostedClusterManager(c client.Client, scheme *runtime.Scheme) *HostedClu
 func (hm *HostedClusterManager) CreateOrUpdateHostedCluster(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
+	if ok, retryAfter := hm.Breaker.Allow(ctx); !ok {
+		log.V(1).Info("HyperShift circuit open, deferring HostedCluster creation", "retryAfter", retryAfter)
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+
 	hcName := cr.Name
 	hcNamespace := cr.Namespace
 
@@ -72,8 +114,19 @@ func (hm *HostedClusterManager) CreateOrUpdateHostedCluster(ctx context.Context,
 	err := hm.Get(ctx, hcKey, existingHC)
 
 	if err == nil {
+		// Never mutate a HostedCluster whose back-reference annotations point to another DPFHCPBridge
+		if err := verifyBackReference(existingHC, cr); err != nil {
+			return ctrl.Result{}, fmt.Errorf("hostedCluster ownership check failed: %w", err)
+		}
+
 		// HostedCluster exists - verify ownership via OwnerReference
 		if metav1.IsControlledBy(existingHC, cr) {
+			// Backfill back-reference annotations on objects created before they were introduced
+			if setBackReference(existingHC, cr) {
+				if err := hm.Update(ctx, existingHC); err != nil {
+					return ctrl.Result{}, fmt.Errorf("failed to annotate HostedCluster with back-reference: %w", err)
+				}
+			}
 			log.V(1).Info("HostedCluster already exists and is owned by this DPFHCPBridge, adopting",
 				"hostedCluster", hcName,
 				"namespace", hcNamespace)
@@ -81,6 +134,15 @@ func (hm *HostedClusterManager) CreateOrUpdateHostedCluster(ctx context.Context,
 			return ctrl.Result{}, nil
 		}
 
+		// Adoption mode - take over the resource if nothing controls it yet
+		adopted, adoptErr := adoptIfOrphaned(ctx, hm.Client, hm.Scheme, cr, existingHC)
+		if adoptErr != nil {
+			return ctrl.Result{}, adoptErr
+		}
+		if adopted {
+			return ctrl.Result{}, nil
+		}
+
 		// Name conflict - HC exists but owned by different DPFHCPBridge
 		return ctrl.Result{}, fmt.Errorf("hostedCluster %s exists in %s but is owned by different DPFHCPBridge", hcName, hcNamespace)
 	}
@@ -90,21 +152,57 @@ func (hm *HostedClusterManager) CreateOrUpdateHostedCluster(ctx context.Context,
 	}
 
 	// HostedCluster doesn't exist - create it
-	exposeThroughLB := cr.ShouldExposeThroughLoadBalancer()
 	log.Info("Creating HostedCluster",
 		"hostedCluster", hcName,
 		"namespace", hcNamespace,
-		"releaseImage", cr.Spec.OCPReleaseImage,
-		"exposeThroughLoadBalancer", exposeThroughLB)
+		"releaseImage", cr.PinnedOCPReleaseImage(),
+		"exposeThroughLoadBalancer", cr.ShouldExposeThroughLoadBalancer())
+
+	hc, err := hm.RenderHostedCluster(ctx, cr)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Known admission rejections are sanitized or reported in the HostedClusterAdmitted condition
+	if err := hm.createHostedCluster(ctx, cr, hc); err != nil {
+		log.Error(err, "Failed to create HostedCluster",
+			"hostedCluster", hcName,
+			"namespace", hcNamespace)
+		return ctrl.Result{}, err
+	}
 
-	// Detect node address if using NodePort mode
+	log.Info("HostedCluster created successfully",
+		"hostedCluster", hcName,
+		"namespace", hcNamespace)
+
+	return ctrl.Result{}, nil
+}
+
+// RenderHostedCluster returns the HostedCluster CreateOrUpdateHostedCluster would create for the bridge now,
+// with the operator defaults, the bridge overrides, the owner reference and the back-reference applied.
+// Nothing is written; in NodePort mode the node the services are published on is looked up.
+func (hm *HostedClusterManager) RenderHostedCluster(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (*hyperv1.HostedCluster, error) {
+	log := logf.FromContext(ctx)
+
+	exposeThroughLB := cr.ShouldExposeThroughLoadBalancer()
+
+	// Detect node address if using NodePort mode, or select the first of spec.nodePortAddresses whose node is Ready
 	var nodeAddress string
-	if !exposeThroughLB {
+	if !exposeThroughLB && len(cr.Spec.NodePortAddresses) > 0 {
+		log.V(1).Info("Selecting node address for NodePort mode", "candidates", cr.Spec.NodePortAddresses)
+		addr, err := firstReadyNodePortAddress(ctx, hm.Client, cr.Spec.NodePortAddresses)
+		if err != nil {
+			log.Error(err, "Failed to select node address")
+			return nil, fmt.Errorf("failed to select node address: %w", err)
+		}
+		nodeAddress = addr
+		log.Info("Selected node address", "address", nodeAddress)
+	} else if !exposeThroughLB {
 		log.V(1).Info("Detecting node address for NodePort mode")
 		addr, err := detectNodeAddress(ctx, hm.Client)
 		if err != nil {
 			log.Error(err, "Failed to detect node address")
-			return ctrl.Result{}, fmt.Errorf("failed to detect node address: %w", err)
+			return nil, fmt.Errorf("failed to detect node address: %w", err)
 		}
 		nodeAddress = addr
 		log.Info("Detected node address", "address", nodeAddress)
@@ -112,24 +210,47 @@ func (hm *HostedClusterManager) CreateOrUpdateHostedCluster(ctx context.Context,
 
 	hc := hm.buildHostedCluster(cr, nodeAddress)
 
+	// Apply the operator defaults for the OCP minor version of the release image
+	version := ocpVersion(cr)
+	if minor, err := hm.Overlays.Apply(hc, version); err != nil {
+		log.Error(err, "Failed to apply version overlay to HostedCluster", "version", version)
+		return nil, err
+	} else if minor != "" {
+		log.Info("Applied version overlay to HostedCluster", "overlay", minor)
+	}
+
+	// Publish the OIDC service as configured, as HyperShift versions differ in whether they accept it
+	if publish := hm.publishOIDC(version); publish != nil {
+		hc.Spec.Services = setOIDCPublishing(hc.Spec.Services, *publish)
+	}
+
+	// Check the services against the release before HostedCluster admission does, so that the
+	// offending service is named; a misconfigured overlay is not fixed by retrying
+	services, removed, err := validateServicePublishing(hc.Spec.Services, version)
+	if err != nil {
+		log.Error(err, "Refusing to create HostedCluster", "version", version)
+		return nil, reconcile.TerminalError(err)
+	}
+	if len(removed) > 0 {
+		log.Info("Dropped services the release no longer publishes", "version", version, "services", removed)
+	}
+	hc.Spec.Services = services
+
+	// The bridge's size profile, proxy and image mirrors take precedence over the operator defaults
+	applySizeProfile(hc, cr)
+	applyProxy(hc, cr)
+	applyImageMirrors(hc, cr)
+
 	// Set owner reference for automatic garbage collection
 	if err := controllerutil.SetControllerReference(cr, hc, hm.Scheme); err != nil {
 		log.Error(err, "Failed to set owner reference on HostedCluster")
-		return ctrl.Result{}, fmt.Errorf("failed to set owner reference on HostedCluster: %w", err)
-	}
-
-	if err := hm.Create(ctx, hc); err != nil {
-		log.Error(err, "Failed to create HostedCluster",
-			"hostedCluster", hcName,
-			"namespace", hcNamespace)
-		return ctrl.Result{}, fmt.Errorf("failed to create HostedCluster: %w", err)
+		return nil, fmt.Errorf("failed to set owner reference on HostedCluster: %w", err)
 	}
 
-	log.Info("HostedCluster created successfully",
-		"hostedCluster", hcName,
-		"namespace", hcNamespace)
+	// Annotate with a back-reference to the owning DPFHCPBridge, verified before later mutations
+	setBackReference(hc, cr)
 
-	return ctrl.Result{}, nil
+	return hc, nil
 }
 
 // buildHostedCluster constructs the HostedCluster spec from DPFHCPBridge fields
@@ -153,12 +274,12 @@ func (hm *HostedClusterManager) buildHostedCluster(cr *provisioningv1alpha1.DPFH
 		Spec: hyperv1.HostedClusterSpec{
 			// Release image
 			Release: hyperv1.Release{
-				Image: cr.Spec.OCPReleaseImage,
+				Image: cr.PinnedOCPReleaseImage(),
 			},
 
 			// Pull secret reference (copied to clusters namespace)
 			PullSecret: corev1.LocalObjectReference{
-				Name: fmt.Sprintf("%s-pull-secret", cr.Name),
+				Name: cr.PullSecretCopyName(),
 			},
 
 			// SSH key reference (copied to clusters namespace)
@@ -183,22 +304,11 @@ func (hm *HostedClusterManager) buildHostedCluster(cr *provisioningv1alpha1.DPFH
 			},
 
 			// Networking configuration with Other network type
-			// Default CIDRs
-			Networking: hyperv1.ClusterNetworking{
-				NetworkType: hyperv1.Other,
-				ServiceNetwork: []hyperv1.ServiceNetworkEntry{
-					{CIDR: *ipnet.MustParseCIDR("172.31.0.0/16")},
-				},
-				ClusterNetwork: []hyperv1.ClusterNetworkEntry{
-					{CIDR: *ipnet.MustParseCIDR("10.132.0.0/14")},
-				},
-				MachineNetwork: []hyperv1.MachineNetworkEntry{},
-			},
+			// Default CIDRs unless set in the DPFHCPBridge spec
+			Networking: getClusterNetworking(cr),
 
-			// Platform: None (for DPU environments)
-			Platform: hyperv1.PlatformSpec{
-				Type: hyperv1.NonePlatform,
-			},
+			// Platform: None (for DPU environments), or Agent when assisted-service discovers the DPU workers
+			Platform: getPlatform(cr),
 
 			// Availability policy from DPFHCPBridge spec
 			ControllerAvailabilityPolicy: cr.Spec.ControlPlaneAvailabilityPolicy,
@@ -241,15 +351,116 @@ func (hm *HostedClusterManager) buildHostedCluster(cr *provisioningv1alpha1.DPFH
 	return hc
 }
 
+const (
+	// DefaultClusterNetwork is the pod CIDR of hosted clusters without spec.networking.clusterNetwork
+	DefaultClusterNetwork = "10.132.0.0/14"
+
+	// DefaultServiceNetwork is the service CIDR of hosted clusters without spec.networking.serviceNetwork
+	DefaultServiceNetwork = "172.31.0.0/16"
+)
+
+// DefaultNodeSelector returns the node selector of the hosted control plane pods without spec.nodeSelector,
+// which schedules them only on control-plane nodes
+func DefaultNodeSelector() map[string]string {
+	return map[string]string{
+		"node-role.kubernetes.io/control-plane": "",
+	}
+}
+
 // getNodeSelector returns the NodeSelector from DPFHCPBridge spec or the default if not specified
 func getNodeSelector(cr *provisioningv1alpha1.DPFHCPBridge) map[string]string {
 	if cr.Spec.NodeSelector != nil && len(cr.Spec.NodeSelector) > 0 {
 		return cr.Spec.NodeSelector
 	}
-	// Default: Schedule control plane pods only on control-plane nodes
-	return map[string]string{
-		"node-role.kubernetes.io/control-plane": "",
+	return DefaultNodeSelector()
+}
+
+// getPlatform returns the HostedCluster platform. With spec.platform Agent, the Agents of the DPU
+// workers are looked up in the bridge namespace, where the InfraEnv discovering them is created.
+func getPlatform(cr *provisioningv1alpha1.DPFHCPBridge) hyperv1.PlatformSpec {
+	if cr.UsesAgentPlatform() {
+		return hyperv1.PlatformSpec{
+			Type: hyperv1.AgentPlatform,
+			Agent: &hyperv1.AgentPlatformSpec{
+				AgentNamespace: cr.Namespace,
+			},
+		}
+	}
+	return hyperv1.PlatformSpec{Type: hyperv1.NonePlatform}
+}
+
+// getClusterNetworking returns the HostedCluster networking, with the CIDRs set in the DPFHCPBridge spec
+// replacing the defaults
+func getClusterNetworking(cr *provisioningv1alpha1.DPFHCPBridge) hyperv1.ClusterNetworking {
+	networking := hyperv1.ClusterNetworking{
+		NetworkType: hyperv1.Other,
+		ServiceNetwork: []hyperv1.ServiceNetworkEntry{
+			{CIDR: *ipnet.MustParseCIDR(DefaultServiceNetwork)},
+		},
+		ClusterNetwork: []hyperv1.ClusterNetworkEntry{
+			{CIDR: *ipnet.MustParseCIDR(DefaultClusterNetwork)},
+		},
+		MachineNetwork: []hyperv1.MachineNetworkEntry{},
+	}
+	spec := cr.Spec.Networking
+	if spec == nil {
+		return networking
+	}
+
+	if cidrs := parseCIDRs(spec.ClusterNetwork); len(cidrs) > 0 {
+		networking.ClusterNetwork = nil
+		for _, cidr := range cidrs {
+			networking.ClusterNetwork = append(networking.ClusterNetwork, hyperv1.ClusterNetworkEntry{CIDR: cidr})
+		}
+	}
+	if spec.HostPrefix != nil {
+		for i := range networking.ClusterNetwork {
+			networking.ClusterNetwork[i].HostPrefix = *spec.HostPrefix
+		}
+	}
+	if cidrs := parseCIDRs(spec.ServiceNetwork); len(cidrs) > 0 {
+		networking.ServiceNetwork = nil
+		for _, cidr := range cidrs {
+			networking.ServiceNetwork = append(networking.ServiceNetwork, hyperv1.ServiceNetworkEntry{CIDR: cidr})
+		}
+	}
+	for _, cidr := range parseCIDRs(spec.MachineNetwork) {
+		networking.MachineNetwork = append(networking.MachineNetwork, hyperv1.MachineNetworkEntry{CIDR: cidr})
+	}
+	return networking
+}
+
+// parseCIDRs parses CIDRs of the DPFHCPBridge spec. The CRD schema only admits valid CIDRs,
+// so entries that fail to parse cannot occur and are skipped.
+func parseCIDRs(cidrs []provisioningv1alpha1.CIDR) []ipnet.IPNet {
+	parsed := make([]ipnet.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		if ipNet, err := ipnet.ParseCIDR(string(cidr)); err == nil {
+			parsed = append(parsed, *ipNet)
+		}
+	}
+	return parsed
+}
+
+// ocpVersion returns the OCP version of the release image, as resolved into status by the
+// BlueField image resolver or, if that has not run, parsed from the image tag without its architecture suffix
+func ocpVersion(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	if cr.Status.OCPVersion != "" {
+		return cr.Status.OCPVersion
+	}
+	return versionskew.ImageVersion(cr.ResolvedOCPReleaseImage())
+}
+
+// publishOIDC returns whether a HostedCluster of the OCP version publishes the OIDC service,
+// or nil to keep the default of the publishing mode
+func (hm *HostedClusterManager) publishOIDC(version string) *bool {
+	switch hm.OIDCPublishing {
+	case OIDCPublishingAlways:
+		return ptr.To(true)
+	case OIDCPublishingNever:
+		return ptr.To(false)
 	}
+	return hm.Overlays.PublishOIDC(version)
 }
 
 // detectNodeAddress auto-detects the management cluster node address for NodePort publishing
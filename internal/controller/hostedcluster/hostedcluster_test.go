@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
 )
@@ -80,6 +81,15 @@ var _ = Describe("HostedCluster Builder", func() {
 
 			Expect(hc.Spec.Platform.Type).To(Equal(hyperv1.NonePlatform))
 		})
+
+		It("should look up the Agents in the bridge namespace on the Agent platform", func() {
+			cr.Spec.Platform = provisioningv1alpha1.PlatformAgent
+			hc := hm.buildHostedCluster(cr, "")
+
+			Expect(hc.Spec.Platform.Type).To(Equal(hyperv1.AgentPlatform))
+			Expect(hc.Spec.Platform.Agent).NotTo(BeNil())
+			Expect(hc.Spec.Platform.Agent.AgentNamespace).To(Equal(cr.Namespace))
+		})
 	})
 
 	Context("ETCD Configuration", func() {
@@ -131,6 +141,36 @@ var _ = Describe("HostedCluster Builder", func() {
 
 			Expect(hc.Spec.Networking.MachineNetwork).To(BeEmpty())
 		})
+
+		It("should use the network CIDRs from DPFHCPBridge spec", func() {
+			cr.Spec.Networking = &provisioningv1alpha1.ClusterNetworkingSpec{
+				ClusterNetwork: []provisioningv1alpha1.CIDR{"10.200.0.0/14"},
+				ServiceNetwork: []provisioningv1alpha1.CIDR{"172.40.0.0/16"},
+				MachineNetwork: []provisioningv1alpha1.CIDR{"192.168.10.0/24"},
+				HostPrefix:     ptr.To[int32](24),
+			}
+
+			hc := hm.buildHostedCluster(cr, "")
+
+			Expect(hc.Spec.Networking.ClusterNetwork).To(HaveLen(1))
+			Expect(hc.Spec.Networking.ClusterNetwork[0].CIDR.String()).To(Equal("10.200.0.0/14"))
+			Expect(hc.Spec.Networking.ClusterNetwork[0].HostPrefix).To(Equal(int32(24)))
+			Expect(hc.Spec.Networking.ServiceNetwork).To(HaveLen(1))
+			Expect(hc.Spec.Networking.ServiceNetwork[0].CIDR.String()).To(Equal("172.40.0.0/16"))
+			Expect(hc.Spec.Networking.MachineNetwork).To(HaveLen(1))
+			Expect(hc.Spec.Networking.MachineNetwork[0].CIDR.String()).To(Equal("192.168.10.0/24"))
+		})
+
+		It("should keep the default CIDRs not set in DPFHCPBridge spec", func() {
+			cr.Spec.Networking = &provisioningv1alpha1.ClusterNetworkingSpec{
+				ServiceNetwork: []provisioningv1alpha1.CIDR{"172.40.0.0/16"},
+			}
+
+			hc := hm.buildHostedCluster(cr, "")
+
+			Expect(hc.Spec.Networking.ClusterNetwork[0].CIDR.String()).To(Equal("10.132.0.0/14"))
+			Expect(hc.Spec.Networking.ServiceNetwork[0].CIDR.String()).To(Equal("172.40.0.0/16"))
+		})
 	})
 
 	Context("Availability Policies", func() {
@@ -231,6 +271,24 @@ var _ = Describe("HostedCluster Builder", func() {
 			Expect(hc2.Spec.InfraID).To(HavePrefix("test-bridge-"))
 		})
 	})
+
+	Context("OCP Version", func() {
+		It("should prefer the version resolved into status", func() {
+			cr.Status.OCPVersion = "4.18.3"
+
+			Expect(ocpVersion(cr)).To(Equal("4.18.3"))
+		})
+
+		It("should fall back to the release image tag", func() {
+			Expect(ocpVersion(cr)).To(Equal("4.19.0"))
+		})
+
+		It("should return nothing for digest-referenced images", func() {
+			cr.Spec.OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release@sha256:abc"
+
+			Expect(ocpVersion(cr)).To(BeEmpty())
+		})
+	})
 })
 
 // Helper function to find strategy for a specific service
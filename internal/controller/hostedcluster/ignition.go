@@ -0,0 +1,295 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"time"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+const (
+	// IgnitionSecretSuffix is appended to the bridge name to name the published copy of the boot artifacts
+	IgnitionSecretSuffix = "-ignition"
+
+	// IgnitionUserDataKey is the key of the published Secret holding the NodePool user-data
+	IgnitionUserDataKey = "user-data"
+
+	// IgnitionTokenKey is the key of the published Secret holding the ignition token
+	IgnitionTokenKey = "token"
+
+	// IgnitionEndpointKey is the key of the published Secret holding the ignition server endpoint
+	IgnitionEndpointKey = "endpoint"
+
+	// IgnitionCAKey is the key of the published Secret holding the ignition server CA certificate
+	IgnitionCAKey = "ca.crt"
+
+	// AnnotationNodePool is set by HyperShift on the user-data and token Secrets of a NodePool
+	// to the namespaced name of the NodePool
+	AnnotationNodePool = "hypershift.openshift.io/nodePool"
+
+	// userDataSecretPrefix and tokenSecretPrefix start the names of the Secrets HyperShift
+	// generates for every NodePool config in the hosted control plane namespace
+	userDataSecretPrefix = "user-data-"
+	tokenSecretPrefix    = "token-"
+
+	// ignitionCASecretName is the Secret HyperShift keeps the ignition server CA in, in the
+	// hosted control plane namespace
+	ignitionCASecretName = "ignition-server-ca-cert"
+
+	// Data keys of the Secrets generated by HyperShift
+	hypershiftUserDataKey = "value"
+	hypershiftTokenKey    = "token"
+	hypershiftCAKey       = corev1.TLSCertKey
+)
+
+// IgnitionSecretName returns the name of the published boot artifacts Secret of a bridge
+func IgnitionSecretName(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	return cr.Name + IgnitionSecretSuffix
+}
+
+// SyncIgnition reports the NodePool user-data and ignition token Secrets generated by HyperShift,
+// and the ignition server CA, in status.ignition, so that tooling booting DPUs out-of-band does not have to know where
+// HyperShift keeps them. When spec.publishIgnitionSecret is set, their content is also copied
+// into <name>-ignition in the bridge namespace. Status changes are persisted by the caller.
+//
+// HyperShift generates a new pair of Secrets whenever the NodePool config changes and marks
+// the tokens it rotates out with an expiration timestamp; the newest unexpired ones are reported.
+func (nm *NodePoolManager) SyncIgnition(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if cr.Status.HostedClusterRef == nil {
+		return ctrl.Result{}, nil
+	}
+
+	hc := &hyperv1.HostedCluster{}
+	hcKey := types.NamespacedName{Name: cr.Status.HostedClusterRef.Name, Namespace: cr.Status.HostedClusterRef.Namespace}
+	if err := nm.Get(ctx, hcKey, hc); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get HostedCluster for ignition sync: %w", err)
+	}
+
+	// HyperShift runs the hosted control plane in the <namespace>-<name> namespace of the HostedCluster
+	controlPlaneNamespace := hc.Namespace + "-" + hc.Name
+	secrets := &corev1.SecretList{}
+	if err := nm.List(ctx, secrets, client.InNamespace(controlPlaneNamespace)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list secrets in hosted control plane namespace %s: %w", controlPlaneNamespace, err)
+	}
+
+	nodePool := types.NamespacedName{Name: cr.Name, Namespace: cr.Namespace}.String()
+	userData := currentNodePoolSecret(secrets.Items, nodePool, userDataSecretPrefix+cr.Name+"-")
+	token := currentNodePoolSecret(secrets.Items, nodePool, tokenSecretPrefix+cr.Name+"-")
+	var ca *corev1.Secret
+	for i := range secrets.Items {
+		if secrets.Items[i].Name == ignitionCASecretName {
+			ca = &secrets.Items[i]
+			break
+		}
+	}
+
+	status := &provisioningv1alpha1.IgnitionStatus{Endpoint: hc.Status.IgnitionEndpoint}
+	if ca != nil {
+		status.CASecretRef = &corev1.SecretReference{Name: ca.Name, Namespace: ca.Namespace}
+	}
+	if userData != nil {
+		status.UserDataSecretRef = &corev1.SecretReference{Name: userData.Name, Namespace: userData.Namespace}
+	}
+	if token != nil {
+		status.TokenSecretRef = &corev1.SecretReference{Name: token.Name, Namespace: token.Namespace}
+		if expiration, ok := token.Annotations[hyperv1.IgnitionServerTokenExpirationTimestampAnnotation]; ok {
+			if t, err := time.Parse(time.RFC3339, expiration); err == nil {
+				status.TokenExpirationTime = &metav1.Time{Time: t}
+			}
+		}
+	}
+
+	if cr.Spec.PublishIgnitionSecret {
+		published, err := nm.publishIgnition(ctx, cr, status.Endpoint, userData, token, ca)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if published {
+			status.PublishedSecretRef = &corev1.LocalObjectReference{Name: IgnitionSecretName(cr)}
+		}
+	} else if err := nm.unpublishIgnition(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if equality.Semantic.DeepEqual(status, &provisioningv1alpha1.IgnitionStatus{}) {
+		status = nil
+	}
+	if !equality.Semantic.DeepEqual(cr.Status.Ignition, status) {
+		log.V(1).Info("Ignition status changed", "ignition", status)
+	}
+	cr.Status.Ignition = status
+
+	return ctrl.Result{}, nil
+}
+
+// currentNodePoolSecret returns the newest Secret generated for nodePool whose name starts with
+// prefix, preferring Secrets that HyperShift has not scheduled for expiration, or nil if none exists
+func currentNodePoolSecret(secrets []corev1.Secret, nodePool, prefix string) *corev1.Secret {
+	var candidates []*corev1.Secret
+	for i := range secrets {
+		secret := &secrets[i]
+		if secret.Annotations[AnnotationNodePool] != nodePool || !strings.HasPrefix(secret.Name, prefix) ||
+			!secret.DeletionTimestamp.IsZero() {
+			continue
+		}
+		candidates = append(candidates, secret)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		_, iExpiring := candidates[i].Annotations[hyperv1.IgnitionServerTokenExpirationTimestampAnnotation]
+		_, jExpiring := candidates[j].Annotations[hyperv1.IgnitionServerTokenExpirationTimestampAnnotation]
+		if iExpiring != jExpiring {
+			return !iExpiring
+		}
+		if !candidates[i].CreationTimestamp.Equal(&candidates[j].CreationTimestamp) {
+			return candidates[j].CreationTimestamp.Before(&candidates[i].CreationTimestamp)
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+	return candidates[0]
+}
+
+// publishIgnition creates or refreshes the <name>-ignition Secret in the bridge namespace.
+// It returns false while HyperShift has not generated the user-data, token and CA yet, so that
+// the published Secret is always complete.
+// A Secret of the same name that is not owned by this bridge is never overwritten.
+func (nm *NodePoolManager) publishIgnition(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, endpoint string, userData, token, ca *corev1.Secret) (bool, error) {
+	log := logf.FromContext(ctx)
+
+	if userData == nil || token == nil || ca == nil {
+		log.V(1).Info("Waiting for HyperShift to generate the NodePool user-data, ignition token and ignition server CA")
+		return false, nil
+	}
+
+	data := map[string][]byte{
+		IgnitionUserDataKey: userData.Data[hypershiftUserDataKey],
+		IgnitionTokenKey:    token.Data[hypershiftTokenKey],
+		IgnitionEndpointKey: []byte(endpoint),
+		IgnitionCAKey:       ca.Data[hypershiftCAKey],
+	}
+	labels := common.ComponentOwnerLabels(cr, common.ComponentIgnition)
+
+	existing := &corev1.Secret{}
+	err := nm.Get(ctx, types.NamespacedName{Name: IgnitionSecretName(cr), Namespace: cr.Namespace}, existing)
+	if apierrors.IsNotFound(err) {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      IgnitionSecretName(cr),
+				Namespace: cr.Namespace,
+				Labels:    labels,
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: data,
+		}
+		if err := controllerutil.SetControllerReference(cr, secret, nm.Scheme); err != nil {
+			return false, fmt.Errorf("failed to set owner reference on ignition secret: %w", err)
+		}
+		if err := nm.Create(ctx, secret); err != nil {
+			return false, fmt.Errorf("failed to create ignition secret: %w", err)
+		}
+		log.Info("Published ignition secret", "secret", secret.Name)
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get ignition secret: %w", err)
+	}
+
+	if existing.Labels[common.LabelOwnedBy] != cr.Name || existing.Labels[common.LabelNamespace] != cr.Namespace {
+		return false, fmt.Errorf("secret %s/%s already exists and is not owned by this DPFHCPBridge", cr.Namespace, existing.Name)
+	}
+
+	if equality.Semantic.DeepEqual(existing.Data, data) {
+		return true, nil
+	}
+	if existing.Labels == nil {
+		existing.Labels = map[string]string{}
+	}
+	maps.Copy(existing.Labels, labels)
+	existing.Data = data
+	if err := nm.Update(ctx, existing); err != nil {
+		return false, fmt.Errorf("failed to update ignition secret: %w", err)
+	}
+	log.Info("Refreshed ignition secret", "secret", existing.Name)
+	return true, nil
+}
+
+// unpublishIgnition deletes the <name>-ignition Secret of the bridge once publishing is turned off
+func (nm *NodePoolManager) unpublishIgnition(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) error {
+	existing := &corev1.Secret{}
+	if err := nm.Get(ctx, types.NamespacedName{Name: IgnitionSecretName(cr), Namespace: cr.Namespace}, existing); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get ignition secret: %w", err)
+	}
+	if existing.Labels[common.LabelOwnedBy] != cr.Name || existing.Labels[common.LabelNamespace] != cr.Namespace ||
+		existing.Labels[common.LabelComponent] != common.ComponentIgnition {
+		return nil
+	}
+	if err := nm.Delete(ctx, existing); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete ignition secret: %w", err)
+	}
+	logf.FromContext(ctx).Info("Deleted ignition secret, publishing is disabled", "secret", existing.Name)
+	return nil
+}
+
+// IsIgnitionSecretPredicate returns a predicate matching the user-data and token Secrets HyperShift generates for NodePools
+func IsIgnitionSecretPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, ok := obj.GetAnnotations()[AnnotationNodePool]
+		return ok && (strings.HasPrefix(obj.GetName(), userDataSecretPrefix) || strings.HasPrefix(obj.GetName(), tokenSecretPrefix))
+	})
+}
+
+// FindBridgeForIgnitionSecret maps a NodePool user-data or token Secret to the DPFHCPBridge
+// owning the NodePool, which has the same name and namespace as the bridge
+func FindBridgeForIgnitionSecret(_ context.Context, obj client.Object) []reconcile.Request {
+	namespace, name, ok := strings.Cut(obj.GetAnnotations()[AnnotationNodePool], "/")
+	if !ok || namespace == "" || name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name, Namespace: namespace}}}
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+var _ = Describe("NodePool Ignition", func() {
+	const (
+		controlPlaneNamespace = "clusters-test-bridge"
+		endpoint              = "ignition-server.example.com"
+	)
+
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		cr     *provisioningv1alpha1.DPFHCPBridge
+		hc     *hyperv1.HostedCluster
+		now    time.Time
+	)
+
+	nodePoolSecret := func(name, key, value string, age time.Duration) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         controlPlaneNamespace,
+				Annotations:       map[string]string{AnnotationNodePool: "clusters/test-bridge"},
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Data: map[string][]byte{key: []byte(value)},
+		}
+	}
+
+	caSecret := func() *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ignition-server-ca-cert", Namespace: controlPlaneNamespace},
+			Data:       map[string][]byte{"tls.crt": []byte("ca-cert"), "tls.key": []byte("ca-key")},
+		}
+	}
+
+	newManager := func(objs ...client.Object) *NodePoolManager {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, hc)...).Build()
+		return NewNodePoolManager(c, scheme)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+		now = time.Now().Truncate(time.Second)
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "clusters", UID: "bridge-uid"},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				HostedClusterRef: &corev1.ObjectReference{Name: "test-bridge", Namespace: "clusters"},
+			},
+		}
+		hc = &hyperv1.HostedCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "clusters"},
+			Status:     hyperv1.HostedClusterStatus{IgnitionEndpoint: endpoint},
+		}
+	})
+
+	It("should do nothing before the HostedCluster is created", func() {
+		cr.Status.HostedClusterRef = nil
+		nm := newManager()
+
+		_, err := nm.SyncIgnition(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cr.Status.Ignition).To(BeNil())
+	})
+
+	It("should report the endpoint while HyperShift has not generated the secrets yet", func() {
+		nm := newManager()
+
+		_, err := nm.SyncIgnition(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cr.Status.Ignition).To(Equal(&provisioningv1alpha1.IgnitionStatus{Endpoint: endpoint}))
+	})
+
+	It("should report the newest user-data and the unexpired token", func() {
+		expiring := nodePoolSecret("token-test-bridge-new0", "token", "expiring", 0)
+		expiring.Annotations[hyperv1.IgnitionServerTokenExpirationTimestampAnnotation] = now.Add(time.Hour).Format(time.RFC3339)
+		nm := newManager(
+			nodePoolSecret("user-data-test-bridge-aaaa", "value", "old", time.Hour),
+			nodePoolSecret("user-data-test-bridge-bbbb", "value", "new", time.Minute),
+			nodePoolSecret("token-test-bridge-bbbb", "token", "current", time.Minute),
+			expiring,
+			caSecret(),
+		)
+		// Secrets are matched on the NodePool annotation, not only on their name
+		other := nodePoolSecret("user-data-test-bridge-dddd", "value", "foreign", 0)
+		other.Annotations[AnnotationNodePool] = "clusters/test-bridge-other"
+		Expect(nm.Create(ctx, other)).To(Succeed())
+
+		_, err := nm.SyncIgnition(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cr.Status.Ignition.Endpoint).To(Equal(endpoint))
+		Expect(cr.Status.Ignition.UserDataSecretRef).To(Equal(&corev1.SecretReference{
+			Name: "user-data-test-bridge-bbbb", Namespace: controlPlaneNamespace,
+		}))
+		Expect(cr.Status.Ignition.TokenSecretRef).To(Equal(&corev1.SecretReference{
+			Name: "token-test-bridge-bbbb", Namespace: controlPlaneNamespace,
+		}))
+		Expect(cr.Status.Ignition.CASecretRef).To(Equal(&corev1.SecretReference{
+			Name: "ignition-server-ca-cert", Namespace: controlPlaneNamespace,
+		}))
+		Expect(cr.Status.Ignition.TokenExpirationTime).To(BeNil())
+		Expect(cr.Status.Ignition.PublishedSecretRef).To(BeNil())
+	})
+
+	It("should report the expiration of a token that is being rotated", func() {
+		token := nodePoolSecret("token-test-bridge-aaaa", "token", "expiring", time.Minute)
+		token.Annotations[hyperv1.IgnitionServerTokenExpirationTimestampAnnotation] = now.Add(time.Hour).Format(time.RFC3339)
+		nm := newManager(token)
+
+		_, err := nm.SyncIgnition(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cr.Status.Ignition.TokenSecretRef.Name).To(Equal("token-test-bridge-aaaa"))
+		Expect(cr.Status.Ignition.TokenExpirationTime.Time).To(BeTemporally("==", now.Add(time.Hour)))
+	})
+
+	Context("when publishing is enabled", func() {
+		BeforeEach(func() {
+			cr.Spec.PublishIgnitionSecret = true
+		})
+
+		getPublished := func(nm *NodePoolManager) (*corev1.Secret, error) {
+			secret := &corev1.Secret{}
+			err := nm.Get(ctx, types.NamespacedName{Name: "test-bridge-ignition", Namespace: "clusters"}, secret)
+			return secret, err
+		}
+
+		It("should wait for HyperShift to generate the user-data and token", func() {
+			nm := newManager(nodePoolSecret("user-data-test-bridge-aaaa", "value", "stub", 0), caSecret())
+
+			_, err := nm.SyncIgnition(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cr.Status.Ignition.PublishedSecretRef).To(BeNil())
+			_, err = getPublished(nm)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should wait for HyperShift to generate the ignition server CA", func() {
+			nm := newManager(
+				nodePoolSecret("user-data-test-bridge-aaaa", "value", "stub", 0),
+				nodePoolSecret("token-test-bridge-aaaa", "token", "token-1", 0),
+			)
+
+			_, err := nm.SyncIgnition(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cr.Status.Ignition.CASecretRef).To(BeNil())
+			Expect(cr.Status.Ignition.PublishedSecretRef).To(BeNil())
+			_, err = getPublished(nm)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should copy the boot artifacts into the bridge namespace and refresh them on rotation", func() {
+			nm := newManager(
+				nodePoolSecret("user-data-test-bridge-aaaa", "value", "stub", time.Minute),
+				nodePoolSecret("token-test-bridge-aaaa", "token", "token-1", time.Minute),
+				caSecret(),
+			)
+
+			_, err := nm.SyncIgnition(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cr.Status.Ignition.PublishedSecretRef).To(Equal(&corev1.LocalObjectReference{Name: "test-bridge-ignition"}))
+
+			published, err := getPublished(nm)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(published.Data).To(Equal(map[string][]byte{
+				IgnitionUserDataKey: []byte("stub"),
+				IgnitionTokenKey:    []byte("token-1"),
+				IgnitionEndpointKey: []byte(endpoint),
+				IgnitionCAKey:       []byte("ca-cert"),
+			}))
+			Expect(published.Labels).To(HaveKeyWithValue(common.LabelComponent, common.ComponentIgnition))
+			Expect(metav1.IsControlledBy(published, cr)).To(BeTrue())
+
+			// HyperShift rotates the token
+			Expect(nm.Create(ctx, nodePoolSecret("token-test-bridge-bbbb", "token", "token-2", 0))).To(Succeed())
+			_, err = nm.SyncIgnition(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+			published, err = getPublished(nm)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(published.Data[IgnitionTokenKey]).To(Equal([]byte("token-2")))
+		})
+
+		It("should not overwrite a secret it does not own", func() {
+			foreign := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test-bridge-ignition", Namespace: "clusters"}}
+			nm := newManager(
+				foreign,
+				nodePoolSecret("user-data-test-bridge-aaaa", "value", "stub", 0),
+				nodePoolSecret("token-test-bridge-aaaa", "token", "token-1", 0),
+				caSecret(),
+			)
+
+			_, err := nm.SyncIgnition(ctx, cr)
+			Expect(err).To(MatchError(ContainSubstring("not owned by this DPFHCPBridge")))
+		})
+
+		It("should delete the published secret once publishing is turned off", func() {
+			nm := newManager(
+				nodePoolSecret("user-data-test-bridge-aaaa", "value", "stub", 0),
+				nodePoolSecret("token-test-bridge-aaaa", "token", "token-1", 0),
+				caSecret(),
+			)
+			_, err := nm.SyncIgnition(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+
+			cr.Spec.PublishIgnitionSecret = false
+			_, err = nm.SyncIgnition(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cr.Status.Ignition.PublishedSecretRef).To(BeNil())
+			_, err = getPublished(nm)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	It("should map NodePool secrets to the owning bridge", func() {
+		secret := nodePoolSecret("token-test-bridge-aaaa", "token", "token-1", 0)
+		Expect(FindBridgeForIgnitionSecret(ctx, secret)).To(ConsistOf(
+			HaveField("NamespacedName", types.NamespacedName{Name: "test-bridge", Namespace: "clusters"}),
+		))
+
+		secret.Annotations = nil
+		Expect(FindBridgeForIgnitionSecret(ctx, secret)).To(BeEmpty())
+	})
+})
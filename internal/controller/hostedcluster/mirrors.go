@@ -0,0 +1,45 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// applyImageMirrors sets the bridge's spec.imageMirrors as the image content sources of the HostedCluster.
+// HyperShift uses them for the control plane and renders them into the ignition of every NodePool,
+// so the DPU workers pull from the same mirrors.
+// Returns true if the HostedCluster image content sources were changed.
+func applyImageMirrors(hc *hyperv1.HostedCluster, cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	var desired []hyperv1.ImageContentSource
+	for _, mirror := range cr.Spec.ImageMirrors {
+		desired = append(desired, hyperv1.ImageContentSource{
+			Source:  mirror.Source,
+			Mirrors: mirror.Mirrors,
+		})
+	}
+
+	// Semantic.DeepEqual treats nil and empty lists alike
+	if equality.Semantic.DeepEqual(hc.Spec.ImageContentSources, desired) {
+		return false
+	}
+	hc.Spec.ImageContentSources = desired
+	return true
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Image mirrors", func() {
+	var (
+		hc *hyperv1.HostedCluster
+		cr *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	BeforeEach(func() {
+		hc = &hyperv1.HostedCluster{}
+		cr = &provisioningv1alpha1.DPFHCPBridge{}
+	})
+
+	It("should leave the image content sources unset when no mirrors are set", func() {
+		Expect(applyImageMirrors(hc, cr)).To(BeFalse())
+		Expect(hc.Spec.ImageContentSources).To(BeEmpty())
+	})
+
+	It("should render spec.imageMirrors into the image content sources", func() {
+		cr.Spec.ImageMirrors = []provisioningv1alpha1.ImageMirror{{
+			Source:  "quay.io/openshift-release-dev/ocp-release",
+			Mirrors: []string{"mirror.example.com/ocp/release"},
+		}}
+
+		Expect(applyImageMirrors(hc, cr)).To(BeTrue())
+		Expect(hc.Spec.ImageContentSources).To(Equal([]hyperv1.ImageContentSource{{
+			Source:  "quay.io/openshift-release-dev/ocp-release",
+			Mirrors: []string{"mirror.example.com/ocp/release"},
+		}}))
+
+		Expect(applyImageMirrors(hc, cr)).To(BeFalse())
+	})
+
+	It("should remove the image content sources when the mirrors are cleared", func() {
+		hc.Spec.ImageContentSources = []hyperv1.ImageContentSource{{Source: "quay.io/ocp", Mirrors: []string{"mirror.example.com/ocp"}}}
+
+		Expect(applyImageMirrors(hc, cr)).To(BeTrue())
+		Expect(hc.Spec.ImageContentSources).To(BeEmpty())
+	})
+})
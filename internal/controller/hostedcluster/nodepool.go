@@ -19,11 +19,17 @@ package hostedcluster
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -32,12 +38,34 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/blackout"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/circuitbreaker"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/dpurollout"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/versionskew"
 )
 
+// MachineDeploymentGVK is the Cluster API MachineDeployment HyperShift rolls out the machines of a NodePool with
+var MachineDeploymentGVK = schema.GroupVersionKind{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "MachineDeployment"}
+
+// nodePoolRolloutConditions are the NodePool conditions mirrored in the status of the bridge
+var nodePoolRolloutConditions = []string{
+	hyperv1.NodePoolUpdatingConfigConditionType,
+	hyperv1.NodePoolUpdatingVersionConditionType,
+}
+
 // NodePoolManager manages NodePool resources
 type NodePoolManager struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Breaker, if set, is shared by all bridges and backs off writes while the HyperShift API is failing
+	Breaker *circuitbreaker.Breaker
+
+	// Blackout, if set, holds the operator-wide windows during which release image changes are deferred
+	Blackout *blackout.Config
+
+	// DPURollouts, if set, defers release image changes while DPF rolls out DPU services to the DPUs of the bridge
+	DPURollouts *dpurollout.Guard
 }
 
 // NewNodePoolManager creates a new NodePoolManager
@@ -52,15 +80,31 @@ func NewNodePoolManager(c client.Client, scheme *runtime.Scheme) *NodePoolManage
 // Returns ctrl.Result and error for reconciliation flow
 //
 // NodePool is created with:
-// - replicas=0 (DPU workers will join manually via CSR approval)
+// - replicas from spec.nodePoolReplicas, 0 if unset (DPU workers join manually via CSR approval)
 // - None platform type
 // - Matching release image from DPFHCPBridge
 // - Upgrade type: Replace (as per spec)
+// - The configs rendered from spec.timeSync, spec.nodeTuning and spec.containerRuntime, if set
 func (nm *NodePoolManager) CreateNodePool(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	// The NodePool config ConfigMaps exist before the NodePool references them
+	if err := nm.ensureNodePoolConfigs(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+	return nm.ensureNodePool(ctx, cr, nm.buildNodePool(cr))
+}
+
+// ensureNodePool creates the desired NodePool unless it already exists and is controlled by the bridge.
+// An existing NodePool without controller is adopted in adoption mode; any other existing NodePool is a conflict.
+func (nm *NodePoolManager) ensureNodePool(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, np *hyperv1.NodePool) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
-	npName := cr.Name
-	npNamespace := cr.Namespace
+	if ok, retryAfter := nm.Breaker.Allow(ctx); !ok {
+		log.V(1).Info("HyperShift circuit open, deferring NodePool creation", "retryAfter", retryAfter)
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+
+	npName := np.Name
+	npNamespace := np.Namespace
 
 	// Check if NodePool already exists (idempotency)
 	existingNP := &hyperv1.NodePool{}
@@ -68,14 +112,34 @@ func (nm *NodePoolManager) CreateNodePool(ctx context.Context, cr *provisioningv
 	err := nm.Get(ctx, npKey, existingNP)
 
 	if err == nil {
+		// Never mutate a NodePool whose back-reference annotations point to another DPFHCPBridge
+		if err := verifyBackReference(existingNP, cr); err != nil {
+			return ctrl.Result{}, fmt.Errorf("nodePool ownership check failed: %w", err)
+		}
+
 		// NodePool exists - verify ownership via OwnerReference
 		if metav1.IsControlledBy(existingNP, cr) {
+			// Backfill back-reference annotations on objects created before they were introduced
+			if setBackReference(existingNP, cr) {
+				if err := nm.Update(ctx, existingNP); err != nil {
+					return ctrl.Result{}, fmt.Errorf("failed to annotate NodePool with back-reference: %w", err)
+				}
+			}
 			log.V(1).Info("NodePool already exists and is owned by this DPFHCPBridge",
 				"nodePool", npName,
 				"namespace", npNamespace)
 			return ctrl.Result{}, nil
 		}
 
+		// Adoption mode - take over the resource if nothing controls it yet
+		adopted, adoptErr := adoptIfOrphaned(ctx, nm.Client, nm.Scheme, cr, existingNP)
+		if adoptErr != nil {
+			return ctrl.Result{}, adoptErr
+		}
+		if adopted {
+			return ctrl.Result{}, nil
+		}
+
 		// Name conflict - NP exists but owned by different DPFHCPBridge
 		return ctrl.Result{}, fmt.Errorf("nodePool %s exists in %s but is owned by different DPFHCPBridge", npName, npNamespace)
 	}
@@ -88,9 +152,7 @@ func (nm *NodePoolManager) CreateNodePool(ctx context.Context, cr *provisioningv
 	log.Info("Creating NodePool",
 		"nodePool", npName,
 		"namespace", npNamespace,
-		"replicas", 0)
-
-	np := nm.buildNodePool(cr)
+		"replicas", ptr.Deref(np.Spec.Replicas, 0))
 
 	// Set owner reference for automatic garbage collection
 	if err := controllerutil.SetControllerReference(cr, np, nm.Scheme); err != nil {
@@ -98,7 +160,12 @@ func (nm *NodePoolManager) CreateNodePool(ctx context.Context, cr *provisioningv
 		return ctrl.Result{}, fmt.Errorf("failed to set owner reference on NodePool: %w", err)
 	}
 
-	if err := nm.Create(ctx, np); err != nil {
+	// Annotate with a back-reference to the owning DPFHCPBridge, verified before later mutations
+	setBackReference(np, cr)
+
+	err = nm.Create(ctx, np)
+	nm.Breaker.Record(ctx, err)
+	if err != nil {
 		log.Error(err, "Failed to create NodePool",
 			"nodePool", npName,
 			"namespace", npNamespace)
@@ -112,36 +179,447 @@ func (nm *NodePoolManager) CreateNodePool(ctx context.Context, cr *provisioningv
 	return ctrl.Result{}, nil
 }
 
-// buildNodePool constructs the NodePool spec
+// buildNodePool constructs the spec of the default NodePool
 func (nm *NodePoolManager) buildNodePool(cr *provisioningv1alpha1.DPFHCPBridge) *hyperv1.NodePool {
+	np := newNodePool(cr, cr.Name, nodePoolReplicas(cr), cr.PinnedOCPReleaseImage())
+	if len(cr.Spec.DPUClusterRefs) > 0 {
+		// The default NodePool serves the first of several DPUClusters
+		np.Spec.NodeLabels = dpuClusterNodeLabels(cr.Spec.DPUClusterRefs[0])
+	}
+	return np
+}
+
+// RenderNodePools returns the NodePools CreateNodePool and SyncNodePools would create for the bridge now:
+// the default NodePool, unless the bridge is an unclaimed BridgePool spare, followed by the additional
+// NodePools, with the owner reference and the back-reference applied. Nothing is written.
+func (nm *NodePoolManager) RenderNodePools(cr *provisioningv1alpha1.DPFHCPBridge) ([]*hyperv1.NodePool, error) {
+	var nodePools []*hyperv1.NodePool
+	if !cr.IsSpare() {
+		nodePools = append(nodePools, nm.buildNodePool(cr))
+	}
+	for _, pool := range additionalNodePools(cr) {
+		releaseImage := pool.OCPReleaseImage
+		if releaseImage == "" {
+			releaseImage = cr.PinnedOCPReleaseImage()
+		}
+		np := newNodePool(cr, AdditionalNodePoolName(cr, pool.Name), ptr.Deref(pool.Replicas, 0), releaseImage)
+		np.Spec.NodeLabels = pool.nodeLabels
+		nodePools = append(nodePools, np)
+	}
+
+	for _, np := range nodePools {
+		if err := controllerutil.SetControllerReference(cr, np, nm.Scheme); err != nil {
+			return nil, fmt.Errorf("failed to set owner reference on NodePool %s: %w", np.Name, err)
+		}
+		setBackReference(np, cr)
+	}
+	return nodePools, nil
+}
+
+// dpuClusterNodeLabels returns the labels telling the nodes of a NodePool which DPUCluster they belong to
+func dpuClusterNodeLabels(ref provisioningv1alpha1.DPUClusterReference) map[string]string {
+	return map[string]string{
+		provisioningv1alpha1.LabelDPUCluster:          ref.Name,
+		provisioningv1alpha1.LabelDPUClusterNamespace: ref.Namespace,
+	}
+}
+
+// newNodePool constructs a NodePool of the bridge's HostedCluster
+func newNodePool(cr *provisioningv1alpha1.DPFHCPBridge, name string, replicas int32, releaseImage string) *hyperv1.NodePool {
 	np := &hyperv1.NodePool{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      cr.Name,
+			Name:      name,
 			Namespace: cr.Namespace,
 		},
 		Spec: hyperv1.NodePoolSpec{
 			// ClusterName links this NodePool to the HostedCluster
 			ClusterName: cr.Name,
 
-			// Replicas=0 - DPU workers will be added manually
-			Replicas: ptr.To(int32(0)),
+			// DPU workers are added manually or discovered as Agents, so replicas is the number of nodes expected to join
+			Replicas: ptr.To(replicas),
 
 			// Management settings
 			Management: hyperv1.NodePoolManagement{
 				UpgradeType: hyperv1.UpgradeTypeReplace,
 			},
 
-			// Platform: None (DPU environment)
-			Platform: hyperv1.NodePoolPlatform{
-				Type: hyperv1.NonePlatform,
-			},
+			// Platform: None (DPU environment), or Agent when assisted-service discovers the DPU workers
+			Platform: nodePoolPlatform(cr),
 
-			// Release image matches HostedCluster
+			// Release image matches HostedCluster unless overridden per NodePool
 			Release: hyperv1.Release{
-				Image: cr.Spec.OCPReleaseImage,
+				Image: releaseImage,
 			},
+
+			// Machine configuration rendered from the bridge spec, e.g. the chrony configuration of spec.timeSync
+			// and the kernel arguments of spec.nodeTuning
+			Config: nodePoolConfig(cr),
 		},
 	}
 
 	return np
 }
+
+// nodePoolPlatform returns the NodePool platform. With spec.platform Agent, the NodePool claims the
+// Agents discovered through the InfraEnv of the bridge, which labels them with the bridge name.
+func nodePoolPlatform(cr *provisioningv1alpha1.DPFHCPBridge) hyperv1.NodePoolPlatform {
+	if cr.UsesAgentPlatform() {
+		return hyperv1.NodePoolPlatform{
+			Type: hyperv1.AgentPlatform,
+			Agent: &hyperv1.AgentNodePoolPlatform{
+				AgentLabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{provisioningv1alpha1.LabelAgentBridge: cr.Name},
+				},
+			},
+		}
+	}
+	return hyperv1.NodePoolPlatform{Type: hyperv1.NonePlatform}
+}
+
+// SyncNodePoolReplicas propagates spec.nodePoolReplicas, which the scale subresource writes, and the
+// spec.timeSync, spec.nodeTuning and spec.containerRuntime configuration to the existing NodePool, and records
+// the NodePool replica counts, version and rollout conditions in status.nodePoolStatus.
+// An unset spec.nodePoolReplicas leaves the NodePool replicas alone, so that NodePools scaled directly,
+// e.g. those of bridges created before the field existed, are not scaled down.
+// Status changes are persisted by the caller.
+func (nm *NodePoolManager) SyncNodePoolReplicas(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if err := nm.ensureNodePoolConfigs(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	np := &hyperv1.NodePool{}
+	if err := nm.Get(ctx, types.NamespacedName{Name: cr.Name, Namespace: cr.Namespace}, np); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get NodePool for replica sync: %w", err)
+	}
+
+	if !metav1.IsControlledBy(np, cr) || !np.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	if err := verifyBackReference(np, cr); err != nil {
+		return ctrl.Result{}, fmt.Errorf("nodePool ownership check failed: %w", err)
+	}
+
+	desired := ptr.Deref(np.Spec.Replicas, 0)
+	scale := false
+	if cr.Spec.NodePoolReplicas != nil {
+		desired = *cr.Spec.NodePoolReplicas
+		scale = np.Spec.Replicas == nil || *np.Spec.Replicas != desired
+	}
+	configChanged, removed := applyNodePoolConfig(np, cr)
+	for _, source := range removed {
+		// The source was removed from the spec: delete its ConfigMap while the NodePool still references it,
+		// so that a failure is retried on the next reconcile
+		if err := nm.syncNodePoolConfig(ctx, cr, source); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if scale || configChanged {
+		if ok, retryAfter := nm.Breaker.Allow(ctx); !ok {
+			log.V(1).Info("HyperShift circuit open, deferring NodePool update", "retryAfter", retryAfter)
+			return ctrl.Result{RequeueAfter: retryAfter}, nil
+		}
+
+		if scale {
+			log.Info("Scaling NodePool",
+				"nodePool", np.Name,
+				"replicas", desired,
+				"previousReplicas", ptr.Deref(np.Spec.Replicas, 0))
+		}
+		if configChanged {
+			log.Info("Updating NodePool config", "nodePool", np.Name, "config", np.Spec.Config)
+		}
+
+		if scale {
+			np.Spec.Replicas = ptr.To(desired)
+		}
+		err := nm.Update(ctx, np)
+		nm.Breaker.Record(ctx, err)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update NodePool replicas: %w", err)
+		}
+	}
+
+	status := &provisioningv1alpha1.NodePoolStatus{Replicas: desired}
+	if err := nm.observeNodePool(ctx, cr, np, status); err != nil {
+		return ctrl.Result{}, err
+	}
+	cr.Status.NodePoolStatus = status
+
+	return ctrl.Result{}, nil
+}
+
+// AdditionalNodePoolName returns the name of the NodePool created for an entry of spec.nodePools,
+// or for a DPUCluster of spec.dpuClusterRefs other than the first
+func AdditionalNodePoolName(cr *provisioningv1alpha1.DPFHCPBridge, name string) string {
+	return cr.Name + "-" + name
+}
+
+// additionalNodePool is a NodePool besides the default one
+type additionalNodePool struct {
+	provisioningv1alpha1.NodePoolSpec
+
+	// nodeLabels are set on the nodes of the NodePool
+	nodeLabels map[string]string
+}
+
+// additionalNodePools returns the entries of spec.nodePools followed by a NodePool for each DPUCluster of
+// spec.dpuClusterRefs after the first, which the default NodePool serves. DPUCluster NodePools are named
+// after their DPUCluster and have spec.nodePoolReplicas replicas; like the default NodePool, their replicas
+// are left alone while it is unset.
+func additionalNodePools(cr *provisioningv1alpha1.DPFHCPBridge) []additionalNodePool {
+	pools := make([]additionalNodePool, 0, len(cr.Spec.NodePools)+len(cr.Spec.DPUClusterRefs))
+	for _, pool := range cr.Spec.NodePools {
+		pools = append(pools, additionalNodePool{NodePoolSpec: pool})
+	}
+	for i := 1; i < len(cr.Spec.DPUClusterRefs); i++ {
+		ref := cr.Spec.DPUClusterRefs[i]
+		pools = append(pools, additionalNodePool{
+			NodePoolSpec: provisioningv1alpha1.NodePoolSpec{
+				Name:     ref.Name,
+				Replicas: cr.Spec.NodePoolReplicas,
+			},
+			nodeLabels: dpuClusterNodeLabels(ref),
+		})
+	}
+	return pools
+}
+
+// SyncNodePools creates the NodePools listed in spec.nodePools and those of spec.dpuClusterRefs,
+// propagates their replicas, release image and config, deletes the NodePools of removed entries and records
+// their replica counts, versions, version skew and rollout conditions in status.nodePools.
+// Status changes are persisted by the caller.
+// A NodePool whose version skew to the control plane is not supported keeps its running release.
+// A release image change rolls the NodePool according to its Replace upgrade type, so it is deferred
+// until the end of an active blackout window, and while DPU services roll out if DPURollouts is set;
+// replica changes are applied right away.
+func (nm *NodePoolManager) SyncNodePools(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	deferred := ctrl.Result{}
+
+	controlPlaneVersion := ocpVersion(cr)
+	var unsupportedSkews []string
+
+	pools := additionalNodePools(cr)
+	statuses := make([]provisioningv1alpha1.NodePoolStatus, 0, len(pools))
+	desired := map[string]bool{}
+	for _, pool := range pools {
+		releaseImage := pool.OCPReleaseImage
+		version := versionskew.ImageVersion(pool.OCPReleaseImage)
+		if releaseImage == "" {
+			releaseImage = cr.PinnedOCPReleaseImage()
+			version = controlPlaneVersion
+		}
+		want := newNodePool(cr, AdditionalNodePoolName(cr, pool.Name), ptr.Deref(pool.Replicas, 0), releaseImage)
+		want.Spec.NodeLabels = pool.nodeLabels
+		desired[want.Name] = true
+
+		status := provisioningv1alpha1.NodePoolStatus{Name: pool.Name, Replicas: *want.Spec.Replicas, Version: version}
+		skew, known, skewErr := versionskew.Check(controlPlaneVersion, version)
+		if known {
+			status.MinorVersionSkew = ptr.To(int32(skew))
+		}
+
+		np := &hyperv1.NodePool{}
+		if skewErr != nil {
+			// Keep the running release of the NodePool, and do not create it, until the skew is supported.
+			// Admission rejects such skews, but the control plane release can also change through a ReleaseCatalog.
+			unsupportedSkews = append(unsupportedSkews, fmt.Sprintf("%s: %v", pool.Name, skewErr))
+			log.Info("NodePool version skew is not supported, keeping its running release",
+				"nodePool", want.Name,
+				"controlPlaneVersion", controlPlaneVersion,
+				"nodePoolVersion", version)
+			releaseImage = ""
+
+			if err := nm.Get(ctx, types.NamespacedName{Name: want.Name, Namespace: want.Namespace}, np); err != nil {
+				if apierrors.IsNotFound(err) {
+					statuses = append(statuses, status)
+					continue
+				}
+				return ctrl.Result{}, fmt.Errorf("failed to get NodePool %s: %w", want.Name, err)
+			}
+		}
+
+		if result, err := nm.ensureNodePool(ctx, cr, want); err != nil || result.RequeueAfter > 0 {
+			return result, err
+		}
+
+		if err := nm.Get(ctx, types.NamespacedName{Name: want.Name, Namespace: want.Namespace}, np); err != nil {
+			if apierrors.IsNotFound(err) {
+				// Just created and not in the cache yet
+				statuses = append(statuses, status)
+				continue
+			}
+			return ctrl.Result{}, fmt.Errorf("failed to get NodePool %s: %w", want.Name, err)
+		}
+
+		if releaseImage == "" {
+			// Release image not resolved or not pinned yet: keep the running release
+			releaseImage = np.Spec.Release.Image
+		}
+		if np.Spec.Release.Image != releaseImage {
+			now := time.Now()
+			if window, end, ok := nm.Blackout.Active(now); ok {
+				log.Info("NodePool release image changed during a blackout window, deferring the upgrade until it ends",
+					"nodePool", np.Name,
+					"blackoutWindow", window,
+					"retryAfter", end.Sub(now))
+				releaseImage = np.Spec.Release.Image
+				deferred.RequeueAfter = end.Sub(now)
+			} else if rollouts, err := nm.DPURollouts.Rollouts(ctx, cr); err != nil {
+				return ctrl.Result{}, err
+			} else if len(rollouts) > 0 {
+				log.Info("NodePool release image changed while DPU services roll out, deferring the upgrade until they finish",
+					"nodePool", np.Name,
+					"rollouts", rollouts)
+				releaseImage = np.Spec.Release.Image
+				deferred.RequeueAfter = nm.DPURollouts.RecheckInterval
+			}
+		}
+
+		replicas := *want.Spec.Replicas
+		if pool.Replicas == nil {
+			replicas = ptr.Deref(np.Spec.Replicas, 0)
+			status.Replicas = replicas
+		}
+		configChanged, _ := applyNodePoolConfig(np, cr)
+		if ptr.Deref(np.Spec.Replicas, 0) != replicas || np.Spec.Release.Image != releaseImage || configChanged {
+			if ok, retryAfter := nm.Breaker.Allow(ctx); !ok {
+				log.V(1).Info("HyperShift circuit open, deferring NodePool update", "retryAfter", retryAfter)
+				return ctrl.Result{RequeueAfter: retryAfter}, nil
+			}
+
+			log.Info("Updating NodePool",
+				"nodePool", np.Name,
+				"replicas", replicas,
+				"releaseImage", releaseImage,
+				"config", np.Spec.Config)
+
+			if pool.Replicas != nil {
+				np.Spec.Replicas = ptr.To(replicas)
+			}
+			np.Spec.Release.Image = releaseImage
+			err := nm.Update(ctx, np)
+			nm.Breaker.Record(ctx, err)
+			if err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to update NodePool %s: %w", np.Name, err)
+			}
+		}
+
+		if err := nm.observeNodePool(ctx, cr, np, &status); err != nil {
+			return ctrl.Result{}, err
+		}
+		statuses = append(statuses, status)
+	}
+
+	// Delete the NodePools of entries removed from spec.nodePools
+	existing := &hyperv1.NodePoolList{}
+	if err := nm.List(ctx, existing, client.InNamespace(cr.Namespace)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list NodePools: %w", err)
+	}
+	for i := range existing.Items {
+		np := &existing.Items[i]
+		if np.Name == cr.Name || desired[np.Name] || !metav1.IsControlledBy(np, cr) || !np.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := verifyBackReference(np, cr); err != nil {
+			continue
+		}
+		if ok, retryAfter := nm.Breaker.Allow(ctx); !ok {
+			log.V(1).Info("HyperShift circuit open, deferring NodePool deletion", "retryAfter", retryAfter)
+			return ctrl.Result{RequeueAfter: retryAfter}, nil
+		}
+
+		log.Info("Deleting NodePool removed from spec.nodePools", "nodePool", np.Name)
+		err := nm.Delete(ctx, np)
+		nm.Breaker.Record(ctx, err)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("failed to delete NodePool %s: %w", np.Name, err)
+		}
+	}
+
+	if len(statuses) == 0 {
+		statuses = nil
+	}
+	cr.Status.NodePools = statuses
+	setVersionSkewCondition(cr, unsupportedSkews)
+
+	return deferred, nil
+}
+
+// observeNodePool records the ready and updated replicas, the current version and the rollout conditions
+// of the NodePool in its status entry, so that nodes that never join or never finish updating show on the bridge.
+// Updated replicas are only known for NodePools whose machines HyperShift manages through a MachineDeployment
+// in the hosted control plane namespace.
+func (nm *NodePoolManager) observeNodePool(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge,
+	np *hyperv1.NodePool, status *provisioningv1alpha1.NodePoolStatus) error {
+	status.ReadyReplicas = np.Status.Replicas
+	status.CurrentVersion = np.Status.Version
+
+	for _, condition := range np.Status.Conditions {
+		if !slices.Contains(nodePoolRolloutConditions, condition.Type) {
+			continue
+		}
+		reason := condition.Reason
+		if reason == "" {
+			reason = hyperv1.AsExpectedReason
+		}
+		status.Conditions = append(status.Conditions, metav1.Condition{
+			Type:               condition.Type,
+			Status:             metav1.ConditionStatus(condition.Status),
+			Reason:             reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime,
+			ObservedGeneration: condition.ObservedGeneration,
+		})
+	}
+
+	md := &unstructured.Unstructured{}
+	md.SetGroupVersionKind(MachineDeploymentGVK)
+	err := nm.Get(ctx, types.NamespacedName{Name: np.Name, Namespace: ControlPlaneNamespace(cr)}, md)
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get MachineDeployment of NodePool %s: %w", np.Name, err)
+	}
+	updated, _, err := unstructured.NestedInt64(md.Object, "status", "updatedReplicas")
+	if err != nil {
+		return fmt.Errorf("failed to read updated replicas of MachineDeployment %s: %w", np.Name, err)
+	}
+	status.UpdatedReplicas = ptr.To(int32(updated))
+	return nil
+}
+
+// nodePoolReplicas returns the number of replicas a new NodePool is created with, 0 when unset
+func nodePoolReplicas(cr *provisioningv1alpha1.DPFHCPBridge) int32 {
+	return ptr.Deref(cr.Spec.NodePoolReplicas, 0)
+}
+
+// setVersionSkewCondition reports whether the NodePools in spec.nodePools are within the supported
+// version skew, removing the condition if there are none
+func setVersionSkewCondition(cr *provisioningv1alpha1.DPFHCPBridge, unsupported []string) {
+	if len(cr.Spec.NodePools) == 0 {
+		meta.RemoveStatusCondition(&cr.Status.Conditions, provisioningv1alpha1.NodePoolVersionSkewSupported)
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:               provisioningv1alpha1.NodePoolVersionSkewSupported,
+		Status:             metav1.ConditionTrue,
+		Reason:             provisioningv1alpha1.ReasonVersionSkewSupported,
+		Message:            fmt.Sprintf("NodePools are at most %d minor versions behind the control plane", versionskew.MaxMinorSkew),
+		ObservedGeneration: cr.Generation,
+	}
+	if len(unsupported) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = provisioningv1alpha1.ReasonVersionSkewUnsupported
+		condition.Message = "Unsupported NodePool version skew: " + strings.Join(unsupported, "; ")
+	}
+	meta.SetStatusCondition(&cr.Status.Conditions, condition)
+}
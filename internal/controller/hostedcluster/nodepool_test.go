@@ -17,12 +17,27 @@ limitations under the License.
 package hostedcluster
 
 import (
+	"context"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/blackout"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/circuitbreaker"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/dpurollout"
 )
 
 var _ = Describe("NodePool Builder", func() {
@@ -58,24 +73,52 @@ var _ = Describe("NodePool Builder", func() {
 			Expect(np.Spec.ClusterName).To(Equal("test-bridge"))
 		})
 
-		It("should set replicas to 0", func() {
+		It("should set replicas to 0 by default", func() {
 			np := npm.buildNodePool(cr)
 
 			Expect(np.Spec.Replicas).ToNot(BeNil())
 			Expect(*np.Spec.Replicas).To(Equal(int32(0)))
 		})
 
+		It("should set replicas from spec.nodePoolReplicas", func() {
+			cr.Spec.NodePoolReplicas = ptr.To(int32(3))
+			np := npm.buildNodePool(cr)
+
+			Expect(*np.Spec.Replicas).To(Equal(int32(3)))
+		})
+
 		It("should set platform to None", func() {
 			np := npm.buildNodePool(cr)
 
 			Expect(np.Spec.Platform.Type).To(Equal(hyperv1.NonePlatform))
 		})
 
+		It("should select the Agents of the bridge on the Agent platform", func() {
+			cr.Spec.Platform = provisioningv1alpha1.PlatformAgent
+			np := npm.buildNodePool(cr)
+
+			Expect(np.Spec.Platform.Type).To(Equal(hyperv1.AgentPlatform))
+			Expect(np.Spec.Platform.Agent).NotTo(BeNil())
+			Expect(np.Spec.Platform.Agent.AgentLabelSelector.MatchLabels).To(
+				HaveKeyWithValue(provisioningv1alpha1.LabelAgentBridge, "test-bridge"))
+		})
+
 		It("should set release image from DPFHCPBridge spec", func() {
 			np := npm.buildNodePool(cr)
 
 			Expect(np.Spec.Release.Image).To(Equal(cr.Spec.OCPReleaseImage))
 		})
+
+		It("should label the nodes with the first of several DPUClusters", func() {
+			cr.Spec.DPUClusterRefs = []provisioningv1alpha1.DPUClusterReference{
+				{Name: "dpu-east", Namespace: "dpf-east"},
+				{Name: "dpu-west", Namespace: "dpf-west"},
+			}
+			np := npm.buildNodePool(cr)
+
+			Expect(np.Spec.NodeLabels).To(HaveKeyWithValue(provisioningv1alpha1.LabelDPUCluster, "dpu-east"))
+			Expect(np.Spec.NodeLabels).To(HaveKeyWithValue(provisioningv1alpha1.LabelDPUClusterNamespace, "dpf-east"))
+		})
 	})
 
 	Context("Management Configuration", func() {
@@ -86,3 +129,397 @@ var _ = Describe("NodePool Builder", func() {
 		})
 	})
 })
+
+var _ = Describe("NodePool Creation with circuit breaker", func() {
+	It("should stop calling the API while the HyperShift circuit is open", func() {
+		ctx := context.Background()
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		cr := &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", UID: "test-uid"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+			},
+		}
+
+		creates := 0
+		c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
+				creates++
+				return apierrors.NewServiceUnavailable("hypershift webhook unavailable")
+			},
+		}).Build()
+
+		npm := NewNodePoolManager(c, scheme)
+		npm.Breaker = circuitbreaker.NewBreaker("hypershift-test", 2, time.Minute)
+
+		for i := 0; i < 2; i++ {
+			_, err := npm.CreateNodePool(ctx, cr)
+			Expect(err).To(HaveOccurred())
+		}
+		Expect(npm.Breaker.State()).To(Equal(circuitbreaker.StateOpen))
+
+		result, err := npm.CreateNodePool(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(creates).To(Equal(2))
+	})
+})
+
+var _ = Describe("NodePool replica sync", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		cr     *provisioningv1alpha1.DPFHCPBridge
+		np     *hyperv1.NodePool
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", UID: "bridge-uid"},
+			Spec:       provisioningv1alpha1.DPFHCPBridgeSpec{NodePoolReplicas: ptr.To(int32(4))},
+		}
+		np = (&NodePoolManager{}).buildNodePool(cr)
+		np.Spec.Replicas = ptr.To(int32(1))
+		np.Status.Replicas = 1
+		np.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(cr, provisioningv1alpha1.GroupVersion.WithKind("DPFHCPBridge"))}
+	})
+
+	It("should scale the NodePool and report its replicas", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(np).Build()
+
+		result, err := NewNodePoolManager(c, scheme).SyncNodePoolReplicas(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		updated := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(np), updated)).To(Succeed())
+		Expect(*updated.Spec.Replicas).To(Equal(int32(4)))
+		Expect(cr.Status.NodePoolStatus).To(Equal(&provisioningv1alpha1.NodePoolStatus{Replicas: 4, ReadyReplicas: 1}))
+	})
+
+	It("should report the rollout of the NodePool", func() {
+		np.Status.Version = "4.19.0"
+		np.Status.Conditions = []hyperv1.NodePoolCondition{
+			{Type: hyperv1.NodePoolUpdatingConfigConditionType, Status: corev1.ConditionTrue, Reason: "AsExpected", Message: "Updating config in progress"},
+			{Type: hyperv1.NodePoolUpdatingVersionConditionType, Status: corev1.ConditionFalse},
+			{Type: hyperv1.NodePoolReadyConditionType, Status: corev1.ConditionTrue, Reason: "AsExpected"},
+		}
+		md := &unstructured.Unstructured{Object: map[string]interface{}{
+			"status": map[string]interface{}{"replicas": int64(4), "updatedReplicas": int64(2)},
+		}}
+		md.SetGroupVersionKind(MachineDeploymentGVK)
+		md.SetName("test-bridge")
+		md.SetNamespace(ControlPlaneNamespace(cr))
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(np, md).Build()
+
+		_, err := NewNodePoolManager(c, scheme).SyncNodePoolReplicas(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		status := cr.Status.NodePoolStatus
+		Expect(status.Replicas).To(Equal(int32(4)))
+		Expect(status.ReadyReplicas).To(Equal(int32(1)))
+		Expect(status.UpdatedReplicas).To(Equal(ptr.To(int32(2))))
+		Expect(status.CurrentVersion).To(Equal("4.19.0"))
+		Expect(status.Conditions).To(HaveLen(2))
+		updatingConfig := meta.FindStatusCondition(status.Conditions, hyperv1.NodePoolUpdatingConfigConditionType)
+		Expect(updatingConfig.Status).To(Equal(metav1.ConditionTrue))
+		Expect(updatingConfig.Message).To(Equal("Updating config in progress"))
+		updatingVersion := meta.FindStatusCondition(status.Conditions, hyperv1.NodePoolUpdatingVersionConditionType)
+		Expect(updatingVersion.Status).To(Equal(metav1.ConditionFalse))
+		Expect(updatingVersion.Reason).To(Equal(hyperv1.AsExpectedReason))
+	})
+
+	It("should leave the NodePool replicas alone while spec.nodePoolReplicas is unset", func() {
+		// A bridge created before spec.nodePoolReplicas existed, whose NodePool was scaled directly
+		cr.Spec.NodePoolReplicas = nil
+		np.Spec.Replicas = ptr.To(int32(6))
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(np).Build()
+
+		_, err := NewNodePoolManager(c, scheme).SyncNodePoolReplicas(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		unchanged := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(np), unchanged)).To(Succeed())
+		Expect(*unchanged.Spec.Replicas).To(Equal(int32(6)))
+		Expect(cr.Status.NodePoolStatus.Replicas).To(Equal(int32(6)))
+
+		cr.Spec.NodePoolReplicas = ptr.To(int32(2))
+		_, err = NewNodePoolManager(c, scheme).SyncNodePoolReplicas(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(np), unchanged)).To(Succeed())
+		Expect(*unchanged.Spec.Replicas).To(Equal(int32(2)))
+	})
+
+	It("should not touch a NodePool it does not control", func() {
+		np.OwnerReferences = nil
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(np).Build()
+
+		_, err := NewNodePoolManager(c, scheme).SyncNodePoolReplicas(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		unchanged := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(np), unchanged)).To(Succeed())
+		Expect(*unchanged.Spec.Replicas).To(Equal(int32(1)))
+		Expect(cr.Status.NodePoolStatus).To(BeNil())
+	})
+
+	It("should do nothing when the NodePool does not exist yet", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		result, err := NewNodePoolManager(c, scheme).SyncNodePoolReplicas(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(cr.Status.NodePoolStatus).To(BeNil())
+	})
+})
+
+var _ = Describe("Additional NodePool sync", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		cr     *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", UID: "bridge-uid"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.20.0-multi",
+				NodePools: []provisioningv1alpha1.NodePoolSpec{
+					{Name: "canary", Replicas: ptr.To(int32(1)), OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.0-multi"},
+					{Name: "bulk", Replicas: ptr.To(int32(5))},
+				},
+			},
+		}
+	})
+
+	It("should create one NodePool per entry", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		_, err := NewNodePoolManager(c, scheme).SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		canary := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge-canary", Namespace: "default"}, canary)).To(Succeed())
+		Expect(*canary.Spec.Replicas).To(Equal(int32(1)))
+		Expect(canary.Spec.Release.Image).To(Equal("quay.io/openshift-release-dev/ocp-release:4.19.0-multi"))
+		Expect(canary.Spec.ClusterName).To(Equal("test-bridge"))
+		Expect(metav1.IsControlledBy(canary, cr)).To(BeTrue())
+
+		bulk := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge-bulk", Namespace: "default"}, bulk)).To(Succeed())
+		Expect(*bulk.Spec.Replicas).To(Equal(int32(5)))
+		Expect(bulk.Spec.Release.Image).To(Equal("quay.io/openshift-release-dev/ocp-release:4.20.0-multi"))
+
+		Expect(cr.Status.NodePools).To(HaveLen(2))
+		Expect(cr.Status.NodePools[0].Name).To(Equal("canary"))
+		Expect(cr.Status.NodePools[1].Replicas).To(Equal(int32(5)))
+	})
+
+	It("should render the default and additional NodePools without creating them", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		nodePools, err := NewNodePoolManager(c, scheme).RenderNodePools(cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodePools).To(HaveLen(3))
+		Expect(nodePools[0].Name).To(Equal("test-bridge"))
+		Expect(nodePools[1].Name).To(Equal("test-bridge-canary"))
+		Expect(nodePools[1].Spec.Release.Image).To(Equal("quay.io/openshift-release-dev/ocp-release:4.19.0-multi"))
+		Expect(nodePools[2].Spec.Release.Image).To(Equal("quay.io/openshift-release-dev/ocp-release:4.20.0-multi"))
+		for _, np := range nodePools {
+			Expect(metav1.IsControlledBy(np, cr)).To(BeTrue())
+		}
+
+		nps := &hyperv1.NodePoolList{}
+		Expect(c.List(ctx, nps)).To(Succeed())
+		Expect(nps.Items).To(BeEmpty())
+	})
+
+	It("should create a labelled NodePool per additional DPUCluster", func() {
+		cr.Spec.NodePools = nil
+		cr.Spec.NodePoolReplicas = ptr.To(int32(4))
+		cr.Spec.DPUClusterRefs = []provisioningv1alpha1.DPUClusterReference{
+			{Name: "dpu-east", Namespace: "dpf-east"},
+			{Name: "dpu-west", Namespace: "dpf-west"},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		npm := NewNodePoolManager(c, scheme)
+
+		_, err := npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		west := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge-dpu-west", Namespace: "default"}, west)).To(Succeed())
+		Expect(*west.Spec.Replicas).To(Equal(int32(4)))
+		Expect(west.Spec.NodeLabels).To(HaveKeyWithValue(provisioningv1alpha1.LabelDPUCluster, "dpu-west"))
+		Expect(west.Spec.NodeLabels).To(HaveKeyWithValue(provisioningv1alpha1.LabelDPUClusterNamespace, "dpf-west"))
+		err = c.Get(ctx, client.ObjectKey{Name: "test-bridge-dpu-east", Namespace: "default"}, &hyperv1.NodePool{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		Expect(cr.Status.NodePools).To(HaveLen(1))
+		Expect(cr.Status.NodePools[0].Name).To(Equal("dpu-west"))
+	})
+
+	It("should propagate replica and release image changes", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		npm := NewNodePoolManager(c, scheme)
+		_, err := npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		cr.Spec.NodePools[1].Replicas = ptr.To(int32(2))
+		cr.Spec.NodePools[1].OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.18.0-multi"
+		_, err = npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		bulk := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge-bulk", Namespace: "default"}, bulk)).To(Succeed())
+		Expect(*bulk.Spec.Replicas).To(Equal(int32(2)))
+		Expect(bulk.Spec.Release.Image).To(Equal("quay.io/openshift-release-dev/ocp-release:4.18.0-multi"))
+	})
+
+	It("should delete the NodePools of removed entries only", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		npm := NewNodePoolManager(c, scheme)
+		_, err := npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		foreign := (&NodePoolManager{}).buildNodePool(cr)
+		foreign.Name = "test-bridge-foreign"
+		Expect(c.Create(ctx, foreign)).To(Succeed())
+
+		cr.Spec.NodePools = cr.Spec.NodePools[:1]
+		_, err = npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = c.Get(ctx, client.ObjectKey{Name: "test-bridge-bulk", Namespace: "default"}, &hyperv1.NodePool{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge-canary", Namespace: "default"}, &hyperv1.NodePool{})).To(Succeed())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(foreign), &hyperv1.NodePool{})).To(Succeed())
+		Expect(cr.Status.NodePools).To(HaveLen(1))
+	})
+
+	It("should clear the status when no NodePools are listed", func() {
+		cr.Spec.NodePools = nil
+		cr.Status.NodePools = []provisioningv1alpha1.NodePoolStatus{{Name: "stale"}}
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		_, err := NewNodePoolManager(c, scheme).SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cr.Status.NodePools).To(BeNil())
+	})
+
+	It("should scale but defer release image changes during a blackout window", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		npm := NewNodePoolManager(c, scheme)
+		_, err := npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		npm.Blackout, err = blackout.Parse([]byte("windows:\n- {name: freeze, start: \"00:00\", duration: 168h}"))
+		Expect(err).NotTo(HaveOccurred())
+		cr.Spec.NodePools[1].Replicas = ptr.To(int32(2))
+		cr.Spec.NodePools[1].OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.18.0-multi"
+		result, err := npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+		bulk := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge-bulk", Namespace: "default"}, bulk)).To(Succeed())
+		Expect(*bulk.Spec.Replicas).To(Equal(int32(2)))
+		Expect(bulk.Spec.Release.Image).To(Equal("quay.io/openshift-release-dev/ocp-release:4.20.0-multi"))
+	})
+
+	It("should scale but defer release image changes while DPU services roll out", func() {
+		cr.Spec.DPUClusterRef = provisioningv1alpha1.DPUClusterReference{Name: "dpu-x7k2p", Namespace: "dpu-system"}
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		npm := NewNodePoolManager(c, scheme)
+		npm.DPURollouts = dpurollout.NewGuard(c)
+		_, err := npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		ovn := &unstructured.Unstructured{}
+		ovn.SetGroupVersionKind(dpurollout.DPUServiceGVK)
+		ovn.SetName("ovn")
+		ovn.SetNamespace("dpu-system")
+		Expect(c.Create(ctx, ovn)).To(Succeed())
+
+		cr.Spec.NodePools[1].Replicas = ptr.To(int32(2))
+		cr.Spec.NodePools[1].OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.18.0-multi"
+		result, err := npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(dpurollout.DefaultRecheckInterval))
+
+		bulk := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge-bulk", Namespace: "default"}, bulk)).To(Succeed())
+		Expect(*bulk.Spec.Replicas).To(Equal(int32(2)))
+		Expect(bulk.Spec.Release.Image).To(Equal("quay.io/openshift-release-dev/ocp-release:4.20.0-multi"))
+
+		Expect(unstructured.SetNestedField(ovn.Object, map[string]interface{}{
+			"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+		}, "status")).To(Succeed())
+		Expect(c.Update(ctx, ovn)).To(Succeed())
+		result, err = npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge-bulk", Namespace: "default"}, bulk)).To(Succeed())
+		Expect(bulk.Spec.Release.Image).To(Equal("quay.io/openshift-release-dev/ocp-release:4.18.0-multi"))
+	})
+
+	It("should report the version skew of each NodePool", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		_, err := NewNodePoolManager(c, scheme).SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(cr.Status.NodePools[0].Version).To(Equal("4.19.0"))
+		Expect(cr.Status.NodePools[0].MinorVersionSkew).To(Equal(ptr.To(int32(1))))
+		Expect(cr.Status.NodePools[1].Version).To(Equal("4.20.0"))
+		Expect(cr.Status.NodePools[1].MinorVersionSkew).To(Equal(ptr.To(int32(0))))
+		condition := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.NodePoolVersionSkewSupported)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should neither create nor upgrade NodePools with an unsupported version skew", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		npm := NewNodePoolManager(c, scheme)
+		_, err := npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		cr.Spec.NodePools[0].OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.21.0-multi"
+		cr.Spec.NodePools = append(cr.Spec.NodePools, provisioningv1alpha1.NodePoolSpec{
+			Name: "legacy", OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.16.0-multi",
+		})
+		_, err = npm.SyncNodePools(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		canary := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge-canary", Namespace: "default"}, canary)).To(Succeed())
+		Expect(canary.Spec.Release.Image).To(Equal("quay.io/openshift-release-dev/ocp-release:4.19.0-multi"))
+		err = c.Get(ctx, client.ObjectKey{Name: "test-bridge-legacy", Namespace: "default"}, &hyperv1.NodePool{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		Expect(cr.Status.NodePools).To(HaveLen(3))
+		Expect(cr.Status.NodePools[0].MinorVersionSkew).To(Equal(ptr.To(int32(-1))))
+		Expect(cr.Status.NodePools[2].MinorVersionSkew).To(Equal(ptr.To(int32(4))))
+		condition := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.NodePoolVersionSkewSupported)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonVersionSkewUnsupported))
+		Expect(condition.Message).To(ContainSubstring("canary: NodePool version 4.21.0 is newer than the control plane version 4.20.0"))
+		Expect(condition.Message).To(ContainSubstring("legacy: NodePool version 4.16.0 is 4 minor versions behind"))
+	})
+})
@@ -0,0 +1,221 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;update;delete
+
+// nodePoolConfigKey is the ConfigMap key HyperShift reads NodePool config manifests from
+const nodePoolConfigKey = "config"
+
+// nodePoolConfigSource is a bridge spec field rendered into a ConfigMap referenced by the config of every NodePool
+type nodePoolConfigSource struct {
+	// description names the source in logs and errors, e.g. "time sync"
+	description string
+
+	// component is the owner label value of the ConfigMap
+	component string
+
+	// configMapName returns the name of the ConfigMap in the bridge namespace
+	configMapName func(cr *provisioningv1alpha1.DPFHCPBridge) string
+
+	// enabled reports whether the bridge spec sets the source
+	enabled func(cr *provisioningv1alpha1.DPFHCPBridge) bool
+
+	// render returns the manifest stored in the ConfigMap, only called when the source is enabled
+	render func(cr *provisioningv1alpha1.DPFHCPBridge) (string, error)
+}
+
+// nodePoolConfigSources are the NodePool configs managed by the operator, in the order they are referenced
+var nodePoolConfigSources = []nodePoolConfigSource{
+	timeSyncConfigSource,
+	nodeTuningConfigSource,
+	containerRuntimeConfigSource,
+	registriesConfigSource,
+}
+
+// renderMachineConfig returns a worker MachineConfig with the given name and spec
+func renderMachineConfig(name string, spec map[string]any) (string, error) {
+	machineConfig := map[string]any{
+		"apiVersion": "machineconfiguration.openshift.io/v1",
+		"kind":       "MachineConfig",
+		"metadata": map[string]any{
+			"name": name,
+			"labels": map[string]any{
+				"machineconfiguration.openshift.io/role": "worker",
+			},
+		},
+		"spec": spec,
+	}
+	data, err := yaml.Marshal(machineConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to render MachineConfig %s: %w", name, err)
+	}
+	return string(data), nil
+}
+
+// machineConfigFile returns an ignition storage file with the given contents, readable by everyone
+func machineConfigFile(path, contents string) map[string]any {
+	return map[string]any{
+		"path":      path,
+		"mode":      0o644,
+		"overwrite": true,
+		"contents": map[string]any{
+			"source": "data:text/plain;charset=utf-8;base64," + base64.StdEncoding.EncodeToString([]byte(contents)),
+		},
+	}
+}
+
+// nodePoolConfig returns the NodePool config references of the bridge, nil if no source is enabled
+func nodePoolConfig(cr *provisioningv1alpha1.DPFHCPBridge) []corev1.LocalObjectReference {
+	var refs []corev1.LocalObjectReference
+	for _, source := range nodePoolConfigSources {
+		if source.enabled(cr) {
+			refs = append(refs, corev1.LocalObjectReference{Name: source.configMapName(cr)})
+		}
+	}
+	return refs
+}
+
+// applyNodePoolConfig adds or removes the references to the ConfigMaps of the operator managed sources
+// in the NodePool config, leaving references added by others alone.
+// Returns true if the NodePool config was changed, and the sources whose reference was removed.
+func applyNodePoolConfig(np *hyperv1.NodePool, cr *provisioningv1alpha1.DPFHCPBridge) (bool, []nodePoolConfigSource) {
+	changed := false
+	var removed []nodePoolConfigSource
+
+	for _, source := range nodePoolConfigSources {
+		name := source.configMapName(cr)
+		isRef := func(ref corev1.LocalObjectReference) bool { return ref.Name == name }
+		referenced := slices.ContainsFunc(np.Spec.Config, isRef)
+
+		switch enabled := source.enabled(cr); {
+		case enabled && !referenced:
+			np.Spec.Config = append(np.Spec.Config, corev1.LocalObjectReference{Name: name})
+			changed = true
+		case !enabled && referenced:
+			np.Spec.Config = slices.DeleteFunc(np.Spec.Config, isRef)
+			removed = append(removed, source)
+			changed = true
+		}
+	}
+	if changed && len(np.Spec.Config) == 0 {
+		np.Spec.Config = nil
+	}
+	return changed, removed
+}
+
+// ensureNodePoolConfigs creates or refreshes the ConfigMaps of the sources the bridge spec sets
+func (nm *NodePoolManager) ensureNodePoolConfigs(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) error {
+	for _, source := range nodePoolConfigSources {
+		if !source.enabled(cr) {
+			continue
+		}
+		if err := nm.syncNodePoolConfig(ctx, cr, source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncNodePoolConfig creates or refreshes the ConfigMap of a source the NodePools of the bridge reference,
+// and deletes it once the source is removed from the bridge spec.
+// The ConfigMap is in the bridge namespace, which is also the namespace of the NodePools.
+// A ConfigMap of the same name that is not owned by this bridge is never overwritten.
+func (nm *NodePoolManager) syncNodePoolConfig(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge,
+	source nodePoolConfigSource) error {
+	log := logf.FromContext(ctx)
+	name := source.configMapName(cr)
+
+	existing := &corev1.ConfigMap{}
+	err := nm.Get(ctx, types.NamespacedName{Name: name, Namespace: cr.Namespace}, existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get %s ConfigMap: %w", source.description, err)
+	}
+	exists := err == nil
+	owned := exists && existing.Labels[common.LabelOwnedBy] == cr.Name &&
+		existing.Labels[common.LabelNamespace] == cr.Namespace &&
+		existing.Labels[common.LabelComponent] == source.component
+
+	if !source.enabled(cr) {
+		if !owned {
+			return nil
+		}
+		if err := nm.Delete(ctx, existing); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s ConfigMap: %w", source.description, err)
+		}
+		log.Info("Deleted NodePool config ConfigMap, it was removed from the spec", "configMap", name)
+		return nil
+	}
+
+	manifest, err := source.render(cr)
+	if err != nil {
+		return err
+	}
+	data := map[string]string{nodePoolConfigKey: manifest}
+
+	if !exists {
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: cr.Namespace,
+				Labels:    common.ComponentOwnerLabels(cr, source.component),
+			},
+			Data: data,
+		}
+		if err := controllerutil.SetControllerReference(cr, configMap, nm.Scheme); err != nil {
+			return fmt.Errorf("failed to set owner reference on %s ConfigMap: %w", source.description, err)
+		}
+		if err := nm.Create(ctx, configMap); err != nil {
+			return fmt.Errorf("failed to create %s ConfigMap: %w", source.description, err)
+		}
+		log.Info("Created NodePool config ConfigMap", "configMap", name)
+		return nil
+	}
+
+	if !owned {
+		return fmt.Errorf("configMap %s/%s already exists and is not owned by this DPFHCPBridge", cr.Namespace, name)
+	}
+	if maps.Equal(existing.Data, data) {
+		return nil
+	}
+	existing.Data = data
+	if err := nm.Update(ctx, existing); err != nil {
+		return fmt.Errorf("failed to update %s ConfigMap: %w", source.description, err)
+	}
+	log.Info("Refreshed NodePool config ConfigMap", "configMap", name)
+	return nil
+}
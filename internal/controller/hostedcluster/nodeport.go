@@ -0,0 +1,245 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// NodePortHealthCheckInterval is how often the node the services are published on in NodePort mode is checked
+const NodePortHealthCheckInterval = 30 * time.Second
+
+// SyncNodePortAddress checks the node of the address the HostedCluster publishes its services on in NodePort
+// mode and, once that node is not Ready, switches the HostedCluster to the next address of spec.nodePortAddresses
+// whose node is Ready. The services are not switched back when the node recovers. The address is reported in
+// status.nodePortAddress and the NodePortAddressReady condition, and every switch in a NodePortAddressSwitched event.
+//
+// The switch keeps the control plane reachable, so it is neither rate limited nor deferred by blackout windows.
+// A RequeueAfter result does not indicate that reconciliation should stop.
+func (hm *HostedClusterManager) SyncNodePortAddress(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if len(cr.Spec.NodePortAddresses) == 0 || cr.ShouldExposeThroughLoadBalancer() {
+		cr.Status.NodePortAddress = nil
+		meta.RemoveStatusCondition(&cr.Status.Conditions, provisioningv1alpha1.NodePortAddressReady)
+		return ctrl.Result{}, nil
+	}
+	if cr.Status.HostedClusterRef == nil {
+		return ctrl.Result{}, nil
+	}
+
+	hc := &hyperv1.HostedCluster{}
+	if err := hm.Get(ctx, types.NamespacedName{Name: cr.Name, Namespace: cr.Namespace}, hc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get HostedCluster for NodePort address check: %w", err)
+	}
+	if !metav1.IsControlledBy(hc, cr) || !hc.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	if err := verifyBackReference(hc, cr); err != nil {
+		return ctrl.Result{}, fmt.Errorf("hostedCluster ownership check failed: %w", err)
+	}
+
+	active := publishedNodePortAddress(hc.Spec.Services)
+	if active == "" {
+		// Not published on node ports, e.g. an adopted HostedCluster
+		return ctrl.Result{}, nil
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := hm.List(ctx, nodes); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	status := cr.Status.NodePortAddress
+	if status == nil || status.Address != active {
+		status = &provisioningv1alpha1.NodePortAddressStatus{Address: active}
+		cr.Status.NodePortAddress = status
+	}
+	node, ready := nodeOfAddress(nodes.Items, active)
+	status.Node = node
+	if ready {
+		setNodePortAddressCondition(cr, metav1.ConditionTrue, provisioningv1alpha1.ReasonNodeReady,
+			fmt.Sprintf("Node %s of address %s is Ready", node, active))
+		return ctrl.Result{RequeueAfter: NodePortHealthCheckInterval}, nil
+	}
+
+	next, nextNode := nextReadyNodePortAddress(nodes.Items, cr.Spec.NodePortAddresses, active)
+	if next == "" {
+		log.Info("Node of the NodePort address is not Ready and no other node of spec.nodePortAddresses is",
+			"address", active, "node", node)
+		setNodePortAddressCondition(cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonNoReadyNode,
+			fmt.Sprintf("The services stay on address %s: %s and no node of the other spec.nodePortAddresses is Ready",
+				active, nodeNotReady(active, node)))
+		return ctrl.Result{RequeueAfter: NodePortHealthCheckInterval}, nil
+	}
+
+	if ok, retryAfter := hm.Breaker.Allow(ctx); !ok {
+		log.V(1).Info("HyperShift circuit open, deferring NodePort address switch", "retryAfter", retryAfter)
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+
+	log.Info("Node of the NodePort address is not Ready, switching the HostedCluster services",
+		"hostedCluster", hc.Name,
+		"address", next,
+		"node", nextNode,
+		"previousAddress", active,
+		"previousNode", node)
+	hc.Spec.Services = setNodePortAddress(hc.Spec.Services, next)
+	err := hm.Update(ctx, hc)
+	hm.Breaker.Record(ctx, err)
+	if apierrors.IsInvalid(err) {
+		// HyperShift releases that treat the services as immutable reject the switch; retried with the next check
+		message := fmt.Sprintf("Cannot switch the HostedCluster to address %s although %s: %v",
+			next, nodeNotReady(active, node), err)
+		setNodePortAddressCondition(cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonNodePortSwitchRejected, message)
+		if hm.Recorder != nil {
+			hm.Recorder.Event(cr, corev1.EventTypeWarning, "NodePortAddressSwitchRejected", message)
+		}
+		return ctrl.Result{RequeueAfter: NodePortHealthCheckInterval}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update HostedCluster NodePort address: %w", err)
+	}
+
+	switchTime := metav1.NewTime(hm.clock())
+	cr.Status.NodePortAddress = &provisioningv1alpha1.NodePortAddressStatus{
+		Address:         next,
+		Node:            nextNode,
+		PreviousAddress: active,
+		LastSwitchTime:  &switchTime,
+	}
+	setNodePortAddressCondition(cr, metav1.ConditionTrue, provisioningv1alpha1.ReasonNodeReady,
+		fmt.Sprintf("Node %s of address %s is Ready, switched from address %s", nextNode, next, active))
+	if hm.Recorder != nil {
+		hm.Recorder.Eventf(cr, corev1.EventTypeWarning, "NodePortAddressSwitched",
+			"Switched the control plane services to address %s of node %s: %s",
+			next, nextNode, nodeNotReady(active, node))
+	}
+	return ctrl.Result{RequeueAfter: NodePortHealthCheckInterval}, nil
+}
+
+// firstReadyNodePortAddress returns the first of addresses whose node is Ready, for publishing a new
+// HostedCluster on
+func firstReadyNodePortAddress(ctx context.Context, c client.Client, addresses []string) (string, error) {
+	nodes := &corev1.NodeList{}
+	if err := c.List(ctx, nodes); err != nil {
+		return "", fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	for _, address := range addresses {
+		if _, ready := nodeOfAddress(nodes.Items, address); ready {
+			return address, nil
+		}
+	}
+	return "", fmt.Errorf("no node of spec.nodePortAddresses %v is Ready", addresses)
+}
+
+// nextReadyNodePortAddress returns the address following active in addresses, wrapping around, whose node is
+// Ready, and the name of that node. It returns an empty address if there is none.
+func nextReadyNodePortAddress(nodes []corev1.Node, addresses []string, active string) (string, string) {
+	start := slices.Index(addresses, active) + 1
+	for i := range addresses {
+		address := addresses[(start+i)%len(addresses)]
+		if address == active {
+			continue
+		}
+		if node, ready := nodeOfAddress(nodes, address); ready {
+			return address, node
+		}
+	}
+	return "", ""
+}
+
+// nodeOfAddress returns the name of the node that has address, empty if no node has it, and whether the node is Ready
+func nodeOfAddress(nodes []corev1.Node, address string) (string, bool) {
+	for i := range nodes {
+		node := &nodes[i]
+		for _, nodeAddress := range node.Status.Addresses {
+			if nodeAddress.Address != address {
+				continue
+			}
+			for _, condition := range node.Status.Conditions {
+				if condition.Type == corev1.NodeReady {
+					return node.Name, condition.Status == corev1.ConditionTrue
+				}
+			}
+			return node.Name, false
+		}
+	}
+	return "", false
+}
+
+// publishedNodePortAddress returns the address the API server is published on in NodePort mode, empty
+// if it is not published on a node port
+func publishedNodePortAddress(services []hyperv1.ServicePublishingStrategyMapping) string {
+	for _, mapping := range services {
+		if mapping.Service == hyperv1.APIServer && mapping.Type == hyperv1.NodePort && mapping.NodePort != nil {
+			return mapping.NodePort.Address
+		}
+	}
+	return ""
+}
+
+// setNodePortAddress publishes every service published on a node port on address instead, keeping its port
+func setNodePortAddress(services []hyperv1.ServicePublishingStrategyMapping, address string) []hyperv1.ServicePublishingStrategyMapping {
+	result := make([]hyperv1.ServicePublishingStrategyMapping, 0, len(services))
+	for _, mapping := range services {
+		mapping = *mapping.DeepCopy()
+		if mapping.Type == hyperv1.NodePort && mapping.NodePort != nil {
+			mapping.NodePort.Address = address
+		}
+		result = append(result, mapping)
+	}
+	return result
+}
+
+// nodeNotReady describes in messages that the node of address is not Ready, or that no node has address
+func nodeNotReady(address, node string) string {
+	if node == "" {
+		return fmt.Sprintf("no node has address %s", address)
+	}
+	return fmt.Sprintf("node %s of address %s is not Ready", node, address)
+}
+
+// setNodePortAddressCondition sets the NodePortAddressReady condition
+func setNodePortAddressCondition(cr *provisioningv1alpha1.DPFHCPBridge, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+		Type:               provisioningv1alpha1.NodePortAddressReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: cr.Generation,
+	})
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("NodePort failover", func() {
+	var (
+		ctx      context.Context
+		scheme   *runtime.Scheme
+		cr       *provisioningv1alpha1.DPFHCPBridge
+		hc       *hyperv1.HostedCluster
+		nodes    []client.Object
+		hm       *HostedClusterManager
+		recorder *record.FakeRecorder
+		now      time.Time
+	)
+
+	newNode := func(name, address string, ready corev1.ConditionStatus) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{
+				Addresses:  []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: address}},
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+			},
+		}
+	}
+
+	build := func(funcs interceptor.Funcs) client.Client {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(append([]client.Object{cr, hc}, nodes...)...).
+			WithInterceptorFuncs(funcs).
+			Build()
+		hm = NewHostedClusterManager(c, scheme)
+		hm.Recorder = recorder
+		hm.now = func() time.Time { return now }
+		return c
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+		recorder = record.NewFakeRecorder(10)
+		now = time.Now().Truncate(time.Second)
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", UID: "bridge-uid"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				ControlPlaneAvailabilityPolicy: hyperv1.SingleReplica,
+				NodePortAddresses:              []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+			},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				HostedClusterRef: &corev1.ObjectReference{Name: "test-bridge", Namespace: "default"},
+			},
+		}
+		hc = &hyperv1.HostedCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default"},
+			Spec: hyperv1.HostedClusterSpec{
+				Services: BuildServicePublishingStrategy(false, "10.0.0.1"),
+			},
+		}
+		hc.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(cr, provisioningv1alpha1.GroupVersion.WithKind("DPFHCPBridge"))}
+		setBackReference(hc, cr)
+		nodes = []client.Object{
+			newNode("master-0", "10.0.0.1", corev1.ConditionTrue),
+			newNode("master-1", "10.0.0.2", corev1.ConditionTrue),
+			newNode("master-2", "10.0.0.3", corev1.ConditionTrue),
+		}
+	})
+
+	It("should keep the services on the address of a Ready node", func() {
+		c := build(interceptor.Funcs{})
+
+		result, err := hm.SyncNodePortAddress(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(NodePortHealthCheckInterval))
+		Expect(cr.Status.NodePortAddress).To(Equal(&provisioningv1alpha1.NodePortAddressStatus{Address: "10.0.0.1", Node: "master-0"}))
+		cond := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.NodePortAddressReady)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonNodeReady))
+
+		updated := &hyperv1.HostedCluster{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(hc), updated)).To(Succeed())
+		Expect(publishedNodePortAddress(updated.Spec.Services)).To(Equal("10.0.0.1"))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should switch the services to the next address whose node is Ready", func() {
+		nodes = []client.Object{
+			newNode("master-0", "10.0.0.1", corev1.ConditionFalse),
+			newNode("master-1", "10.0.0.2", corev1.ConditionUnknown),
+			newNode("master-2", "10.0.0.3", corev1.ConditionTrue),
+		}
+		c := build(interceptor.Funcs{})
+
+		result, err := hm.SyncNodePortAddress(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(NodePortHealthCheckInterval))
+
+		updated := &hyperv1.HostedCluster{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(hc), updated)).To(Succeed())
+		Expect(updated.Spec.Services).To(HaveLen(len(hc.Spec.Services)))
+		for _, mapping := range updated.Spec.Services {
+			Expect(mapping.NodePort.Address).To(Equal("10.0.0.3"), string(mapping.Service))
+		}
+
+		Expect(cr.Status.NodePortAddress.Address).To(Equal("10.0.0.3"))
+		Expect(cr.Status.NodePortAddress.Node).To(Equal("master-2"))
+		Expect(cr.Status.NodePortAddress.PreviousAddress).To(Equal("10.0.0.1"))
+		Expect(cr.Status.NodePortAddress.LastSwitchTime.Time).To(BeTemporally("==", now))
+		Expect(meta.IsStatusConditionTrue(cr.Status.Conditions, provisioningv1alpha1.NodePortAddressReady)).To(BeTrue())
+		Expect(recorder.Events).To(Receive(And(
+			ContainSubstring("NodePortAddressSwitched"),
+			ContainSubstring("node master-0 of address 10.0.0.1 is not Ready"))))
+
+		// The services are not switched back once the node recovers
+		recovered := &corev1.Node{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "master-0"}, recovered)).To(Succeed())
+		recovered.Status.Conditions[0].Status = corev1.ConditionTrue
+		Expect(c.Update(ctx, recovered)).To(Succeed())
+		result, err = hm.SyncNodePortAddress(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(NodePortHealthCheckInterval))
+		Expect(cr.Status.NodePortAddress.Address).To(Equal("10.0.0.3"))
+		Expect(cr.Status.NodePortAddress.PreviousAddress).To(Equal("10.0.0.1"))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should keep the services on their address while no other node is Ready", func() {
+		nodes = []client.Object{
+			newNode("master-1", "10.0.0.2", corev1.ConditionFalse),
+		}
+		c := build(interceptor.Funcs{})
+
+		_, err := hm.SyncNodePortAddress(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		updated := &hyperv1.HostedCluster{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(hc), updated)).To(Succeed())
+		Expect(publishedNodePortAddress(updated.Spec.Services)).To(Equal("10.0.0.1"))
+		Expect(cr.Status.NodePortAddress).To(Equal(&provisioningv1alpha1.NodePortAddressStatus{Address: "10.0.0.1"}))
+		cond := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.NodePortAddressReady)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonNoReadyNode))
+		Expect(cond.Message).To(ContainSubstring("no node has address 10.0.0.1"))
+	})
+
+	It("should report a switch HyperShift rejects", func() {
+		nodes[0] = newNode("master-0", "10.0.0.1", corev1.ConditionFalse)
+		build(interceptor.Funcs{
+			Update: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.UpdateOption) error {
+				return apierrors.NewInvalid(schema.GroupKind{Group: "hypershift.openshift.io", Kind: "HostedCluster"}, obj.GetName(),
+					field.ErrorList{field.Invalid(field.NewPath("spec", "services"), nil, "Services is immutable")})
+			},
+		})
+
+		result, err := hm.SyncNodePortAddress(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(NodePortHealthCheckInterval))
+		Expect(cr.Status.NodePortAddress.Address).To(Equal("10.0.0.1"))
+		cond := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.NodePortAddressReady)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonNodePortSwitchRejected))
+		Expect(cond.Message).To(ContainSubstring("Services is immutable"))
+		Expect(recorder.Events).To(Receive(ContainSubstring("NodePortAddressSwitchRejected")))
+	})
+
+	It("should clear the status once the candidate addresses are removed", func() {
+		build(interceptor.Funcs{})
+		_, err := hm.SyncNodePortAddress(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cr.Status.NodePortAddress).NotTo(BeNil())
+
+		cr.Spec.NodePortAddresses = nil
+		result, err := hm.SyncNodePortAddress(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(cr.Status.NodePortAddress).To(BeNil())
+		Expect(meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.NodePortAddressReady)).To(BeNil())
+	})
+
+	It("should publish a new HostedCluster on the first address whose node is Ready", func() {
+		nodes[0] = newNode("master-0", "10.0.0.1", corev1.ConditionFalse)
+		c := build(interceptor.Funcs{})
+
+		address, err := firstReadyNodePortAddress(ctx, c, cr.Spec.NodePortAddresses)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(address).To(Equal("10.0.0.2"))
+
+		_, err = firstReadyNodePortAddress(ctx, c, []string{"10.0.0.1", "10.0.0.9"})
+		Expect(err).To(MatchError(ContainSubstring("no node of spec.nodePortAddresses")))
+	})
+})
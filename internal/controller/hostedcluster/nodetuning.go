@@ -0,0 +1,83 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"cmp"
+	"strconv"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+// NodeTuningConfigMapSuffix is appended to the bridge name to name the NodePool config ConfigMap
+// holding the kernel arguments MachineConfig
+const NodeTuningConfigMapSuffix = "-nodetuning"
+
+// nodeTuningConfigSource renders spec.nodeTuning into the NodePool config
+var nodeTuningConfigSource = nodePoolConfigSource{
+	description:   "node tuning",
+	component:     common.ComponentNodeTuning,
+	configMapName: NodeTuningConfigMapName,
+	enabled: func(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+		return cr.Spec.NodeTuning != nil
+	},
+	render: renderNodeTuningMachineConfig,
+}
+
+// NodeTuningConfigMapName returns the name of the NodePool config ConfigMap of spec.nodeTuning
+func NodeTuningConfigMapName(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	return cr.Name + NodeTuningConfigMapSuffix
+}
+
+// nodeTuningKernelArguments returns the kernel arguments of the presets in spec.nodeTuning
+func nodeTuningKernelArguments(cr *provisioningv1alpha1.DPFHCPBridge) []string {
+	tuning := cr.Spec.NodeTuning
+	var args []string
+
+	if tuning.Hugepages != nil {
+		size := cmp.Or(tuning.Hugepages.Size, provisioningv1alpha1.HugepageSize2M)
+		args = append(args,
+			"default_hugepagesz="+string(size),
+			"hugepagesz="+string(size),
+			"hugepages="+strconv.Itoa(int(tuning.Hugepages.Count)))
+	}
+
+	if tuning.IsolatedCPUs != "" {
+		args = append(args, "isolcpus="+tuning.IsolatedCPUs)
+	}
+
+	// The DPU workers are arm64, whose SMMU is configured through the iommu.* parameters
+	switch tuning.IOMMU {
+	case provisioningv1alpha1.IOMMUModePassthrough:
+		args = append(args, "iommu.passthrough=1")
+	case provisioningv1alpha1.IOMMUModeStrict:
+		args = append(args, "iommu.passthrough=0", "iommu.strict=1")
+	}
+
+	return args
+}
+
+// renderNodeTuningMachineConfig returns the MachineConfig adding the kernel arguments to the DPU workers
+func renderNodeTuningMachineConfig(cr *provisioningv1alpha1.DPFHCPBridge) (string, error) {
+	return renderMachineConfig("50-dpf-hcp-bridge-node-tuning", map[string]any{
+		"config": map[string]any{
+			"ignition": map[string]any{"version": "3.2.0"},
+		},
+		"kernelArguments": nodeTuningKernelArguments(cr),
+	})
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+var _ = Describe("Node tuning", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		cr     *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", UID: "bridge-uid"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+				NodeTuning: &provisioningv1alpha1.NodeTuningSpec{
+					Hugepages:    &provisioningv1alpha1.HugepagesSpec{Size: provisioningv1alpha1.HugepageSize1G, Count: 4},
+					IsolatedCPUs: "2-7",
+					IOMMU:        provisioningv1alpha1.IOMMUModePassthrough,
+				},
+			},
+		}
+	})
+
+	It("should convert the presets into kernel arguments", func() {
+		Expect(nodeTuningKernelArguments(cr)).To(Equal([]string{
+			"default_hugepagesz=1G", "hugepagesz=1G", "hugepages=4",
+			"isolcpus=2-7",
+			"iommu.passthrough=1",
+		}))
+
+		cr.Spec.NodeTuning = &provisioningv1alpha1.NodeTuningSpec{
+			Hugepages: &provisioningv1alpha1.HugepagesSpec{Count: 512},
+			IOMMU:     provisioningv1alpha1.IOMMUModeStrict,
+		}
+		Expect(nodeTuningKernelArguments(cr)).To(Equal([]string{
+			"default_hugepagesz=2M", "hugepagesz=2M", "hugepages=512",
+			"iommu.passthrough=0", "iommu.strict=1",
+		}))
+	})
+
+	It("should render the kernel arguments into a worker MachineConfig", func() {
+		rendered, err := renderNodeTuningMachineConfig(cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		var machineConfig struct {
+			Kind     string            `json:"kind"`
+			Metadata metav1.ObjectMeta `json:"metadata"`
+			Spec     struct {
+				KernelArguments []string `json:"kernelArguments"`
+			} `json:"spec"`
+		}
+		Expect(yaml.Unmarshal([]byte(rendered), &machineConfig)).To(Succeed())
+		Expect(machineConfig.Kind).To(Equal("MachineConfig"))
+		Expect(machineConfig.Metadata.Labels).To(HaveKeyWithValue("machineconfiguration.openshift.io/role", "worker"))
+		Expect(machineConfig.Spec.KernelArguments).To(Equal(nodeTuningKernelArguments(cr)))
+	})
+
+	It("should reference the node tuning ConfigMap after the time sync one", func() {
+		cr.Spec.TimeSync = &provisioningv1alpha1.TimeSyncSpec{Servers: []string{"ntp1.example.com"}}
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		_, err := NewNodePoolManager(c, scheme).CreateNodePool(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		np := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "test-bridge", Namespace: "default"}, np)).To(Succeed())
+		Expect(np.Spec.Config).To(Equal([]corev1.LocalObjectReference{
+			{Name: "test-bridge-timesync"},
+			{Name: "test-bridge-nodetuning"},
+		}))
+
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "test-bridge-nodetuning", Namespace: "default"}, cm)).To(Succeed())
+		Expect(cm.Labels).To(HaveKeyWithValue(common.LabelComponent, common.ComponentNodeTuning))
+		Expect(metav1.IsControlledBy(cm, cr)).To(BeTrue())
+	})
+
+	It("should only remove the node tuning configuration when spec.nodeTuning is removed", func() {
+		cr.Spec.TimeSync = &provisioningv1alpha1.TimeSyncSpec{Servers: []string{"ntp1.example.com"}}
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		npm := NewNodePoolManager(c, scheme)
+		_, err := npm.CreateNodePool(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		cr.Spec.NodeTuning = nil
+		_, err = npm.SyncNodePoolReplicas(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		np := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "test-bridge", Namespace: "default"}, np)).To(Succeed())
+		Expect(np.Spec.Config).To(Equal([]corev1.LocalObjectReference{{Name: "test-bridge-timesync"}}))
+
+		err = c.Get(ctx, types.NamespacedName{Name: "test-bridge-nodetuning", Namespace: "default"}, &corev1.ConfigMap{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(c.Get(ctx, types.NamespacedName{Name: "test-bridge-timesync", Namespace: "default"}, &corev1.ConfigMap{})).To(Succeed())
+	})
+})
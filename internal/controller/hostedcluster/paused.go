@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// hostedClusterPausedUntil returns whether reconciliation of the HostedCluster is paused at the given time and,
+// unless it is paused indefinitely, when it resumes.
+// spec.pausedUntil is interpreted like HyperShift does: a boolean pauses indefinitely when true, an RFC3339
+// timestamp pauses until then, and any other value does not pause.
+func hostedClusterPausedUntil(hc *hyperv1.HostedCluster, now time.Time) (bool, *time.Time) {
+	if hc.Spec.PausedUntil == nil {
+		return false, nil
+	}
+	if paused, err := strconv.ParseBool(*hc.Spec.PausedUntil); err == nil {
+		return paused, nil
+	}
+	until, err := time.Parse(time.RFC3339, *hc.Spec.PausedUntil)
+	if err != nil || !now.Before(until) {
+		return false, nil
+	}
+	return true, &until
+}
+
+// syncPaused reports a paused HostedCluster in the Paused condition and status.pausedUntil, so that bridges
+// left paused past the intended window stand out. The condition is only added once the HostedCluster is paused.
+// Returns the time after which a timed pause expires and must be re-evaluated, or 0.
+func syncPaused(cr *provisioningv1alpha1.DPFHCPBridge, hc *hyperv1.HostedCluster, now time.Time) time.Duration {
+	paused, until := hostedClusterPausedUntil(hc, now)
+
+	switch {
+	case paused && until != nil:
+		cr.Status.PausedUntil = &metav1.Time{Time: *until}
+		meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+			Type:               provisioningv1alpha1.Paused,
+			Status:             metav1.ConditionTrue,
+			Reason:             provisioningv1alpha1.ReasonPausedUntil,
+			Message:            fmt.Sprintf("Reconciliation of HostedCluster %s/%s is paused until %s", hc.Namespace, hc.Name, until.UTC().Format(time.RFC3339)),
+			ObservedGeneration: cr.Generation,
+		})
+		return until.Sub(now)
+	case paused:
+		cr.Status.PausedUntil = nil
+		meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+			Type:               provisioningv1alpha1.Paused,
+			Status:             metav1.ConditionTrue,
+			Reason:             provisioningv1alpha1.ReasonPausedIndefinitely,
+			Message:            fmt.Sprintf("Reconciliation of HostedCluster %s/%s is paused until spec.pausedUntil is removed", hc.Namespace, hc.Name),
+			ObservedGeneration: cr.Generation,
+		})
+	default:
+		cr.Status.PausedUntil = nil
+		if meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.Paused) != nil {
+			meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+				Type:               provisioningv1alpha1.Paused,
+				Status:             metav1.ConditionFalse,
+				Reason:             provisioningv1alpha1.ReasonResumed,
+				Message:            fmt.Sprintf("Reconciliation of HostedCluster %s/%s is not paused", hc.Namespace, hc.Name),
+				ObservedGeneration: cr.Generation,
+			})
+		}
+	}
+	return 0
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Paused HostedCluster", func() {
+	var (
+		cr  *provisioningv1alpha1.DPFHCPBridge
+		hc  *hyperv1.HostedCluster
+		now time.Time
+	)
+
+	BeforeEach(func() {
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", Generation: 2},
+		}
+		hc = &hyperv1.HostedCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default"},
+		}
+		now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	It("should not add the condition while the HostedCluster was never paused", func() {
+		Expect(syncPaused(cr, hc, now)).To(BeZero())
+
+		Expect(cr.Status.Conditions).To(BeEmpty())
+		Expect(cr.Status.PausedUntil).To(BeNil())
+	})
+
+	It("should report a timed pause with its expiry and requeue when it expires", func() {
+		hc.Spec.PausedUntil = ptr.To("2026-03-01T14:00:00Z")
+
+		Expect(syncPaused(cr, hc, now)).To(Equal(2 * time.Hour))
+
+		condition := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.Paused)
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonPausedUntil))
+		Expect(condition.Message).To(ContainSubstring("2026-03-01T14:00:00Z"))
+		Expect(cr.Status.PausedUntil.Time).To(BeTemporally("==", now.Add(2*time.Hour)))
+	})
+
+	It("should report an indefinite pause without expiry", func() {
+		hc.Spec.PausedUntil = ptr.To("true")
+
+		Expect(syncPaused(cr, hc, now)).To(BeZero())
+
+		condition := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.Paused)
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonPausedIndefinitely))
+		Expect(cr.Status.PausedUntil).To(BeNil())
+	})
+
+	It("should report the HostedCluster resumed once the pause expired", func() {
+		hc.Spec.PausedUntil = ptr.To("2026-03-01T14:00:00Z")
+		syncPaused(cr, hc, now)
+
+		Expect(syncPaused(cr, hc, now.Add(3*time.Hour))).To(BeZero())
+
+		condition := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.Paused)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonResumed))
+		Expect(cr.Status.PausedUntil).To(BeNil())
+	})
+
+	It("should not treat values HyperShift ignores as a pause", func() {
+		for _, value := range []string{"false", "tomorrow"} {
+			hc.Spec.PausedUntil = ptr.To(value)
+			syncPaused(cr, hc, now)
+			Expect(cr.Status.Conditions).To(BeEmpty(), value)
+		}
+	})
+})
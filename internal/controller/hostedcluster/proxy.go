@@ -0,0 +1,64 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	configv1 "github.com/openshift/api/config/v1"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// applyProxy sets the bridge's spec.proxy as the cluster-wide proxy of the HostedCluster, replacing
+// a proxy set by a version overlay; clearing spec.proxy removes it. The rest of the configuration is left alone.
+// Returns true if the HostedCluster configuration was changed.
+func applyProxy(hc *hyperv1.HostedCluster, cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	desired := getProxy(cr)
+
+	var current *configv1.ProxySpec
+	if hc.Spec.Configuration != nil {
+		current = hc.Spec.Configuration.Proxy
+	}
+	if equality.Semantic.DeepEqual(current, desired) {
+		return false
+	}
+
+	if hc.Spec.Configuration == nil {
+		hc.Spec.Configuration = &hyperv1.ClusterConfiguration{}
+	}
+	hc.Spec.Configuration.Proxy = desired
+	return true
+}
+
+// getProxy returns the HostedCluster proxy configuration of the bridge, nil if spec.proxy is not set
+func getProxy(cr *provisioningv1alpha1.DPFHCPBridge) *configv1.ProxySpec {
+	if cr.Spec.Proxy == nil {
+		return nil
+	}
+
+	proxy := &configv1.ProxySpec{
+		HTTPProxy:  cr.Spec.Proxy.HTTPProxy,
+		HTTPSProxy: cr.Spec.Proxy.HTTPSProxy,
+		NoProxy:    cr.Spec.Proxy.NoProxy,
+	}
+	// The ConfigMap is in the bridge namespace, which is also the namespace of the HostedCluster
+	if cr.Spec.Proxy.TrustedCA != nil {
+		proxy.TrustedCA = configv1.ConfigMapNameReference{Name: cr.Spec.Proxy.TrustedCA.Name}
+	}
+	return proxy
+}
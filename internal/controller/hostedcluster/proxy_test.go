@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Proxy", func() {
+	var (
+		hc *hyperv1.HostedCluster
+		cr *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	BeforeEach(func() {
+		hc = &hyperv1.HostedCluster{}
+		cr = &provisioningv1alpha1.DPFHCPBridge{}
+	})
+
+	It("should leave the configuration unset when no proxy is set", func() {
+		Expect(applyProxy(hc, cr)).To(BeFalse())
+		Expect(hc.Spec.Configuration).To(BeNil())
+	})
+
+	It("should render spec.proxy into the HostedCluster configuration", func() {
+		cr.Spec.Proxy = &provisioningv1alpha1.ProxySpec{
+			HTTPProxy:  "http://proxy.example.com:3128",
+			HTTPSProxy: "http://proxy.example.com:3128",
+			NoProxy:    ".cluster.local,10.0.0.0/8",
+			TrustedCA:  &corev1.LocalObjectReference{Name: "proxy-ca"},
+		}
+
+		Expect(applyProxy(hc, cr)).To(BeTrue())
+		Expect(hc.Spec.Configuration.Proxy).To(Equal(&configv1.ProxySpec{
+			HTTPProxy:  "http://proxy.example.com:3128",
+			HTTPSProxy: "http://proxy.example.com:3128",
+			NoProxy:    ".cluster.local,10.0.0.0/8",
+			TrustedCA:  configv1.ConfigMapNameReference{Name: "proxy-ca"},
+		}))
+
+		Expect(applyProxy(hc, cr)).To(BeFalse())
+	})
+
+	It("should remove the proxy but keep the rest of the configuration when spec.proxy is cleared", func() {
+		hc.Spec.Configuration = &hyperv1.ClusterConfiguration{
+			Proxy: &configv1.ProxySpec{HTTPProxy: "http://proxy.example.com:3128"},
+			FeatureGate: &configv1.FeatureGateSpec{
+				FeatureGateSelection: configv1.FeatureGateSelection{FeatureSet: configv1.TechPreviewNoUpgrade},
+			},
+		}
+
+		Expect(applyProxy(hc, cr)).To(BeTrue())
+		Expect(hc.Spec.Configuration.Proxy).To(BeNil())
+		Expect(hc.Spec.Configuration.FeatureGate).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,313 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/secrets"
+)
+
+const (
+	// PullSecretRolloutPollInterval is how often a pull secret rollout is checked for completion
+	PullSecretRolloutPollInterval = 30 * time.Second
+
+	// PullSecretRolloutSettleTime is how long HyperShift is given to start rolling out a rotated pull secret;
+	// a rollout is only complete once it has passed and no NodePool is updating its config
+	PullSecretRolloutSettleTime = 2 * time.Minute
+)
+
+// RotatePullSecret copies the pull secret referenced by the DPFHCPBridge under a new name once its data
+// no longer matches the copy the HostedCluster references, and records the new copy in status.pullSecretRollout
+// for RolloutPullSecret to switch the HostedCluster to.
+//
+// Copies are never updated in place: HyperShift only rolls out a pull secret to the nodes when the
+// HostedCluster references another Secret, so the content of a referenced copy must not change.
+// With SharedPullSecrets, the new copy is the shared copy of the new data.
+// The status changes are persisted by the caller.
+func (sm *SecretManager) RotatePullSecret(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) error {
+	log := logf.FromContext(ctx)
+
+	current := &corev1.Secret{}
+	if err := sm.Get(ctx, types.NamespacedName{Name: cr.PullSecretCopyName(), Namespace: cr.Namespace}, current); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Not copied yet, SyncSecrets creates it with the current data
+			return nil
+		}
+		return fmt.Errorf("failed to get pull secret copy: %w", err)
+	}
+	if !metav1.IsControlledBy(current, cr) && !referencesSharedPullSecret(current, cr) {
+		return nil
+	}
+
+	source, err := sm.Backend.Fetch(ctx, cr, cr.Spec.PullSecretRef.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get pull-secret %s/%s: %w", cr.Namespace, cr.Spec.PullSecretRef.Name, err)
+	}
+	dataHash := secretDataHash(source.Data)
+	if dataHash == secretDataHash(current.Data) {
+		return nil
+	}
+
+	var rotated *corev1.Secret
+	if sm.SharedPullSecrets {
+		rotated, _, err = sm.ensureSharedPullSecret(ctx, cr, source, dataHash)
+	} else {
+		rotated, err = sm.createRotatedPullSecret(ctx, cr, source, dataHash)
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Info("Pull secret changed, rolling out a new copy",
+		"previousCopy", current.Name,
+		"copy", rotated.Name,
+		"dataHash", dataHash)
+	cr.Status.PullSecretRollout = &provisioningv1alpha1.PullSecretRolloutStatus{
+		SecretName: rotated.Name,
+		DataHash:   dataHash,
+	}
+	recordSecretCopy(cr, secretCopyStatus(rotated, source.Name))
+	if sm.Recorder != nil {
+		sm.Recorder.Eventf(cr, corev1.EventTypeNormal, "PullSecretRotated",
+			"Pull secret %s changed (data %s), rolling out copy %s to the hosted cluster",
+			source.Name, dataHash, rotated.Name)
+	}
+	return nil
+}
+
+// rotatedPullSecretName returns the name of the copy of a rotated pull secret, derived from its data
+func rotatedPullSecretName(cr *provisioningv1alpha1.DPFHCPBridge, dataHash string) string {
+	return fmt.Sprintf("%s-pull-secret-%s", cr.Name, strings.TrimPrefix(dataHash, "sha256:")[:10])
+}
+
+// createRotatedPullSecret creates the copy of the rotated pull secret owned by the DPFHCPBridge
+func (sm *SecretManager) createRotatedPullSecret(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge,
+	source *secrets.SourceSecret, dataHash string) (*corev1.Secret, error) {
+	rotated := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rotatedPullSecretName(cr, dataHash),
+			Namespace: cr.Namespace,
+			Labels:    common.ComponentOwnerLabels(cr, common.ComponentHostedClusterSecrets),
+			Annotations: map[string]string{
+				AnnotationSourceResourceVersion: source.Version,
+				AnnotationLastSecretSync:        time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: source.Data,
+	}
+	if err := controllerutil.SetControllerReference(cr, rotated, sm.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set owner reference on rotated pull secret: %w", err)
+	}
+
+	err := sm.Create(ctx, rotated)
+	if apierrors.IsAlreadyExists(err) {
+		// Created by an earlier reconcile whose status update was lost
+		if err := sm.Get(ctx, client.ObjectKeyFromObject(rotated), rotated); err != nil {
+			return nil, fmt.Errorf("failed to get rotated pull secret: %w", err)
+		}
+		if !metav1.IsControlledBy(rotated, cr) || secretDataHash(rotated.Data) != dataHash {
+			return nil, fmt.Errorf("pull-secret %s exists in %s but is not the rotated copy of this DPFHCPBridge",
+				rotated.Name, cr.Namespace)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to create rotated pull secret: %w", err)
+	}
+
+	return rotated, nil
+}
+
+// RolloutPullSecret switches the HostedCluster to the pull secret copy recorded in status.pullSecretRollout
+// and tracks the rollout HyperShift starts for it: the pull secret of the hosted cluster is updated right
+// away, while the NodePools roll out their nodes with the new config. The rollout is complete once no
+// NodePool of the bridge is updating its config after PullSecretRolloutSettleTime; the superseded copies
+// are deleted then. Progress is reported in the PullSecretRolledOut condition.
+//
+// Rotated credentials must reach the nodes before the old ones are revoked, so the switch is neither
+// rate limited nor deferred by blackout windows.
+// A RequeueAfter result does not indicate that reconciliation should stop.
+func (hm *HostedClusterManager) RolloutPullSecret(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	rollout := cr.Status.PullSecretRollout
+	if rollout == nil || rollout.CompletionTime != nil || cr.Status.HostedClusterRef == nil {
+		return ctrl.Result{}, nil
+	}
+
+	hc := &hyperv1.HostedCluster{}
+	if err := hm.Get(ctx, types.NamespacedName{Name: cr.Name, Namespace: cr.Namespace}, hc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get HostedCluster for pull secret rollout: %w", err)
+	}
+	if !metav1.IsControlledBy(hc, cr) || !hc.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	if err := verifyBackReference(hc, cr); err != nil {
+		return ctrl.Result{}, fmt.Errorf("hostedCluster ownership check failed: %w", err)
+	}
+
+	now := hm.clock()
+	if hc.Spec.PullSecret.Name != rollout.SecretName {
+		if ok, retryAfter := hm.Breaker.Allow(ctx); !ok {
+			log.V(1).Info("HyperShift circuit open, deferring pull secret rollout", "retryAfter", retryAfter)
+			return ctrl.Result{RequeueAfter: retryAfter}, nil
+		}
+
+		log.Info("Switching HostedCluster to the rotated pull secret",
+			"hostedCluster", hc.Name,
+			"pullSecret", rollout.SecretName,
+			"previousPullSecret", hc.Spec.PullSecret.Name)
+		hc.Spec.PullSecret.Name = rollout.SecretName
+		err := hm.Update(ctx, hc)
+		hm.Breaker.Record(ctx, err)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update HostedCluster pull secret: %w", err)
+		}
+
+		startTime := metav1.NewTime(now)
+		rollout.StartTime = &startTime
+		setPullSecretRolloutCondition(cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonPullSecretRollingOut,
+			fmt.Sprintf("Rolling out pull secret %s to the hosted cluster", rollout.SecretName))
+		return ctrl.Result{RequeueAfter: PullSecretRolloutPollInterval}, nil
+	}
+	if rollout.StartTime == nil {
+		// The HostedCluster was switched by a reconcile whose status update was lost
+		startTime := metav1.NewTime(now)
+		rollout.StartTime = &startTime
+	}
+
+	updating, err := hm.nodePoolsUpdatingConfig(ctx, cr)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(updating) > 0 {
+		setPullSecretRolloutCondition(cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonPullSecretRollingOut,
+			fmt.Sprintf("NodePools %s are rolling out pull secret %s", strings.Join(updating, ", "), rollout.SecretName))
+		return ctrl.Result{RequeueAfter: PullSecretRolloutPollInterval}, nil
+	}
+	if settle := PullSecretRolloutSettleTime - now.Sub(rollout.StartTime.Time); settle > 0 {
+		setPullSecretRolloutCondition(cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonPullSecretRollingOut,
+			fmt.Sprintf("Rolling out pull secret %s to the hosted cluster", rollout.SecretName))
+		return ctrl.Result{RequeueAfter: min(settle, PullSecretRolloutPollInterval)}, nil
+	}
+
+	if err := hm.deleteSupersededPullSecrets(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+	completionTime := metav1.NewTime(now)
+	rollout.CompletionTime = &completionTime
+	log.Info("Pull secret rolled out", "pullSecret", rollout.SecretName,
+		"duration", now.Sub(rollout.StartTime.Time).Round(time.Second))
+	setPullSecretRolloutCondition(cr, metav1.ConditionTrue, provisioningv1alpha1.ReasonPullSecretRolledOut,
+		fmt.Sprintf("The nodes of the hosted cluster run with pull secret %s", rollout.SecretName))
+	return ctrl.Result{}, nil
+}
+
+// nodePoolsUpdatingConfig returns the sorted names of the NodePools of the bridge HyperShift is rolling
+// out a new config to
+func (hm *HostedClusterManager) nodePoolsUpdatingConfig(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) ([]string, error) {
+	nodePools := &hyperv1.NodePoolList{}
+	if err := hm.List(ctx, nodePools, client.InNamespace(cr.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list NodePools: %w", err)
+	}
+
+	var updating []string
+	for i := range nodePools.Items {
+		np := &nodePools.Items[i]
+		if !metav1.IsControlledBy(np, cr) {
+			continue
+		}
+		for _, condition := range np.Status.Conditions {
+			if condition.Type == hyperv1.NodePoolUpdatingConfigConditionType && condition.Status == corev1.ConditionTrue {
+				updating = append(updating, np.Name)
+			}
+		}
+	}
+	sort.Strings(updating)
+	return updating, nil
+}
+
+// deleteSupersededPullSecrets deletes the pull secret copies of the bridge other than the one the
+// HostedCluster references, releases the shared copies it no longer references, and drops them from
+// status.secretCopies
+func (hm *HostedClusterManager) deleteSupersededPullSecrets(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) error {
+	copies := &corev1.SecretList{}
+	if err := hm.List(ctx, copies, client.InNamespace(cr.Namespace),
+		client.MatchingLabels(common.ComponentOwnerLabels(cr, common.ComponentHostedClusterSecrets))); err != nil {
+		return fmt.Errorf("failed to list pull secret copies: %w", err)
+	}
+
+	current := cr.PullSecretCopyName()
+	for i := range copies.Items {
+		copied := &copies.Items[i]
+		if copied.Type != corev1.SecretTypeDockerConfigJson || copied.Name == current || !metav1.IsControlledBy(copied, cr) {
+			continue
+		}
+		if err := hm.Delete(ctx, copied); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete superseded pull secret %s: %w", copied.Name, err)
+		}
+		logf.FromContext(ctx).Info("Deleted superseded pull secret copy", "secret", copied.Name)
+		dropSecretCopy(cr, copied.Name)
+	}
+
+	released, err := releaseSharedPullSecrets(ctx, hm.Client, cr, current)
+	for _, name := range released {
+		dropSecretCopy(cr, name)
+	}
+	return err
+}
+
+// dropSecretCopy removes a deleted Secret copy from status.secretCopies
+func dropSecretCopy(cr *provisioningv1alpha1.DPFHCPBridge, name string) {
+	for i := range cr.Status.SecretCopies {
+		if cr.Status.SecretCopies[i].Name == name {
+			cr.Status.SecretCopies = append(cr.Status.SecretCopies[:i], cr.Status.SecretCopies[i+1:]...)
+			return
+		}
+	}
+}
+
+// setPullSecretRolloutCondition sets the PullSecretRolledOut condition
+func setPullSecretRolloutCondition(cr *provisioningv1alpha1.DPFHCPBridge, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+		Type:               provisioningv1alpha1.PullSecretRolledOut,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: cr.Generation,
+	})
+}
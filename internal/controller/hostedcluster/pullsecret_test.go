@@ -0,0 +1,227 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Pull secret rotation", func() {
+	var (
+		ctx      context.Context
+		scheme   *runtime.Scheme
+		c        client.Client
+		cr       *provisioningv1alpha1.DPFHCPBridge
+		pull     *corev1.Secret
+		sm       *SecretManager
+		hm       *HostedClusterManager
+		recorder *record.FakeRecorder
+		now      time.Time
+	)
+
+	getSecret := func(name string) (*corev1.Secret, error) {
+		secret := &corev1.Secret{}
+		err := c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, secret)
+		return secret, err
+	}
+
+	currentHC := func() *hyperv1.HostedCluster {
+		hc := &hyperv1.HostedCluster{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge", Namespace: "default"}, hc)).To(Succeed())
+		return hc
+	}
+
+	rotate := func() {
+		pull.Data = map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"auth":"bmV3"}}}`)}
+		Expect(c.Update(ctx, pull)).To(Succeed())
+		Expect(sm.RotatePullSecret(ctx, cr)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+		recorder = record.NewFakeRecorder(10)
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", UID: "bridge-uid"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				PullSecretRef:   corev1.LocalObjectReference{Name: "pull"},
+				SSHKeySecretRef: corev1.LocalObjectReference{Name: "ssh"},
+			},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				HostedClusterRef: &corev1.ObjectReference{Name: "test-bridge", Namespace: "default"},
+			},
+		}
+		pull = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "pull", Namespace: "default"},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"auth":"b2xk"}}}`)},
+		}
+		ssh := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ssh", Namespace: "default"},
+			Data:       map[string][]byte{"id_rsa.pub": []byte("ssh-ed25519 AAAA")},
+		}
+
+		hc := &hyperv1.HostedCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default"},
+			Spec: hyperv1.HostedClusterSpec{
+				PullSecret: corev1.LocalObjectReference{Name: "test-bridge-pull-secret"},
+			},
+		}
+		hc.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(cr, provisioningv1alpha1.GroupVersion.WithKind("DPFHCPBridge"))}
+		setBackReference(hc, cr)
+		np := &hyperv1.NodePool{ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default"}}
+		np.OwnerReferences = hc.OwnerReferences
+
+		c = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(cr, pull, ssh, hc, np).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+		sm = NewSecretManager(c, scheme)
+		hm = NewHostedClusterManager(c, scheme)
+		now = time.Now().Truncate(time.Second)
+		hm.now = func() time.Time { return now }
+
+		// The HostedCluster was created with a copy of the original pull secret
+		_, err := sm.SyncSecrets(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(pull), pull)).To(Succeed())
+		sm.Recorder = recorder
+	})
+
+	It("should not rotate an unchanged pull secret", func() {
+		Expect(sm.RotatePullSecret(ctx, cr)).To(Succeed())
+		Expect(cr.Status.PullSecretRollout).To(BeNil())
+		Expect(cr.PullSecretCopyName()).To(Equal("test-bridge-pull-secret"))
+
+		result, err := hm.RolloutPullSecret(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.PullSecretRolledOut)).To(BeNil())
+	})
+
+	It("should copy a changed pull secret under a new name without touching the referenced copy", func() {
+		rotate()
+
+		rollout := cr.Status.PullSecretRollout
+		Expect(rollout).NotTo(BeNil())
+		Expect(rollout.SecretName).To(Equal(rotatedPullSecretName(cr, secretDataHash(pull.Data))))
+		Expect(rollout.DataHash).To(Equal(secretDataHash(pull.Data)))
+		Expect(cr.PullSecretCopyName()).To(Equal(rollout.SecretName))
+
+		rotated, err := getSecret(rollout.SecretName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rotated.Data).To(Equal(pull.Data))
+		Expect(rotated.Type).To(Equal(corev1.SecretTypeDockerConfigJson))
+		Expect(metav1.IsControlledBy(rotated, cr)).To(BeTrue())
+
+		original, err := getSecret("test-bridge-pull-secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(original.Data[corev1.DockerConfigJsonKey]).To(ContainSubstring("b2xk"))
+
+		Expect(cr.Status.SecretCopies).To(ContainElement(HaveField("Name", rollout.SecretName)))
+		Expect(recorder.Events).To(Receive(ContainSubstring("PullSecretRotated")))
+
+		// Rotating again with a lost status update reuses the copy
+		cr.Status.PullSecretRollout = nil
+		Expect(sm.RotatePullSecret(ctx, cr)).To(Succeed())
+		Expect(cr.Status.PullSecretRollout.SecretName).To(Equal(rotated.Name))
+	})
+
+	It("should switch the HostedCluster and complete once the NodePools rolled out", func() {
+		rotate()
+		rotatedName := cr.Status.PullSecretRollout.SecretName
+
+		result, err := hm.RolloutPullSecret(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(PullSecretRolloutPollInterval))
+		Expect(currentHC().Spec.PullSecret.Name).To(Equal(rotatedName))
+		Expect(cr.Status.PullSecretRollout.StartTime.Time).To(Equal(now))
+		condition := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.PullSecretRolledOut)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonPullSecretRollingOut))
+
+		// HyperShift replaces the nodes of the NodePool
+		np := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge", Namespace: "default"}, np)).To(Succeed())
+		np.Status.Conditions = []hyperv1.NodePoolCondition{{
+			Type:   hyperv1.NodePoolUpdatingConfigConditionType,
+			Status: corev1.ConditionTrue,
+		}}
+		Expect(c.Update(ctx, np)).To(Succeed())
+
+		now = now.Add(PullSecretRolloutSettleTime)
+		result, err = hm.RolloutPullSecret(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(PullSecretRolloutPollInterval))
+		condition = meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.PullSecretRolledOut)
+		Expect(condition.Message).To(ContainSubstring("NodePools test-bridge are rolling out"))
+		Expect(cr.Status.PullSecretRollout.CompletionTime).To(BeNil())
+
+		np.Status.Conditions = nil
+		Expect(c.Update(ctx, np)).To(Succeed())
+		result, err = hm.RolloutPullSecret(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(cr.Status.PullSecretRollout.CompletionTime.Time).To(Equal(now))
+		condition = meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.PullSecretRolledOut)
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonPullSecretRolledOut))
+
+		// The superseded copy is deleted, the SSH key and ETCD encryption key are kept
+		_, err = getSecret("test-bridge-pull-secret")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		for _, name := range []string{rotatedName, "test-bridge-ssh-key", "test-bridge-etcd-encryption-key"} {
+			_, err = getSecret(name)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(cr.Status.SecretCopies).NotTo(ContainElement(HaveField("Name", "test-bridge-pull-secret")))
+	})
+
+	It("should wait for HyperShift to start the rollout before completing it", func() {
+		rotate()
+		_, err := hm.RolloutPullSecret(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		now = now.Add(time.Minute + 45*time.Second)
+		result, err := hm.RolloutPullSecret(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(15 * time.Second))
+		Expect(cr.Status.PullSecretRollout.CompletionTime).To(BeNil())
+		_, err = getSecret("test-bridge-pull-secret")
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
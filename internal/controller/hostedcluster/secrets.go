@@ -19,13 +19,20 @@ package hostedcluster
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"time"
 
+	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -33,62 +40,138 @@ import (
 
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/secrets"
+)
+
+const (
+	// AnnotationSourceResourceVersion records on a copied Secret the resourceVersion of its source at copy time
+	AnnotationSourceResourceVersion = "dpf-hcp-bridge-operator/source-resource-version"
+
+	// AnnotationLastSecretSync records on a copied Secret when its data was copied from the source
+	AnnotationLastSecretSync = "dpf-hcp-bridge-operator/last-sync"
 )
 
 // SecretManager handles secret copying and ETCD key generation for HostedCluster
 type SecretManager struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Recorder, if set, receives an event for every Secret copied for the HostedCluster
+	Recorder record.EventRecorder
+
+	// Backend sources the pull secret and SSH key; defaults to Secrets in the bridge namespace
+	Backend secrets.Backend
+
+	// SharedPullSecrets, if set, makes the bridges of a namespace whose pull secret has the same data
+	// reference a single shared copy of it instead of one copy each
+	SharedPullSecrets bool
 }
 
 // NewSecretManager creates a new SecretManager
 func NewSecretManager(c client.Client, scheme *runtime.Scheme) *SecretManager {
 	return &SecretManager{
-		Client: c,
-		Scheme: scheme,
+		Client:  c,
+		Scheme:  scheme,
+		Backend: secrets.NewClusterBackend(c),
 	}
 }
 
-// CopySecrets copies pull-secret and ssh-key within the same namespace as DPFHCPBridge
+// SyncSecrets copies the pull secret and SSH key within the DPFHCPBridge namespace and generates the ETCD
+// encryption key of the HostedCluster. The three Secrets are independent, so they are synced concurrently and a
+// bridge waits for the slowest API round trip instead of their sum; the first failure cancels the others.
+// The outcome is reported in the SecretsSynced condition, which is persisted when it changes. The source
+// resourceVersion, data hash and copy time of both copies are recorded in status.secretCopies; these status
+// changes are persisted by the caller. With SharedPullSecrets, a new bridge references the shared copy of its
+// pull secret, which is recorded in status.pullSecretRollout.
 // Returns ctrl.Result and error for reconciliation flow
-func (sm *SecretManager) CopySecrets(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+func (sm *SecretManager) SyncSecrets(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
-	// Copy pull-secret
-	pullSecretName := fmt.Sprintf("%s-pull-secret", cr.Name)
-	if err := sm.copyPullSecret(ctx, cr, pullSecretName); err != nil {
-		log.Error(err, "Failed to copy pull-secret")
-		return ctrl.Result{}, err
+	sharesPullSecret := sm.sharesPullSecret(cr)
+	pullSecretName := cr.PullSecretCopyName()
+	sshKeyName := fmt.Sprintf("%s-ssh-key", cr.Name)
+	etcdKeyName := fmt.Sprintf("%s-etcd-encryption-key", cr.Name)
+
+	// Each goroutine only reads cr; the copies are recorded in its status once all of them returned
+	var pullSecretCopy, sshKeyCopy secretCopy
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		if sharesPullSecret {
+			pullSecretCopy, err = sm.copySharedPullSecret(gctx, cr)
+			return err
+		}
+		pullSecretCopy, err = sm.copySecret(gctx, cr, "pull-secret", cr.Spec.PullSecretRef.Name, pullSecretName, corev1.SecretTypeDockerConfigJson)
+		return err
+	})
+	g.Go(func() (err error) {
+		sshKeyCopy, err = sm.copySecret(gctx, cr, "ssh-key", cr.Spec.SSHKeySecretRef.Name, sshKeyName, corev1.SecretTypeOpaque)
+		return err
+	})
+	g.Go(func() error {
+		return sm.generateETCDEncryptionKey(gctx, cr, etcdKeyName)
+	})
+	err := g.Wait()
+
+	// Copies that succeeded are recorded even if another sync failed, as they exist now
+	for _, copied := range []secretCopy{pullSecretCopy, sshKeyCopy} {
+		if copied.entry.Name == "" {
+			continue
+		}
+		recordSecretCopy(cr, copied.entry)
+		if copied.created {
+			sm.recordCopyEvent(cr, copied.entry)
+		}
+	}
+	if sharesPullSecret && pullSecretCopy.entry.Name != "" {
+		// The HostedCluster is created with the shared copy, there is nothing to roll out
+		now := metav1.Now()
+		cr.Status.PullSecretRollout = &provisioningv1alpha1.PullSecretRolloutStatus{
+			SecretName:     pullSecretCopy.entry.Name,
+			DataHash:       pullSecretCopy.entry.DataHash,
+			CompletionTime: &now,
+		}
+		pullSecretName = pullSecretCopy.entry.Name
 	}
 
-	// Copy ssh-key
-	sshKeyName := fmt.Sprintf("%s-ssh-key", cr.Name)
-	if err := sm.copySSHKey(ctx, cr, sshKeyName); err != nil {
-		log.Error(err, "Failed to copy ssh-key")
+	if err != nil {
+		log.Error(err, "Failed to sync secrets")
+		if condErr := sm.setCondition(ctx, cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonSecretsSyncFailed,
+			fmt.Sprintf("Failed to sync secrets: %v", err)); condErr != nil {
+			log.Error(condErr, "Failed to update condition")
+		}
 		return ctrl.Result{}, err
 	}
 
-	log.V(1).Info("Successfully copied secrets",
+	log.V(1).Info("Successfully synced secrets",
 		"pullSecret", pullSecretName,
 		"sshKey", sshKeyName,
+		"etcdEncryptionKey", etcdKeyName,
 		"namespace", cr.Namespace)
 
+	if err := sm.setCondition(ctx, cr, metav1.ConditionTrue, provisioningv1alpha1.ReasonSecretsSynced,
+		fmt.Sprintf("Pull secret, SSH key and ETCD encryption key exist in namespace %s", cr.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
 	return ctrl.Result{}, nil
 }
 
-// copyPullSecret copies the pull-secret within the same namespace with proper type and labels
-func (sm *SecretManager) copyPullSecret(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, targetName string) error {
-	log := logf.FromContext(ctx)
+// secretCopy is the outcome of copying a source Secret for the HostedCluster
+type secretCopy struct {
+	entry   provisioningv1alpha1.SecretCopyStatus
+	created bool
+}
 
-	// Get source pull-secret from DPFHCPBridge namespace
-	sourceSecret := &corev1.Secret{}
-	sourceKey := types.NamespacedName{
-		Name:      cr.Spec.PullSecretRef.Name,
-		Namespace: cr.Namespace,
-	}
+// copySecret copies the source Secret within the same namespace with the given type and proper labels.
+// kind names the Secret in logs and errors, e.g. pull-secret.
+func (sm *SecretManager) copySecret(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge,
+	kind, sourceName, targetName string, secretType corev1.SecretType) (secretCopy, error) {
+	log := logf.FromContext(ctx)
 
-	if err := sm.Get(ctx, sourceKey, sourceSecret); err != nil {
-		return fmt.Errorf("failed to get pull-secret %s/%s: %w", cr.Namespace, cr.Spec.PullSecretRef.Name, err)
+	// Get source secret from the secret backend
+	sourceSecret, err := sm.Backend.Fetch(ctx, cr, sourceName)
+	if err != nil {
+		return secretCopy{}, fmt.Errorf("failed to get %s %s/%s: %w", kind, cr.Namespace, sourceName, err)
 	}
 
 	// Check if target secret already exists with matching labels (idempotency)
@@ -97,20 +180,30 @@ func (sm *SecretManager) copyPullSecret(ctx context.Context, cr *provisioningv1a
 		Namespace: cr.Namespace,
 	}
 	existingSecret := &corev1.Secret{}
-	err := sm.Get(ctx, targetKey, existingSecret)
+	err = sm.Get(ctx, targetKey, existingSecret)
 	if err == nil {
-		// Secret exists, verify ownership via OwnerReference
-		if metav1.IsControlledBy(existingSecret, cr) {
-			log.V(1).Info("Pull-secret already exists and is owned by this DPFHCPBridge, reusing",
+		// Secret exists, verify ownership via OwnerReference, or the reference label of a shared copy
+		if metav1.IsControlledBy(existingSecret, cr) || referencesSharedPullSecret(existingSecret, cr) {
+			log.V(1).Info("Secret copy already exists and is owned by this DPFHCPBridge, reusing",
+				"kind", kind,
 				"secret", targetName,
 				"namespace", cr.Namespace)
-			return nil
+			return secretCopy{entry: secretCopyStatus(existingSecret, sourceName)}, nil
 		}
-		return fmt.Errorf("pull-secret %s exists in %s but is owned by different DPFHCPBridge", targetName, cr.Namespace)
+		// Adoption mode - take over the resource if nothing controls it yet
+		adopted, adoptErr := adoptIfOrphaned(ctx, sm.Client, sm.Scheme, cr, existingSecret)
+		if adoptErr != nil {
+			return secretCopy{}, adoptErr
+		}
+		if adopted {
+			return secretCopy{entry: secretCopyStatus(existingSecret, sourceName)}, nil
+		}
+
+		return secretCopy{}, fmt.Errorf("%s %s exists in %s but is owned by different DPFHCPBridge", kind, targetName, cr.Namespace)
 	}
 
 	if !apierrors.IsNotFound(err) {
-		return fmt.Errorf("failed to check existing pull-secret: %w", err)
+		return secretCopy{}, fmt.Errorf("failed to check existing %s: %w", kind, err)
 	}
 
 	// Create target secret with correct type
@@ -118,96 +211,93 @@ func (sm *SecretManager) copyPullSecret(ctx context.Context, cr *provisioningv1a
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      targetName,
 			Namespace: cr.Namespace,
+			Labels:    common.ComponentOwnerLabels(cr, common.ComponentHostedClusterSecrets),
+			Annotations: map[string]string{
+				AnnotationSourceResourceVersion: sourceSecret.Version,
+				AnnotationLastSecretSync:        time.Now().UTC().Format(time.RFC3339),
+			},
 		},
-		Type: corev1.SecretTypeDockerConfigJson,
+		Type: secretType,
 		Data: sourceSecret.Data,
 	}
 
 	// Set owner reference for automatic garbage collection
 	if err := controllerutil.SetControllerReference(cr, targetSecret, sm.Scheme); err != nil {
-		return fmt.Errorf("failed to set owner reference on pull-secret: %w", err)
+		return secretCopy{}, fmt.Errorf("failed to set owner reference on %s: %w", kind, err)
 	}
 
 	if err := sm.Create(ctx, targetSecret); err != nil {
-		return fmt.Errorf("failed to create pull-secret: %w", err)
+		return secretCopy{}, fmt.Errorf("failed to create %s: %w", kind, err)
 	}
 
-	log.Info("Created pull-secret",
+	log.Info("Created secret copy",
+		"kind", kind,
 		"secret", targetName,
-		"namespace", cr.Namespace)
+		"namespace", cr.Namespace,
+		"sourceResourceVersion", sourceSecret.Version)
 
-	return nil
+	return secretCopy{entry: secretCopyStatus(targetSecret, sourceSecret.Name), created: true}, nil
 }
 
-// copySSHKey copies the ssh-key within the same namespace with proper type and labels
-func (sm *SecretManager) copySSHKey(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, targetName string) error {
-	log := logf.FromContext(ctx)
-
-	// Get source ssh-key from DPFHCPBridge namespace
-	sourceSecret := &corev1.Secret{}
-	sourceKey := types.NamespacedName{
-		Name:      cr.Spec.SSHKeySecretRef.Name,
-		Namespace: cr.Namespace,
+// secretCopyStatus returns the provenance of a copied Secret.
+// Copies made before the provenance annotations were introduced are reported with their creation time.
+func secretCopyStatus(copied *corev1.Secret, sourceName string) provisioningv1alpha1.SecretCopyStatus {
+	entry := provisioningv1alpha1.SecretCopyStatus{
+		Name:                  copied.Name,
+		SourceName:            sourceName,
+		SourceResourceVersion: copied.Annotations[AnnotationSourceResourceVersion],
+		DataHash:              secretDataHash(copied.Data),
 	}
-
-	if err := sm.Get(ctx, sourceKey, sourceSecret); err != nil {
-		return fmt.Errorf("failed to get ssh-key %s/%s: %w", cr.Namespace, cr.Spec.SSHKeySecretRef.Name, err)
+	lastSync := copied.CreationTimestamp
+	if synced, err := time.Parse(time.RFC3339, copied.Annotations[AnnotationLastSecretSync]); err == nil {
+		lastSync = metav1.NewTime(synced)
 	}
-
-	// Check if target secret already exists with matching labels (idempotency)
-	targetKey := types.NamespacedName{
-		Name:      targetName,
-		Namespace: cr.Namespace,
-	}
-	existingSecret := &corev1.Secret{}
-	err := sm.Get(ctx, targetKey, existingSecret)
-	if err == nil {
-		// Secret exists, verify ownership via OwnerReference
-		if metav1.IsControlledBy(existingSecret, cr) {
-			log.V(1).Info("SSH key already exists and is owned by this DPFHCPBridge, reusing",
-				"secret", targetName,
-				"namespace", cr.Namespace)
-			return nil
-		}
-		return fmt.Errorf("ssh-key %s exists in %s but is owned by different DPFHCPBridge", targetName, cr.Namespace)
+	if !lastSync.IsZero() {
+		entry.LastSyncTime = &lastSync
 	}
+	return entry
+}
 
-	if !apierrors.IsNotFound(err) {
-		return fmt.Errorf("failed to check existing ssh-key: %w", err)
+// recordSecretCopy records the provenance of a copied Secret in status.secretCopies
+func recordSecretCopy(cr *provisioningv1alpha1.DPFHCPBridge, entry provisioningv1alpha1.SecretCopyStatus) {
+	for i := range cr.Status.SecretCopies {
+		if cr.Status.SecretCopies[i].Name == entry.Name {
+			cr.Status.SecretCopies[i] = entry
+			return
+		}
 	}
+	cr.Status.SecretCopies = append(cr.Status.SecretCopies, entry)
+}
 
-	// Create target secret with correct type
-	targetSecret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      targetName,
-			Namespace: cr.Namespace,
-		},
-		Type: corev1.SecretTypeOpaque,
-		Data: sourceSecret.Data,
+// recordCopyEvent emits an event for a Secret copied for the HostedCluster
+func (sm *SecretManager) recordCopyEvent(cr *provisioningv1alpha1.DPFHCPBridge, entry provisioningv1alpha1.SecretCopyStatus) {
+	if sm.Recorder == nil {
+		return
 	}
+	sm.Recorder.Eventf(cr, corev1.EventTypeNormal, "SecretCopied",
+		"Copied Secret %s (resourceVersion %s, data %s) to %s",
+		entry.SourceName, entry.SourceResourceVersion, entry.DataHash, entry.Name)
+}
 
-	// Set owner reference for automatic garbage collection
-	if err := controllerutil.SetControllerReference(cr, targetSecret, sm.Scheme); err != nil {
-		return fmt.Errorf("failed to set owner reference on ssh-key: %w", err)
+// secretDataHash returns the SHA-256 hash of Secret data, computed over the sorted keys and their values
+func secretDataHash(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
 	}
+	sort.Strings(keys)
 
-	if err := sm.Create(ctx, targetSecret); err != nil {
-		return fmt.Errorf("failed to create ssh-key: %w", err)
+	hasher := sha256.New()
+	for _, key := range keys {
+		fmt.Fprintf(hasher, "%s\x00%s\x00", key, data[key])
 	}
-
-	log.Info("Created ssh-key",
-		"secret", targetName,
-		"namespace", cr.Namespace)
-
-	return nil
+	return "sha256:" + hex.EncodeToString(hasher.Sum(nil))
 }
 
-// GenerateETCDEncryptionKey generates a 32-byte random key for ETCD encryption
-// Returns ctrl.Result and error for reconciliation flow
-func (sm *SecretManager) GenerateETCDEncryptionKey(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+// generateETCDEncryptionKey generates a 32-byte random key for ETCD encryption into the secretName Secret
+func (sm *SecretManager) generateETCDEncryptionKey(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, secretName string) error {
 	log := logf.FromContext(ctx)
 
-	secretName := fmt.Sprintf("%s-etcd-encryption-key", cr.Name)
 	targetKey := types.NamespacedName{
 		Name:      secretName,
 		Namespace: cr.Namespace,
@@ -222,19 +312,28 @@ func (sm *SecretManager) GenerateETCDEncryptionKey(ctx context.Context, cr *prov
 			log.V(1).Info("ETCD encryption key already exists and is owned by this DPFHCPBridge, reusing",
 				"secret", secretName,
 				"namespace", cr.Namespace)
-			return ctrl.Result{}, nil
+			return nil
 		}
-		return ctrl.Result{}, fmt.Errorf("etcd encryption key %s exists in %s but is owned by different DPFHCPBridge", secretName, cr.Namespace)
+		// Adoption mode - take over the resource if nothing controls it yet
+		adopted, adoptErr := adoptIfOrphaned(ctx, sm.Client, sm.Scheme, cr, existingSecret)
+		if adoptErr != nil {
+			return adoptErr
+		}
+		if adopted {
+			return nil
+		}
+
+		return fmt.Errorf("etcd encryption key %s exists in %s but is owned by different DPFHCPBridge", secretName, cr.Namespace)
 	}
 
 	if !apierrors.IsNotFound(err) {
-		return ctrl.Result{}, fmt.Errorf("failed to check existing etcd encryption key: %w", err)
+		return fmt.Errorf("failed to check existing etcd encryption key: %w", err)
 	}
 
 	// Generate 32 random bytes for ETCD encryption
 	keyBytes := make([]byte, 32)
 	if _, err := rand.Read(keyBytes); err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to generate random encryption key: %w", err)
+		return fmt.Errorf("failed to generate random encryption key: %w", err)
 	}
 
 	// Create secret with proper type
@@ -242,6 +341,7 @@ func (sm *SecretManager) GenerateETCDEncryptionKey(ctx context.Context, cr *prov
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      secretName,
 			Namespace: cr.Namespace,
+			Labels:    common.ComponentOwnerLabels(cr, common.ComponentHostedClusterSecrets),
 		},
 		Type: corev1.SecretTypeOpaque,
 		Data: map[string][]byte{
@@ -251,11 +351,11 @@ func (sm *SecretManager) GenerateETCDEncryptionKey(ctx context.Context, cr *prov
 
 	// Set owner reference for automatic garbage collection
 	if err := controllerutil.SetControllerReference(cr, secret, sm.Scheme); err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to set owner reference on etcd encryption key: %w", err)
+		return fmt.Errorf("failed to set owner reference on etcd encryption key: %w", err)
 	}
 
 	if err := sm.Create(ctx, secret); err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to create etcd encryption key secret: %w", err)
+		return fmt.Errorf("failed to create etcd encryption key secret: %w", err)
 	}
 
 	log.Info("Generated ETCD encryption key",
@@ -263,5 +363,32 @@ func (sm *SecretManager) GenerateETCDEncryptionKey(ctx context.Context, cr *prov
 		"namespace", cr.Namespace,
 		"keyLength", len(keyBytes))
 
-	return ctrl.Result{}, nil
+	return nil
+}
+
+// setCondition sets the SecretsSynced condition, emitting an event and persisting the status if it changed
+func (sm *SecretManager) setCondition(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, status metav1.ConditionStatus, reason, message string) error {
+	condition := metav1.Condition{
+		Type:               provisioningv1alpha1.SecretsSynced,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: cr.Generation,
+	}
+	if !meta.SetStatusCondition(&cr.Status.Conditions, condition) {
+		return nil
+	}
+
+	if sm.Recorder != nil {
+		eventType := corev1.EventTypeNormal
+		if status == metav1.ConditionFalse {
+			eventType = corev1.EventTypeWarning
+		}
+		sm.Recorder.Event(cr, eventType, reason, message)
+	}
+
+	if err := sm.Status().Update(ctx, cr); err != nil {
+		return fmt.Errorf("failed to update SecretsSynced condition: %w", err)
+	}
+	return nil
 }
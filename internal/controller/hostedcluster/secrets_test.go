@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Secret sync", func() {
+	var (
+		ctx      context.Context
+		scheme   *runtime.Scheme
+		cr       *provisioningv1alpha1.DPFHCPBridge
+		pull     *corev1.Secret
+		ssh      *corev1.Secret
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		recorder = record.NewFakeRecorder(10)
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", UID: "bridge-uid"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				PullSecretRef:   corev1.LocalObjectReference{Name: "pull"},
+				SSHKeySecretRef: corev1.LocalObjectReference{Name: "ssh"},
+			},
+		}
+		pull = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "pull", Namespace: "default"},
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte("{}")},
+		}
+		ssh = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ssh", Namespace: "default"},
+			Data:       map[string][]byte{"id_rsa.pub": []byte("ssh-ed25519 AAAA")},
+		}
+	})
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(append([]client.Object{cr}, objs...)...).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+	}
+
+	It("should record the source version, data hash and copy time of each copy", func() {
+		c := newClient(pull, ssh)
+		sm := NewSecretManager(c, scheme)
+		sm.Recorder = recorder
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(pull), pull)).To(Succeed())
+
+		before := time.Now().Add(-time.Second)
+		_, err := sm.SyncSecrets(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(cr.Status.SecretCopies).To(HaveLen(2))
+		entry := cr.Status.SecretCopies[0]
+		Expect(entry.Name).To(Equal("test-bridge-pull-secret"))
+		Expect(entry.SourceName).To(Equal("pull"))
+		Expect(entry.SourceResourceVersion).To(Equal(pull.ResourceVersion))
+		Expect(entry.DataHash).To(Equal(secretDataHash(pull.Data)))
+		Expect(entry.LastSyncTime.Time).To(BeTemporally(">=", before))
+		Expect(cr.Status.SecretCopies[1].SourceName).To(Equal("ssh"))
+
+		Expect(recorder.Events).To(Receive(ContainSubstring("SecretCopied")))
+		Expect(recorder.Events).To(Receive(ContainSubstring("SecretCopied")))
+	})
+
+	It("should restore the audit trail from the copies without copying again", func() {
+		c := newClient(pull, ssh)
+		sm := NewSecretManager(c, scheme)
+		_, err := sm.SyncSecrets(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		recorded := cr.Status.SecretCopies
+
+		cr.Status.SecretCopies = nil
+		sm.Recorder = recorder
+		_, err = sm.SyncSecrets(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cr.Status.SecretCopies).To(Equal(recorded))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should generate the ETCD encryption key and report the secrets as synced", func() {
+		c := newClient(pull, ssh)
+		sm := NewSecretManager(c, scheme)
+		_, err := sm.SyncSecrets(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		etcdKey := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge-etcd-encryption-key", Namespace: "default"}, etcdKey)).To(Succeed())
+		Expect(etcdKey.Data[hyperv1.AESCBCKeySecretKey]).To(HaveLen(32))
+		Expect(metav1.IsControlledBy(etcdKey, cr)).To(BeTrue())
+
+		condition := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.SecretsSynced)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonSecretsSynced))
+
+		persisted := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(cr), persisted)).To(Succeed())
+		Expect(meta.IsStatusConditionTrue(persisted.Status.Conditions, provisioningv1alpha1.SecretsSynced)).To(BeTrue())
+	})
+
+	It("should report secrets that cannot be synced in the condition", func() {
+		c := newClient(pull)
+		sm := NewSecretManager(c, scheme)
+		sm.Recorder = recorder
+		_, err := sm.SyncSecrets(ctx, cr)
+		Expect(err).To(MatchError(ContainSubstring("failed to get ssh-key default/ssh")))
+
+		condition := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.SecretsSynced)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(provisioningv1alpha1.ReasonSecretsSyncFailed))
+		Expect(condition.Message).To(ContainSubstring("ssh-key"))
+	})
+
+	It("should hash secret data independently of key order", func() {
+		Expect(secretDataHash(map[string][]byte{"a": []byte("1"), "b": []byte("2")})).
+			To(Equal(secretDataHash(map[string][]byte{"b": []byte("2"), "a": []byte("1")})))
+		Expect(secretDataHash(map[string][]byte{"a": []byte("1")})).
+			NotTo(Equal(secretDataHash(map[string][]byte{"a": []byte("2")})))
+		Expect(secretDataHash(nil)).To(HavePrefix("sha256:"))
+	})
+})
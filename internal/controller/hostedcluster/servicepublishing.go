@@ -17,9 +17,24 @@ limitations under the License.
 package hostedcluster
 
 import (
+	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/overlays"
+)
+
+const (
+	// OIDCPublishingAuto publishes the OIDC service as set by the version overlay of the release,
+	// falling back to the default of the publishing mode (NodePort only)
+	OIDCPublishingAuto = "auto"
+	// OIDCPublishingAlways publishes the OIDC service in both publishing modes
+	OIDCPublishingAlways = "always"
+	// OIDCPublishingNever never publishes the OIDC service
+	OIDCPublishingNever = "never"
 )
 
 // BuildServicePublishingStrategy builds the service publishing strategy configuration
@@ -93,3 +108,158 @@ func BuildServicePublishingStrategy(exposeThroughLoadBalancer bool, nodeAddress
 
 	return result
 }
+
+// setOIDCPublishing adds or removes the OIDC service publishing strategy. An added OIDC service is
+// published like the OAuth server: through a Route in LoadBalancer mode and on the node address in
+// NodePort mode. Nothing is added if there is no OAuth server strategy to follow.
+func setOIDCPublishing(services []hyperv1.ServicePublishingStrategyMapping, publish bool) []hyperv1.ServicePublishingStrategyMapping {
+	var oauth *hyperv1.ServicePublishingStrategy
+	result := make([]hyperv1.ServicePublishingStrategyMapping, 0, len(services)+1)
+	for _, mapping := range services {
+		switch mapping.Service {
+		case hyperv1.OIDC:
+			if !publish {
+				continue
+			}
+			return services
+		case hyperv1.OAuthServer:
+			oauth = &mapping.ServicePublishingStrategy
+		}
+		result = append(result, mapping)
+	}
+
+	if publish && oauth != nil {
+		result = append(result, hyperv1.ServicePublishingStrategyMapping{
+			Service:                   hyperv1.OIDC,
+			ServicePublishingStrategy: *oauth.DeepCopy(),
+		})
+	}
+	return result
+}
+
+// requiredServices must be published by every HostedCluster
+var requiredServices = []hyperv1.ServiceType{hyperv1.APIServer, hyperv1.OAuthServer, hyperv1.Konnectivity, hyperv1.Ignition}
+
+// serviceRule describes which publishing strategies HyperShift accepts for a service
+type serviceRule struct {
+	// types are the accepted publishing strategy types
+	types []hyperv1.PublishingStrategyType
+
+	// removedIn is the first OCP minor version whose HostedClusters no longer use the service; empty if in use
+	removedIn string
+}
+
+// serviceRules are the services HyperShift knows about. The OVN southbound database is no longer
+// published since OVN interconnect, so the service is dropped for those releases.
+var serviceRules = map[hyperv1.ServiceType]serviceRule{
+	hyperv1.APIServer: {
+		types: []hyperv1.PublishingStrategyType{hyperv1.LoadBalancer, hyperv1.NodePort, hyperv1.Route},
+	},
+	hyperv1.OAuthServer: {
+		types: []hyperv1.PublishingStrategyType{hyperv1.LoadBalancer, hyperv1.NodePort, hyperv1.Route},
+	},
+	hyperv1.Konnectivity: {
+		types: []hyperv1.PublishingStrategyType{hyperv1.LoadBalancer, hyperv1.NodePort, hyperv1.Route},
+	},
+	hyperv1.Ignition: {
+		types: []hyperv1.PublishingStrategyType{hyperv1.LoadBalancer, hyperv1.NodePort, hyperv1.Route},
+	},
+	hyperv1.OIDC: {
+		types: []hyperv1.PublishingStrategyType{hyperv1.NodePort, hyperv1.Route, hyperv1.None, hyperv1.S3},
+	},
+	hyperv1.OVNSbDb: {
+		types:     []hyperv1.PublishingStrategyType{hyperv1.NodePort, hyperv1.Route},
+		removedIn: "4.14",
+	},
+}
+
+// validateServicePublishing checks the service publishing strategies of a new HostedCluster against
+// the OCP version of its release, so that a bad version overlay is reported with the offending
+// service rather than by HostedCluster admission. Services the release no longer uses are dropped
+// and returned as removed; anything else HyperShift would reject is returned as an error. Version
+// dependent rules are skipped if the version is unknown.
+func validateServicePublishing(services []hyperv1.ServicePublishingStrategyMapping, version string) (
+	[]hyperv1.ServicePublishingStrategyMapping, []hyperv1.ServiceType, error) {
+	var problems []string
+	var removed []hyperv1.ServiceType
+	seen := map[hyperv1.ServiceType]bool{}
+	result := make([]hyperv1.ServicePublishingStrategyMapping, 0, len(services))
+
+	for _, mapping := range services {
+		rule, known := serviceRules[mapping.Service]
+		switch {
+		case !known:
+			problems = append(problems, fmt.Sprintf("unknown service %q", mapping.Service))
+			continue
+		case seen[mapping.Service]:
+			problems = append(problems, fmt.Sprintf("service %s is published more than once", mapping.Service))
+			continue
+		case rule.removedIn != "" && minorAtLeast(version, rule.removedIn):
+			removed = append(removed, mapping.Service)
+			continue
+		}
+		seen[mapping.Service] = true
+
+		strategy := mapping.ServicePublishingStrategy
+		if !containsType(rule.types, strategy.Type) {
+			problems = append(problems, fmt.Sprintf("service %s cannot be published as %q (accepted: %s)",
+				mapping.Service, strategy.Type, joinTypes(rule.types)))
+		} else if strategy.Type == hyperv1.NodePort && (strategy.NodePort == nil || strategy.NodePort.Address == "") {
+			problems = append(problems, fmt.Sprintf("service %s is published as NodePort without an address", mapping.Service))
+		}
+		result = append(result, mapping)
+	}
+
+	for _, service := range requiredServices {
+		if !seen[service] {
+			problems = append(problems, fmt.Sprintf("required service %s is not published", service))
+		}
+	}
+
+	if len(problems) > 0 {
+		release := version
+		if release == "" {
+			release = "unknown"
+		}
+		return nil, nil, fmt.Errorf("invalid service publishing strategy for OCP %s: %s", release, strings.Join(problems, "; "))
+	}
+	return result, removed, nil
+}
+
+// minorAtLeast returns true if the OCP minor of version is the given minor or later.
+// It returns false if version is not an OCP version.
+func minorAtLeast(version, minor string) bool {
+	major, sub, ok := parseMinor(overlays.MinorVersion(version))
+	if !ok {
+		return false
+	}
+	wantMajor, wantSub, _ := parseMinor(minor)
+	return major > wantMajor || (major == wantMajor && sub >= wantSub)
+}
+
+// parseMinor splits a major.minor version into its numbers
+func parseMinor(minor string) (int, int, bool) {
+	majorPart, subPart, found := strings.Cut(minor, ".")
+	major, err1 := strconv.Atoi(majorPart)
+	sub, err2 := strconv.Atoi(subPart)
+	return major, sub, found && err1 == nil && err2 == nil
+}
+
+// containsType returns true if types contains t
+func containsType(types []hyperv1.PublishingStrategyType, t hyperv1.PublishingStrategyType) bool {
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
+
+// joinTypes formats publishing strategy types for error messages
+func joinTypes(types []hyperv1.PublishingStrategyType) string {
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, string(t))
+	}
+	return strings.Join(names, ", ")
+}
@@ -20,6 +20,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"k8s.io/utils/ptr"
+
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/overlays"
 )
 
 var _ = Describe("Service Publishing Strategy Builder", func() {
@@ -130,6 +133,126 @@ var _ = Describe("Service Publishing Strategy Builder", func() {
 			}
 		})
 	})
+
+	Context("OIDC Publishing", func() {
+		nodeAddress := "192.168.1.100"
+
+		It("should publish OIDC through a Route in LoadBalancer mode", func() {
+			strategy := setOIDCPublishing(BuildServicePublishingStrategy(true, ""), true)
+
+			Expect(strategy).To(HaveLen(5))
+			oidcStrategy := findServiceStrategyByType(strategy, hyperv1.OIDC)
+			Expect(oidcStrategy).ToNot(BeNil())
+			Expect(oidcStrategy.Type).To(Equal(hyperv1.Route))
+		})
+
+		It("should remove OIDC in NodePort mode", func() {
+			strategy := setOIDCPublishing(BuildServicePublishingStrategy(false, nodeAddress), false)
+
+			Expect(strategy).To(HaveLen(4))
+			Expect(findServiceStrategyByType(strategy, hyperv1.OIDC)).To(BeNil())
+		})
+
+		It("should keep the default strategies of the mode", func() {
+			Expect(setOIDCPublishing(BuildServicePublishingStrategy(true, ""), false)).
+				To(Equal(BuildServicePublishingStrategy(true, "")))
+			Expect(setOIDCPublishing(BuildServicePublishingStrategy(false, nodeAddress), true)).
+				To(Equal(BuildServicePublishingStrategy(false, nodeAddress)))
+		})
+
+		It("should follow the OAuth server strategy in NodePort mode", func() {
+			strategy := BuildServicePublishingStrategy(false, nodeAddress)
+			strategy = setOIDCPublishing(setOIDCPublishing(strategy, false), true)
+
+			oidcStrategy := findServiceStrategyByType(strategy, hyperv1.OIDC)
+			Expect(oidcStrategy).ToNot(BeNil())
+			Expect(oidcStrategy.Type).To(Equal(hyperv1.NodePort))
+			Expect(oidcStrategy.NodePort.Address).To(Equal(nodeAddress))
+		})
+
+		DescribeTable("should decide from the operator setting and the version overlays",
+			func(setting, version string, expected *bool) {
+				config, err := overlays.Parse([]byte("overlays:\n- version: \"4.19\"\n  publishOIDC: true\n"))
+				Expect(err).NotTo(HaveOccurred())
+				hm := &HostedClusterManager{Overlays: config, OIDCPublishing: setting}
+
+				Expect(hm.publishOIDC(version)).To(Equal(expected))
+			},
+			Entry("overlay of the release", OIDCPublishingAuto, "4.19.2", ptr.To(true)),
+			Entry("mode default without overlay", OIDCPublishingAuto, "4.18.3", nil),
+			Entry("always", OIDCPublishingAlways, "4.18.3", ptr.To(true)),
+			Entry("never overrides the overlay", OIDCPublishingNever, "4.19.2", ptr.To(false)),
+		)
+	})
+
+	Context("Validation against the release", func() {
+		const nodeAddress = "192.168.1.100"
+
+		withService := func(services []hyperv1.ServicePublishingStrategyMapping, service hyperv1.ServiceType,
+			strategy hyperv1.ServicePublishingStrategy) []hyperv1.ServicePublishingStrategyMapping {
+			return append(services, hyperv1.ServicePublishingStrategyMapping{Service: service, ServicePublishingStrategy: strategy})
+		}
+
+		It("should accept the generated strategies of both modes", func() {
+			for _, strategy := range [][]hyperv1.ServicePublishingStrategyMapping{
+				BuildServicePublishingStrategy(true, ""),
+				BuildServicePublishingStrategy(false, nodeAddress),
+			} {
+				services, removed, err := validateServicePublishing(strategy, "4.19.0")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(removed).To(BeEmpty())
+				Expect(services).To(Equal(strategy))
+			}
+		})
+
+		It("should drop services the release no longer publishes", func() {
+			strategy := withService(BuildServicePublishingStrategy(true, ""), hyperv1.OVNSbDb,
+				hyperv1.ServicePublishingStrategy{Type: hyperv1.Route})
+
+			services, removed, err := validateServicePublishing(strategy, "4.14.1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(ConsistOf(hyperv1.OVNSbDb))
+			Expect(services).To(Equal(BuildServicePublishingStrategy(true, "")))
+
+			services, removed, err = validateServicePublishing(strategy, "4.13.20")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeEmpty())
+			Expect(services).To(HaveLen(5))
+		})
+
+		It("should keep services of unknown versions", func() {
+			strategy := withService(BuildServicePublishingStrategy(true, ""), hyperv1.OVNSbDb,
+				hyperv1.ServicePublishingStrategy{Type: hyperv1.Route})
+
+			_, removed, err := validateServicePublishing(strategy, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeEmpty())
+		})
+
+		It("should name every problem of the strategy", func() {
+			strategy := BuildServicePublishingStrategy(true, "")[:2]
+			strategy = withService(strategy, hyperv1.APIServer, hyperv1.ServicePublishingStrategy{Type: hyperv1.LoadBalancer})
+			strategy = withService(strategy, "Dashboard", hyperv1.ServicePublishingStrategy{Type: hyperv1.Route})
+			strategy = withService(strategy, hyperv1.OIDC, hyperv1.ServicePublishingStrategy{Type: hyperv1.NodePort})
+
+			_, _, err := validateServicePublishing(strategy, "4.19.0")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("invalid service publishing strategy for OCP 4.19.0: "))
+			Expect(err.Error()).To(ContainSubstring("service APIServer is published more than once"))
+			Expect(err.Error()).To(ContainSubstring(`unknown service "Dashboard"`))
+			Expect(err.Error()).To(ContainSubstring("service OIDC is published as NodePort without an address"))
+			Expect(err.Error()).To(ContainSubstring("required service Konnectivity is not published"))
+			Expect(err.Error()).To(ContainSubstring("required service Ignition is not published"))
+		})
+
+		It("should reject strategy types the service does not accept", func() {
+			strategy := BuildServicePublishingStrategy(true, "")
+			strategy[0].Type = hyperv1.S3
+
+			_, _, err := validateServicePublishing(strategy, "4.19.0")
+			Expect(err).To(MatchError(ContainSubstring(`service APIServer cannot be published as "S3"`)))
+		})
+	})
 })
 
 // Helper function to find strategy for a specific service
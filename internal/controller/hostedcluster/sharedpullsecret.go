@@ -0,0 +1,198 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/secrets"
+)
+
+// With shared pull secrets, the bridges of a namespace whose pull secret has the same data reference a single
+// copy of it instead of one copy each. A shared copy has no owner: every bridge referencing it sets a label on
+// it, and the copy is deleted once the last of them has removed its label, after a rotation or on deletion.
+
+// LabelSharedByPrefix prefixes the label a DPFHCPBridge sets on the shared pull secret copy it references.
+// The label name is the bridge name, shortened with a hash suffix if it is too long for a label name.
+const LabelSharedByPrefix = "shared-by.dpf-hcp-bridge-operator/"
+
+// sharedPullSecretName returns the name of the shared copy of the pull secret, derived from its source and data
+func sharedPullSecretName(sourceName, dataHash string) string {
+	return fmt.Sprintf("%s-shared-%s", sourceName, strings.TrimPrefix(dataHash, "sha256:")[:10])
+}
+
+// sharedByLabel returns the label key marking a shared pull secret copy as referenced by the DPFHCPBridge
+func sharedByLabel(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	// Label names are limited to 63 characters
+	const maxLength = 63
+	name := cr.Name
+	if len(name) > maxLength {
+		sum := sha256.Sum256([]byte(name))
+		name = name[:maxLength-11] + "-" + hex.EncodeToString(sum[:])[:10]
+	}
+	return LabelSharedByPrefix + name
+}
+
+// sharedPullSecretReferences returns the number of DPFHCPBridges referencing the shared pull secret copy
+func sharedPullSecretReferences(secret *corev1.Secret) int {
+	references := 0
+	for key := range secret.Labels {
+		if strings.HasPrefix(key, LabelSharedByPrefix) {
+			references++
+		}
+	}
+	return references
+}
+
+// referencesSharedPullSecret returns true if the Secret is a shared pull secret copy referenced by the DPFHCPBridge
+func referencesSharedPullSecret(secret *corev1.Secret, cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	_, ok := secret.Labels[sharedByLabel(cr)]
+	return ok
+}
+
+// sharesPullSecret returns true if the first copy of the pull secret of the DPFHCPBridge is to be a shared copy.
+// Bridges whose HostedCluster already references a copy of their own switch to a shared copy at the next rotation.
+func (sm *SecretManager) sharesPullSecret(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	return sm.SharedPullSecrets && cr.Status.PullSecretRollout == nil && cr.Status.HostedClusterRef == nil
+}
+
+// copySharedPullSecret references the shared copy of the pull secret of the DPFHCPBridge, creating it if no
+// other bridge did yet
+func (sm *SecretManager) copySharedPullSecret(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (secretCopy, error) {
+	source, err := sm.Backend.Fetch(ctx, cr, cr.Spec.PullSecretRef.Name)
+	if err != nil {
+		return secretCopy{}, fmt.Errorf("failed to get pull-secret %s/%s: %w", cr.Namespace, cr.Spec.PullSecretRef.Name, err)
+	}
+
+	shared, created, err := sm.ensureSharedPullSecret(ctx, cr, source, secretDataHash(source.Data))
+	if err != nil {
+		return secretCopy{}, err
+	}
+	return secretCopy{entry: secretCopyStatus(shared, source.Name), created: created}, nil
+}
+
+// ensureSharedPullSecret creates the shared copy of the pull secret with the given data hash, or adds the
+// reference of the DPFHCPBridge to it if another bridge created it already. Returns true if it was created.
+func (sm *SecretManager) ensureSharedPullSecret(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge,
+	source *secrets.SourceSecret, dataHash string) (*corev1.Secret, bool, error) {
+	log := logf.FromContext(ctx)
+
+	name := sharedPullSecretName(source.Name, dataHash)
+	shared := &corev1.Secret{}
+	err := sm.Get(ctx, types.NamespacedName{Name: name, Namespace: cr.Namespace}, shared)
+	if apierrors.IsNotFound(err) {
+		shared = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: cr.Namespace,
+				Labels: map[string]string{
+					common.LabelComponent: common.ComponentSharedPullSecret,
+					sharedByLabel(cr):     "true",
+				},
+				Annotations: map[string]string{
+					AnnotationSourceResourceVersion: source.Version,
+					AnnotationLastSecretSync:        time.Now().UTC().Format(time.RFC3339),
+				},
+			},
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: source.Data,
+		}
+		// A copy created concurrently by another bridge is referenced on the next reconcile
+		if err := sm.Create(ctx, shared); err != nil {
+			return nil, false, fmt.Errorf("failed to create shared pull secret %s: %w", name, err)
+		}
+		log.Info("Created shared pull secret copy", "secret", name, "namespace", cr.Namespace)
+		return shared, true, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get shared pull secret %s: %w", name, err)
+	}
+
+	if shared.Labels[common.LabelComponent] != common.ComponentSharedPullSecret || secretDataHash(shared.Data) != dataHash {
+		return nil, false, fmt.Errorf("pull-secret %s exists in %s but is not a shared pull secret copy", name, cr.Namespace)
+	}
+	if !referencesSharedPullSecret(shared, cr) {
+		shared.Labels[sharedByLabel(cr)] = "true"
+		if err := sm.Update(ctx, shared); err != nil {
+			return nil, false, fmt.Errorf("failed to reference shared pull secret %s: %w", name, err)
+		}
+		log.Info("Referencing shared pull secret copy", "secret", name, "namespace", cr.Namespace,
+			"references", sharedPullSecretReferences(shared))
+	}
+	return shared, false, nil
+}
+
+// listSharedPullSecrets returns the shared pull secret copies the DPFHCPBridge references
+func listSharedPullSecrets(ctx context.Context, c client.Client, cr *provisioningv1alpha1.DPFHCPBridge) ([]corev1.Secret, error) {
+	list := &corev1.SecretList{}
+	if err := c.List(ctx, list, client.InNamespace(cr.Namespace), client.HasLabels{sharedByLabel(cr)}); err != nil {
+		return nil, fmt.Errorf("failed to list shared pull secrets: %w", err)
+	}
+	return list.Items, nil
+}
+
+// releaseSharedPullSecrets removes the reference of the DPFHCPBridge from the shared pull secret copies it
+// references other than keep, and deletes the copies no other bridge references anymore.
+// Returns the names of the released copies.
+func releaseSharedPullSecrets(ctx context.Context, c client.Client, cr *provisioningv1alpha1.DPFHCPBridge, keep string) ([]string, error) {
+	log := logf.FromContext(ctx)
+
+	shared, err := listSharedPullSecrets(ctx, c, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	var released []string
+	for i := range shared {
+		secret := &shared[i]
+		if secret.Name == keep {
+			continue
+		}
+
+		delete(secret.Labels, sharedByLabel(cr))
+		references := sharedPullSecretReferences(secret)
+		if references == 0 {
+			// The precondition fails if another bridge referenced the copy since it was read
+			resourceVersion := secret.ResourceVersion
+			err = c.Delete(ctx, secret, client.Preconditions{ResourceVersion: &resourceVersion})
+		} else {
+			err = c.Update(ctx, secret)
+		}
+		if err != nil && !apierrors.IsNotFound(err) {
+			return released, fmt.Errorf("failed to release shared pull secret %s: %w", secret.Name, err)
+		}
+
+		log.Info("Released shared pull secret copy", "secret", secret.Name, "namespace", secret.Namespace,
+			"remainingReferences", references)
+		released = append(released, secret.Name)
+	}
+	return released, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+var _ = Describe("Shared pull secrets", func() {
+	var (
+		ctx      context.Context
+		c        client.Client
+		sm       *SecretManager
+		pull     *corev1.Secret
+		bridgeA  *provisioningv1alpha1.DPFHCPBridge
+		bridgeB  *provisioningv1alpha1.DPFHCPBridge
+		original = map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"auth":"b2xk"}}}`)}
+	)
+
+	bridge := func(name string) *provisioningv1alpha1.DPFHCPBridge {
+		return &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID("uid-" + name)},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				PullSecretRef:   corev1.LocalObjectReference{Name: "pull"},
+				SSHKeySecretRef: corev1.LocalObjectReference{Name: "ssh"},
+			},
+		}
+	}
+
+	sharedName := sharedPullSecretName("pull", secretDataHash(original))
+
+	getShared := func(name string) (*corev1.Secret, error) {
+		secret := &corev1.Secret{}
+		err := c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, secret)
+		return secret, err
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		bridgeA = bridge("bridge-a")
+		bridgeB = bridge("bridge-b")
+		pull = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "pull", Namespace: "default"},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       original,
+		}
+		ssh := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ssh", Namespace: "default"},
+			Data:       map[string][]byte{"id_rsa.pub": []byte("ssh-ed25519 AAAA")},
+		}
+		c = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(bridgeA, bridgeB, pull, ssh).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+		sm = NewSecretManager(c, scheme)
+		sm.SharedPullSecrets = true
+
+		for _, cr := range []*provisioningv1alpha1.DPFHCPBridge{bridgeA, bridgeB} {
+			_, err := sm.SyncSecrets(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("should reference a single copy from the bridges whose pull secret has the same data", func() {
+		shared, err := getShared(sharedName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shared.Data).To(Equal(original))
+		Expect(shared.OwnerReferences).To(BeEmpty())
+		Expect(shared.Labels).To(Equal(map[string]string{
+			common.LabelComponent:            common.ComponentSharedPullSecret,
+			LabelSharedByPrefix + "bridge-a": "true",
+			LabelSharedByPrefix + "bridge-b": "true",
+		}))
+
+		for _, cr := range []*provisioningv1alpha1.DPFHCPBridge{bridgeA, bridgeB} {
+			Expect(cr.PullSecretCopyName()).To(Equal(sharedName))
+			Expect(cr.Status.PullSecretRollout.CompletionTime).NotTo(BeNil())
+			_, err := getShared(cr.Name + "-pull-secret")
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		}
+	})
+
+	It("should delete the copy once the last bridge released it", func() {
+		released, err := releaseSharedPullSecrets(ctx, c, bridgeA, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(released).To(ConsistOf(sharedName))
+
+		shared, err := getShared(sharedName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sharedPullSecretReferences(shared)).To(Equal(1))
+		Expect(referencesSharedPullSecret(shared, bridgeB)).To(BeTrue())
+
+		_, err = releaseSharedPullSecrets(ctx, c, bridgeB, "")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = getShared(sharedName)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should rotate to the shared copy of the new data and keep the old one while it is referenced", func() {
+		pull.Data = map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"auth":"bmV3"}}}`)}
+		Expect(c.Update(ctx, pull)).To(Succeed())
+
+		Expect(sm.RotatePullSecret(ctx, bridgeA)).To(Succeed())
+		rotatedName := sharedPullSecretName("pull", secretDataHash(pull.Data))
+		Expect(bridgeA.PullSecretCopyName()).To(Equal(rotatedName))
+		Expect(bridgeA.Status.PullSecretRollout.CompletionTime).To(BeNil())
+
+		// Once bridge-a rolled out the new copy, it releases the old one still used by bridge-b
+		released, err := releaseSharedPullSecrets(ctx, c, bridgeA, rotatedName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(released).To(ConsistOf(sharedName))
+
+		old, err := getShared(sharedName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(referencesSharedPullSecret(old, bridgeA)).To(BeFalse())
+		Expect(referencesSharedPullSecret(old, bridgeB)).To(BeTrue())
+		rotated, err := getShared(rotatedName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(referencesSharedPullSecret(rotated, bridgeA)).To(BeTrue())
+	})
+
+	It("should shorten bridge names too long for a label name", func() {
+		long := bridge("bridge-with-a-name-far-too-long-to-be-used-as-the-name-of-a-label-key")
+		label := sharedByLabel(long)
+		Expect(len(label) - len(LabelSharedByPrefix)).To(Equal(63))
+		Expect(label).NotTo(Equal(sharedByLabel(bridgeA)))
+	})
+})
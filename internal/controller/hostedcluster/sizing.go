@@ -0,0 +1,90 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var (
+	// kubeAPIServerRequestsAnnotation overrides the resource requests of the hosted kube-apiserver
+	kubeAPIServerRequestsAnnotation = hyperv1.ResourceRequestOverrideAnnotationPrefix + "/kube-apiserver.kube-apiserver"
+
+	// etcdRequestsAnnotation overrides the resource requests of the hosted etcd
+	etcdRequestsAnnotation = hyperv1.ResourceRequestOverrideAnnotationPrefix + "/etcd.etcd"
+
+	// sizeProfileAnnotations are the HostedCluster annotations set for each spec.sizeProfile
+	sizeProfileAnnotations = map[provisioningv1alpha1.SizeProfile]map[string]string{
+		provisioningv1alpha1.SizeProfileSmall: {
+			hyperv1.ClusterSizeOverrideAnnotation: string(provisioningv1alpha1.SizeProfileSmall),
+			kubeAPIServerRequestsAnnotation:       "cpu=500m,memory=2Gi",
+			etcdRequestsAnnotation:                "cpu=300m,memory=1Gi",
+		},
+		provisioningv1alpha1.SizeProfileMedium: {
+			hyperv1.ClusterSizeOverrideAnnotation: string(provisioningv1alpha1.SizeProfileMedium),
+			kubeAPIServerRequestsAnnotation:       "cpu=1,memory=4Gi",
+			etcdRequestsAnnotation:                "cpu=500m,memory=2Gi",
+		},
+		provisioningv1alpha1.SizeProfileLarge: {
+			hyperv1.ClusterSizeOverrideAnnotation: string(provisioningv1alpha1.SizeProfileLarge),
+			kubeAPIServerRequestsAnnotation:       "cpu=2,memory=8Gi",
+			etcdRequestsAnnotation:                "cpu=1,memory=4Gi",
+		},
+	}
+)
+
+// applySizeProfile sets the annotations of the bridge's spec.sizeProfile on the HostedCluster.
+// Annotations set by a previous profile are removed when the profile is cleared; values that do not
+// come from any profile (e.g. set by hand or by a version overlay) are left alone in that case.
+// Returns true if the HostedCluster annotations were changed.
+func applySizeProfile(hc *hyperv1.HostedCluster, cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	desired := sizeProfileAnnotations[cr.Spec.SizeProfile]
+	changed := false
+
+	if desired == nil {
+		for key, value := range hc.Annotations {
+			if isSizeProfileValue(key, value) {
+				delete(hc.Annotations, key)
+				changed = true
+			}
+		}
+		return changed
+	}
+
+	if hc.Annotations == nil {
+		hc.Annotations = map[string]string{}
+	}
+	for key, value := range desired {
+		if hc.Annotations[key] != value {
+			hc.Annotations[key] = value
+			changed = true
+		}
+	}
+	return changed
+}
+
+// isSizeProfileValue returns true if the annotation is set to the value of one of the size profiles
+func isSizeProfileValue(key, value string) bool {
+	for _, annotations := range sizeProfileAnnotations {
+		if v, ok := annotations[key]; ok && v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Size profiles", func() {
+	var (
+		hc *hyperv1.HostedCluster
+		cr *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	BeforeEach(func() {
+		hc = &hyperv1.HostedCluster{}
+		cr = &provisioningv1alpha1.DPFHCPBridge{}
+	})
+
+	It("should leave sizing to HyperShift when no profile is set", func() {
+		Expect(applySizeProfile(hc, cr)).To(BeFalse())
+		Expect(hc.Annotations).To(BeEmpty())
+	})
+
+	DescribeTable("should set the cluster size override and control plane requests of the profile",
+		func(profile provisioningv1alpha1.SizeProfile, kubeAPIServerRequests string) {
+			cr.Spec.SizeProfile = profile
+			Expect(applySizeProfile(hc, cr)).To(BeTrue())
+			Expect(hc.Annotations).To(HaveKeyWithValue(hyperv1.ClusterSizeOverrideAnnotation, string(profile)))
+			Expect(hc.Annotations).To(HaveKeyWithValue(kubeAPIServerRequestsAnnotation, kubeAPIServerRequests))
+			Expect(hc.Annotations).To(HaveKey(etcdRequestsAnnotation))
+
+			Expect(applySizeProfile(hc, cr)).To(BeFalse())
+		},
+		Entry("small", provisioningv1alpha1.SizeProfileSmall, "cpu=500m,memory=2Gi"),
+		Entry("medium", provisioningv1alpha1.SizeProfileMedium, "cpu=1,memory=4Gi"),
+		Entry("large", provisioningv1alpha1.SizeProfileLarge, "cpu=2,memory=8Gi"),
+	)
+
+	It("should switch between profiles", func() {
+		cr.Spec.SizeProfile = provisioningv1alpha1.SizeProfileSmall
+		applySizeProfile(hc, cr)
+
+		cr.Spec.SizeProfile = provisioningv1alpha1.SizeProfileLarge
+		Expect(applySizeProfile(hc, cr)).To(BeTrue())
+		Expect(hc.Annotations).To(Equal(sizeProfileAnnotations[provisioningv1alpha1.SizeProfileLarge]))
+	})
+
+	It("should only remove the annotations set by a profile when the profile is cleared", func() {
+		hc.Annotations = map[string]string{
+			hyperv1.ClusterSizeOverrideAnnotation: "medium",
+			kubeAPIServerRequestsAnnotation:       "cpu=3,memory=12Gi",
+		}
+
+		Expect(applySizeProfile(hc, cr)).To(BeTrue())
+		Expect(hc.Annotations).To(Equal(map[string]string{kubeAPIServerRequestsAnnotation: "cpu=3,memory=12Gi"}))
+	})
+})
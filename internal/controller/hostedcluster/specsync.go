@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const (
+	// DefaultUpdateInterval is the default minimum time between two spec updates of a HostedCluster
+	DefaultUpdateInterval = 2 * time.Minute
+
+	// AnnotationLastSpecUpdate records on the HostedCluster when its spec was last updated from the bridge
+	AnnotationLastSpecUpdate = "dpf-hcp-bridge-operator/last-spec-update"
+)
+
+// SyncHostedClusterSpec propagates the mutable, spec-derived fields of the DPFHCPBridge
+// (release image, control plane node selector, size profile, proxy and image mirrors) to its existing HostedCluster.
+//
+// Each HostedCluster update can trigger a HyperShift rollout, so updates are rate limited
+// per bridge: at most one update per UpdateInterval. Edits made while the interval has not
+// elapsed are coalesced and applied together once it has, via a RequeueAfter result.
+// Updates are not essential to keep the cluster running, so they are also deferred until the end
+// of an active blackout window.
+// A RequeueAfter result does not indicate that reconciliation should stop.
+func (hm *HostedClusterManager) SyncHostedClusterSpec(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if cr.Status.HostedClusterRef == nil {
+		return ctrl.Result{}, nil
+	}
+
+	hc := &hyperv1.HostedCluster{}
+	if err := hm.Get(ctx, types.NamespacedName{Name: cr.Name, Namespace: cr.Namespace}, hc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get HostedCluster for spec sync: %w", err)
+	}
+
+	if !metav1.IsControlledBy(hc, cr) || !hc.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	if err := verifyBackReference(hc, cr); err != nil {
+		return ctrl.Result{}, fmt.Errorf("hostedCluster ownership check failed: %w", err)
+	}
+
+	desiredImage := cr.PinnedOCPReleaseImage()
+	if desiredImage == "" {
+		// releaseCatalogRef not resolved or release image not pinned (reported by the ReleaseResolved and
+		// ReleaseImagePinned conditions): keep the running release
+		desiredImage = hc.Spec.Release.Image
+	}
+	desiredNodeSelector := getNodeSelector(cr)
+	// Applied to hc in place, and only persisted by the update below
+	sizeProfileChanged := applySizeProfile(hc, cr)
+	proxyChanged := applyProxy(hc, cr)
+	imageMirrorsChanged := applyImageMirrors(hc, cr)
+	if hc.Spec.Release.Image == desiredImage && equality.Semantic.DeepEqual(hc.Spec.NodeSelector, desiredNodeSelector) &&
+		!sizeProfileChanged && !proxyChanged && !imageMirrorsChanged {
+		return ctrl.Result{}, nil
+	}
+
+	now := hm.clock()
+	if window, end, ok := hm.Blackout.Active(now); ok {
+		log.Info("HostedCluster spec changed during a blackout window, deferring the update until it ends",
+			"hostedCluster", hc.Name,
+			"blackoutWindow", window,
+			"retryAfter", end.Sub(now))
+		return ctrl.Result{RequeueAfter: end.Sub(now)}, nil
+	}
+	if wait := hm.updateWait(hc, now); wait > 0 {
+		log.Info("HostedCluster spec changed, coalescing with further changes until the update interval has passed",
+			"hostedCluster", hc.Name,
+			"retryAfter", wait)
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	if ok, retryAfter := hm.Breaker.Allow(ctx); !ok {
+		log.V(1).Info("HyperShift circuit open, deferring HostedCluster update", "retryAfter", retryAfter)
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+
+	log.Info("Updating HostedCluster spec from DPFHCPBridge",
+		"hostedCluster", hc.Name,
+		"releaseImage", desiredImage,
+		"previousReleaseImage", hc.Spec.Release.Image,
+		"sizeProfile", cr.Spec.SizeProfile,
+		"proxyChanged", proxyChanged,
+		"imageMirrorsChanged", imageMirrorsChanged)
+
+	hc.Spec.Release.Image = desiredImage
+	hc.Spec.NodeSelector = desiredNodeSelector
+	if hc.Annotations == nil {
+		hc.Annotations = map[string]string{}
+	}
+	hc.Annotations[AnnotationLastSpecUpdate] = now.UTC().Format(time.RFC3339)
+
+	err := hm.Update(ctx, hc)
+	hm.Breaker.Record(ctx, err)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update HostedCluster spec: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// updateWait returns how long to wait before the HostedCluster may be updated again
+func (hm *HostedClusterManager) updateWait(hc *hyperv1.HostedCluster, now time.Time) time.Duration {
+	if hm.UpdateInterval <= 0 {
+		return 0
+	}
+
+	last, err := time.Parse(time.RFC3339, hc.Annotations[AnnotationLastSpecUpdate])
+	if err != nil {
+		// Never updated (or unparsable): update right away
+		return 0
+	}
+
+	return hm.UpdateInterval - now.Sub(last)
+}
+
+func (hm *HostedClusterManager) clock() time.Time {
+	if hm.now == nil {
+		return time.Now()
+	}
+	return hm.now()
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/blackout"
+)
+
+var _ = Describe("HostedCluster Spec Sync", func() {
+	const (
+		oldImage = "quay.io/openshift-release-dev/ocp-release:4.19.0-multi"
+		newImage = "quay.io/openshift-release-dev/ocp-release:4.19.1-multi"
+	)
+
+	var (
+		ctx context.Context
+		cr  *provisioningv1alpha1.DPFHCPBridge
+		c   client.Client
+		hm  *HostedClusterManager
+		now time.Time
+	)
+
+	key := types.NamespacedName{Name: "test-bridge", Namespace: "default"}
+
+	currentHC := func() *hyperv1.HostedCluster {
+		hc := &hyperv1.HostedCluster{}
+		Expect(c.Get(ctx, key, hc)).To(Succeed())
+		return hc
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace, UID: "bridge-uid"},
+			Spec:       provisioningv1alpha1.DPFHCPBridgeSpec{OCPReleaseImage: oldImage},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				HostedClusterRef: &corev1.ObjectReference{Name: key.Name, Namespace: key.Namespace},
+			},
+		}
+
+		hc := &hyperv1.HostedCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: hyperv1.HostedClusterSpec{
+				Release:      hyperv1.Release{Image: oldImage},
+				NodeSelector: getNodeSelector(cr),
+			},
+		}
+		hc.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(cr, provisioningv1alpha1.GroupVersion.WithKind("DPFHCPBridge"))}
+		setBackReference(hc, cr)
+
+		c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(hc).Build()
+		// The last update is recorded with second precision
+		now = time.Now().Truncate(time.Second)
+		hm = NewHostedClusterManager(c, scheme)
+		hm.UpdateInterval = time.Minute
+		hm.now = func() time.Time { return now }
+	})
+
+	It("should not update an up-to-date HostedCluster", func() {
+		result, err := hm.SyncHostedClusterSpec(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(currentHC().Annotations).NotTo(HaveKey(AnnotationLastSpecUpdate))
+	})
+
+	It("should apply the first change right away", func() {
+		cr.Spec.OCPReleaseImage = newImage
+
+		result, err := hm.SyncHostedClusterSpec(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		hc := currentHC()
+		Expect(hc.Spec.Release.Image).To(Equal(newImage))
+		Expect(hc.Annotations).To(HaveKey(AnnotationLastSpecUpdate))
+	})
+
+	It("should coalesce changes made within the update interval into one update", func() {
+		cr.Spec.OCPReleaseImage = newImage
+		_, err := hm.SyncHostedClusterSpec(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		firstUpdate := currentHC().ResourceVersion
+
+		// Two quick edits shortly after the first update
+		now = now.Add(10 * time.Second)
+		cr.Spec.NodeSelector = map[string]string{"role": "a"}
+		result, err := hm.SyncHostedClusterSpec(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(50 * time.Second))
+
+		now = now.Add(20 * time.Second)
+		cr.Spec.NodeSelector = map[string]string{"role": "b"}
+		result, err = hm.SyncHostedClusterSpec(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+		Expect(currentHC().ResourceVersion).To(Equal(firstUpdate))
+
+		// Once the interval has passed, the latest state is applied in a single update
+		now = now.Add(30 * time.Second)
+		result, err = hm.SyncHostedClusterSpec(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(currentHC().Spec.NodeSelector).To(Equal(map[string]string{"role": "b"}))
+	})
+
+	It("should propagate a size profile change", func() {
+		cr.Spec.SizeProfile = provisioningv1alpha1.SizeProfileMedium
+
+		result, err := hm.SyncHostedClusterSpec(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		hc := currentHC()
+		Expect(hc.Annotations).To(HaveKeyWithValue(hyperv1.ClusterSizeOverrideAnnotation, "medium"))
+		Expect(hc.Annotations).To(HaveKey(AnnotationLastSpecUpdate))
+	})
+
+	It("should propagate a proxy change", func() {
+		cr.Spec.Proxy = &provisioningv1alpha1.ProxySpec{HTTPSProxy: "http://proxy.example.com:3128"}
+
+		result, err := hm.SyncHostedClusterSpec(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		hc := currentHC()
+		Expect(hc.Spec.Configuration.Proxy.HTTPSProxy).To(Equal("http://proxy.example.com:3128"))
+		Expect(hc.Annotations).To(HaveKey(AnnotationLastSpecUpdate))
+	})
+
+	It("should not rate limit when the update interval is zero", func() {
+		hm.UpdateInterval = 0
+
+		cr.Spec.OCPReleaseImage = newImage
+		_, err := hm.SyncHostedClusterSpec(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		cr.Spec.NodeSelector = map[string]string{"role": "a"}
+		result, err := hm.SyncHostedClusterSpec(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(currentHC().Spec.NodeSelector).To(Equal(map[string]string{"role": "a"}))
+	})
+
+	It("should defer changes until the end of a blackout window", func() {
+		start := now.UTC().Truncate(time.Minute)
+		var err error
+		hm.Blackout, err = blackout.Parse([]byte("windows:\n- {name: freeze, start: \"" + start.Format("15:04") + "\", duration: 1h}"))
+		Expect(err).NotTo(HaveOccurred())
+		cr.Spec.OCPReleaseImage = newImage
+
+		result, err := hm.SyncHostedClusterSpec(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(start.Add(time.Hour).Sub(now)))
+		Expect(currentHC().Spec.Release.Image).To(Equal(oldImage))
+
+		now = start.Add(time.Hour)
+		result, err = hm.SyncHostedClusterSpec(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(currentHC().Spec.Release.Image).To(Equal(newImage))
+	})
+})
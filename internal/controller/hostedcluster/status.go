@@ -18,6 +18,12 @@ package hostedcluster
 
 import (
 	"context"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -28,11 +34,21 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/conditions"
 )
 
+// debouncedConditions are the mirrored conditions prone to flapping; their status changes are debounced
+var debouncedConditions = map[string]bool{
+	provisioningv1alpha1.HostedClusterAvailable: true,
+	provisioningv1alpha1.HostedClusterDegraded:  true,
+}
+
 // StatusSyncer manages status synchronization from HostedCluster to DPFHCPBridge
 type StatusSyncer struct {
 	client.Client
+
+	// Debouncer, if set, delays status changes of flapping conditions until they persist
+	Debouncer *conditions.Debouncer
 }
 
 // NewStatusSyncer creates a new StatusSyncer
@@ -45,7 +61,9 @@ func NewStatusSyncer(c client.Client) *StatusSyncer {
 // - Only syncs status when hostedClusterRef is set in DPFHCPBridge status
 // - Handles missing HostedCluster gracefully (may be creating or deleted)
 //
-// Returns ctrl.Result and error for reconciliation flow
+// Returns ctrl.Result and error for reconciliation flow.
+// A RequeueAfter result means a condition status change is being debounced, or a timed pause of the
+// HostedCluster expires, and must be re-evaluated later; it does not indicate that reconciliation should stop.
 func (ss *StatusSyncer) SyncStatusFromHostedCluster(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
@@ -84,12 +102,17 @@ func (ss *StatusSyncer) SyncStatusFromHostedCluster(ctx context.Context, cr *pro
 		return ctrl.Result{}, err
 	}
 
+	cr.Status.ReleaseImageDigest = releaseImageDigest(hc)
+	syncEndpoints(cr, hc)
+	resumeAfter := syncPaused(cr, hc, time.Now())
+
 	// Check if HostedCluster status is populated yet
 	if hc.Status.Conditions == nil || len(hc.Status.Conditions) == 0 {
 		log.V(1).Info("HostedCluster status not yet populated, skipping sync",
 			"hostedCluster", hcKey.String())
 		// Don't requeue - the HostedCluster watch will trigger reconciliation when status changes.
-		return ctrl.Result{}, nil
+		// A timed pause is re-evaluated once it expires, since expiry changes nothing HyperShift watches.
+		return ctrl.Result{RequeueAfter: resumeAfter}, nil
 	}
 
 	log.V(1).Info("Syncing status from HostedCluster",
@@ -97,18 +120,22 @@ func (ss *StatusSyncer) SyncStatusFromHostedCluster(ctx context.Context, cr *pro
 		"conditions", len(hc.Status.Conditions))
 
 	// Mirror conditions from HostedCluster to DPFHCPBridge
-	ss.mirrorConditions(ctx, cr, hc)
+	requeueAfter := ss.mirrorConditions(ctx, cr, hc)
+	if resumeAfter > 0 && (requeueAfter == 0 || resumeAfter < requeueAfter) {
+		requeueAfter = resumeAfter
+	}
 
 	log.V(1).Info("Status sync completed successfully",
 		"hostedCluster", hcKey.String())
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // mirrorConditions mirrors the 7 specific HostedCluster conditions to DPFHCPBridge
 // This simply copies the condition status, reason, and message from HostedCluster to DPFHCPBridge
-// No additional logic - just mirroring
-func (ss *StatusSyncer) mirrorConditions(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, hc *hyperv1.HostedCluster) {
+// Status changes of flapping conditions are debounced when a Debouncer is configured.
+// Returns the shortest time after which a debounced change must be re-evaluated, or 0.
+func (ss *StatusSyncer) mirrorConditions(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, hc *hyperv1.HostedCluster) time.Duration {
 	log := logf.FromContext(ctx)
 
 	// Map of HostedCluster condition types to DPFHCPBridge condition types
@@ -125,22 +152,101 @@ func (ss *StatusSyncer) mirrorConditions(ctx context.Context, cr *provisioningv1
 		string(hyperv1.IgnitionServerValidReleaseInfo): provisioningv1alpha1.IgnitionServerValidReleaseInfo,
 	}
 
+	var requeueAfter time.Duration
+
 	// Mirror each HostedCluster condition to DPFHCPBridge
 	for hcCondType, dpfCondType := range conditionMappings {
 		hcCond := meta.FindStatusCondition(hc.Status.Conditions, hcCondType)
 		if hcCond != nil {
 			// Found the condition, mirror it
-			meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+			condition := metav1.Condition{
 				Type:               dpfCondType,
 				Status:             hcCond.Status,
 				Reason:             hcCond.Reason,
 				Message:            hcCond.Message,
 				ObservedGeneration: cr.Generation,
-			})
+			}
+
+			if !debouncedConditions[dpfCondType] {
+				meta.SetStatusCondition(&cr.Status.Conditions, condition)
+			} else if _, wait := ss.Debouncer.SetStatusCondition(cr, &cr.Status.Conditions, condition); wait > 0 {
+				log.V(1).Info("Debouncing condition status change",
+					"conditionType", dpfCondType,
+					"status", hcCond.Status,
+					"retryAfter", wait)
+				if requeueAfter == 0 || wait < requeueAfter {
+					requeueAfter = wait
+				}
+				continue
+			}
+
 			log.V(2).Info("Mirrored condition from HostedCluster",
 				"conditionType", dpfCondType,
 				"status", hcCond.Status,
 				"reason", hcCond.Reason)
 		}
 	}
+
+	return requeueAfter
+}
+
+// releaseImageDigest returns the digest of the release image the HostedCluster is reconciling towards.
+// The image reported by HyperShift in status.version.desired is preferred over the spec image, since
+// it is the one actually rolled out. Returns "" when neither image is referenced by digest.
+func releaseImageDigest(hc *hyperv1.HostedCluster) string {
+	images := []string{hc.Spec.Release.Image}
+	if hc.Status.Version != nil {
+		images = append([]string{hc.Status.Version.Desired.Image}, images...)
+	}
+
+	for _, image := range images {
+		if _, digest, found := strings.Cut(image, "@"); found && digest != "" {
+			return digest
+		}
+	}
+	return ""
+}
+
+// syncEndpoints reports the URLs of the API server, web console and OAuth server of the hosted cluster.
+// HyperShift publishes the API server and OAuth server endpoints in the HostedCluster status; the console is
+// served on the ingress domain of the hosted cluster and is reported once the HostedCluster is Available.
+// A URL is kept once reported, so that it does not disappear while the HostedCluster is degraded.
+func syncEndpoints(cr *provisioningv1alpha1.DPFHCPBridge, hc *hyperv1.HostedCluster) {
+	if endpoint := hc.Status.ControlPlaneEndpoint; endpoint.Host != "" {
+		cr.Status.APIEndpoint = "https://" + endpoint.Host
+		if endpoint.Port != 0 {
+			cr.Status.APIEndpoint = "https://" + net.JoinHostPort(endpoint.Host, strconv.Itoa(int(endpoint.Port)))
+		}
+	}
+
+	if endpoint := oauthEndpoint(hc.Status.OAuthCallbackURLTemplate); endpoint != "" {
+		cr.Status.OAuthEndpoint = endpoint
+	}
+
+	if meta.IsStatusConditionTrue(hc.Status.Conditions, string(hyperv1.HostedClusterAvailable)) && hc.Spec.DNS.BaseDomain != "" {
+		cr.Status.ConsoleURL = "https://console-openshift-console." + ingressDomain(hc)
+	}
+}
+
+// oauthEndpoint returns the URL of the OAuth server from the identity provider callback URL template
+// HyperShift publishes, e.g. https://oauth.my-cluster.example.com:443/oauth2callback/[identity-provider-name].
+// Returns "" when the template is not published yet or cannot be parsed.
+func oauthEndpoint(callbackURLTemplate string) string {
+	if callbackURLTemplate == "" {
+		return ""
+	}
+	callbackURL, err := url.Parse(callbackURLTemplate)
+	if err != nil || callbackURL.Host == "" {
+		return ""
+	}
+	return callbackURL.Scheme + "://" + callbackURL.Host
+}
+
+// ingressDomain returns the domain the routes of the hosted cluster are served on: the domain configured
+// on the HostedCluster, or apps.<name>.<base domain> by default
+func ingressDomain(hc *hyperv1.HostedCluster) string {
+	if hc.Spec.Configuration != nil && hc.Spec.Configuration.Ingress != nil && hc.Spec.Configuration.Ingress.Domain != "" {
+		return hc.Spec.Configuration.Ingress.Domain
+	}
+	return "apps." + hc.Name + "." + hc.Spec.DNS.BaseDomain
 }
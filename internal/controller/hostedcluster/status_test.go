@@ -18,9 +18,11 @@ package hostedcluster
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -29,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/conditions"
 )
 
 var _ = Describe("Status Syncer", func() {
@@ -230,5 +233,101 @@ var _ = Describe("Status Syncer", func() {
 			Expect(progressingCond).ToNot(BeNil())
 			Expect(progressingCond.ObservedGeneration).To(Equal(cr.Generation))
 		})
+
+		It("should debounce status changes of flapping conditions", func() {
+			meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+				Type:   provisioningv1alpha1.HostedClusterAvailable,
+				Status: metav1.ConditionFalse,
+				Reason: "Previous",
+			})
+			client := fakeClient.Build()
+			syncer = NewStatusSyncer(client)
+			syncer.Debouncer = conditions.NewDebouncer(time.Minute)
+
+			result, err := syncer.SyncStatusFromHostedCluster(ctx, cr)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+			// Flapping condition keeps its previous status, others are mirrored immediately
+			availableCond := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.HostedClusterAvailable)
+			Expect(availableCond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.HostedClusterProgressing)).ToNot(BeNil())
+		})
+
+		It("should report the hosted cluster endpoints once HyperShift publishes them", func() {
+			hc.Spec.DNS.BaseDomain = "example.com"
+			hc.Status.ControlPlaneEndpoint = hyperv1.APIEndpoint{Host: "api.test-bridge.example.com", Port: 6443}
+			hc.Status.OAuthCallbackURLTemplate = "https://oauth.test-bridge.example.com:443/oauth2callback/[identity-provider-name]"
+			syncer = NewStatusSyncer(fakeClient.Build())
+
+			_, err := syncer.SyncStatusFromHostedCluster(ctx, cr)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cr.Status.APIEndpoint).To(Equal("https://api.test-bridge.example.com:6443"))
+			Expect(cr.Status.ConsoleURL).To(Equal("https://console-openshift-console.apps.test-bridge.example.com"))
+			Expect(cr.Status.OAuthEndpoint).To(Equal("https://oauth.test-bridge.example.com:443"))
+		})
+
+		It("should keep the reported endpoints while the HostedCluster is not Available", func() {
+			cr.Status.APIEndpoint = "https://api.test-bridge.example.com:6443"
+			cr.Status.ConsoleURL = "https://console-openshift-console.apps.test-bridge.example.com"
+			hc.Spec.DNS.BaseDomain = "example.com"
+			meta.SetStatusCondition(&hc.Status.Conditions, metav1.Condition{
+				Type:   string(hyperv1.HostedClusterAvailable),
+				Status: metav1.ConditionFalse,
+				Reason: "KubeAPIServerNotAvailable",
+			})
+			syncer = NewStatusSyncer(fakeClient.Build())
+
+			_, err := syncer.SyncStatusFromHostedCluster(ctx, cr)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cr.Status.APIEndpoint).To(Equal("https://api.test-bridge.example.com:6443"))
+			Expect(cr.Status.ConsoleURL).To(Equal("https://console-openshift-console.apps.test-bridge.example.com"))
+			Expect(cr.Status.OAuthEndpoint).To(BeEmpty())
+		})
+
+		It("should report the console on the ingress domain configured on the HostedCluster", func() {
+			hc.Spec.DNS.BaseDomain = "example.com"
+			hc.Spec.Configuration = &hyperv1.ClusterConfiguration{Ingress: &configv1.IngressSpec{Domain: "apps.tenant-a.example.org"}}
+
+			syncEndpoints(cr, hc)
+
+			Expect(cr.Status.ConsoleURL).To(Equal("https://console-openshift-console.apps.tenant-a.example.org"))
+		})
+
+		It("should record the release image digest reported by HyperShift", func() {
+			hc.Spec.Release.Image = "quay.io/openshift-release-dev/ocp-release:4.19.0-multi"
+			hc.Status.Version = &hyperv1.ClusterVersionStatus{}
+			hc.Status.Version.Desired.Image = "quay.io/openshift-release-dev/ocp-release@sha256:abc123"
+			syncer = NewStatusSyncer(fakeClient.Build())
+
+			_, err := syncer.SyncStatusFromHostedCluster(ctx, cr)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cr.Status.ReleaseImageDigest).To(Equal("sha256:abc123"))
+		})
+
+		It("should record the digest of a digest-pinned spec release image", func() {
+			hc.Spec.Release.Image = "quay.io/openshift-release-dev/ocp-release@sha256:def456"
+			syncer = NewStatusSyncer(fakeClient.Build())
+
+			_, err := syncer.SyncStatusFromHostedCluster(ctx, cr)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cr.Status.ReleaseImageDigest).To(Equal("sha256:def456"))
+		})
+
+		It("should clear the release image digest when the release image is referenced by tag", func() {
+			cr.Status.ReleaseImageDigest = "sha256:stale"
+			hc.Spec.Release.Image = "quay.io/openshift-release-dev/ocp-release:4.19.1-multi"
+			syncer = NewStatusSyncer(fakeClient.Build())
+
+			_, err := syncer.SyncStatusFromHostedCluster(ctx, cr)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cr.Status.ReleaseImageDigest).To(BeEmpty())
+		})
 	})
 })
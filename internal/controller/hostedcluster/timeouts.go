@@ -0,0 +1,203 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const (
+	// DefaultHostedClusterAvailableTimeout is how long the HostedCluster may take to become Available by default
+	DefaultHostedClusterAvailableTimeout = 30 * time.Minute
+
+	// DefaultFirstNodeJoinedTimeout is how long the first DPU may take to join the hosted cluster by default
+	DefaultFirstNodeJoinedTimeout = time.Hour
+
+	// DefaultNodePoolReadyTimeout is how long the NodePools may take to have all their nodes Ready by default
+	DefaultNodePoolReadyTimeout = 2 * time.Hour
+)
+
+// provisioningStage is a stage of the provisioning bounded by spec.provisioningTimeouts
+type provisioningStage struct {
+	// condType is the condition set to True once the stage overran its timeout
+	condType string
+	timeout  time.Duration
+	// waitingFor and completed describe the stage in the condition messages
+	waitingFor string
+	completed  string
+}
+
+// CheckProvisioningTimeouts reports the provisioning stages that overran their timeout in spec.provisioningTimeouts:
+// the HostedCluster becoming Available (measured from its creation), and the first DPU joining the hosted cluster
+// and all nodes of the NodePools being Ready (both measured from the HostedCluster becoming Available).
+// Each stage sets its own condition, False while it is in progress and True with a Warning event once it timed out;
+// provisioning itself continues. A completed stage is not checked again, so later node failures are not reported
+// as provisioning timeouts.
+//
+// A RequeueAfter result is when the next stage times out; it does not indicate that reconciliation should stop.
+func (hm *HostedClusterManager) CheckProvisioningTimeouts(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	if cr.Status.HostedClusterRef == nil {
+		return ctrl.Result{}, nil
+	}
+
+	timeouts := cr.Spec.ProvisioningTimeouts
+	if timeouts == nil {
+		timeouts = &provisioningv1alpha1.ProvisioningTimeouts{}
+	}
+	var requeueAfter time.Duration
+	requeueAt := func(wait time.Duration) {
+		if wait > 0 && (requeueAfter == 0 || wait < requeueAfter) {
+			requeueAfter = wait
+		}
+	}
+
+	available := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.HostedClusterAvailable)
+	isAvailable := available != nil && available.Status == metav1.ConditionTrue
+
+	availableStage := provisioningStage{
+		condType:   provisioningv1alpha1.HostedClusterAvailableTimedOut,
+		timeout:    timeoutOrDefault(timeouts.HostedClusterAvailable, DefaultHostedClusterAvailableTimeout),
+		waitingFor: "the HostedCluster to become Available",
+		completed:  "The HostedCluster became Available",
+	}
+	if !stageCompleted(cr, availableStage.condType) {
+		hc := &hyperv1.HostedCluster{}
+		key := types.NamespacedName{Name: cr.Status.HostedClusterRef.Name, Namespace: cr.Status.HostedClusterRef.Namespace}
+		if err := hm.Get(ctx, key, hc); err != nil {
+			if apierrors.IsNotFound(err) {
+				return ctrl.Result{}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("failed to get HostedCluster for provisioning timeouts: %w", err)
+		}
+		requeueAt(hm.checkStage(ctx, cr, availableStage, hc.CreationTimestamp.Time, isAvailable))
+	}
+
+	// The node stages start once the control plane is Available, and need nodes to be expected
+	if !isAvailable || cr.IsSpare() {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	var desired int32
+	joined, ready := false, true
+	for _, np := range nodePoolStatuses(cr) {
+		desired += np.Replicas
+		joined = joined || np.ReadyReplicas > 0
+		ready = ready && np.ReadyReplicas >= np.Replicas
+	}
+	if desired == 0 {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	for _, stage := range []struct {
+		provisioningStage
+		done bool
+	}{
+		{provisioningStage{
+			condType:   provisioningv1alpha1.FirstNodeJoinTimedOut,
+			timeout:    timeoutOrDefault(timeouts.FirstNodeJoined, DefaultFirstNodeJoinedTimeout),
+			waitingFor: "the first DPU to join the hosted cluster",
+			completed:  "The first DPU joined the hosted cluster",
+		}, joined},
+		{provisioningStage{
+			condType:   provisioningv1alpha1.NodePoolReadyTimedOut,
+			timeout:    timeoutOrDefault(timeouts.NodePoolReady, DefaultNodePoolReadyTimeout),
+			waitingFor: "all nodes of the NodePools to be Ready",
+			completed:  "All nodes of the NodePools are Ready",
+		}, ready},
+	} {
+		if !stageCompleted(cr, stage.condType) {
+			requeueAt(hm.checkStage(ctx, cr, stage.provisioningStage, available.LastTransitionTime.Time, stage.done))
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// checkStage sets the timeout condition of a stage that started at start.
+// Returns how long until the stage times out, or 0 once it completed or timed out.
+func (hm *HostedClusterManager) checkStage(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, stage provisioningStage, start time.Time, done bool) time.Duration {
+	if stage.timeout <= 0 {
+		meta.RemoveStatusCondition(&cr.Status.Conditions, stage.condType)
+		return 0
+	}
+	if done {
+		setStageCondition(cr, stage.condType, metav1.ConditionFalse, provisioningv1alpha1.ReasonStageCompleted, stage.completed)
+		return 0
+	}
+
+	remaining := start.Add(stage.timeout).Sub(hm.clock())
+	if remaining > 0 {
+		setStageCondition(cr, stage.condType, metav1.ConditionFalse, provisioningv1alpha1.ReasonStageInProgress,
+			fmt.Sprintf("Waiting at most %s for %s", stage.timeout, stage.waitingFor))
+		return remaining
+	}
+
+	message := fmt.Sprintf("Timed out after %s waiting for %s", stage.timeout, stage.waitingFor)
+	if setStageCondition(cr, stage.condType, metav1.ConditionTrue, provisioningv1alpha1.ReasonStageTimedOut, message) {
+		logf.FromContext(ctx).Info("Provisioning stage timed out", "condition", stage.condType, "timeout", stage.timeout)
+		if hm.Recorder != nil {
+			hm.Recorder.Event(cr, corev1.EventTypeWarning, "ProvisioningStageTimedOut", message)
+		}
+	}
+	return 0
+}
+
+// stageCompleted returns true if the stage of the timeout condition condType completed
+func stageCompleted(cr *provisioningv1alpha1.DPFHCPBridge, condType string) bool {
+	cond := meta.FindStatusCondition(cr.Status.Conditions, condType)
+	return cond != nil && cond.Reason == provisioningv1alpha1.ReasonStageCompleted
+}
+
+// setStageCondition sets a provisioning stage timeout condition and returns true if it changed
+func setStageCondition(cr *provisioningv1alpha1.DPFHCPBridge, condType string, status metav1.ConditionStatus, reason, message string) bool {
+	return meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+		Type:               condType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: cr.Generation,
+	})
+}
+
+// nodePoolStatuses returns the reported status of the default NodePool and of the additional NodePools
+func nodePoolStatuses(cr *provisioningv1alpha1.DPFHCPBridge) []provisioningv1alpha1.NodePoolStatus {
+	var statuses []provisioningv1alpha1.NodePoolStatus
+	if cr.Status.NodePoolStatus != nil {
+		statuses = append(statuses, *cr.Status.NodePoolStatus)
+	}
+	return append(statuses, cr.Status.NodePools...)
+}
+
+// timeoutOrDefault returns the timeout d, or def if it is not set
+func timeoutOrDefault(d *metav1.Duration, def time.Duration) time.Duration {
+	if d == nil {
+		return def
+	}
+	return d.Duration
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Provisioning timeouts", func() {
+	var (
+		ctx      context.Context
+		cr       *provisioningv1alpha1.DPFHCPBridge
+		hc       *hyperv1.HostedCluster
+		hm       *HostedClusterManager
+		recorder *record.FakeRecorder
+		now      time.Time
+	)
+
+	check := func() time.Duration {
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(hc).Build()
+		hm = NewHostedClusterManager(c, scheme)
+		hm.Recorder = recorder
+		hm.now = func() time.Time { return now }
+
+		result, err := hm.CheckProvisioningTimeouts(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		return result.RequeueAfter
+	}
+
+	setAvailable := func(since time.Time) {
+		meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+			Type:               provisioningv1alpha1.HostedClusterAvailable,
+			Status:             metav1.ConditionTrue,
+			Reason:             "AsExpected",
+			LastTransitionTime: metav1.NewTime(since),
+		})
+	}
+
+	condition := func(condType string) *metav1.Condition {
+		return meta.FindStatusCondition(cr.Status.Conditions, condType)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = record.NewFakeRecorder(10)
+		now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", Generation: 1},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				HostedClusterRef: &corev1.ObjectReference{Name: "test-bridge", Namespace: "default"},
+			},
+		}
+		hc = &hyperv1.HostedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "test-bridge",
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(now.Add(-10 * time.Minute)),
+			},
+		}
+	})
+
+	It("should requeue when the HostedCluster Available stage times out", func() {
+		Expect(check()).To(Equal(20 * time.Minute))
+
+		cond := condition(provisioningv1alpha1.HostedClusterAvailableTimedOut)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonStageInProgress))
+		Expect(condition(provisioningv1alpha1.FirstNodeJoinTimedOut)).To(BeNil())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should report a HostedCluster that does not become Available in time", func() {
+		hc.CreationTimestamp = metav1.NewTime(now.Add(-31 * time.Minute))
+
+		Expect(check()).To(BeZero())
+
+		cond := condition(provisioningv1alpha1.HostedClusterAvailableTimedOut)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonStageTimedOut))
+		Expect(cond.Message).To(Equal("Timed out after 30m0s waiting for the HostedCluster to become Available"))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Warning ProvisioningStageTimedOut")))
+
+		// The event is only recorded when the stage times out
+		check()
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should time the node stages from the HostedCluster becoming Available", func() {
+		setAvailable(now.Add(-45 * time.Minute))
+		cr.Status.NodePoolStatus = &provisioningv1alpha1.NodePoolStatus{Replicas: 2}
+
+		Expect(check()).To(Equal(15 * time.Minute))
+
+		Expect(condition(provisioningv1alpha1.HostedClusterAvailableTimedOut).Reason).To(Equal(provisioningv1alpha1.ReasonStageCompleted))
+		Expect(condition(provisioningv1alpha1.FirstNodeJoinTimedOut).Reason).To(Equal(provisioningv1alpha1.ReasonStageInProgress))
+		Expect(condition(provisioningv1alpha1.NodePoolReadyTimedOut).Reason).To(Equal(provisioningv1alpha1.ReasonStageInProgress))
+	})
+
+	It("should report the stage that is stuck", func() {
+		setAvailable(now.Add(-3 * time.Hour))
+		cr.Status.NodePoolStatus = &provisioningv1alpha1.NodePoolStatus{Replicas: 2, ReadyReplicas: 1}
+
+		Expect(check()).To(BeZero())
+
+		Expect(condition(provisioningv1alpha1.FirstNodeJoinTimedOut).Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition(provisioningv1alpha1.FirstNodeJoinTimedOut).Reason).To(Equal(provisioningv1alpha1.ReasonStageCompleted))
+		Expect(condition(provisioningv1alpha1.NodePoolReadyTimedOut).Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition(provisioningv1alpha1.NodePoolReadyTimedOut).Message).To(
+			Equal("Timed out after 2h0m0s waiting for all nodes of the NodePools to be Ready"))
+	})
+
+	It("should not check a completed stage again", func() {
+		setAvailable(now.Add(-3 * time.Hour))
+		cr.Status.NodePoolStatus = &provisioningv1alpha1.NodePoolStatus{Replicas: 2, ReadyReplicas: 2}
+		check()
+		Expect(condition(provisioningv1alpha1.NodePoolReadyTimedOut).Reason).To(Equal(provisioningv1alpha1.ReasonStageCompleted))
+
+		cr.Status.NodePoolStatus.ReadyReplicas = 1
+		check()
+
+		Expect(condition(provisioningv1alpha1.NodePoolReadyTimedOut).Reason).To(Equal(provisioningv1alpha1.ReasonStageCompleted))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should honor configured timeouts and disable a stage with a zero timeout", func() {
+		setAvailable(now.Add(-20 * time.Minute))
+		cr.Status.NodePoolStatus = &provisioningv1alpha1.NodePoolStatus{Replicas: 2}
+		cr.Spec.ProvisioningTimeouts = &provisioningv1alpha1.ProvisioningTimeouts{
+			FirstNodeJoined: &metav1.Duration{Duration: 15 * time.Minute},
+			NodePoolReady:   &metav1.Duration{},
+		}
+
+		check()
+
+		Expect(condition(provisioningv1alpha1.FirstNodeJoinTimedOut).Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition(provisioningv1alpha1.NodePoolReadyTimedOut)).To(BeNil())
+	})
+})
@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"fmt"
+	"strings"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+const (
+	// TimeSyncConfigMapSuffix is appended to the bridge name to name the NodePool config ConfigMap
+	// holding the chrony MachineConfig
+	TimeSyncConfigMapSuffix = "-timesync"
+
+	// chronyConfigPath is where chrony reads its configuration from on the DPU workers
+	chronyConfigPath = "/etc/chrony.conf"
+)
+
+// timeSyncConfigSource renders spec.timeSync into the NodePool config
+var timeSyncConfigSource = nodePoolConfigSource{
+	description:   "time sync",
+	component:     common.ComponentTimeSync,
+	configMapName: TimeSyncConfigMapName,
+	enabled: func(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+		return cr.Spec.TimeSync != nil
+	},
+	render: renderTimeSyncMachineConfig,
+}
+
+// TimeSyncConfigMapName returns the name of the NodePool config ConfigMap of spec.timeSync
+func TimeSyncConfigMapName(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	return cr.Name + TimeSyncConfigMapSuffix
+}
+
+// renderChronyConfig returns the chrony configuration synchronizing with the given NTP servers.
+// makestep without a limit lets chrony step the clock at any time rather than only at boot, since
+// BlueField cards on isolated management networks drift far enough to fail TLS.
+func renderChronyConfig(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Generated from spec.timeSync of DPFHCPBridge %s/%s\n", cr.Namespace, cr.Name)
+	for _, server := range cr.Spec.TimeSync.Servers {
+		fmt.Fprintf(&b, "server %s iburst\n", server)
+	}
+	b.WriteString("driftfile /var/lib/chrony/drift\n")
+	b.WriteString("makestep 1.0 -1\n")
+	b.WriteString("rtcsync\n")
+	b.WriteString("logdir /var/log/chrony\n")
+	return b.String()
+}
+
+// renderTimeSyncMachineConfig returns the MachineConfig writing the chrony configuration on the DPU workers
+func renderTimeSyncMachineConfig(cr *provisioningv1alpha1.DPFHCPBridge) (string, error) {
+	return renderMachineConfig("50-dpf-hcp-bridge-chrony", map[string]any{
+		"config": map[string]any{
+			"ignition": map[string]any{"version": "3.2.0"},
+			"storage": map[string]any{
+				"files": []any{machineConfigFile(chronyConfigPath, renderChronyConfig(cr))},
+			},
+		},
+	})
+}
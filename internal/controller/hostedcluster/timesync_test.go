@@ -0,0 +1,178 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedcluster
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+var _ = Describe("Time synchronization", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		cr     *provisioningv1alpha1.DPFHCPBridge
+		cmKey  types.NamespacedName
+	)
+
+	// chronyConfigOf decodes the chrony configuration from the MachineConfig of the ConfigMap
+	chronyConfigOf := func(cm *corev1.ConfigMap) string {
+		var machineConfig struct {
+			Kind     string            `json:"kind"`
+			Metadata metav1.ObjectMeta `json:"metadata"`
+			Spec     struct {
+				Config struct {
+					Storage struct {
+						Files []struct {
+							Path     string `json:"path"`
+							Contents struct {
+								Source string `json:"source"`
+							} `json:"contents"`
+						} `json:"files"`
+					} `json:"storage"`
+				} `json:"config"`
+			} `json:"spec"`
+		}
+		ExpectWithOffset(1, yaml.Unmarshal([]byte(cm.Data[nodePoolConfigKey]), &machineConfig)).To(Succeed())
+		ExpectWithOffset(1, machineConfig.Kind).To(Equal("MachineConfig"))
+		ExpectWithOffset(1, machineConfig.Metadata.Labels).To(HaveKeyWithValue("machineconfiguration.openshift.io/role", "worker"))
+		ExpectWithOffset(1, machineConfig.Spec.Config.Storage.Files).To(HaveLen(1))
+		file := machineConfig.Spec.Config.Storage.Files[0]
+		ExpectWithOffset(1, file.Path).To(Equal(chronyConfigPath))
+		encoded, ok := strings.CutPrefix(file.Contents.Source, "data:text/plain;charset=utf-8;base64,")
+		ExpectWithOffset(1, ok).To(BeTrue())
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return string(decoded)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", UID: "bridge-uid"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+				TimeSync: &provisioningv1alpha1.TimeSyncSpec{
+					Servers: []string{"ntp1.example.com", "10.0.0.123"},
+				},
+			},
+		}
+		cmKey = types.NamespacedName{Name: "test-bridge-timesync", Namespace: "default"}
+	})
+
+	It("should render a chrony configuration stepping the clock at any offset", func() {
+		config := renderChronyConfig(cr)
+
+		Expect(config).To(ContainSubstring("server ntp1.example.com iburst\n"))
+		Expect(config).To(ContainSubstring("server 10.0.0.123 iburst\n"))
+		Expect(config).To(ContainSubstring("makestep 1.0 -1\n"))
+	})
+
+	It("should reference the time sync ConfigMap from new NodePools only with spec.timeSync", func() {
+		np := (&NodePoolManager{}).buildNodePool(cr)
+		Expect(np.Spec.Config).To(Equal([]corev1.LocalObjectReference{{Name: cmKey.Name}}))
+
+		cr.Spec.TimeSync = nil
+		np = (&NodePoolManager{}).buildNodePool(cr)
+		Expect(np.Spec.Config).To(BeNil())
+	})
+
+	It("should create the ConfigMap before the NodePool", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		_, err := NewNodePoolManager(c, scheme).CreateNodePool(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, cmKey, cm)).To(Succeed())
+		Expect(cm.Labels).To(HaveKeyWithValue(common.LabelComponent, common.ComponentTimeSync))
+		Expect(metav1.IsControlledBy(cm, cr)).To(BeTrue())
+		Expect(chronyConfigOf(cm)).To(Equal(renderChronyConfig(cr)))
+
+		np := &hyperv1.NodePool{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "test-bridge", Namespace: "default"}, np)).To(Succeed())
+		Expect(np.Spec.Config).To(ContainElement(corev1.LocalObjectReference{Name: cmKey.Name}))
+	})
+
+	It("should add, refresh and remove the configuration of an existing NodePool", func() {
+		withoutTimeSync := cr.DeepCopy()
+		withoutTimeSync.Spec.TimeSync = nil
+		np := (&NodePoolManager{}).buildNodePool(withoutTimeSync)
+		np.Spec.Config = []corev1.LocalObjectReference{{Name: "user-config"}}
+		np.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(cr, provisioningv1alpha1.GroupVersion.WithKind("DPFHCPBridge"))}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(np).Build()
+		npm := NewNodePoolManager(c, scheme)
+		npKey := client.ObjectKeyFromObject(np)
+
+		_, err := npm.SyncNodePoolReplicas(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, npKey, np)).To(Succeed())
+		Expect(np.Spec.Config).To(Equal([]corev1.LocalObjectReference{{Name: "user-config"}, {Name: cmKey.Name}}))
+
+		cr.Spec.TimeSync.Servers = []string{"ntp2.example.com"}
+		_, err = npm.SyncNodePoolReplicas(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, cmKey, cm)).To(Succeed())
+		Expect(chronyConfigOf(cm)).To(ContainSubstring("server ntp2.example.com iburst\n"))
+		Expect(chronyConfigOf(cm)).NotTo(ContainSubstring("ntp1.example.com"))
+
+		cr.Spec.TimeSync = nil
+		_, err = npm.SyncNodePoolReplicas(ctx, cr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, npKey, np)).To(Succeed())
+		Expect(np.Spec.Config).To(Equal([]corev1.LocalObjectReference{{Name: "user-config"}}))
+		err = c.Get(ctx, cmKey, &corev1.ConfigMap{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not overwrite a ConfigMap that is not owned by the bridge", func() {
+		foreign := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: cmKey.Name, Namespace: cmKey.Namespace},
+			Data:       map[string]string{nodePoolConfigKey: "foreign"},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(foreign).Build()
+
+		_, err := NewNodePoolManager(c, scheme).CreateNodePool(ctx, cr)
+		Expect(err).To(MatchError(ContainSubstring("not owned by this DPFHCPBridge")))
+
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, cmKey, cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue(nodePoolConfigKey, "foreign"))
+	})
+})
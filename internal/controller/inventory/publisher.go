@@ -0,0 +1,315 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inventory publishes a periodic report of the DPFHCPBridges of the fleet for asset management:
+// their OCP version, DPUClusters, phase and the API endpoint of their hosted cluster.
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const (
+	// DefaultReportInterval is how often the inventory report is published
+	DefaultReportInterval = 5 * time.Minute
+
+	// DefaultPushTimeout bounds each push of the report to the HTTP endpoint
+	DefaultPushTimeout = 30 * time.Second
+
+	// ReportKey is the key of the report in the inventory ConfigMap
+	ReportKey = "inventory.json"
+
+	// LabelInventory is the label key identifying inventory ConfigMaps published by the operator
+	LabelInventory = "dpf-hcp-bridge-operator/inventory"
+)
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;update
+
+// Snapshot is the inventory of the DPFHCPBridges of this operator instance
+type Snapshot struct {
+	// GeneratedAt is when the report was generated
+	GeneratedAt metav1.Time `json:"generatedAt"`
+
+	// Bridges are the DPFHCPBridges, sorted by namespace and name
+	Bridges []Bridge `json:"bridges"`
+}
+
+// Bridge is the inventory entry of a DPFHCPBridge
+type Bridge struct {
+	// Namespace is the namespace of the DPFHCPBridge
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the DPFHCPBridge
+	Name string `json:"name"`
+
+	// OCPVersion is the OCP version of the release image, empty until it is known
+	OCPVersion string `json:"ocpVersion,omitempty"`
+
+	// OCPReleaseImage is the release image of the hosted cluster, empty until it is resolved
+	OCPReleaseImage string `json:"ocpReleaseImage,omitempty"`
+
+	// DPUClusters are the DPUClusters backing the hosted cluster, empty until they are resolved
+	DPUClusters []provisioningv1alpha1.DPUClusterReference `json:"dpuClusters,omitempty"`
+
+	// Phase is the phase of the DPFHCPBridge
+	Phase provisioningv1alpha1.DPFHCPBridgePhase `json:"phase,omitempty"`
+
+	// Endpoint is the URL of the hosted cluster API server, empty until HyperShift reports it
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// Publisher periodically publishes the inventory Snapshot into a ConfigMap and/or pushes it to an
+// HTTP endpoint, replacing scripts that scrape the bridges and HostedClusters.
+type Publisher struct {
+	client.Client
+
+	// Interval is how often the report is published
+	Interval time.Duration
+
+	// ConfigMapNamespace and ConfigMapName name the ConfigMap the report is published into under
+	// ReportKey; no ConfigMap is written if ConfigMapName is empty
+	ConfigMapNamespace string
+	ConfigMapName      string
+
+	// URL is the HTTP endpoint the report is POSTed to as JSON; nothing is pushed if it is empty
+	URL string
+
+	// TokenFile, if set, is read before every push and sent as bearer token, so that it can be rotated
+	TokenFile string
+
+	// HTTPClient is used to push the report; http.DefaultClient is used if it is nil
+	HTTPClient *http.Client
+
+	// Timeout bounds each push of the report; no timeout if 0
+	Timeout time.Duration
+
+	// ShardSelector, if set, restricts the report to the bridges of this operator instance
+	ShardSelector labels.Selector
+}
+
+// NewPublisher creates a new Publisher with the default interval and push timeout
+func NewPublisher(c client.Client) *Publisher {
+	return &Publisher{
+		Client:   c,
+		Interval: DefaultReportInterval,
+		Timeout:  DefaultPushTimeout,
+	}
+}
+
+// Start implements manager.Runnable. It publishes the report until the context is cancelled.
+// Being a leader election runnable, it runs on the elected instance only.
+func (p *Publisher) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithValues("feature", "inventory-report")
+	ctx = logf.IntoContext(ctx, log)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := p.Publish(ctx); err != nil {
+			log.Error(err, "Failed to publish inventory report")
+		}
+	}, p.Interval)
+	return nil
+}
+
+// Publish generates the report and publishes it to every configured destination. A failing
+// destination does not prevent publishing to the other one.
+func (p *Publisher) Publish(ctx context.Context) error {
+	report, err := p.Generate(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to encode inventory report: %w", err)
+	}
+
+	var errs []error
+	if p.ConfigMapName != "" {
+		if err := p.writeConfigMap(ctx, data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if p.URL != "" {
+		if err := p.push(ctx, data); err != nil {
+			errs = append(errs, fmt.Errorf("failed to push inventory report to %s: %w", p.URL, err))
+		}
+	}
+	if len(errs) == 0 {
+		logf.FromContext(ctx).V(1).Info("Published inventory report", "bridges", len(report.Bridges))
+	}
+	return errors.Join(errs...)
+}
+
+// Generate lists the bridges and their HostedClusters and builds the report
+func (p *Publisher) Generate(ctx context.Context) (*Snapshot, error) {
+	opts := []client.ListOption{}
+	if p.ShardSelector != nil {
+		opts = append(opts, client.MatchingLabelsSelector{Selector: p.ShardSelector})
+	}
+	var bridges provisioningv1alpha1.DPFHCPBridgeList
+	if err := p.List(ctx, &bridges, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list DPFHCPBridges: %w", err)
+	}
+
+	report := &Snapshot{
+		GeneratedAt: metav1.Now(),
+		Bridges:     make([]Bridge, 0, len(bridges.Items)),
+	}
+	for i := range bridges.Items {
+		cr := &bridges.Items[i]
+		endpoint, err := p.endpoint(ctx, cr)
+		if err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", cr.Namespace, cr.Name, err)
+		}
+		report.Bridges = append(report.Bridges, Bridge{
+			Namespace:       cr.Namespace,
+			Name:            cr.Name,
+			OCPVersion:      cr.Status.OCPVersion,
+			OCPReleaseImage: cr.ResolvedOCPReleaseImage(),
+			DPUClusters:     cr.ResolvedDPUClusterRefs(),
+			Phase:           cr.Status.Phase,
+			Endpoint:        endpoint,
+		})
+	}
+	sort.Slice(report.Bridges, func(i, j int) bool {
+		a, b := report.Bridges[i], report.Bridges[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return report, nil
+}
+
+// endpoint returns the URL of the API server of the bridge's HostedCluster, or an empty string while
+// the HostedCluster or its control plane endpoint does not exist yet
+func (p *Publisher) endpoint(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (string, error) {
+	if cr.Status.HostedClusterRef == nil {
+		return "", nil
+	}
+	hc := &hyperv1.HostedCluster{}
+	key := types.NamespacedName{Name: cr.Status.HostedClusterRef.Name, Namespace: cr.Status.HostedClusterRef.Namespace}
+	if err := p.Get(ctx, key, hc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get HostedCluster: %w", err)
+	}
+
+	apiEndpoint := hc.Status.ControlPlaneEndpoint
+	if apiEndpoint.Host == "" {
+		return "", nil
+	}
+	return "https://" + net.JoinHostPort(apiEndpoint.Host, strconv.Itoa(int(apiEndpoint.Port))), nil
+}
+
+// writeConfigMap creates or updates the inventory ConfigMap. A ConfigMap with the same name that
+// lacks LabelInventory is not overwritten.
+func (p *Publisher) writeConfigMap(ctx context.Context, data []byte) error {
+	key := types.NamespacedName{Name: p.ConfigMapName, Namespace: p.ConfigMapNamespace}
+	existing := &corev1.ConfigMap{}
+	if err := p.Get(ctx, key, existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get inventory ConfigMap %s: %w", key, err)
+		}
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      p.ConfigMapName,
+				Namespace: p.ConfigMapNamespace,
+				Labels: map[string]string{
+					LabelInventory: "true",
+				},
+			},
+			Data: map[string]string{
+				ReportKey: string(data),
+			},
+		}
+		if err := p.Create(ctx, configMap); err != nil {
+			return fmt.Errorf("failed to create inventory ConfigMap %s: %w", key, err)
+		}
+		return nil
+	}
+
+	if existing.Labels[LabelInventory] != "true" {
+		return fmt.Errorf("ConfigMap %s exists and is not an inventory ConfigMap, refusing to overwrite it", key)
+	}
+	existing.Data = map[string]string{
+		ReportKey: string(data),
+	}
+	if err := p.Update(ctx, existing); err != nil {
+		return fmt.Errorf("failed to update inventory ConfigMap %s: %w", key, err)
+	}
+	return nil
+}
+
+// push POSTs the report to the HTTP endpoint
+func (p *Publisher) push(ctx context.Context, data []byte) error {
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.URL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.TokenFile != "" {
+		token, err := os.ReadFile(p.TokenFile)
+		if err != nil {
+			return fmt.Errorf("failed to read push token: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+
+	httpClient := p.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
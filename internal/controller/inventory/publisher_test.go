@@ -0,0 +1,190 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Publisher", func() {
+	var (
+		ctx       context.Context
+		c         client.Client
+		publisher *Publisher
+	)
+
+	newBridge := func(namespace, name, shard string) *provisioningv1alpha1.DPFHCPBridge {
+		return &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: map[string]string{"shard": shard}},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef:   provisioningv1alpha1.DPUClusterReference{Name: name + "-dpu", Namespace: "dpf"},
+				OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.0-x86_64",
+			},
+		}
+	}
+
+	getSnapshot := func() *Snapshot {
+		configMap := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "bridge-inventory", Namespace: "operator"}, configMap)).To(Succeed())
+		report := &Snapshot{}
+		Expect(json.Unmarshal([]byte(configMap.Data[ReportKey]), report)).To(Succeed())
+		return report
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+
+		ready := newBridge("tenant-b", "bridge", "a")
+		ready.Status = provisioningv1alpha1.DPFHCPBridgeStatus{
+			Phase:            provisioningv1alpha1.PhaseReady,
+			OCPVersion:       "4.19.0",
+			HostedClusterRef: &corev1.ObjectReference{Name: "bridge", Namespace: "tenant-b"},
+		}
+		hc := &hyperv1.HostedCluster{ObjectMeta: metav1.ObjectMeta{Name: "bridge", Namespace: "tenant-b"}}
+		hc.Status.ControlPlaneEndpoint = hyperv1.APIEndpoint{Host: "api.bridge.example.com", Port: 6443}
+
+		c = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(ready, hc, newBridge("tenant-a", "pending", "a"), newBridge("tenant-a", "other-shard", "b")).
+			Build()
+
+		publisher = NewPublisher(c)
+		publisher.ShardSelector = labels.SelectorFromSet(labels.Set{"shard": "a"})
+	})
+
+	It("should list the bridges of the shard sorted by namespace and name", func() {
+		report, err := publisher.Generate(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(report.Bridges).To(Equal([]Bridge{
+			{
+				Namespace:       "tenant-a",
+				Name:            "pending",
+				OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.0-x86_64",
+				DPUClusters:     []provisioningv1alpha1.DPUClusterReference{{Name: "pending-dpu", Namespace: "dpf"}},
+			},
+			{
+				Namespace:       "tenant-b",
+				Name:            "bridge",
+				OCPVersion:      "4.19.0",
+				OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.0-x86_64",
+				DPUClusters:     []provisioningv1alpha1.DPUClusterReference{{Name: "bridge-dpu", Namespace: "dpf"}},
+				Phase:           provisioningv1alpha1.PhaseReady,
+				Endpoint:        "https://api.bridge.example.com:6443",
+			},
+		}))
+	})
+
+	It("should create and update the inventory ConfigMap", func() {
+		publisher.ConfigMapNamespace = "operator"
+		publisher.ConfigMapName = "bridge-inventory"
+
+		Expect(publisher.Publish(ctx)).To(Succeed())
+		Expect(getSnapshot().Bridges).To(HaveLen(2))
+
+		Expect(c.Delete(ctx, &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "tenant-a"},
+		})).To(Succeed())
+		Expect(publisher.Publish(ctx)).To(Succeed())
+		Expect(getSnapshot().Bridges).To(ConsistOf(HaveField("Name", "bridge")))
+	})
+
+	It("should not overwrite a ConfigMap it did not create", func() {
+		publisher.ConfigMapNamespace = "operator"
+		publisher.ConfigMapName = "bridge-inventory"
+		Expect(c.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "bridge-inventory", Namespace: "operator"},
+			Data:       map[string]string{"owner": "someone-else"},
+		})).To(Succeed())
+
+		Expect(publisher.Publish(ctx)).To(MatchError(ContainSubstring("refusing to overwrite")))
+
+		configMap := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "bridge-inventory", Namespace: "operator"}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(Equal(map[string]string{"owner": "someone-else"}))
+	})
+
+	Context("when pushing to an HTTP endpoint", func() {
+		var (
+			server        *httptest.Server
+			status        int
+			authorization string
+			received      *Snapshot
+		)
+
+		BeforeEach(func() {
+			status = http.StatusNoContent
+			received = nil
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Method).To(Equal(http.MethodPost))
+				Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+				authorization = r.Header.Get("Authorization")
+				body, err := io.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				received = &Snapshot{}
+				Expect(json.Unmarshal(body, received)).To(Succeed())
+				w.WriteHeader(status)
+			}))
+			DeferCleanup(server.Close)
+			publisher.URL = server.URL
+		})
+
+		It("should POST the report with the bearer token", func() {
+			publisher.TokenFile = filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(publisher.TokenFile, []byte("s3cr3t\n"), 0o600)).To(Succeed())
+
+			Expect(publisher.Publish(ctx)).To(Succeed())
+			Expect(received).NotTo(BeNil())
+			Expect(received.Bridges).To(HaveLen(2))
+			Expect(authorization).To(Equal("Bearer s3cr3t"))
+		})
+
+		It("should fail when the endpoint rejects the report but still write the ConfigMap", func() {
+			status = http.StatusInternalServerError
+			publisher.ConfigMapNamespace = "operator"
+			publisher.ConfigMapName = "bridge-inventory"
+
+			Expect(publisher.Publish(ctx)).To(MatchError(ContainSubstring("500")))
+			Expect(authorization).To(BeEmpty())
+			Expect(getSnapshot().Bridges).To(HaveLen(2))
+		})
+	})
+})
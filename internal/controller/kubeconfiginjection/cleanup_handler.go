@@ -19,15 +19,17 @@ package kubeconfiginjection
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/finalizer"
 )
 
 // CleanupHandler handles cleanup of kubeconfig secrets created in DPUCluster namespace
@@ -36,9 +38,18 @@ import (
 // This handler is responsible for:
 // 1. Finding kubeconfig secrets by labels (owned by this DPFHCPBridge)
 // 2. Deleting all found kubeconfig secrets
+// 3. Refreshing the merged kubeconfig Secret without this bridge (if enabled)
+// 4. Deleting the kubeconfig replica in the DPF operator namespace (if enabled)
+// 5. Deleting the kubeconfig exported to the Secret named by spec.kubeconfigExport
 type CleanupHandler struct {
 	client   client.Client
 	recorder record.EventRecorder
+
+	// PublishMergedKubeconfig enables refreshing the namespace-wide merged kubeconfig Secret
+	PublishMergedKubeconfig bool
+
+	// ReplicaNamespace is the DPF operator namespace kubeconfig replicas are deleted from
+	ReplicaNamespace string
 }
 
 // NewCleanupHandler creates a new kubeconfig cleanup handler
@@ -57,18 +68,15 @@ func (h *CleanupHandler) Name() string {
 // Cleanup deletes the kubeconfig secret created in DPUCluster namespace.
 // This is called during finalizer cleanup when the DPFHCPBridge is deleted.
 //
-// The cleanup process:
-// 1. List all secrets with labels matching this DPFHCPBridge
-// 2. Delete each found secret
-//
-// Labels used for finding secrets:
+// The kubeconfig secret lives in another namespace and cannot have an OwnerReference,
+// so it is found and deleted by its ownership labels (see common.DeleteOwnedObjects):
 // - dpf-hcp-bridge-operator/owned-by: <bridge-name>
 // - dpf-hcp-bridge-operator/namespace: <bridge-namespace>
 //
 // Returns:
-// - nil if cleanup succeeded or secrets are already gone
+// - ctrl.Result{}, nil if cleanup succeeded or secrets are already gone
 // - error if cleanup failed and should be retried
-func (h *CleanupHandler) Cleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) error {
+func (h *CleanupHandler) Cleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
 	log := logf.FromContext(ctx).WithValues(
 		"handler", h.Name(),
 		common.DPFHCPBridgeName, fmt.Sprintf("%s/%s", cr.Namespace, cr.Name),
@@ -76,49 +84,53 @@ func (h *CleanupHandler) Cleanup(ctx context.Context, cr *provisioningv1alpha1.D
 
 	log.Info("Cleaning up kubeconfig secrets")
 
-	// Find kubeconfig secret by labels
-	secretList := &corev1.SecretList{}
-	err := h.client.List(ctx, secretList,
-		client.MatchingLabels{
-			LabelOwnedBy:   cr.Name,
-			LabelNamespace: cr.Namespace,
-		})
+	if h.PublishMergedKubeconfig {
+		if err := PublishMergedKubeconfig(ctx, h.client, cr.Namespace, cr.Name); err != nil {
+			log.Error(err, "Failed to refresh merged kubeconfig")
+			return ctrl.Result{}, fmt.Errorf("failed to refresh merged kubeconfig: %w", err)
+		}
+	}
+
+	if h.ReplicaNamespace != "" {
+		deletedCount, err := common.DeleteOwnedObjects(ctx, h.client, cr, &corev1.SecretList{},
+			h.ReplicaNamespace, common.ComponentIn(common.ComponentKubeconfigReplica))
+		if err != nil {
+			log.Error(err, "Failed to delete kubeconfig replica")
+			return ctrl.Result{}, fmt.Errorf("failed to delete kubeconfig replica: %w", err)
+		}
+		log.Info("Deleted kubeconfig replicas", "namespace", h.ReplicaNamespace, "deletedCount", deletedCount)
+	}
+
+	// The export may be in any namespace, and the spec may have been changed since it was created
+	exportedCount, err := common.DeleteOwnedObjects(ctx, h.client, cr, &corev1.SecretList{},
+		"", common.ComponentIn(common.ComponentKubeconfigExport))
 	if err != nil {
-		log.Error(err, "Failed to list kubeconfig secrets")
-		return fmt.Errorf("failed to list kubeconfig secrets: %w", err)
+		log.Error(err, "Failed to delete exported kubeconfig")
+		return ctrl.Result{}, fmt.Errorf("failed to delete exported kubeconfig: %w", err)
+	}
+	if exportedCount > 0 {
+		log.Info("Deleted exported kubeconfigs", "deletedCount", exportedCount)
 	}
 
-	if len(secretList.Items) == 0 {
-		log.Info("No kubeconfig secrets found, nothing to clean up")
-		return nil
+	// A dpuClusterSelector that was never resolved means no kubeconfig was ever injected
+	namespaces := dpuClusterNamespaces(cr)
+	if len(namespaces) == 0 {
+		log.Info("DPUCluster was never resolved, no kubeconfig secrets to clean up")
+		return ctrl.Result{}, nil
 	}
 
-	// Delete found secrets
+	// Delete the kubeconfig secrets labelled as owned by this bridge in the DPUCluster namespaces.
+	// Secrets injected before component labels were introduced carry only the ownership labels,
+	// so everything but the HostedCluster secrets (deleted later by the HostedCluster handler) is matched.
 	deletedCount := 0
-	for i := range secretList.Items {
-		secret := &secretList.Items[i]
-		log.Info("Deleting kubeconfig secret",
-			"secretName", secret.Name,
-			"namespace", secret.Namespace)
-
-		if err := h.client.Delete(ctx, secret); err != nil {
-			if apierrors.IsNotFound(err) {
-				// Already deleted (race condition)
-				log.V(1).Info("Kubeconfig secret already deleted",
-					"secretName", secret.Name,
-					"namespace", secret.Namespace)
-				continue
-			}
-			log.Error(err, "Failed to delete kubeconfig secret",
-				"secretName", secret.Name,
-				"namespace", secret.Namespace)
-			return fmt.Errorf("failed to delete kubeconfig secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	for _, namespace := range namespaces {
+		deleted, err := common.DeleteOwnedObjects(ctx, h.client, cr, &corev1.SecretList{},
+			namespace, common.ComponentNotIn(common.ComponentHostedClusterSecrets))
+		if err != nil {
+			log.Error(err, "Failed to delete kubeconfig secrets", "namespace", namespace)
+			return ctrl.Result{}, fmt.Errorf("failed to delete kubeconfig secrets: %w", err)
 		}
-
-		deletedCount++
-		log.Info("Kubeconfig secret deleted successfully",
-			"secretName", secret.Name,
-			"namespace", secret.Namespace)
+		deletedCount += deleted
 	}
 
 	log.Info("Kubeconfig cleanup completed successfully",
@@ -126,5 +138,49 @@ func (h *CleanupHandler) Cleanup(ctx context.Context, cr *provisioningv1alpha1.D
 	h.recorder.Eventf(cr, "Normal", "KubeconfigCleanupSucceeded",
 		"Deleted %d kubeconfig secret(s)", deletedCount)
 
-	return nil
+	return ctrl.Result{}, nil
+}
+
+// dpuClusterNamespaces returns the distinct namespaces of the DPUClusters of the bridge
+func dpuClusterNamespaces(cr *provisioningv1alpha1.DPFHCPBridge) []string {
+	var namespaces []string
+	for _, ref := range cr.ResolvedDPUClusterRefs() {
+		if !slices.Contains(namespaces, ref.Namespace) {
+			namespaces = append(namespaces, ref.Namespace)
+		}
+	}
+	return namespaces
+}
+
+// PreviewCleanup returns the kubeconfig secrets Cleanup would delete: the replicas, the exported
+// kubeconfigs and the secrets in the DPUCluster namespaces. The merged kubeconfig is refreshed, not deleted.
+func (h *CleanupHandler) PreviewCleanup(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) ([]provisioningv1alpha1.CleanupResource, error) {
+	var secrets []client.Object
+
+	if h.ReplicaNamespace != "" {
+		replicas, err := common.ListOwnedObjects(ctx, h.client, cr, &corev1.SecretList{},
+			h.ReplicaNamespace, common.ComponentIn(common.ComponentKubeconfigReplica))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list kubeconfig replicas: %w", err)
+		}
+		secrets = append(secrets, replicas...)
+	}
+
+	exported, err := common.ListOwnedObjects(ctx, h.client, cr, &corev1.SecretList{},
+		"", common.ComponentIn(common.ComponentKubeconfigExport))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exported kubeconfigs: %w", err)
+	}
+	secrets = append(secrets, exported...)
+
+	for _, namespace := range dpuClusterNamespaces(cr) {
+		injected, err := common.ListOwnedObjects(ctx, h.client, cr, &corev1.SecretList{},
+			namespace, common.ComponentNotIn(common.ComponentHostedClusterSecrets))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list kubeconfig secrets: %w", err)
+		}
+		secrets = append(secrets, injected...)
+	}
+
+	return finalizer.CleanupResources("Secret", secrets), nil
 }
@@ -0,0 +1,161 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfiginjection
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+// ExportKubeconfigKey is the data key of the exported kubeconfig Secret
+const ExportKubeconfigKey = "kubeconfig"
+
+// ExportSecretKey returns the Secret the admin kubeconfig of the bridge is exported to,
+// or nil if spec.kubeconfigExport is not set
+func ExportSecretKey(bridge *provisioningv1alpha1.DPFHCPBridge) *types.NamespacedName {
+	export := bridge.Spec.KubeconfigExport
+	if export == nil {
+		return nil
+	}
+	namespace := export.Namespace
+	if namespace == "" {
+		namespace = bridge.Namespace
+	}
+	return &types.NamespacedName{Name: export.Name, Namespace: namespace}
+}
+
+// exportKubeconfig keeps the Secret named by spec.kubeconfigExport in sync with the HostedCluster
+// admin kubeconfig, and deletes the exports of this bridge the spec no longer names.
+//
+// The export may live in any namespace and cannot have an OwnerReference, so it is tracked by
+// its ownership labels. A Secret of the same name that is not owned by this bridge is never overwritten.
+func (ki *KubeconfigInjector) exportKubeconfig(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge) error {
+	desired := ExportSecretKey(bridge)
+	if err := ki.deleteStaleExports(ctx, bridge, desired); err != nil {
+		return err
+	}
+	if desired == nil {
+		return nil
+	}
+
+	log := logf.FromContext(ctx).WithValues("kubeconfigExport", desired.String())
+
+	source := &corev1.Secret{}
+	sourceKey := types.NamespacedName{Name: bridge.Name + KubeconfigSecretSuffix, Namespace: bridge.Namespace}
+	if err := ki.Client.Get(ctx, sourceKey, source); err != nil {
+		return fmt.Errorf("failed to read source kubeconfig secret: %w", err)
+	}
+	sourceData, ok := source.Data["kubeconfig"]
+	if !ok {
+		return fmt.Errorf("source secret missing 'kubeconfig' key")
+	}
+	kubeconfigData := destinationKubeconfig(ctx, bridge, sourceData)
+	sourceHash := kubeconfigHash(sourceData)
+	exportLabels := common.ComponentOwnerLabels(bridge, common.ComponentKubeconfigExport)
+
+	existing := &corev1.Secret{}
+	err := ki.Client.Get(ctx, *desired, existing)
+	if apierrors.IsNotFound(err) {
+		export := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      desired.Name,
+				Namespace: desired.Namespace,
+				// Possibly cross-namespace: garbage collected by label from the bridge finalizer
+				Labels: exportLabels,
+				Annotations: map[string]string{
+					AnnotationSourceHash: sourceHash,
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				ExportKubeconfigKey: kubeconfigData,
+			},
+		}
+		if err := ki.Client.Create(ctx, export); err != nil {
+			return fmt.Errorf("failed to create exported kubeconfig secret: %w", err)
+		}
+		log.Info("Exported kubeconfig")
+		ki.Recorder.Eventf(bridge, corev1.EventTypeNormal, "KubeconfigExported",
+			"Kubeconfig exported to %s", desired)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get exported kubeconfig secret: %w", err)
+	}
+
+	if existing.Labels[common.LabelOwnedBy] != bridge.Name || existing.Labels[common.LabelNamespace] != bridge.Namespace {
+		return fmt.Errorf("secret %s already exists and is not owned by this DPFHCPBridge", desired)
+	}
+
+	if bytes.Equal(existing.Data[ExportKubeconfigKey], kubeconfigData) {
+		return nil
+	}
+
+	maps.Copy(existing.Labels, exportLabels)
+	if existing.Annotations == nil {
+		existing.Annotations = map[string]string{}
+	}
+	existing.Annotations[AnnotationSourceHash] = sourceHash
+	existing.Data = map[string][]byte{
+		ExportKubeconfigKey: kubeconfigData,
+	}
+	if err := ki.Client.Update(ctx, existing); err != nil {
+		return fmt.Errorf("failed to update exported kubeconfig secret: %w", err)
+	}
+	log.Info("Refreshed exported kubeconfig")
+	ki.Recorder.Eventf(bridge, corev1.EventTypeNormal, "KubeconfigExportRefreshed",
+		"Exported kubeconfig %s refreshed", desired)
+	return nil
+}
+
+// deleteStaleExports deletes the kubeconfig exports of the bridge other than desired,
+// i.e. all of them once spec.kubeconfigExport is removed, or the previous one after it is renamed
+func (ki *KubeconfigInjector) deleteStaleExports(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge, desired *types.NamespacedName) error {
+	var exports corev1.SecretList
+	selector := labels.SelectorFromSet(common.ComponentOwnerLabels(bridge, common.ComponentKubeconfigExport))
+	if err := ki.Client.List(ctx, &exports, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("failed to list exported kubeconfig secrets: %w", err)
+	}
+
+	for i := range exports.Items {
+		export := &exports.Items[i]
+		if desired != nil && export.Name == desired.Name && export.Namespace == desired.Namespace {
+			continue
+		}
+		if err := ki.Client.Delete(ctx, export); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete exported kubeconfig secret %s/%s: %w", export.Namespace, export.Name, err)
+		}
+		logf.FromContext(ctx).Info("Deleted stale kubeconfig export",
+			"secret", fmt.Sprintf("%s/%s", export.Namespace, export.Name))
+		ki.Recorder.Eventf(bridge, corev1.EventTypeNormal, "KubeconfigExportDeleted",
+			"Exported kubeconfig %s/%s deleted", export.Namespace, export.Name)
+	}
+	return nil
+}
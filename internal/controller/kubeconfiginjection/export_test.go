@@ -0,0 +1,193 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfiginjection
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+var _ = Describe("Kubeconfig Export", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		injector   *KubeconfigInjector
+		bridge     *provisioningv1alpha1.DPFHCPBridge
+		hcSecret   *corev1.Secret
+		exportKey  types.NamespacedName
+	)
+
+	build := func(objs ...client.Object) {
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(dpuprovisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dpu", Namespace: "dpu-ns"},
+			Spec:       dpuprovisioningv1alpha1.DPUClusterSpec{Type: "bf3"},
+		}
+		objs = append(objs, bridge, hcSecret, dpuCluster)
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(bridge, dpuCluster).
+			Build()
+
+		injector = NewKubeconfigInjector(fakeClient, recorder)
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		recorder = record.NewFakeRecorder(100)
+		exportKey = types.NamespacedName{Name: "tenant-kubeconfig", Namespace: "tenant-ns"}
+
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "test-ns"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef: provisioningv1alpha1.DPUClusterReference{Name: "test-dpu", Namespace: "dpu-ns"},
+				KubeconfigExport: &provisioningv1alpha1.KubeconfigExportSpec{
+					Name:      exportKey.Name,
+					Namespace: exportKey.Namespace,
+				},
+			},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				HostedClusterRef: &corev1.ObjectReference{Name: "test-bridge", Namespace: "test-ns"},
+				Conditions:       []metav1.Condition{hostedClusterAvailable},
+			},
+		}
+		hcSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge-admin-kubeconfig", Namespace: "test-ns"},
+			Data:       map[string][]byte{"kubeconfig": []byte("fake-kubeconfig-data")},
+		}
+	})
+
+	It("should export the kubeconfig to the chosen secret with ownership labels", func() {
+		build()
+
+		_, err := injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		export := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, exportKey, export)).To(Succeed())
+		Expect(export.Data).To(HaveKeyWithValue(ExportKubeconfigKey, []byte("fake-kubeconfig-data")))
+		Expect(export.Labels).To(HaveKeyWithValue(common.LabelOwnedBy, "test-bridge"))
+		Expect(export.Labels).To(HaveKeyWithValue(common.LabelNamespace, "test-ns"))
+		Expect(export.Labels).To(HaveKeyWithValue(common.LabelComponent, common.ComponentKubeconfigExport))
+	})
+
+	It("should default the namespace to the bridge namespace", func() {
+		bridge.Spec.KubeconfigExport.Namespace = ""
+		build()
+
+		_, err := injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		key := types.NamespacedName{Name: exportKey.Name, Namespace: "test-ns"}
+		Expect(fakeClient.Get(ctx, key, &corev1.Secret{})).To(Succeed())
+	})
+
+	It("should refresh the export when HyperShift rotates the kubeconfig", func() {
+		build()
+
+		_, err := injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		rotated := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(hcSecret), rotated)).To(Succeed())
+		rotated.Data["kubeconfig"] = []byte("rotated-kubeconfig-data")
+		Expect(fakeClient.Update(ctx, rotated)).To(Succeed())
+
+		_, err = injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		export := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, exportKey, export)).To(Succeed())
+		Expect(export.Data).To(HaveKeyWithValue(ExportKubeconfigKey, []byte("rotated-kubeconfig-data")))
+	})
+
+	It("should not overwrite a secret that is not owned by the bridge", func() {
+		foreign := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: exportKey.Name, Namespace: exportKey.Namespace},
+			Data:       map[string][]byte{ExportKubeconfigKey: []byte("foreign")},
+		}
+		build(foreign)
+
+		_, err := injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not owned by this DPFHCPBridge"))
+
+		export := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, exportKey, export)).To(Succeed())
+		Expect(export.Data).To(HaveKeyWithValue(ExportKubeconfigKey, []byte("foreign")))
+	})
+
+	It("should move the export when it is renamed and delete it when the field is removed", func() {
+		build()
+
+		_, err := injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		bridge.Spec.KubeconfigExport.Name = "renamed-kubeconfig"
+		Expect(fakeClient.Update(ctx, bridge)).To(Succeed())
+		_, err = injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = fakeClient.Get(ctx, exportKey, &corev1.Secret{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		renamedKey := types.NamespacedName{Name: "renamed-kubeconfig", Namespace: exportKey.Namespace}
+		Expect(fakeClient.Get(ctx, renamedKey, &corev1.Secret{})).To(Succeed())
+
+		bridge.Spec.KubeconfigExport = nil
+		Expect(fakeClient.Update(ctx, bridge)).To(Succeed())
+		_, err = injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = fakeClient.Get(ctx, renamedKey, &corev1.Secret{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should delete the export during cleanup", func() {
+		build()
+
+		_, err := injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeClient.Get(ctx, exportKey, &corev1.Secret{})).To(Succeed())
+
+		handler := NewCleanupHandler(fakeClient, recorder)
+		_, err = handler.Cleanup(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = fakeClient.Get(ctx, exportKey, &corev1.Secret{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
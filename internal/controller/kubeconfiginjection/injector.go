@@ -19,7 +19,10 @@ package kubeconfiginjection
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -41,16 +44,28 @@ const (
 	KubeconfigSecretSuffix = "-admin-kubeconfig"
 
 	// LabelOwnedBy is the label key for ownership tracking
-	LabelOwnedBy = "dpf-hcp-bridge-operator/owned-by"
+	LabelOwnedBy = common.LabelOwnedBy
 
 	// LabelNamespace is the label key for namespace tracking
-	LabelNamespace = "dpf-hcp-bridge-operator/namespace"
+	LabelNamespace = common.LabelNamespace
+
+	// AnnotationSourceHash records on each kubeconfig copy the hash of the HostedCluster
+	// kubeconfig it was made from, to tell a HyperShift rotation from local drift
+	AnnotationSourceHash = "dpf-hcp-bridge-operator/kubeconfig-source-hash"
 )
 
 // KubeconfigInjector handles kubeconfig injection from HostedCluster to DPUCluster
 type KubeconfigInjector struct {
 	Client   client.Client
 	Recorder record.EventRecorder
+
+	// PublishMergedKubeconfig enables publishing a merged kubeconfig Secret
+	// covering all DPFHCPBridges in the bridge's namespace
+	PublishMergedKubeconfig bool
+
+	// ReplicaNamespace, if set, is the DPF operator namespace the admin kubeconfig is
+	// replicated into under the DPF naming convention
+	ReplicaNamespace string
 }
 
 // NewKubeconfigInjector creates a new KubeconfigInjector
@@ -64,12 +79,14 @@ func NewKubeconfigInjector(client client.Client, recorder record.EventRecorder)
 // InjectKubeconfig performs the kubeconfig injection workflow
 //
 // This function implements the complete kubeconfig injection flow:
-// 1. Verify HC and NodePool created
+// 1. Verify HC and NodePool created, and the HC Available before the first injection
 // 2. Check injection state (secret + DPUCluster reference)
 // 3. Detect HC kubeconfig secret availability
 // 4. Create/update secret in DPUCluster namespace
 // 5. Update DPUCluster CR spec.kubeconfig
-// 6. Update DPFHCPBridge status (condition + kubeConfigSecretRef)
+// 6. Repeat 4 and 5 for the other DPUClusters of spec.dpuClusterRefs
+// 7. Update DPFHCPBridge status (condition + kubeConfigSecretRef)
+// 8. Export the kubeconfig to the Secret named by spec.kubeconfigExport
 func (ki *KubeconfigInjector) InjectKubeconfig(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
 	log := logf.FromContext(ctx).WithValues(
 		"feature", "kubeconfig-injection",
@@ -79,7 +96,7 @@ func (ki *KubeconfigInjector) InjectKubeconfig(ctx context.Context, bridge *prov
 	log.Info("Starting kubeconfig injection",
 		"bridge", bridge.Name,
 		"namespace", bridge.Namespace,
-		"targetNamespace", bridge.Spec.DPUClusterRef.Namespace)
+		"targetNamespace", bridge.ResolvedDPUClusterRef().Namespace)
 
 	// Step 1: Verify HC and NodePool created
 	if bridge.Status.HostedClusterRef == nil {
@@ -87,6 +104,22 @@ func (ki *KubeconfigInjector) InjectKubeconfig(ctx context.Context, bridge *prov
 		return ctrl.Result{}, nil
 	}
 
+	// DPF is only pointed at the hosted cluster once its control plane serves. After that the DPUCluster
+	// keeps its kubeconfig while the control plane is temporarily unavailable, e.g. during upgrades.
+	// The watch on HostedCluster conditions triggers reconciliation when it becomes Available.
+	if bridge.Status.KubeConfigSecretRef == nil &&
+		!meta.IsStatusConditionTrue(bridge.Status.Conditions, provisioningv1alpha1.HostedClusterAvailable) {
+		log.Info("HostedCluster not available yet, waiting before injecting kubeconfig",
+			"hcName", bridge.Name,
+			"hcNamespace", bridge.Namespace)
+		if err := ki.setCondition(ctx, bridge, metav1.ConditionFalse, provisioningv1alpha1.ReasonKubeConfigPending,
+			fmt.Sprintf("Waiting for HostedCluster %s to become Available", bridge.Name)); err != nil {
+			log.Error(err, "Failed to update condition")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// Step 2: Check injection state
 	secretExists, dpuClusterUpdated, err := ki.checkInjectionState(ctx, bridge)
 	if err != nil {
@@ -140,19 +173,38 @@ func (ki *KubeconfigInjector) InjectKubeconfig(ctx context.Context, bridge *prov
 	// If idempotency scenario handled everything, we're done
 	if !needsInjection {
 		log.V(1).Info("Idempotency scenario handled, injection complete")
+		if err := ki.syncAdditionalDPUClusters(ctx, bridge, secretName); err != nil {
+			log.Error(err, "Failed to inject kubeconfig into additional DPUClusters")
+			if condErr := ki.setCondition(ctx, bridge, metav1.ConditionFalse, provisioningv1alpha1.ReasonKubeConfigInjectionFailed,
+				fmt.Sprintf("Failed to inject kubeconfig into additional DPUClusters: %v", err)); condErr != nil {
+				log.Error(condErr, "Failed to update condition")
+			}
+			return ctrl.Result{}, err
+		}
 		if err := ki.setCondition(ctx, bridge, metav1.ConditionTrue, provisioningv1alpha1.ReasonKubeConfigInjected,
-			fmt.Sprintf("Kubeconfig secret successfully created in namespace %s and DPUCluster CR updated", bridge.Spec.DPUClusterRef.Namespace)); err != nil {
+			injectedMessage(bridge)); err != nil {
 			log.Error(err, "Failed to update condition")
 			return ctrl.Result{}, err
 		}
+		if err := ki.publishMergedKubeconfig(ctx, bridge); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := ki.replicateKubeconfig(ctx, bridge); err != nil {
+			log.Error(err, "Failed to replicate kubeconfig")
+			return ctrl.Result{}, err
+		}
+		if err := ki.exportKubeconfig(ctx, bridge); err != nil {
+			log.Error(err, "Failed to export kubeconfig")
+			return ctrl.Result{}, err
+		}
 		return ctrl.Result{}, nil
 	}
 
 	// Step 5: Create/update secret in DPUCluster namespace
-	if err := ki.createOrUpdateKubeconfigSecret(ctx, bridge, secretName); err != nil {
+	if err := ki.createOrUpdateKubeconfigSecret(ctx, bridge, bridge.ResolvedDPUClusterRef().Namespace, secretName); err != nil {
 		log.Error(err, "Failed to create/update kubeconfig secret")
 		if condErr := ki.setCondition(ctx, bridge, metav1.ConditionFalse, provisioningv1alpha1.ReasonKubeConfigInjectionFailed,
-			fmt.Sprintf("Failed to create kubeconfig secret in namespace %s: %v", bridge.Spec.DPUClusterRef.Namespace, err)); condErr != nil {
+			fmt.Sprintf("Failed to create kubeconfig secret in namespace %s: %v", bridge.ResolvedDPUClusterRef().Namespace, err)); condErr != nil {
 			log.Error(condErr, "Failed to update condition")
 		}
 		// Event emitted by setCondition
@@ -161,13 +213,13 @@ func (ki *KubeconfigInjector) InjectKubeconfig(ctx context.Context, bridge *prov
 
 	log.Info("Kubeconfig secret created/updated",
 		"secretName", secretName,
-		"namespace", bridge.Spec.DPUClusterRef.Namespace)
+		"namespace", bridge.ResolvedDPUClusterRef().Namespace)
 	ki.Recorder.Event(bridge, corev1.EventTypeNormal, "KubeConfigInjected",
-		fmt.Sprintf("Kubeconfig secret %s created in namespace %s", secretName, bridge.Spec.DPUClusterRef.Namespace))
+		fmt.Sprintf("Kubeconfig secret %s created in namespace %s", secretName, bridge.ResolvedDPUClusterRef().Namespace))
 
 	// Step 6: Update DPUCluster CR spec.kubeconfig (only if not already updated)
 	if !dpuClusterUpdated {
-		if err := ki.updateDPUClusterReference(ctx, bridge, secretName); err != nil {
+		if err := ki.updateDPUClusterReference(ctx, bridge.ResolvedDPUClusterRef(), secretName); err != nil {
 			log.Error(err, "Failed to update DPUCluster reference")
 			if condErr := ki.setCondition(ctx, bridge, metav1.ConditionFalse, provisioningv1alpha1.ReasonKubeConfigInjectionFailed,
 				fmt.Sprintf("Failed to update DPUCluster spec.kubeconfig: %v", err)); condErr != nil {
@@ -178,32 +230,164 @@ func (ki *KubeconfigInjector) InjectKubeconfig(ctx context.Context, bridge *prov
 		}
 
 		log.Info("DPUCluster updated with kubeconfig reference",
-			"dpuCluster", bridge.Spec.DPUClusterRef.Name,
-			"namespace", bridge.Spec.DPUClusterRef.Namespace)
+			"dpuCluster", bridge.ResolvedDPUClusterRef().Name,
+			"namespace", bridge.ResolvedDPUClusterRef().Namespace)
 		ki.Recorder.Event(bridge, corev1.EventTypeNormal, "DPUClusterUpdated",
-			fmt.Sprintf("DPUCluster %s/%s updated with kubeconfig reference", bridge.Spec.DPUClusterRef.Namespace, bridge.Spec.DPUClusterRef.Name))
+			fmt.Sprintf("DPUCluster %s/%s updated with kubeconfig reference", bridge.ResolvedDPUClusterRef().Namespace, bridge.ResolvedDPUClusterRef().Name))
 	} else {
 		log.V(1).Info("DPUCluster already updated, skipping update",
-			"dpuCluster", bridge.Spec.DPUClusterRef.Name)
+			"dpuCluster", bridge.ResolvedDPUClusterRef().Name)
 	}
 
-	// Step 7: Update DPFHCPBridge status
+	// Step 7: Inject into the other DPUClusters of spec.dpuClusterRefs
+	if err := ki.syncAdditionalDPUClusters(ctx, bridge, secretName); err != nil {
+		log.Error(err, "Failed to inject kubeconfig into additional DPUClusters")
+		if condErr := ki.setCondition(ctx, bridge, metav1.ConditionFalse, provisioningv1alpha1.ReasonKubeConfigInjectionFailed,
+			fmt.Sprintf("Failed to inject kubeconfig into additional DPUClusters: %v", err)); condErr != nil {
+			log.Error(condErr, "Failed to update condition")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Step 8: Update DPFHCPBridge status
 	bridge.Status.KubeConfigSecretRef = &corev1.LocalObjectReference{
 		Name: secretName,
 	}
 	if err := ki.setCondition(ctx, bridge, metav1.ConditionTrue, provisioningv1alpha1.ReasonKubeConfigInjected,
-		fmt.Sprintf("Kubeconfig secret successfully created in namespace %s and DPUCluster CR updated", bridge.Spec.DPUClusterRef.Namespace)); err != nil {
+		injectedMessage(bridge)); err != nil {
 		log.Error(err, "Failed to update condition")
 		return ctrl.Result{}, err
 	}
 
 	log.Info("Kubeconfig injection completed successfully",
 		"secretName", secretName,
-		"dpuCluster", bridge.Spec.DPUClusterRef.Name)
+		"dpuCluster", bridge.ResolvedDPUClusterRef().Name)
+
+	if err := ki.publishMergedKubeconfig(ctx, bridge); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := ki.replicateKubeconfig(ctx, bridge); err != nil {
+		log.Error(err, "Failed to replicate kubeconfig")
+		return ctrl.Result{}, err
+	}
+	if err := ki.exportKubeconfig(ctx, bridge); err != nil {
+		log.Error(err, "Failed to export kubeconfig")
+		return ctrl.Result{}, err
+	}
 
 	return ctrl.Result{}, nil
 }
 
+// publishMergedKubeconfig refreshes the namespace-wide merged kubeconfig Secret if enabled,
+// and removes a Secret published before the option was turned off otherwise
+func (ki *KubeconfigInjector) publishMergedKubeconfig(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge) error {
+	if !ki.PublishMergedKubeconfig {
+		if err := RemoveMergedKubeconfig(ctx, ki.Client, bridge.Namespace); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to remove merged kubeconfig",
+				"namespace", bridge.Namespace)
+			return err
+		}
+		return nil
+	}
+
+	if err := PublishMergedKubeconfig(ctx, ki.Client, bridge.Namespace, ""); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to publish merged kubeconfig",
+			"namespace", bridge.Namespace)
+		return err
+	}
+
+	return nil
+}
+
+// injectedMessage returns the message of the KubeConfigInjected condition once the kubeconfig is injected
+func injectedMessage(bridge *provisioningv1alpha1.DPFHCPBridge) string {
+	refs := bridge.ResolvedDPUClusterRefs()
+	if len(refs) <= 1 {
+		return fmt.Sprintf("Kubeconfig secret successfully created in namespace %s and DPUCluster CR updated", bridge.ResolvedDPUClusterRef().Namespace)
+	}
+	names := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		names = append(names, ref.Namespace+"/"+ref.Name)
+	}
+	return fmt.Sprintf("Kubeconfig secret successfully created and DPUCluster CRs updated for DPUClusters %s", strings.Join(names, ", "))
+}
+
+// syncAdditionalDPUClusters injects the kubeconfig into the DPUClusters of spec.dpuClusterRefs after the
+// first one, which the main injection flow handles. Each copy is rewritten whenever it differs from the
+// HostedCluster kubeconfig, and the DPUCluster spec.kubeconfig is set if it does not reference it.
+func (ki *KubeconfigInjector) syncAdditionalDPUClusters(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge, secretName string) error {
+	refs := bridge.ResolvedDPUClusterRefs()
+	if len(refs) <= 1 {
+		return nil
+	}
+	log := logf.FromContext(ctx)
+
+	source := &corev1.Secret{}
+	if err := ki.Client.Get(ctx, types.NamespacedName{Name: secretName, Namespace: bridge.Namespace}, source); err != nil {
+		return fmt.Errorf("failed to read source kubeconfig secret: %w", err)
+	}
+	want := destinationKubeconfig(ctx, bridge, source.Data["kubeconfig"])
+
+	// DPUClusters sharing a namespace share the kubeconfig secret
+	synced := map[string]bool{refs[0].Namespace: true}
+	for _, ref := range refs[1:] {
+		if !synced[ref.Namespace] {
+			synced[ref.Namespace] = true
+
+			existing := &corev1.Secret{}
+			err := ki.Client.Get(ctx, types.NamespacedName{Name: secretName, Namespace: ref.Namespace}, existing)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to get kubeconfig secret in namespace %s: %w", ref.Namespace, err)
+			}
+			if err != nil || !bytes.Equal(existing.Data["kubeconfig"], want) {
+				if err := ki.createOrUpdateKubeconfigSecret(ctx, bridge, ref.Namespace, secretName); err != nil {
+					return fmt.Errorf("DPUCluster %s/%s: %w", ref.Namespace, ref.Name, err)
+				}
+				ki.Recorder.Event(bridge, corev1.EventTypeNormal, "KubeConfigInjected",
+					fmt.Sprintf("Kubeconfig secret %s created in namespace %s", secretName, ref.Namespace))
+			}
+		}
+
+		dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{}
+		if err := ki.Client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, dpuCluster); err != nil {
+			return fmt.Errorf("failed to get DPUCluster %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		if dpuCluster.Spec.Kubeconfig == secretName {
+			continue
+		}
+		if err := ki.updateDPUClusterReference(ctx, ref, secretName); err != nil {
+			return fmt.Errorf("DPUCluster %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		log.Info("DPUCluster updated with kubeconfig reference",
+			"dpuCluster", ref.Name,
+			"namespace", ref.Namespace)
+		ki.Recorder.Event(bridge, corev1.EventTypeNormal, "DPUClusterUpdated",
+			fmt.Sprintf("DPUCluster %s/%s updated with kubeconfig reference", ref.Namespace, ref.Name))
+	}
+
+	return nil
+}
+
+// destinationKubeconfig returns the kubeconfig content written to the DPUCluster namespace.
+// Context, cluster and user names are normalized to the bridge name; if the source cannot be
+// parsed as a kubeconfig it is copied verbatim.
+func destinationKubeconfig(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge, source []byte) []byte {
+	normalized, err := normalizeKubeconfig(source, bridge.Name)
+	if err != nil {
+		logf.FromContext(ctx).V(1).Info("Unable to normalize kubeconfig, copying as-is",
+			"error", err.Error())
+		return source
+	}
+	return normalized
+}
+
+// kubeconfigHash returns the hash recorded in AnnotationSourceHash for the given source kubeconfig
+func kubeconfigHash(source []byte) string {
+	sum := sha256.Sum256(source)
+	return hex.EncodeToString(sum[:])
+}
+
 // checkInjectionState checks the current state of the kubeconfig injection work
 // to determine if it has already been completed (fully or partially) for idempotency.
 // Returns (secretExists, dpuClusterUpdated, error)
@@ -211,7 +395,7 @@ func (ki *KubeconfigInjector) checkInjectionState(ctx context.Context, bridge *p
 	log := logf.FromContext(ctx)
 
 	secretName := bridge.Name + KubeconfigSecretSuffix
-	dpuClusterNamespace := bridge.Spec.DPUClusterRef.Namespace
+	dpuClusterNamespace := bridge.ResolvedDPUClusterRef().Namespace
 
 	// Check if secret exists in DPUCluster namespace
 	secret := &corev1.Secret{}
@@ -229,13 +413,13 @@ func (ki *KubeconfigInjector) checkInjectionState(ctx context.Context, bridge *p
 	// Check if DPUCluster spec.kubeconfig is populated
 	dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{}
 	dpuClusterKey := types.NamespacedName{
-		Name:      bridge.Spec.DPUClusterRef.Name,
+		Name:      bridge.ResolvedDPUClusterRef().Name,
 		Namespace: dpuClusterNamespace,
 	}
 	dpuClusterErr := ki.Client.Get(ctx, dpuClusterKey, dpuCluster)
 	if dpuClusterErr != nil {
 		if apierrors.IsNotFound(dpuClusterErr) {
-			return secretExists, false, fmt.Errorf("DPUCluster %s/%s not found", dpuClusterNamespace, bridge.Spec.DPUClusterRef.Name)
+			return secretExists, false, fmt.Errorf("DPUCluster %s/%s not found", dpuClusterNamespace, bridge.ResolvedDPUClusterRef().Name)
 		}
 		return secretExists, false, fmt.Errorf("failed to get DPUCluster: %w", dpuClusterErr)
 	}
@@ -292,14 +476,22 @@ func (ki *KubeconfigInjector) handleIdempotencyScenarios(ctx context.Context, br
 	if secretExists && dpuClusterUpdated {
 		// Scenario A: Check for drift
 		log.V(1).Info("Scenario A: Secret exists and DPUCluster updated, checking for drift")
-		hasDrift, err := ki.checkDrift(ctx, bridge, secretName)
+		hasDrift, rotated, err := ki.checkDrift(ctx, bridge, secretName)
 		if err != nil {
 			return false, fmt.Errorf("failed to check drift: %w", err)
 		}
+		if hasDrift && rotated {
+			log.Info("HostedCluster kubeconfig rotated, will re-sync destination secret",
+				"secretName", secretName,
+				"namespace", bridge.ResolvedDPUClusterRef().Namespace)
+			ki.Recorder.Eventf(bridge, corev1.EventTypeNormal, "KubeconfigRotated",
+				"HostedCluster kubeconfig rotated, re-syncing copy in namespace %s", bridge.ResolvedDPUClusterRef().Namespace)
+			return true, nil
+		}
 		if hasDrift {
 			log.Info("Kubeconfig drift detected, will update destination secret",
 				"secretName", secretName,
-				"namespace", bridge.Spec.DPUClusterRef.Namespace)
+				"namespace", bridge.ResolvedDPUClusterRef().Namespace)
 			ki.Recorder.Event(bridge, corev1.EventTypeNormal, "DriftCorrected",
 				"Kubeconfig secret content drift detected and corrected")
 			// Return true to trigger secret update
@@ -313,8 +505,8 @@ func (ki *KubeconfigInjector) handleIdempotencyScenarios(ctx context.Context, br
 		// Scenario B: Update DPUCluster only
 		log.Info("Scenario B: Secret exists but DPUCluster not updated, completing injection",
 			"secretName", secretName,
-			"dpuCluster", bridge.Spec.DPUClusterRef.Name)
-		if err := ki.updateDPUClusterReference(ctx, bridge, secretName); err != nil {
+			"dpuCluster", bridge.ResolvedDPUClusterRef().Name)
+		if err := ki.updateDPUClusterReference(ctx, bridge.ResolvedDPUClusterRef(), secretName); err != nil {
 			return false, fmt.Errorf("failed to update DPUCluster reference: %w", err)
 		}
 		// Update status
@@ -328,7 +520,7 @@ func (ki *KubeconfigInjector) handleIdempotencyScenarios(ctx context.Context, br
 		// Scenario C: Recreate secret
 		log.Info("Scenario C: Secret missing but DPUCluster updated, recreating secret",
 			"secretName", secretName,
-			"namespace", bridge.Spec.DPUClusterRef.Namespace)
+			"namespace", bridge.ResolvedDPUClusterRef().Namespace)
 		// Return true to trigger secret creation
 		return true, nil
 	}
@@ -338,9 +530,11 @@ func (ki *KubeconfigInjector) handleIdempotencyScenarios(ctx context.Context, br
 	return true, nil
 }
 
-// checkDrift compares source and destination secret content
-// Returns (hasDrift, error)
-func (ki *KubeconfigInjector) checkDrift(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge, secretName string) (bool, error) {
+// checkDrift compares source and destination secret content.
+// The destination is considered rotated rather than drifted when it was made from an
+// older version of the source, as recorded by AnnotationSourceHash.
+// Returns (hasDrift, rotated, error)
+func (ki *KubeconfigInjector) checkDrift(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge, secretName string) (bool, bool, error) {
 	log := logf.FromContext(ctx)
 
 	// Get source secret from HC namespace
@@ -350,17 +544,17 @@ func (ki *KubeconfigInjector) checkDrift(ctx context.Context, bridge *provisioni
 		Namespace: bridge.Namespace,
 	}
 	if err := ki.Client.Get(ctx, sourceKey, sourceSecret); err != nil {
-		return false, fmt.Errorf("failed to get source secret: %w", err)
+		return false, false, fmt.Errorf("failed to get source secret: %w", err)
 	}
 
 	// Get destination secret from DPUCluster namespace
 	destSecret := &corev1.Secret{}
 	destKey := types.NamespacedName{
 		Name:      secretName,
-		Namespace: bridge.Spec.DPUClusterRef.Namespace,
+		Namespace: bridge.ResolvedDPUClusterRef().Namespace,
 	}
 	if err := ki.Client.Get(ctx, destKey, destSecret); err != nil {
-		return false, fmt.Errorf("failed to get destination secret: %w", err)
+		return false, false, fmt.Errorf("failed to get destination secret: %w", err)
 	}
 
 	// Compare kubeconfig data
@@ -368,24 +562,27 @@ func (ki *KubeconfigInjector) checkDrift(ctx context.Context, bridge *provisioni
 	destData, destOk := destSecret.Data["kubeconfig"]
 
 	if !sourceOk {
-		return false, fmt.Errorf("source secret missing 'kubeconfig' key")
+		return false, false, fmt.Errorf("source secret missing 'kubeconfig' key")
 	}
 	if !destOk {
-		return false, fmt.Errorf("destination secret missing 'kubeconfig' key")
+		return false, false, fmt.Errorf("destination secret missing 'kubeconfig' key")
 	}
 
-	hasDrift := !bytes.Equal(sourceData, destData)
+	hasDrift := !bytes.Equal(destinationKubeconfig(ctx, bridge, sourceData), destData)
+	recordedHash, hasRecordedHash := destSecret.Annotations[AnnotationSourceHash]
+	rotated := hasDrift && hasRecordedHash && recordedHash != kubeconfigHash(sourceData)
 	if hasDrift {
 		log.Info("Kubeconfig content drift detected",
 			"source", sourceKey,
-			"destination", destKey)
+			"destination", destKey,
+			"rotated", rotated)
 	}
 
-	return hasDrift, nil
+	return hasDrift, rotated, nil
 }
 
-// createOrUpdateKubeconfigSecret creates or updates the secret in DPUCluster namespace
-func (ki *KubeconfigInjector) createOrUpdateKubeconfigSecret(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge, sourceSecretName string) error {
+// createOrUpdateKubeconfigSecret creates or updates the secret in the given DPUCluster namespace
+func (ki *KubeconfigInjector) createOrUpdateKubeconfigSecret(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge, namespace, sourceSecretName string) error {
 	log := logf.FromContext(ctx)
 
 	// Get source secret from HC namespace
@@ -399,19 +596,23 @@ func (ki *KubeconfigInjector) createOrUpdateKubeconfigSecret(ctx context.Context
 	}
 
 	// Extract kubeconfig data
-	kubeconfigData, ok := sourceSecret.Data["kubeconfig"]
+	sourceData, ok := sourceSecret.Data["kubeconfig"]
 	if !ok {
 		return fmt.Errorf("source secret missing 'kubeconfig' key")
 	}
 
+	// Normalize context/cluster/user names to the bridge name
+	kubeconfigData := destinationKubeconfig(ctx, bridge, sourceData)
+
 	// Create destination secret
 	destSecret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      sourceSecretName,
-			Namespace: bridge.Spec.DPUClusterRef.Namespace,
-			Labels: map[string]string{
-				LabelOwnedBy:   bridge.Name,
-				LabelNamespace: bridge.Namespace,
+			Namespace: namespace,
+			// Cross-namespace: garbage collected by label from the bridge finalizer
+			Labels: common.ComponentOwnerLabels(bridge, common.ComponentKubeconfig),
+			Annotations: map[string]string{
+				AnnotationSourceHash: kubeconfigHash(sourceData),
 			},
 		},
 		Type: corev1.SecretTypeOpaque,
@@ -425,7 +626,7 @@ func (ki *KubeconfigInjector) createOrUpdateKubeconfigSecret(ctx context.Context
 	if err == nil {
 		log.Info("Created kubeconfig secret",
 			"secretName", sourceSecretName,
-			"namespace", bridge.Spec.DPUClusterRef.Namespace)
+			"namespace", namespace)
 		return nil
 	}
 
@@ -434,7 +635,7 @@ func (ki *KubeconfigInjector) createOrUpdateKubeconfigSecret(ctx context.Context
 		existing := &corev1.Secret{}
 		existingKey := types.NamespacedName{
 			Name:      sourceSecretName,
-			Namespace: bridge.Spec.DPUClusterRef.Namespace,
+			Namespace: namespace,
 		}
 		if err := ki.Client.Get(ctx, existingKey, existing); err != nil {
 			return fmt.Errorf("failed to get existing secret for update: %w", err)
@@ -442,13 +643,17 @@ func (ki *KubeconfigInjector) createOrUpdateKubeconfigSecret(ctx context.Context
 
 		existing.Data = destSecret.Data
 		existing.Labels = destSecret.Labels
+		if existing.Annotations == nil {
+			existing.Annotations = map[string]string{}
+		}
+		existing.Annotations[AnnotationSourceHash] = destSecret.Annotations[AnnotationSourceHash]
 		if err := ki.Client.Update(ctx, existing); err != nil {
 			return fmt.Errorf("failed to update kubeconfig secret: %w", err)
 		}
 
 		log.Info("Updated existing kubeconfig secret",
 			"secretName", sourceSecretName,
-			"namespace", bridge.Spec.DPUClusterRef.Namespace)
+			"namespace", namespace)
 		return nil
 	}
 
@@ -456,14 +661,14 @@ func (ki *KubeconfigInjector) createOrUpdateKubeconfigSecret(ctx context.Context
 }
 
 // updateDPUClusterReference updates DPUCluster spec.kubeconfig field
-func (ki *KubeconfigInjector) updateDPUClusterReference(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge, secretName string) error {
+func (ki *KubeconfigInjector) updateDPUClusterReference(ctx context.Context, ref provisioningv1alpha1.DPUClusterReference, secretName string) error {
 	log := logf.FromContext(ctx)
 
 	// Get DPUCluster CR
 	dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{}
 	dpuClusterKey := types.NamespacedName{
-		Name:      bridge.Spec.DPUClusterRef.Name,
-		Namespace: bridge.Spec.DPUClusterRef.Namespace,
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
 	}
 	if err := ki.Client.Get(ctx, dpuClusterKey, dpuCluster); err != nil {
 		return fmt.Errorf("failed to get DPUCluster: %w", err)
@@ -18,6 +18,7 @@ package kubeconfiginjection
 
 import (
 	"context"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -27,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -75,6 +77,7 @@ var _ = Describe("Kubeconfig Injection Reconciler", func() {
 						Name:      "test-bridge",
 						Namespace: "test-ns",
 					},
+					Conditions: []metav1.Condition{hostedClusterAvailable},
 				},
 			}
 
@@ -175,6 +178,7 @@ var _ = Describe("Kubeconfig Injection Reconciler", func() {
 						Name:      "test-bridge",
 						Namespace: "test-ns",
 					},
+					Conditions: []metav1.Condition{hostedClusterAvailable},
 				},
 			}
 
@@ -206,6 +210,76 @@ var _ = Describe("Kubeconfig Injection Reconciler", func() {
 			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
 			Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonKubeConfigPending))
 		})
+
+		It("should wait for the HostedCluster to become Available before injecting", func() {
+			// Given: HC kubeconfig secret exists but the HostedCluster is not Available yet
+			bridge := &provisioningv1alpha1.DPFHCPBridge{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-bridge",
+					Namespace: "test-ns",
+				},
+				Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+					DPUClusterRef: provisioningv1alpha1.DPUClusterReference{
+						Name:      "test-dpu",
+						Namespace: "dpu-ns",
+					},
+				},
+				Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+					HostedClusterRef: &corev1.ObjectReference{
+						Name:      "test-bridge",
+						Namespace: "test-ns",
+					},
+				},
+			}
+			hcSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-bridge-admin-kubeconfig",
+					Namespace: "test-ns",
+				},
+				Data: map[string][]byte{
+					"kubeconfig": []byte("fake-kubeconfig-data"),
+				},
+			}
+			dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-dpu",
+					Namespace: "dpu-ns",
+				},
+			}
+
+			fakeClient = fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(bridge, hcSecret, dpuCluster).
+				WithStatusSubresource(bridge).
+				Build()
+
+			injector = NewKubeconfigInjector(fakeClient, recorder)
+
+			// When: Reconciliation runs
+			_, err := injector.InjectKubeconfig(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Then: Nothing is injected yet
+			cond := findCondition(bridge.Status.Conditions, provisioningv1alpha1.KubeConfigInjected)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonKubeConfigPending))
+			Expect(cond.Message).To(ContainSubstring("to become Available"))
+			Expect(bridge.Status.KubeConfigSecretRef).To(BeNil())
+
+			updatedDPU := &dpuprovisioningv1alpha1.DPUCluster{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(dpuCluster), updatedDPU)).To(Succeed())
+			Expect(updatedDPU.Spec.Kubeconfig).To(BeEmpty())
+
+			// When: The HostedCluster becomes Available
+			meta.SetStatusCondition(&bridge.Status.Conditions, hostedClusterAvailable)
+			_, err = injector.InjectKubeconfig(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Then: The DPUCluster points at the kubeconfig secret
+			Expect(meta.IsStatusConditionTrue(bridge.Status.Conditions, provisioningv1alpha1.KubeConfigInjected)).To(BeTrue())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(dpuCluster), updatedDPU)).To(Succeed())
+			Expect(updatedDPU.Spec.Kubeconfig).To(Equal("test-bridge-admin-kubeconfig"))
+		})
 	})
 
 	Describe("Idempotency - Scenario A: Drift Detection", func() {
@@ -227,6 +301,7 @@ var _ = Describe("Kubeconfig Injection Reconciler", func() {
 						Name:      "test-bridge",
 						Namespace: "test-ns",
 					},
+					Conditions: []metav1.Condition{hostedClusterAvailable},
 					KubeConfigSecretRef: &corev1.LocalObjectReference{
 						Name: "test-bridge-admin-kubeconfig",
 					},
@@ -326,6 +401,184 @@ var _ = Describe("Kubeconfig Injection Reconciler", func() {
 		})
 	})
 
+	Describe("Kubeconfig Rotation", func() {
+		It("should re-sync the destination secret and report a rotation when HyperShift rotates the kubeconfig", func() {
+			// Given: An injected kubeconfig
+			bridge := &provisioningv1alpha1.DPFHCPBridge{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-bridge",
+					Namespace: "test-ns",
+				},
+				Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+					DPUClusterRef: provisioningv1alpha1.DPUClusterReference{
+						Name:      "test-dpu",
+						Namespace: "dpu-ns",
+					},
+				},
+				Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+					HostedClusterRef: &corev1.ObjectReference{
+						Name:      "test-bridge",
+						Namespace: "test-ns",
+					},
+					Conditions: []metav1.Condition{hostedClusterAvailable},
+				},
+			}
+
+			hcSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-bridge-admin-kubeconfig",
+					Namespace: "test-ns",
+				},
+				Data: map[string][]byte{
+					"kubeconfig": []byte("original-kubeconfig-data"),
+				},
+			}
+
+			dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-dpu",
+					Namespace: "dpu-ns",
+				},
+			}
+
+			fakeClient = fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(bridge, hcSecret, dpuCluster).
+				WithStatusSubresource(bridge, dpuCluster).
+				Build()
+
+			injector = NewKubeconfigInjector(fakeClient, recorder)
+			_, err := injector.InjectKubeconfig(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+
+			destKey := types.NamespacedName{Name: "test-bridge-admin-kubeconfig", Namespace: "dpu-ns"}
+			destSecret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, destKey, destSecret)).To(Succeed())
+			Expect(destSecret.Annotations).To(HaveKeyWithValue(AnnotationSourceHash, kubeconfigHash([]byte("original-kubeconfig-data"))))
+
+			// When: HyperShift rotates the kubeconfig and reconciliation runs again
+			rotated := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(hcSecret), rotated)).To(Succeed())
+			rotated.Data["kubeconfig"] = []byte("rotated-kubeconfig-data")
+			Expect(fakeClient.Update(ctx, rotated)).To(Succeed())
+
+			_, err = injector.InjectKubeconfig(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Then: The rotation is reported and the copy is re-synced
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("KubeconfigRotated")))
+			Expect(fakeClient.Get(ctx, destKey, destSecret)).To(Succeed())
+			Expect(destSecret.Data["kubeconfig"]).To(Equal([]byte("rotated-kubeconfig-data")))
+			Expect(destSecret.Annotations).To(HaveKeyWithValue(AnnotationSourceHash, kubeconfigHash([]byte("rotated-kubeconfig-data"))))
+		})
+	})
+
+	Describe("Merged Kubeconfig Publishing", func() {
+		It("should create, update and remove the merged kubeconfig secret", func() {
+			// Given: A bridge with a parsable HostedCluster kubeconfig and merged publishing enabled
+			bridge := &provisioningv1alpha1.DPFHCPBridge{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-bridge",
+					Namespace: "test-ns",
+				},
+				Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+					DPUClusterRef: provisioningv1alpha1.DPUClusterReference{
+						Name:      "test-dpu",
+						Namespace: "dpu-ns",
+					},
+				},
+				Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+					HostedClusterRef: &corev1.ObjectReference{
+						Name:      "test-bridge",
+						Namespace: "test-ns",
+					},
+					Conditions: []metav1.Condition{hostedClusterAvailable},
+				},
+			}
+
+			hcSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-bridge-admin-kubeconfig",
+					Namespace: "test-ns",
+				},
+				Data: map[string][]byte{
+					"kubeconfig": []byte(hypershiftKubeconfig),
+				},
+			}
+
+			dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-dpu",
+					Namespace: "dpu-ns",
+				},
+			}
+
+			fakeClient = fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(bridge, hcSecret, dpuCluster).
+				WithStatusSubresource(bridge, dpuCluster).
+				Build()
+
+			injector = NewKubeconfigInjector(fakeClient, recorder)
+			injector.PublishMergedKubeconfig = true
+
+			// When: Reconciliation runs
+			_, err := injector.InjectKubeconfig(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Then: The merged secret is created with a context named after the bridge
+			mergedKey := types.NamespacedName{Name: MergedKubeconfigSecretName, Namespace: "test-ns"}
+			merged := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, mergedKey, merged)).To(Succeed())
+			Expect(merged.Labels).To(HaveKeyWithValue(LabelMergedKubeconfig, "true"))
+			config, err := clientcmd.Load(merged.Data["kubeconfig"])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Contexts).To(HaveKey("test-bridge"))
+			Expect(config.Clusters["test-bridge"].Server).To(Equal("https://api.test-bridge.example.com:6443"))
+
+			// When: HyperShift rotates the kubeconfig and reconciliation runs again
+			rotated := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(hcSecret), rotated)).To(Succeed())
+			rotated.Data["kubeconfig"] = []byte(strings.ReplaceAll(hypershiftKubeconfig, "secret-token", "rotated-token"))
+			Expect(fakeClient.Update(ctx, rotated)).To(Succeed())
+
+			_, err = injector.InjectKubeconfig(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Then: The merged secret is updated
+			Expect(fakeClient.Get(ctx, mergedKey, merged)).To(Succeed())
+			config, err = clientcmd.Load(merged.Data["kubeconfig"])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.AuthInfos["test-bridge"].Token).To(Equal("rotated-token"))
+
+			// When: Merged publishing is turned off and reconciliation runs again
+			injector.PublishMergedKubeconfig = false
+			_, err = injector.InjectKubeconfig(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Then: The merged secret is removed
+			err = fakeClient.Get(ctx, mergedKey, merged)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should leave an unlabeled secret with the merged name alone when publishing is off", func() {
+			unmanaged := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      MergedKubeconfigSecretName,
+					Namespace: "test-ns",
+				},
+			}
+
+			fakeClient = fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(unmanaged).
+				Build()
+
+			Expect(RemoveMergedKubeconfig(ctx, fakeClient, "test-ns")).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(unmanaged), &corev1.Secret{})).To(Succeed())
+		})
+	})
+
 	Describe("Idempotency - Scenario B: Secret Exists, DPUCluster Not Updated", func() {
 		It("should update DPUCluster without recreating secret", func() {
 			// Given: Secret exists but DPUCluster not updated (partial completion scenario)
@@ -345,6 +598,7 @@ var _ = Describe("Kubeconfig Injection Reconciler", func() {
 						Name:      "test-bridge",
 						Namespace: "test-ns",
 					},
+					Conditions: []metav1.Condition{hostedClusterAvailable},
 				},
 			}
 
@@ -466,6 +720,7 @@ var _ = Describe("Kubeconfig Injection Reconciler", func() {
 						Name:      "test-bridge",
 						Namespace: "test-ns",
 					},
+					Conditions: []metav1.Condition{hostedClusterAvailable},
 					KubeConfigSecretRef: &corev1.LocalObjectReference{
 						Name: "test-bridge-admin-kubeconfig",
 					},
@@ -575,6 +830,87 @@ var _ = Describe("Kubeconfig Injection Reconciler", func() {
 		})
 	})
 
+	Describe("Several DPUClusters", func() {
+		It("should inject the kubeconfig into every DPUCluster", func() {
+			bridge := &provisioningv1alpha1.DPFHCPBridge{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-bridge",
+					Namespace: "test-ns",
+				},
+				Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+					DPUClusterRefs: []provisioningv1alpha1.DPUClusterReference{
+						{Name: "dpu-east", Namespace: "dpf-east"},
+						{Name: "dpu-west", Namespace: "dpf-west"},
+					},
+				},
+				Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+					HostedClusterRef: &corev1.ObjectReference{
+						Name:      "test-bridge",
+						Namespace: "test-ns",
+					},
+					Conditions: []metav1.Condition{hostedClusterAvailable},
+				},
+			}
+			hcSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-bridge-admin-kubeconfig",
+					Namespace: "test-ns",
+				},
+				Data: map[string][]byte{
+					"kubeconfig": []byte("fake-kubeconfig-data"),
+				},
+			}
+			east := &dpuprovisioningv1alpha1.DPUCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "dpu-east", Namespace: "dpf-east"},
+			}
+			west := &dpuprovisioningv1alpha1.DPUCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "dpu-west", Namespace: "dpf-west"},
+			}
+
+			fakeClient = fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(bridge, hcSecret, east, west).
+				WithStatusSubresource(bridge).
+				Build()
+			injector = NewKubeconfigInjector(fakeClient, recorder)
+
+			_, err := injector.InjectKubeconfig(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, ref := range bridge.Spec.DPUClusterRefs {
+				destSecret := &corev1.Secret{}
+				Expect(fakeClient.Get(ctx, types.NamespacedName{
+					Name:      "test-bridge-admin-kubeconfig",
+					Namespace: ref.Namespace,
+				}, destSecret)).To(Succeed())
+				Expect(destSecret.Data["kubeconfig"]).To(Equal([]byte("fake-kubeconfig-data")))
+
+				dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{}
+				Expect(fakeClient.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, dpuCluster)).To(Succeed())
+				Expect(dpuCluster.Spec.Kubeconfig).To(Equal("test-bridge-admin-kubeconfig"))
+			}
+
+			cond := findCondition(bridge.Status.Conditions, provisioningv1alpha1.KubeConfigInjected)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Message).To(ContainSubstring("dpf-east/dpu-east, dpf-west/dpu-west"))
+
+			// A rotated kubeconfig is propagated to the other DPUClusters too
+			hcSecret.Data["kubeconfig"] = []byte("rotated-kubeconfig-data")
+			Expect(fakeClient.Update(ctx, hcSecret)).To(Succeed())
+
+			_, err = injector.InjectKubeconfig(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+
+			destSecret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, types.NamespacedName{
+				Name:      "test-bridge-admin-kubeconfig",
+				Namespace: "dpf-west",
+			}, destSecret)).To(Succeed())
+			Expect(destSecret.Data["kubeconfig"]).To(Equal([]byte("rotated-kubeconfig-data")))
+		})
+	})
+
 	Describe("Skip When HostedCluster Not Created", func() {
 		It("should skip injection when HostedClusterRef is nil", func() {
 			// Given: DPFHCPBridge without HostedCluster created yet
@@ -613,6 +949,13 @@ var _ = Describe("Kubeconfig Injection Reconciler", func() {
 	})
 })
 
+// hostedClusterAvailable is the mirrored condition of a HostedCluster whose control plane serves
+var hostedClusterAvailable = metav1.Condition{
+	Type:   provisioningv1alpha1.HostedClusterAvailable,
+	Status: metav1.ConditionTrue,
+	Reason: "AsExpected",
+}
+
 // Helper function to find a condition
 func findCondition(conditions []metav1.Condition, condType string) *metav1.Condition {
 	for i := range conditions {
@@ -0,0 +1,105 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfiginjection
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// normalizeKubeconfig rewrites the kubeconfig so that its cluster, user and context
+// are all named after the bridge. HyperShift generates generic names (e.g. "cluster", "admin")
+// which collide as soon as tooling merges kubeconfigs from several hosted clusters.
+//
+// Only the current context (or the sole context, if current-context is unset) is kept.
+func normalizeKubeconfig(data []byte, name string) ([]byte, error) {
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	contextName := config.CurrentContext
+	if contextName == "" && len(config.Contexts) == 1 {
+		for n := range config.Contexts {
+			contextName = n
+		}
+	}
+
+	kubeContext, ok := config.Contexts[contextName]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig has no usable context (current-context: %q)", config.CurrentContext)
+	}
+
+	cluster, ok := config.Clusters[kubeContext.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig context %q references unknown cluster %q", contextName, kubeContext.Cluster)
+	}
+
+	authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig context %q references unknown user %q", contextName, kubeContext.AuthInfo)
+	}
+
+	normalized := clientcmdapi.NewConfig()
+	normalized.Clusters[name] = cluster
+	normalized.AuthInfos[name] = authInfo
+	normalized.Contexts[name] = &clientcmdapi.Context{
+		Cluster:   name,
+		AuthInfo:  name,
+		Namespace: kubeContext.Namespace,
+	}
+	normalized.CurrentContext = name
+
+	return clientcmd.Write(*normalized)
+}
+
+// mergeKubeconfigs merges normalized kubeconfigs into a single kubeconfig.
+// Keys of the input map are the context names; the current-context of the result
+// is the first context in lexical order so that the output is deterministic.
+func mergeKubeconfigs(kubeconfigs map[string][]byte) ([]byte, error) {
+	names := make([]string, 0, len(kubeconfigs))
+	for name := range kubeconfigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	merged := clientcmdapi.NewConfig()
+	for _, name := range names {
+		config, err := clientcmd.Load(kubeconfigs[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse kubeconfig for %s: %w", name, err)
+		}
+		for k, v := range config.Clusters {
+			merged.Clusters[k] = v
+		}
+		for k, v := range config.AuthInfos {
+			merged.AuthInfos[k] = v
+		}
+		for k, v := range config.Contexts {
+			merged.Contexts[k] = v
+		}
+	}
+
+	if len(names) > 0 {
+		merged.CurrentContext = names[0]
+	}
+
+	return clientcmd.Write(*merged)
+}
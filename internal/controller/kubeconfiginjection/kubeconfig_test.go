@@ -0,0 +1,89 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfiginjection
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const hypershiftKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    server: https://api.test-bridge.example.com:6443
+  name: cluster
+contexts:
+- context:
+    cluster: cluster
+    namespace: default
+    user: admin
+  name: admin
+current-context: admin
+users:
+- name: admin
+  user:
+    token: secret-token
+`
+
+var _ = Describe("Kubeconfig Normalization", func() {
+	It("should rename cluster, user and context to the bridge name", func() {
+		out, err := normalizeKubeconfig([]byte(hypershiftKubeconfig), "test-bridge")
+		Expect(err).NotTo(HaveOccurred())
+
+		config, err := clientcmd.Load(out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.CurrentContext).To(Equal("test-bridge"))
+		Expect(config.Contexts).To(HaveKey("test-bridge"))
+		Expect(config.Contexts["test-bridge"].Cluster).To(Equal("test-bridge"))
+		Expect(config.Contexts["test-bridge"].AuthInfo).To(Equal("test-bridge"))
+		Expect(config.Contexts["test-bridge"].Namespace).To(Equal("default"))
+		Expect(config.Clusters["test-bridge"].Server).To(Equal("https://api.test-bridge.example.com:6443"))
+		Expect(config.AuthInfos["test-bridge"].Token).To(Equal("secret-token"))
+	})
+
+	It("should be idempotent", func() {
+		first, err := normalizeKubeconfig([]byte(hypershiftKubeconfig), "test-bridge")
+		Expect(err).NotTo(HaveOccurred())
+		second, err := normalizeKubeconfig(first, "test-bridge")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second).To(Equal(first))
+	})
+
+	It("should fail on data that is not a kubeconfig", func() {
+		_, err := normalizeKubeconfig([]byte("fake-kubeconfig-data"), "test-bridge")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should merge kubeconfigs of several bridges into one", func() {
+		a, err := normalizeKubeconfig([]byte(hypershiftKubeconfig), "bridge-a")
+		Expect(err).NotTo(HaveOccurred())
+		b, err := normalizeKubeconfig([]byte(hypershiftKubeconfig), "bridge-b")
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := mergeKubeconfigs(map[string][]byte{"bridge-b": b, "bridge-a": a})
+		Expect(err).NotTo(HaveOccurred())
+
+		config, err := clientcmd.Load(out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Contexts).To(HaveLen(2))
+		Expect(config.Contexts).To(HaveKey("bridge-a"))
+		Expect(config.Contexts).To(HaveKey("bridge-b"))
+		Expect(config.CurrentContext).To(Equal("bridge-a"))
+	})
+})
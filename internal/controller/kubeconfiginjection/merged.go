@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfiginjection
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const (
+	// MergedKubeconfigSecretName is the name of the Secret holding the merged kubeconfig
+	// for all DPFHCPBridges in a namespace
+	MergedKubeconfigSecretName = "dpfhcpbridges-merged-kubeconfig"
+
+	// LabelMergedKubeconfig is the label key identifying merged kubeconfig secrets
+	LabelMergedKubeconfig = "dpf-hcp-bridge-operator/merged-kubeconfig"
+)
+
+// PublishMergedKubeconfig rebuilds the merged kubeconfig Secret in the given namespace.
+//
+// The Secret contains one context per DPFHCPBridge in the namespace whose HostedCluster
+// admin kubeconfig is available, each named after its bridge. The bridge named by exclude
+// (typically one being deleted) is left out. If no kubeconfigs remain, the Secret is deleted.
+func PublishMergedKubeconfig(ctx context.Context, c client.Client, namespace, exclude string) error {
+	log := logf.FromContext(ctx).WithValues("mergedKubeconfig", fmt.Sprintf("%s/%s", namespace, MergedKubeconfigSecretName))
+
+	var bridgeList provisioningv1alpha1.DPFHCPBridgeList
+	if err := c.List(ctx, &bridgeList, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list DPFHCPBridges in namespace %s: %w", namespace, err)
+	}
+
+	kubeconfigs := make(map[string][]byte, len(bridgeList.Items))
+	for i := range bridgeList.Items {
+		bridge := &bridgeList.Items[i]
+		if bridge.Name == exclude || !bridge.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		source := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Name: bridge.Name + KubeconfigSecretSuffix, Namespace: namespace}, source); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get kubeconfig secret for bridge %s: %w", bridge.Name, err)
+		}
+
+		normalized, err := normalizeKubeconfig(source.Data["kubeconfig"], bridge.Name)
+		if err != nil {
+			log.V(1).Info("Skipping unparsable kubeconfig in merged output",
+				"bridge", bridge.Name,
+				"error", err.Error())
+			continue
+		}
+		kubeconfigs[bridge.Name] = normalized
+	}
+
+	key := types.NamespacedName{Name: MergedKubeconfigSecretName, Namespace: namespace}
+	existing := &corev1.Secret{}
+	err := c.Get(ctx, key, existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get merged kubeconfig secret: %w", err)
+	}
+	exists := err == nil
+
+	if len(kubeconfigs) == 0 {
+		if exists {
+			log.Info("No kubeconfigs left, deleting merged kubeconfig secret")
+			if err := c.Delete(ctx, existing); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete merged kubeconfig secret: %w", err)
+			}
+		}
+		return nil
+	}
+
+	merged, err := mergeKubeconfigs(kubeconfigs)
+	if err != nil {
+		return fmt.Errorf("failed to merge kubeconfigs: %w", err)
+	}
+
+	if !exists {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      MergedKubeconfigSecretName,
+				Namespace: namespace,
+				Labels: map[string]string{
+					LabelMergedKubeconfig: "true",
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				"kubeconfig": merged,
+			},
+		}
+		if err := c.Create(ctx, secret); err != nil {
+			return fmt.Errorf("failed to create merged kubeconfig secret: %w", err)
+		}
+		log.Info("Created merged kubeconfig secret", "contexts", len(kubeconfigs))
+		return nil
+	}
+
+	if bytes.Equal(existing.Data["kubeconfig"], merged) {
+		return nil
+	}
+
+	existing.Data = map[string][]byte{
+		"kubeconfig": merged,
+	}
+	if err := c.Update(ctx, existing); err != nil {
+		return fmt.Errorf("failed to update merged kubeconfig secret: %w", err)
+	}
+	log.Info("Updated merged kubeconfig secret", "contexts", len(kubeconfigs))
+
+	return nil
+}
+
+// RemoveMergedKubeconfig deletes the merged kubeconfig Secret in the given namespace, if the
+// operator published one. A Secret with the same name that lacks LabelMergedKubeconfig is left alone.
+func RemoveMergedKubeconfig(ctx context.Context, c client.Client, namespace string) error {
+	existing := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: MergedKubeconfigSecretName, Namespace: namespace}, existing); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get merged kubeconfig secret: %w", err)
+	}
+
+	if existing.Labels[LabelMergedKubeconfig] != "true" {
+		return nil
+	}
+
+	if err := c.Delete(ctx, existing); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete merged kubeconfig secret: %w", err)
+	}
+	logf.FromContext(ctx).Info("Merged kubeconfig publishing disabled, deleted merged kubeconfig secret",
+		"mergedKubeconfig", fmt.Sprintf("%s/%s", namespace, MergedKubeconfigSecretName))
+
+	return nil
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfiginjection
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+// ReplicaKubeconfigKey is the data key of the replicated kubeconfig Secret.
+// DPF components read admin kubeconfigs from the "admin.conf" key.
+const ReplicaKubeconfigKey = "admin.conf"
+
+// ReplicaSecretName returns the name of the kubeconfig replica for the given DPUCluster.
+// It follows the DPF convention of naming admin kubeconfigs after the DPUCluster.
+func ReplicaSecretName(dpuCluster provisioningv1alpha1.DPUClusterReference) string {
+	return dpuCluster.Name + KubeconfigSecretSuffix
+}
+
+// replicateKubeconfig keeps a copy of the HostedCluster admin kubeconfig in the DPF operator
+// namespace if ReplicaNamespace is set, one per DPUCluster of the bridge.
+//
+// The replica is rewritten whenever its content differs from the source, so a kubeconfig
+// rotated by HyperShift is propagated on the next reconcile triggered by the secret watch.
+// A Secret of the same name that is not owned by this bridge is never overwritten.
+func (ki *KubeconfigInjector) replicateKubeconfig(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge) error {
+	if ki.ReplicaNamespace == "" {
+		return nil
+	}
+
+	for _, ref := range bridge.ResolvedDPUClusterRefs() {
+		if err := ki.replicateKubeconfigAs(ctx, bridge, ReplicaSecretName(ref)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replicateKubeconfigAs keeps the replica named replicaName in sync with the HostedCluster admin kubeconfig
+func (ki *KubeconfigInjector) replicateKubeconfigAs(ctx context.Context, bridge *provisioningv1alpha1.DPFHCPBridge, replicaName string) error {
+	log := logf.FromContext(ctx).WithValues("replica", fmt.Sprintf("%s/%s", ki.ReplicaNamespace, replicaName))
+
+	source := &corev1.Secret{}
+	sourceKey := types.NamespacedName{Name: bridge.Name + KubeconfigSecretSuffix, Namespace: bridge.Namespace}
+	if err := ki.Client.Get(ctx, sourceKey, source); err != nil {
+		return fmt.Errorf("failed to read source kubeconfig secret: %w", err)
+	}
+	sourceData, ok := source.Data["kubeconfig"]
+	if !ok {
+		return fmt.Errorf("source secret missing 'kubeconfig' key")
+	}
+	kubeconfigData := destinationKubeconfig(ctx, bridge, sourceData)
+	sourceHash := kubeconfigHash(sourceData)
+	labels := common.ComponentOwnerLabels(bridge, common.ComponentKubeconfigReplica)
+
+	existing := &corev1.Secret{}
+	err := ki.Client.Get(ctx, types.NamespacedName{Name: replicaName, Namespace: ki.ReplicaNamespace}, existing)
+	if apierrors.IsNotFound(err) {
+		replica := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      replicaName,
+				Namespace: ki.ReplicaNamespace,
+				// Cross-namespace: garbage collected by label from the bridge finalizer
+				Labels: labels,
+				Annotations: map[string]string{
+					AnnotationSourceHash: sourceHash,
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				ReplicaKubeconfigKey: kubeconfigData,
+			},
+		}
+		if err := ki.Client.Create(ctx, replica); err != nil {
+			return fmt.Errorf("failed to create kubeconfig replica: %w", err)
+		}
+		log.Info("Created kubeconfig replica")
+		ki.Recorder.Eventf(bridge, corev1.EventTypeNormal, "KubeconfigReplicated",
+			"Kubeconfig replicated to %s/%s", ki.ReplicaNamespace, replicaName)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get kubeconfig replica: %w", err)
+	}
+
+	if existing.Labels[common.LabelOwnedBy] != bridge.Name || existing.Labels[common.LabelNamespace] != bridge.Namespace {
+		return fmt.Errorf("secret %s/%s already exists and is not owned by this DPFHCPBridge", ki.ReplicaNamespace, replicaName)
+	}
+
+	if bytes.Equal(existing.Data[ReplicaKubeconfigKey], kubeconfigData) {
+		return nil
+	}
+
+	if existing.Labels == nil {
+		existing.Labels = map[string]string{}
+	}
+	maps.Copy(existing.Labels, labels)
+	if existing.Annotations == nil {
+		existing.Annotations = map[string]string{}
+	}
+	rotated := existing.Annotations[AnnotationSourceHash] != "" && existing.Annotations[AnnotationSourceHash] != sourceHash
+	existing.Annotations[AnnotationSourceHash] = sourceHash
+	existing.Data = map[string][]byte{
+		ReplicaKubeconfigKey: kubeconfigData,
+	}
+	if err := ki.Client.Update(ctx, existing); err != nil {
+		return fmt.Errorf("failed to update kubeconfig replica: %w", err)
+	}
+	log.Info("Refreshed kubeconfig replica", "rotated", rotated)
+	if rotated {
+		ki.Recorder.Eventf(bridge, corev1.EventTypeNormal, "KubeconfigRotated",
+			"HostedCluster kubeconfig rotated, re-synced replica %s/%s", ki.ReplicaNamespace, replicaName)
+	} else {
+		ki.Recorder.Eventf(bridge, corev1.EventTypeNormal, "KubeconfigReplicaRefreshed",
+			"Kubeconfig replica %s/%s refreshed", ki.ReplicaNamespace, replicaName)
+	}
+	return nil
+}
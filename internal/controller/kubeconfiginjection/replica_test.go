@@ -0,0 +1,168 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfiginjection
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+var _ = Describe("Kubeconfig Replica", func() {
+	const replicaNamespace = "dpf-operator-system"
+
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		injector   *KubeconfigInjector
+		bridge     *provisioningv1alpha1.DPFHCPBridge
+		hcSecret   *corev1.Secret
+		replicaKey types.NamespacedName
+	)
+
+	build := func(objs ...client.Object) {
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(dpuprovisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dpu", Namespace: "dpu-ns"},
+			Spec:       dpuprovisioningv1alpha1.DPUClusterSpec{Type: "bf3"},
+		}
+		objs = append(objs, bridge, hcSecret, dpuCluster)
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(bridge, dpuCluster).
+			Build()
+
+		injector = NewKubeconfigInjector(fakeClient, recorder)
+		injector.ReplicaNamespace = replicaNamespace
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		recorder = record.NewFakeRecorder(100)
+		replicaKey = types.NamespacedName{Name: "test-dpu-admin-kubeconfig", Namespace: replicaNamespace}
+
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "test-ns"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef: provisioningv1alpha1.DPUClusterReference{Name: "test-dpu", Namespace: "dpu-ns"},
+			},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				HostedClusterRef: &corev1.ObjectReference{Name: "test-bridge", Namespace: "test-ns"},
+				Conditions:       []metav1.Condition{hostedClusterAvailable},
+			},
+		}
+		hcSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge-admin-kubeconfig", Namespace: "test-ns"},
+			Data:       map[string][]byte{"kubeconfig": []byte("fake-kubeconfig-data")},
+		}
+	})
+
+	It("should replicate the kubeconfig into the replica namespace under the DPF name and key", func() {
+		build()
+
+		_, err := injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		replica := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, replicaKey, replica)).To(Succeed())
+		Expect(replica.Data).To(HaveKeyWithValue(ReplicaKubeconfigKey, []byte("fake-kubeconfig-data")))
+		Expect(replica.Labels).To(HaveKeyWithValue(common.LabelOwnedBy, "test-bridge"))
+		Expect(replica.Labels).To(HaveKeyWithValue(common.LabelNamespace, "test-ns"))
+		Expect(replica.Labels).To(HaveKeyWithValue(common.LabelComponent, common.ComponentKubeconfigReplica))
+	})
+
+	It("should refresh the replica when HyperShift rotates the kubeconfig", func() {
+		build()
+
+		_, err := injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		rotated := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(hcSecret), rotated)).To(Succeed())
+		rotated.Data["kubeconfig"] = []byte("rotated-kubeconfig-data")
+		Expect(fakeClient.Update(ctx, rotated)).To(Succeed())
+
+		_, err = injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		replica := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, replicaKey, replica)).To(Succeed())
+		Expect(replica.Data).To(HaveKeyWithValue(ReplicaKubeconfigKey, []byte("rotated-kubeconfig-data")))
+	})
+
+	It("should not overwrite a secret that is not owned by the bridge", func() {
+		foreign := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: replicaKey.Name, Namespace: replicaKey.Namespace},
+			Data:       map[string][]byte{ReplicaKubeconfigKey: []byte("foreign")},
+		}
+		build(foreign)
+
+		_, err := injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not owned by this DPFHCPBridge"))
+
+		replica := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, replicaKey, replica)).To(Succeed())
+		Expect(replica.Data).To(HaveKeyWithValue(ReplicaKubeconfigKey, []byte("foreign")))
+	})
+
+	It("should not replicate when no replica namespace is configured", func() {
+		build()
+		injector.ReplicaNamespace = ""
+
+		_, err := injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = fakeClient.Get(ctx, replicaKey, &corev1.Secret{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should delete the replica during cleanup", func() {
+		build()
+
+		_, err := injector.InjectKubeconfig(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeClient.Get(ctx, replicaKey, &corev1.Secret{})).To(Succeed())
+
+		handler := NewCleanupHandler(fakeClient, recorder)
+		handler.ReplicaNamespace = replicaNamespace
+		_, err = handler.Cleanup(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = fakeClient.Get(ctx, replicaKey, &corev1.Secret{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
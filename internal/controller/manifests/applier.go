@@ -0,0 +1,319 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const (
+	// FieldManager is the server-side apply field manager used for additional manifests
+	FieldManager = "dpf-hcp-bridge-operator"
+
+	// hostedClusterKubeconfigSuffix is the suffix of the HostedCluster admin kubeconfig secret
+	hostedClusterKubeconfigSuffix = "-admin-kubeconfig"
+
+	// hostedClusterKubeconfigKey is the key holding the kubeconfig in the admin kubeconfig secret
+	hostedClusterKubeconfigKey = "kubeconfig"
+
+	// CRDPendingRequeueInterval is how often manifests of a kind the hosted cluster does not serve yet are retried
+	CRDPendingRequeueInterval = 30 * time.Second
+)
+
+// errInvalidManifest indicates a ConfigMap contains data that cannot be decoded into Kubernetes objects
+var errInvalidManifest = errors.New("invalid manifest")
+
+// HostedClusterClientFunc builds a client for the hosted cluster from its admin kubeconfig
+type HostedClusterClientFunc func(kubeconfig []byte) (client.Client, error)
+
+// Applier applies the manifests referenced by spec.additionalManifestsRefs, plus the built-in
+// DPU device plugin manifests when spec.enableDPUDevicePlugins is set and the built-in ingress VIP
+// manifests when spec.ingressVIP is set, into the hosted cluster.
+//
+// HyperShift only injects MachineConfig-type resources through NodePool.spec.config, so arbitrary
+// day-1 objects (DaemonSets, NetworkPolicies, ...) are server-side applied by the operator using the
+// hosted cluster admin kubeconfig as soon as the hosted control plane is available, before DPU workers join.
+type Applier struct {
+	client   client.Client
+	recorder record.EventRecorder
+
+	// NewHostedClusterClient builds the hosted cluster client; defaults to NewHostedClusterClient
+	NewHostedClusterClient HostedClusterClientFunc
+}
+
+// NewApplier creates a new additional manifests Applier
+func NewApplier(client client.Client, recorder record.EventRecorder) *Applier {
+	return &Applier{
+		client:                 client,
+		recorder:               recorder,
+		NewHostedClusterClient: NewHostedClusterClient,
+	}
+}
+
+// NewHostedClusterClient builds a client for the hosted cluster from an admin kubeconfig
+func NewHostedClusterClient(kubeconfig []byte) (client.Client, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse hosted cluster kubeconfig: %w", err)
+	}
+	return client.New(restConfig, client.Options{})
+}
+
+// ApplyAdditionalManifests applies the referenced manifests into the hosted cluster and reports
+// the outcome in the AdditionalManifestsApplied condition. It also records the DNS record the ingress VIP
+// needs in status.ingressDNSRecord.
+//
+// Manifests are only (re-)applied when their content changes, tracked via status.additionalManifestsHash.
+// Objects removed from the ConfigMaps are not deleted from the hosted cluster. Objects of a kind the
+// hosted cluster does not serve yet, e.g. until an operator installed by the manifests created its CRDs,
+// are retried every CRDPendingRequeueInterval.
+//
+// Returns ctrl.Result and error for reconciliation flow
+func (a *Applier) ApplyAdditionalManifests(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	cr.Status.IngressDNSRecord = IngressDNSRecord(cr)
+
+	if len(cr.Spec.AdditionalManifestsRefs) == 0 && !cr.Spec.EnableDPUDevicePlugins && cr.Spec.IngressVIP == "" {
+		log.V(1).Info("No additional manifests configured")
+		return ctrl.Result{}, nil
+	}
+
+	if !meta.IsStatusConditionTrue(cr.Status.Conditions, provisioningv1alpha1.HostedClusterAvailable) {
+		log.V(1).Info("Skipping additional manifests - HostedCluster not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	objects, hash, err := a.loadManifests(ctx, cr)
+	if err != nil {
+		reason := provisioningv1alpha1.ReasonManifestsConfigMapNotFound
+		if errors.Is(err, errInvalidManifest) {
+			reason = provisioningv1alpha1.ReasonManifestsInvalid
+		} else if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		// ConfigMap watch will trigger a new reconcile once the user fixes the ConfigMap
+		return ctrl.Result{}, a.setCondition(ctx, cr, metav1.ConditionFalse, reason, err.Error())
+	}
+
+	if hash == cr.Status.AdditionalManifestsHash &&
+		meta.IsStatusConditionTrue(cr.Status.Conditions, provisioningv1alpha1.AdditionalManifestsApplied) {
+		log.V(1).Info("Additional manifests already applied", "hash", hash)
+		return ctrl.Result{}, nil
+	}
+
+	kubeconfig, err := a.hostedClusterKubeconfig(ctx, cr)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Kubeconfig secret watch will trigger a new reconcile once HyperShift creates it
+			return ctrl.Result{}, a.setCondition(ctx, cr, metav1.ConditionFalse,
+				provisioningv1alpha1.ReasonManifestsKubeconfigPending, "Waiting for hosted cluster admin kubeconfig")
+		}
+		return ctrl.Result{}, err
+	}
+
+	hcClient, err := a.NewHostedClusterClient(kubeconfig)
+	if err != nil {
+		log.Error(err, "Failed to create hosted cluster client")
+		if condErr := a.setCondition(ctx, cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonManifestsApplyFailed, err.Error()); condErr != nil {
+			return ctrl.Result{}, condErr
+		}
+		return ctrl.Result{}, err
+	}
+
+	for _, obj := range objects {
+		if err := hcClient.Patch(ctx, obj, client.Apply, client.FieldOwner(FieldManager), client.ForceOwnership); err != nil {
+			if meta.IsNoMatchError(err) {
+				log.Info("Kind of additional manifest not served by the hosted cluster yet, retrying",
+					"kind", obj.GetKind(), "namespace", obj.GetNamespace(), "name", obj.GetName())
+				message := fmt.Sprintf("Waiting for the hosted cluster to serve %s %s: %v", obj.GetKind(), client.ObjectKeyFromObject(obj), err)
+				if condErr := a.setCondition(ctx, cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonManifestsCRDPending, message); condErr != nil {
+					return ctrl.Result{}, condErr
+				}
+				return ctrl.Result{RequeueAfter: CRDPendingRequeueInterval}, nil
+			}
+			log.Error(err, "Failed to apply additional manifest",
+				"kind", obj.GetKind(), "namespace", obj.GetNamespace(), "name", obj.GetName())
+			message := fmt.Sprintf("Failed to apply %s %s: %v", obj.GetKind(), client.ObjectKeyFromObject(obj), err)
+			if condErr := a.setCondition(ctx, cr, metav1.ConditionFalse, provisioningv1alpha1.ReasonManifestsApplyFailed, message); condErr != nil {
+				return ctrl.Result{}, condErr
+			}
+			return ctrl.Result{}, fmt.Errorf("failed to apply additional manifest %s %s: %w", obj.GetKind(), client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	log.Info("Applied additional manifests to hosted cluster", "objects", len(objects))
+	cr.Status.AdditionalManifestsHash = hash
+	message := fmt.Sprintf("Applied %d objects from %d ConfigMaps", len(objects), len(cr.Spec.AdditionalManifestsRefs))
+	if cr.Spec.EnableDPUDevicePlugins {
+		message += " and the built-in DPU device plugin manifests"
+	}
+	if cr.Spec.IngressVIP != "" {
+		message += fmt.Sprintf(" and the built-in ingress VIP manifests for %s", cr.Spec.IngressVIP)
+	}
+	return ctrl.Result{}, a.setCondition(ctx, cr, metav1.ConditionTrue, provisioningv1alpha1.ReasonManifestsApplied, message)
+}
+
+// loadManifests decodes the built-in manifests (if enabled) followed by all referenced ConfigMaps.
+// Keys within a ConfigMap are processed in sorted order; documents within a key keep their order.
+// Returns the decoded objects and a hash of the raw manifest content.
+func (a *Applier) loadManifests(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) ([]*unstructured.Unstructured, string, error) {
+	hasher := sha256.New()
+	var objects []*unstructured.Unstructured
+
+	if cr.Spec.EnableDPUDevicePlugins {
+		fmt.Fprintf(hasher, "%s\x00%s\x00", builtinDPUDevicePluginsName, dpuDevicePluginsManifests)
+		decoded, err := decodeManifests(dpuDevicePluginsManifests)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to decode built-in DPU device plugin manifests: %w", err)
+		}
+		objects = append(objects, decoded...)
+	}
+
+	if cr.Spec.IngressVIP != "" {
+		rendered, err := renderIngressVIPManifests(cr.Spec.IngressVIP)
+		if err != nil {
+			return nil, "", err
+		}
+		fmt.Fprintf(hasher, "%s\x00%s\x00", builtinIngressVIPName, rendered)
+		decoded, err := decodeManifests(rendered)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to decode built-in ingress VIP manifests: %w", err)
+		}
+		objects = append(objects, decoded...)
+	}
+
+	for _, ref := range cr.Spec.AdditionalManifestsRefs {
+		cm := &corev1.ConfigMap{}
+		if err := a.client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: cr.Namespace}, cm); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, "", apierrors.NewNotFound(corev1.Resource("configmaps"), ref.Name)
+			}
+			return nil, "", fmt.Errorf("failed to get additional manifests ConfigMap %s: %w", ref.Name, err)
+		}
+
+		keys := make([]string, 0, len(cm.Data))
+		for key := range cm.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			data := cm.Data[key]
+			fmt.Fprintf(hasher, "%s/%s\x00%s\x00", ref.Name, key, data)
+
+			decoded, err := decodeManifests([]byte(data))
+			if err != nil {
+				return nil, "", fmt.Errorf("%w in ConfigMap %s key %s: %v", errInvalidManifest, ref.Name, key, err)
+			}
+			objects = append(objects, decoded...)
+		}
+	}
+
+	return objects, hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// decodeManifests decodes a multi-document YAML or JSON stream into unstructured objects.
+// Empty documents are skipped; every other document must have apiVersion, kind and metadata.name.
+func decodeManifests(data []byte) ([]*unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	var objects []*unstructured.Unstructured
+
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.GetAPIVersion() == "" || obj.GetKind() == "" || obj.GetName() == "" {
+			return nil, fmt.Errorf("document %d is missing apiVersion, kind or metadata.name", len(objects)+1)
+		}
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// hostedClusterKubeconfig reads the HostedCluster admin kubeconfig created by HyperShift
+func (a *Applier) hostedClusterKubeconfig(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) ([]byte, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Name: cr.Name + hostedClusterKubeconfigSuffix, Namespace: cr.Namespace}
+	if err := a.client.Get(ctx, key, secret); err != nil {
+		return nil, err
+	}
+
+	kubeconfig, ok := secret.Data[hostedClusterKubeconfigKey]
+	if !ok || len(kubeconfig) == 0 {
+		return nil, apierrors.NewNotFound(corev1.Resource("secrets"), key.Name)
+	}
+	return kubeconfig, nil
+}
+
+// setCondition updates the AdditionalManifestsApplied condition and persists status.
+// Emits an event only when the condition changes to avoid spam.
+func (a *Applier) setCondition(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, status metav1.ConditionStatus, reason, message string) error {
+	condition := metav1.Condition{
+		Type:               provisioningv1alpha1.AdditionalManifestsApplied,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: cr.Generation,
+	}
+
+	if changed := meta.SetStatusCondition(&cr.Status.Conditions, condition); changed {
+		eventType := corev1.EventTypeNormal
+		if status == metav1.ConditionFalse {
+			eventType = corev1.EventTypeWarning
+		}
+		a.recorder.Event(cr, eventType, reason, message)
+	}
+
+	if err := a.client.Status().Update(ctx, cr); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to update AdditionalManifestsApplied condition", "reason", reason)
+		return fmt.Errorf("failed to update AdditionalManifestsApplied condition: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const daemonSetManifest = `apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: dpu-agent
+  namespace: kube-system
+---
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: allow-dpu
+  namespace: kube-system
+`
+
+var _ = Describe("Additional Manifests Applier", func() {
+	var (
+		ctx        context.Context
+		scheme     *runtime.Scheme
+		recorder   *record.FakeRecorder
+		fakeClient client.Client
+		applier    *Applier
+		bridge     *provisioningv1alpha1.DPFHCPBridge
+		applied    []string
+		kubeconfig *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		recorder = record.NewFakeRecorder(100)
+		applied = nil
+
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-bridge",
+				Namespace: "test-ns",
+			},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				AdditionalManifestsRefs: []corev1.LocalObjectReference{{Name: "day1"}},
+			},
+		}
+		meta.SetStatusCondition(&bridge.Status.Conditions, metav1.Condition{
+			Type:   provisioningv1alpha1.HostedClusterAvailable,
+			Status: metav1.ConditionTrue,
+			Reason: "AsExpected",
+		})
+
+		kubeconfig = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge-admin-kubeconfig", Namespace: "test-ns"},
+			Data:       map[string][]byte{"kubeconfig": []byte("fake-kubeconfig-data")},
+		}
+	})
+
+	buildApplier := func(objs ...client.Object) {
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(append([]client.Object{bridge}, objs...)...).
+			WithStatusSubresource(bridge).
+			Build()
+		applier = NewApplier(fakeClient, recorder)
+		applier.NewHostedClusterClient = func(_ []byte) (client.Client, error) {
+			// The fake client does not support server-side apply, so record apply patches instead
+			return fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, patch client.Patch, _ ...client.PatchOption) error {
+					Expect(patch).To(Equal(client.Apply))
+					applied = append(applied, fmt.Sprintf("%s/%s", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName()))
+					return nil
+				},
+			}).Build(), nil
+		}
+	}
+
+	manifestsConfigMap := func(data string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "day1", Namespace: "test-ns"},
+			Data:       map[string]string{"manifests.yaml": data},
+		}
+	}
+
+	It("should skip when the HostedCluster is not available", func() {
+		bridge.Status.Conditions = nil
+		buildApplier(manifestsConfigMap(daemonSetManifest), kubeconfig)
+
+		_, err := applier.ApplyAdditionalManifests(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(BeEmpty())
+		Expect(meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.AdditionalManifestsApplied)).To(BeNil())
+	})
+
+	It("should apply every document and record the manifests hash", func() {
+		buildApplier(manifestsConfigMap(daemonSetManifest), kubeconfig)
+
+		_, err := applier.ApplyAdditionalManifests(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(Equal([]string{"DaemonSet/dpu-agent", "NetworkPolicy/allow-dpu"}))
+		Expect(bridge.Status.AdditionalManifestsHash).NotTo(BeEmpty())
+		Expect(meta.IsStatusConditionTrue(bridge.Status.Conditions, provisioningv1alpha1.AdditionalManifestsApplied)).To(BeTrue())
+
+		// Unchanged manifests are not re-applied
+		applied = nil
+		_, err = applier.ApplyAdditionalManifests(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(BeEmpty())
+	})
+
+	It("should report a missing ConfigMap", func() {
+		buildApplier(kubeconfig)
+
+		_, err := applier.ApplyAdditionalManifests(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		cond := meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.AdditionalManifestsApplied)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonManifestsConfigMapNotFound))
+	})
+
+	It("should report invalid manifests", func() {
+		buildApplier(manifestsConfigMap("kind: DaemonSet\n"), kubeconfig)
+
+		_, err := applier.ApplyAdditionalManifests(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		cond := meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.AdditionalManifestsApplied)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonManifestsInvalid))
+		Expect(applied).To(BeEmpty())
+	})
+
+	It("should wait for the hosted cluster kubeconfig", func() {
+		buildApplier(manifestsConfigMap(daemonSetManifest))
+
+		_, err := applier.ApplyAdditionalManifests(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		cond := meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.AdditionalManifestsApplied)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonManifestsKubeconfigPending))
+	})
+
+	It("should apply the built-in DPU device plugin manifests before referenced ConfigMaps", func() {
+		bridge.Spec.EnableDPUDevicePlugins = true
+		buildApplier(manifestsConfigMap(daemonSetManifest), kubeconfig)
+
+		_, err := applier.ApplyAdditionalManifests(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(ContainElements("Subscription/sriov-network-operator-subscription", "DaemonSet/rdma-shared-device-plugin"))
+		Expect(applied[0]).To(Equal("Namespace/openshift-sriov-network-operator"))
+		Expect(applied[len(applied)-1]).To(Equal("NetworkPolicy/allow-dpu"))
+	})
+
+	It("should apply the built-in ingress VIP manifests and report the DNS record", func() {
+		bridge.Spec.AdditionalManifestsRefs = nil
+		bridge.Spec.BaseDomain = "example.com"
+		bridge.Spec.IngressVIP = "192.168.1.101"
+		buildApplier(kubeconfig)
+
+		_, err := applier.ApplyAdditionalManifests(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(Equal([]string{
+			"Namespace/metallb-system",
+			"OperatorGroup/metallb-operator",
+			"Subscription/metallb-operator-sub",
+			"MetalLB/metallb",
+			"IPAddressPool/ingress-vip",
+			"L2Advertisement/ingress-vip",
+			"Service/metallb-ingress",
+		}))
+		Expect(bridge.Status.IngressDNSRecord).To(Equal(&provisioningv1alpha1.DNSRecord{
+			Name:    "*.apps.test-bridge.example.com",
+			Type:    "A",
+			Address: "192.168.1.101",
+		}))
+		cond := meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.AdditionalManifestsApplied)
+		Expect(cond.Message).To(ContainSubstring("ingress VIP manifests for 192.168.1.101"))
+
+		// Moving the VIP re-applies the manifests
+		hash := bridge.Status.AdditionalManifestsHash
+		bridge.Spec.IngressVIP = "192.168.1.102"
+		applied = nil
+		_, err = applier.ApplyAdditionalManifests(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(HaveLen(7))
+		Expect(bridge.Status.AdditionalManifestsHash).NotTo(Equal(hash))
+		Expect(bridge.Status.IngressDNSRecord.Address).To(Equal("192.168.1.102"))
+	})
+
+	It("should retry manifests of kinds the hosted cluster does not serve yet", func() {
+		bridge.Spec.AdditionalManifestsRefs = nil
+		bridge.Spec.IngressVIP = "192.168.1.101"
+		buildApplier(kubeconfig)
+		applier.NewHostedClusterClient = func(_ []byte) (client.Client, error) {
+			return fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					gvk := obj.GetObjectKind().GroupVersionKind()
+					if gvk.Group == "metallb.io" {
+						return &meta.NoKindMatchError{GroupKind: gvk.GroupKind(), SearchedVersions: []string{gvk.Version}}
+					}
+					applied = append(applied, fmt.Sprintf("%s/%s", gvk.Kind, obj.GetName()))
+					return nil
+				},
+			}).Build(), nil
+		}
+
+		result, err := applier.ApplyAdditionalManifests(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(CRDPendingRequeueInterval))
+		Expect(applied).To(ContainElement("Subscription/metallb-operator-sub"))
+		Expect(bridge.Status.AdditionalManifestsHash).To(BeEmpty())
+		cond := meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.AdditionalManifestsApplied)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonManifestsCRDPending))
+		Expect(cond.Message).To(ContainSubstring("MetalLB metallb-system/metallb"))
+	})
+
+	It("should apply the built-in manifests without any ConfigMap references", func() {
+		bridge.Spec.AdditionalManifestsRefs = nil
+		bridge.Spec.EnableDPUDevicePlugins = true
+		buildApplier(kubeconfig)
+
+		_, err := applier.ApplyAdditionalManifests(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).NotTo(BeEmpty())
+		Expect(meta.IsStatusConditionTrue(bridge.Status.Conditions, provisioningv1alpha1.AdditionalManifestsApplied)).To(BeTrue())
+	})
+
+	Describe("ingress VIP", func() {
+		It("should announce an IPv6 ingress VIP as a single address", func() {
+			rendered, err := renderIngressVIPManifests("fd00::65")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(rendered)).To(ContainSubstring(`- "fd00::65/128"`))
+			Expect(string(rendered)).To(ContainSubstring(`metallb.universe.tf/loadBalancerIPs: "fd00::65"`))
+
+			bridge.Spec.BaseDomain = "example.com"
+			bridge.Spec.IngressVIP = "fd00::65"
+			Expect(IngressDNSRecord(bridge).Type).To(Equal("AAAA"))
+		})
+
+		It("should render the built-in manifests the bridge enables", func() {
+			rendered, err := RenderBuiltinManifests(bridge)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rendered).To(BeEmpty())
+
+			bridge.Spec.EnableDPUDevicePlugins = true
+			bridge.Spec.IngressVIP = "192.168.1.101"
+			rendered, err = RenderBuiltinManifests(bridge)
+			Expect(err).NotTo(HaveOccurred())
+			objects, err := decodeManifests(rendered)
+			Expect(err).NotTo(HaveOccurred())
+			devicePlugins, err := decodeManifests(dpuDevicePluginsManifests)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(objects)).To(BeNumerically(">", len(devicePlugins)))
+			Expect(string(rendered)).To(ContainSubstring(`- "192.168.1.101/32"`))
+		})
+
+		It("should not report a DNS record without an ingress VIP", func() {
+			Expect(IngressDNSRecord(bridge)).To(BeNil())
+		})
+	})
+
+	Describe("decodeManifests", func() {
+		It("should skip empty documents", func() {
+			objects, err := decodeManifests([]byte("---\n" + daemonSetManifest + "---\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(HaveLen(2))
+		})
+	})
+})
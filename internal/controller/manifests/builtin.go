@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"net/netip"
+	"text/template"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// builtinDPUDevicePluginsName identifies the built-in DPU device plugin manifests in the manifests hash
+const builtinDPUDevicePluginsName = "builtin/dpu-device-plugins.yaml"
+
+// dpuDevicePluginsManifests holds the SR-IOV network operator and RDMA shared device plugin manifests
+// applied when spec.enableDPUDevicePlugins is set
+//
+//go:embed builtin/dpu-device-plugins.yaml
+var dpuDevicePluginsManifests []byte
+
+// builtinIngressVIPName identifies the built-in ingress VIP manifests in the manifests hash
+const builtinIngressVIPName = "builtin/ingress-vip.yaml"
+
+// ingressVIPManifests holds the MetalLB and ingress Service manifests applied when spec.ingressVIP is set,
+// as a template of the address
+//
+//go:embed builtin/ingress-vip.yaml
+var ingressVIPManifests string
+
+var ingressVIPTemplate = template.Must(template.New(builtinIngressVIPName).Parse(ingressVIPManifests))
+
+// renderIngressVIPManifests renders the built-in ingress VIP manifests announcing the address
+func renderIngressVIPManifests(vip string) ([]byte, error) {
+	addr, err := netip.ParseAddr(vip)
+	if err != nil {
+		return nil, fmt.Errorf("%w: ingressVIP %q is not an IP address", errInvalidManifest, vip)
+	}
+
+	var rendered bytes.Buffer
+	if err := ingressVIPTemplate.Execute(&rendered, struct {
+		Address      string
+		PrefixLength int
+	}{
+		Address:      addr.String(),
+		PrefixLength: addr.BitLen(),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to render built-in ingress VIP manifests: %w", err)
+	}
+	return rendered.Bytes(), nil
+}
+
+// RenderBuiltinManifests returns the built-in manifests the bridge spec enables, as applied to the hosted
+// cluster: the DPU device plugin manifests followed by the ingress VIP manifests. The result is empty if
+// the bridge enables neither.
+func RenderBuiltinManifests(cr *provisioningv1alpha1.DPFHCPBridge) ([]byte, error) {
+	var rendered [][]byte
+	if cr.Spec.EnableDPUDevicePlugins {
+		rendered = append(rendered, dpuDevicePluginsManifests)
+	}
+	if cr.Spec.IngressVIP != "" {
+		ingressVIP, err := renderIngressVIPManifests(cr.Spec.IngressVIP)
+		if err != nil {
+			return nil, err
+		}
+		rendered = append(rendered, ingressVIP)
+	}
+	return bytes.Join(rendered, []byte("---\n")), nil
+}
+
+// IngressDNSRecord returns the wildcard DNS record the *.apps routes of the hosted cluster need, resolving
+// to spec.ingressVIP, or nil if it is not set. HyperShift serves the routes of a hosted cluster under
+// apps.<name>.<baseDomain>.
+func IngressDNSRecord(cr *provisioningv1alpha1.DPFHCPBridge) *provisioningv1alpha1.DNSRecord {
+	if cr.Spec.IngressVIP == "" {
+		return nil
+	}
+
+	recordType := "A"
+	if addr, err := netip.ParseAddr(cr.Spec.IngressVIP); err == nil && addr.Is6() {
+		recordType = "AAAA"
+	}
+	return &provisioningv1alpha1.DNSRecord{
+		Name:    fmt.Sprintf("*.apps.%s.%s", cr.Name, cr.Spec.BaseDomain),
+		Type:    recordType,
+		Address: cr.Spec.IngressVIP,
+	}
+}
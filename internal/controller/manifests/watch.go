@@ -0,0 +1,71 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// FindBridgesForManifestsConfigMap maps a ConfigMap to the DPFHCPBridge CRs in its namespace
+// that reference it in spec.additionalManifestsRefs
+func FindBridgesForManifestsConfigMap(ctx context.Context, c client.Client, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		log.Error(nil, "Failed to convert object to ConfigMap", "object", obj)
+		return []reconcile.Request{}
+	}
+
+	var bridgeList provisioningv1alpha1.DPFHCPBridgeList
+	if err := c.List(ctx, &bridgeList, client.InNamespace(cm.Namespace)); err != nil {
+		log.Error(err, "Failed to list DPFHCPBridge CRs for additional manifests ConfigMap watch")
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, bridge := range bridgeList.Items {
+		for _, ref := range bridge.Spec.AdditionalManifestsRefs {
+			if ref.Name == cm.Name {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name:      bridge.Name,
+						Namespace: bridge.Namespace,
+					},
+				})
+				break
+			}
+		}
+	}
+
+	if len(requests) > 0 {
+		log.Info("Additional manifests ConfigMap changed, triggering reconciliation",
+			"configMap", cm.Name,
+			"namespace", cm.Namespace,
+			"reconcileCount", len(requests))
+	}
+
+	return requests
+}
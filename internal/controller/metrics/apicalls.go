@@ -0,0 +1,137 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/rest"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+// coreGroup is the api_group label value of the core ("") API group
+const coreGroup = "core"
+
+// APIRequestDuration is the latency of the operator's requests to the management cluster apiserver,
+// by target API group and verb. Watches are long-lived and excluded.
+var APIRequestDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    common.DPFHCPBridgeName + "_api_request_duration_seconds",
+		Help:    "Latency of the operator's management cluster API requests by target API group and verb",
+		Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	},
+	[]string{"api_group", "verb"},
+)
+
+// APIRequests counts the operator's requests to the management cluster apiserver by target API group,
+// verb and response code, "error" if no response was received
+var APIRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: common.DPFHCPBridgeName + "_api_requests_total",
+		Help: "Number of the operator's management cluster API requests by target API group, verb and response code",
+	},
+	[]string{"api_group", "verb", "code"},
+)
+
+// APIRequestThrottleSeconds is the time the requests of a DPFHCPBridge waited for its API request budget
+var APIRequestThrottleSeconds = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: common.DPFHCPBridgeName + "_api_request_throttle_seconds_total",
+		Help: "Time the management cluster API requests of a DPFHCPBridge waited for its per-bridge request budget",
+	},
+	[]string{"namespace", "name"},
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(APIRequestDuration, APIRequests, APIRequestThrottleSeconds)
+}
+
+// InstrumentAPIRequests wraps the transport of the given config to record APIRequestDuration and APIRequests.
+//
+// Unlike the client-go rest_client metrics, requests are broken down by API group, so that slow
+// HyperShift or MetalLB webhooks can be told apart from a slow apiserver or a slow operator.
+func InstrumentAPIRequests(cfg *rest.Config) {
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &instrumentedRoundTripper{next: rt}
+	})
+}
+
+// instrumentedRoundTripper records the metrics of every request it forwards
+type instrumentedRoundTripper struct {
+	next http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *instrumentedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	group := apiGroupOf(req.URL.Path)
+	verb := verbOf(req)
+
+	start := time.Now()
+	resp, err := rt.next.RoundTrip(req)
+	if verb != "watch" {
+		APIRequestDuration.WithLabelValues(group, verb).Observe(time.Since(start).Seconds())
+	}
+
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	APIRequests.WithLabelValues(group, verb, code).Inc()
+	return resp, err
+}
+
+// apiGroupOf returns the API group a request path targets: "core" for /api, the group for
+// /apis/<group>, and the path itself for non-resource URLs such as /version or /healthz
+func apiGroupOf(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case segments[0] == "api":
+		return coreGroup
+	case segments[0] == "apis" && len(segments) > 1:
+		return segments[1]
+	default:
+		return "/" + segments[0]
+	}
+}
+
+// verbOf maps the HTTP method of a request to the Kubernetes verb; reads of single
+// objects and lists are both reported as get
+func verbOf(req *http.Request) string {
+	switch req.Method {
+	case http.MethodGet:
+		if req.URL.Query().Get("watch") == "true" {
+			return "watch"
+		}
+		return "get"
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		return "delete"
+	default:
+		return strings.ToLower(req.Method)
+	}
+}
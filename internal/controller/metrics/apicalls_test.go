@@ -0,0 +1,86 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/client-go/rest"
+)
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+var _ = Describe("API request metrics", func() {
+	var rt http.RoundTripper
+
+	BeforeEach(func() {
+		APIRequestDuration.Reset()
+		APIRequests.Reset()
+
+		cfg := &rest.Config{}
+		InstrumentAPIRequests(cfg)
+		rt = cfg.WrapTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("X-Fail") != "" {
+				return nil, errors.New("connection refused")
+			}
+			recorder := httptest.NewRecorder()
+			if req.Method == http.MethodPost {
+				recorder.WriteHeader(http.StatusUnprocessableEntity)
+			}
+			return recorder.Result(), nil
+		}))
+	})
+
+	It("should label requests by API group, verb and response code", func() {
+		_, err := rt.RoundTrip(httptest.NewRequest(http.MethodPost,
+			"https://apiserver/apis/hypershift.openshift.io/v1beta1/namespaces/ns/hostedclusters", nil))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = rt.RoundTrip(httptest.NewRequest(http.MethodGet, "https://apiserver/api/v1/namespaces/ns/secrets/s", nil))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testutil.ToFloat64(APIRequests.WithLabelValues("hypershift.openshift.io", "create", "422"))).To(Equal(float64(1)))
+		Expect(testutil.ToFloat64(APIRequests.WithLabelValues("core", "get", "200"))).To(Equal(float64(1)))
+		Expect(testutil.CollectAndCount(APIRequestDuration)).To(Equal(2))
+	})
+
+	It("should count requests without a response as errors", func() {
+		req := httptest.NewRequest(http.MethodPatch, "https://apiserver/apis/metallb.io/v1beta1/namespaces/ns/ipaddresspools/p", nil)
+		req.Header.Set("X-Fail", "true")
+		_, err := rt.RoundTrip(req)
+		Expect(err).To(HaveOccurred())
+
+		Expect(testutil.ToFloat64(APIRequests.WithLabelValues("metallb.io", "patch", "error"))).To(Equal(float64(1)))
+	})
+
+	It("should count watches without observing their duration", func() {
+		_, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "https://apiserver/apis/apps/v1/deployments?watch=true", nil))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testutil.ToFloat64(APIRequests.WithLabelValues("apps", "watch", "200"))).To(Equal(float64(1)))
+		Expect(testutil.CollectAndCount(APIRequestDuration)).To(Equal(0))
+	})
+})
@@ -0,0 +1,150 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/conditions"
+)
+
+// ConditionFailures is set to 1 for every failing condition of a DPFHCPBridge,
+// labeled with the condition Reason and its machine-readable FailureReason
+var ConditionFailures = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: common.DPFHCPBridgeName + "_condition_failure",
+		Help: "Failing DPFHCPBridge conditions, labeled by condition reason and machine-readable failure reason",
+	},
+	[]string{"namespace", "name", "condition", "reason", "failure_reason"},
+)
+
+// DependencyCircuitOpen is set to 1 while the circuit breaker of a dependency is open
+var DependencyCircuitOpen = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: common.DPFHCPBridgeName + "_dependency_circuit_open",
+		Help: "Whether the circuit breaker for an external dependency is open (1) or closed (0)",
+	},
+	[]string{"dependency"},
+)
+
+// UpgradeRevalidationBridges is the number of DPFHCPBridges per result of the revalidation run
+// after the last operator upgrade: valid, invalid, or error if the checks could not be completed
+var UpgradeRevalidationBridges = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: common.DPFHCPBridgeName + "_upgrade_revalidation_bridges",
+		Help: "Number of DPFHCPBridges by result of the preflight revalidation after the last operator upgrade",
+	},
+	[]string{"result"},
+)
+
+// Paused is set to 1 for every DPFHCPBridge whose HostedCluster reconciliation is paused
+var Paused = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: common.DPFHCPBridgeName + "_paused",
+		Help: "Whether reconciliation of the HostedCluster of a DPFHCPBridge is paused (1), labeled by the Paused condition reason",
+	},
+	[]string{"namespace", "name", "reason"},
+)
+
+// PausedUntil is the time a timed pause of the HostedCluster of a DPFHCPBridge expires; unset for indefinite pauses
+var PausedUntil = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: common.DPFHCPBridgeName + "_paused_until_timestamp_seconds",
+		Help: "Unix time the paused reconciliation of the HostedCluster of a DPFHCPBridge resumes",
+	},
+	[]string{"namespace", "name"},
+)
+
+// OrphansDeleted counts the objects deleted by the orphan sweeper because their DPFHCPBridge no longer exists
+var OrphansDeleted = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: common.DPFHCPBridgeName + "_orphans_deleted_total",
+		Help: "Number of objects labelled as owned by a DPFHCPBridge that no longer exists, deleted by the orphan sweeper",
+	},
+	[]string{"kind"},
+)
+
+// ReconcileRetries counts retried reconcile errors by the error class that decided their backoff; terminal
+// errors are not retried and not counted
+var ReconcileRetries = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: common.DPFHCPBridgeName + "_reconcile_retries_total",
+		Help: "Number of retried reconcile errors by error class (Conflict, MissingInput, Transient)",
+	},
+	[]string{"class"},
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(ConditionFailures, DependencyCircuitOpen, UpgradeRevalidationBridges, ReconcileRetries,
+		Paused, PausedUntil, OrphansDeleted)
+}
+
+// RecordConditions replaces the condition failure and pause series of a DPFHCPBridge with its current conditions
+func RecordConditions(cr *provisioningv1alpha1.DPFHCPBridge) {
+	ForgetBridge(cr)
+	recordPause(cr)
+
+	for _, condition := range cr.Status.Conditions {
+		failureReason := conditions.FailureReasonFor(condition)
+		if failureReason == "" {
+			continue
+		}
+		ConditionFailures.With(prometheus.Labels{
+			"namespace":      cr.Namespace,
+			"name":           cr.Name,
+			"condition":      condition.Type,
+			"reason":         condition.Reason,
+			"failure_reason": string(failureReason),
+		}).Set(1)
+	}
+}
+
+// recordPause records the pause of the HostedCluster reported by the Paused condition and status.pausedUntil
+func recordPause(cr *provisioningv1alpha1.DPFHCPBridge) {
+	paused := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.Paused)
+	if paused == nil || paused.Status != metav1.ConditionTrue {
+		return
+	}
+
+	Paused.With(prometheus.Labels{
+		"namespace": cr.Namespace,
+		"name":      cr.Name,
+		"reason":    paused.Reason,
+	}).Set(1)
+	if cr.Status.PausedUntil != nil {
+		PausedUntil.With(prometheus.Labels{
+			"namespace": cr.Namespace,
+			"name":      cr.Name,
+		}).Set(float64(cr.Status.PausedUntil.Unix()))
+	}
+}
+
+// ForgetBridge removes all condition failure and pause series of a DPFHCPBridge
+func ForgetBridge(cr *provisioningv1alpha1.DPFHCPBridge) {
+	labels := prometheus.Labels{
+		"namespace": cr.Namespace,
+		"name":      cr.Name,
+	}
+	ConditionFailures.DeletePartialMatch(labels)
+	Paused.DeletePartialMatch(labels)
+	PausedUntil.DeletePartialMatch(labels)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Condition failure metrics", func() {
+	var cr *provisioningv1alpha1.DPFHCPBridge
+
+	BeforeEach(func() {
+		ConditionFailures.Reset()
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "test-ns"},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				Conditions: []metav1.Condition{
+					{Type: provisioningv1alpha1.SecretsValid, Status: metav1.ConditionFalse, Reason: "PullSecretMissing"},
+					{Type: provisioningv1alpha1.ClusterTypeValid, Status: metav1.ConditionTrue, Reason: "ClusterTypeValid"},
+				},
+			},
+		}
+	})
+
+	It("should export only failing conditions with their failure reason", func() {
+		RecordConditions(cr)
+
+		Expect(testutil.CollectAndCount(ConditionFailures)).To(Equal(1))
+		Expect(testutil.ToFloat64(ConditionFailures.WithLabelValues(
+			"test-ns", "test-bridge", provisioningv1alpha1.SecretsValid, "PullSecretMissing",
+			string(provisioningv1alpha1.FailureReasonSecretMissing)))).To(Equal(float64(1)))
+	})
+
+	It("should drop series of conditions that recovered", func() {
+		RecordConditions(cr)
+
+		cr.Status.Conditions[0].Status = metav1.ConditionTrue
+		cr.Status.Conditions[0].Reason = "SecretsValid"
+		RecordConditions(cr)
+
+		Expect(testutil.CollectAndCount(ConditionFailures)).To(Equal(0))
+	})
+
+	It("should remove all series of a bridge", func() {
+		RecordConditions(cr)
+		ForgetBridge(cr)
+
+		Expect(testutil.CollectAndCount(ConditionFailures)).To(Equal(0))
+	})
+})
+
+var _ = Describe("Pause metrics", func() {
+	var cr *provisioningv1alpha1.DPFHCPBridge
+
+	BeforeEach(func() {
+		Paused.Reset()
+		PausedUntil.Reset()
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "test-ns"},
+		}
+	})
+
+	It("should export the expiry of a timed pause", func() {
+		until := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		cr.Status.PausedUntil = &metav1.Time{Time: until}
+		cr.Status.Conditions = []metav1.Condition{
+			{Type: provisioningv1alpha1.Paused, Status: metav1.ConditionTrue, Reason: provisioningv1alpha1.ReasonPausedUntil},
+		}
+
+		RecordConditions(cr)
+
+		Expect(testutil.ToFloat64(Paused.WithLabelValues("test-ns", "test-bridge", provisioningv1alpha1.ReasonPausedUntil))).To(Equal(float64(1)))
+		Expect(testutil.ToFloat64(PausedUntil.WithLabelValues("test-ns", "test-bridge"))).To(Equal(float64(until.Unix())))
+	})
+
+	It("should export indefinite pauses without expiry", func() {
+		cr.Status.Conditions = []metav1.Condition{
+			{Type: provisioningv1alpha1.Paused, Status: metav1.ConditionTrue, Reason: provisioningv1alpha1.ReasonPausedIndefinitely},
+		}
+
+		RecordConditions(cr)
+
+		Expect(testutil.CollectAndCount(Paused)).To(Equal(1))
+		Expect(testutil.CollectAndCount(PausedUntil)).To(Equal(0))
+	})
+
+	It("should drop the series once the HostedCluster resumes", func() {
+		cr.Status.Conditions = []metav1.Condition{
+			{Type: provisioningv1alpha1.Paused, Status: metav1.ConditionTrue, Reason: provisioningv1alpha1.ReasonPausedIndefinitely},
+		}
+		RecordConditions(cr)
+
+		cr.Status.Conditions[0].Status = metav1.ConditionFalse
+		cr.Status.Conditions[0].Reason = provisioningv1alpha1.ReasonResumed
+		RecordConditions(cr)
+
+		Expect(testutil.CollectAndCount(Paused)).To(Equal(0))
+	})
+})
@@ -0,0 +1,191 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package orphans deletes the objects left behind by DPFHCPBridges that no longer exist. Objects the
+// operator creates for a bridge in other namespaces cannot carry an OwnerReference and are deleted by
+// label from the bridge finalizer; when the operator stops in the middle of a cleanup, or a reconcile
+// races with it, some of them outlive the bridge.
+package orphans
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/metrics"
+)
+
+// DefaultSweepInterval is how often orphaned objects are looked for once the sweeper is enabled
+const DefaultSweepInterval = 10 * time.Minute
+
+// OwnedKinds are the kinds of the objects the operator labels as owned by a DPFHCPBridge on the
+// management cluster
+var OwnedKinds = []schema.GroupVersionKind{
+	{Version: "v1", Kind: "Secret"},
+	{Version: "v1", Kind: "ConfigMap"},
+	dpuprovisioningv1alpha1.GroupVersion.WithKind("BFB"),
+}
+
+// Sweeper periodically deletes the objects labelled as owned by a DPFHCPBridge that no longer exists.
+// Objects of bridges that are being deleted are left to their finalizer, and objects marked
+// retain-on-delete are never deleted. In a sharded deployment the bridge of an orphan is gone, so
+// its shard is told by the labels of the orphan itself.
+type Sweeper struct {
+	client.Client
+
+	// APIReader lists the owned objects and looks their bridges up. It bypasses the cache, so that
+	// a bridge that was just created is never taken for a deleted one.
+	APIReader client.Reader
+
+	// Interval is how often orphaned objects are looked for
+	Interval time.Duration
+
+	// ShardSelector, if set, restricts the sweep to the orphans whose labels match it, so that an
+	// operator instance never deletes the objects of another shard
+	ShardSelector labels.Selector
+}
+
+// NewSweeper creates a new Sweeper with the default interval
+func NewSweeper(c client.Client, apiReader client.Reader) *Sweeper {
+	return &Sweeper{
+		Client:    c,
+		APIReader: apiReader,
+		Interval:  DefaultSweepInterval,
+	}
+}
+
+// Start implements manager.Runnable. It sweeps orphaned objects until the context is cancelled.
+// Being a leader election runnable, it runs on the elected instance only.
+func (s *Sweeper) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithValues("feature", "orphan-sweeper")
+	ctx = logf.IntoContext(ctx, log)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, err := s.Sweep(ctx); err != nil {
+			log.Error(err, "Failed to sweep orphaned objects")
+		}
+	}, s.Interval)
+	return nil
+}
+
+// Sweep deletes the objects of OwnedKinds whose owning DPFHCPBridge no longer exists.
+// Kinds whose CRD is not installed are skipped. Returns the number of objects deleted.
+func (s *Sweeper) Sweep(ctx context.Context) (int, error) {
+	log := logf.FromContext(ctx)
+
+	selector, err := ownedSelector()
+	if err != nil {
+		return 0, err
+	}
+	if s.ShardSelector != nil {
+		requirements, _ := s.ShardSelector.Requirements()
+		selector = selector.Add(requirements...)
+	}
+
+	// Bridges are looked up once per sweep; true if the bridge exists
+	bridges := map[types.NamespacedName]bool{}
+	deleted := 0
+	for _, gvk := range OwnedKinds {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := s.APIReader.List(ctx, list, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			if meta.IsNoMatchError(err) {
+				log.V(1).Info("Kind not installed, skipping", "kind", gvk.Kind)
+				continue
+			}
+			return deleted, fmt.Errorf("failed to list owned %s objects: %w", gvk.Kind, err)
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+			owner := types.NamespacedName{
+				Namespace: obj.Labels[common.LabelNamespace],
+				Name:      obj.Labels[common.LabelOwnedBy],
+			}
+
+			exists, known := bridges[owner]
+			if !known {
+				if exists, err = s.bridgeExists(ctx, owner); err != nil {
+					return deleted, err
+				}
+				bridges[owner] = exists
+			}
+			if exists {
+				continue
+			}
+			if provisioningv1alpha1.RetainedOnDelete(obj) {
+				log.V(1).Info("Orphaned object is marked retain-on-delete, keeping it", "kind", gvk.Kind,
+					"namespace", obj.Namespace, "name", obj.Name, "dpfhcpbridge", owner.String())
+				continue
+			}
+
+			obj.SetGroupVersionKind(gvk)
+			if err := s.Delete(ctx, obj); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return deleted, fmt.Errorf("failed to delete orphaned %s %s/%s: %w", gvk.Kind, obj.Namespace, obj.Name, err)
+			}
+			log.Info("Deleted orphaned object", "kind", gvk.Kind, "namespace", obj.Namespace, "name", obj.Name,
+				"dpfhcpbridge", owner.String())
+			metrics.OrphansDeleted.WithLabelValues(gvk.Kind).Inc()
+			deleted++
+		}
+	}
+
+	log.V(1).Info("Swept orphaned objects", "deleted", deleted, "bridges", len(bridges))
+	return deleted, nil
+}
+
+// bridgeExists returns true if the DPFHCPBridge exists, including while it is being deleted
+func (s *Sweeper) bridgeExists(ctx context.Context, key types.NamespacedName) (bool, error) {
+	bridge := &metav1.PartialObjectMetadata{}
+	bridge.SetGroupVersionKind(provisioningv1alpha1.GroupVersion.WithKind("DPFHCPBridge"))
+	if err := s.APIReader.Get(ctx, key, bridge); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get DPFHCPBridge %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// ownedSelector matches the objects carrying both ownership labels
+func ownedSelector() (labels.Selector, error) {
+	selector := labels.NewSelector()
+	for _, key := range []string{common.LabelOwnedBy, common.LabelNamespace} {
+		req, err := labels.NewRequirement(key, selection.Exists, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ownership label requirement: %w", err)
+		}
+		selector = selector.Add(*req)
+	}
+	return selector, nil
+}
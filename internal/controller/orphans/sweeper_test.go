@@ -0,0 +1,149 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphans
+
+import (
+	"context"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+)
+
+var _ = Describe("Sweeper", func() {
+	var (
+		ctx     context.Context
+		c       client.Client
+		sweeper *Sweeper
+	)
+
+	bridge := func(namespace, name string) *provisioningv1alpha1.DPFHCPBridge {
+		return &provisioningv1alpha1.DPFHCPBridge{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	}
+	live := bridge("clusters", "live")
+	deleted := bridge("clusters", "deleted")
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(dpuprovisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			live.DeepCopy(),
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "live-kubeconfig", Namespace: "dpf-system",
+				Labels: common.ComponentOwnerLabels(live, common.ComponentKubeconfig)}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "deleted-kubeconfig", Namespace: "dpf-system",
+				Labels: common.ComponentOwnerLabels(deleted, common.ComponentKubeconfig)}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "deleted-export", Namespace: "tenant",
+				Labels: common.ComponentOwnerLabels(deleted, common.ComponentKubeconfigExport)}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "deleted-timesync", Namespace: "clusters",
+				Labels: common.ComponentOwnerLabels(deleted, common.ComponentTimeSync)}},
+			&dpuprovisioningv1alpha1.BFB{ObjectMeta: metav1.ObjectMeta{Name: "deleted-bfb", Namespace: "dpf-system",
+				Labels: common.ComponentOwnerLabels(deleted, common.ComponentBFB)}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "user-secret", Namespace: "clusters"}},
+		).Build()
+		sweeper = NewSweeper(c, c)
+	})
+
+	exists := func(obj client.Object, namespace, name string) bool {
+		err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj)
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return true
+	}
+
+	It("should delete the objects of bridges that no longer exist", func() {
+		count, err := sweeper.Sweep(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(4))
+
+		Expect(exists(&corev1.Secret{}, "dpf-system", "deleted-kubeconfig")).To(BeFalse())
+		Expect(exists(&corev1.Secret{}, "tenant", "deleted-export")).To(BeFalse())
+		Expect(exists(&corev1.ConfigMap{}, "clusters", "deleted-timesync")).To(BeFalse())
+		Expect(exists(&dpuprovisioningv1alpha1.BFB{}, "dpf-system", "deleted-bfb")).To(BeFalse())
+	})
+
+	It("should keep the objects of existing bridges and unlabelled objects", func() {
+		_, err := sweeper.Sweep(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(exists(&corev1.Secret{}, "dpf-system", "live-kubeconfig")).To(BeTrue())
+		Expect(exists(&corev1.Secret{}, "clusters", "user-secret")).To(BeTrue())
+	})
+
+	It("should keep orphaned objects marked retain-on-delete", func() {
+		Expect(c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "shared-pull-secret", Namespace: "clusters",
+			Labels:      common.ComponentOwnerLabels(deleted, common.ComponentHostedClusterSecrets),
+			Annotations: map[string]string{provisioningv1alpha1.AnnotationRetainOnDelete: "true"}}})).To(Succeed())
+
+		count, err := sweeper.Sweep(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(4))
+		Expect(exists(&corev1.Secret{}, "clusters", "shared-pull-secret")).To(BeTrue())
+	})
+
+	It("should match the owning bridge by namespace and name", func() {
+		Expect(c.Create(ctx, bridge("other", "deleted"))).To(Succeed())
+
+		count, err := sweeper.Sweep(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(4))
+	})
+
+	It("should only delete the orphans labelled for its shard", func() {
+		for name, shard := range map[string]string{"shard-a": "a", "shard-b": "b"} {
+			l := common.ComponentOwnerLabels(deleted, common.ComponentKubeconfig)
+			l["shard"] = shard
+			Expect(c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "dpf-system",
+				Labels: l}})).To(Succeed())
+		}
+		shardSelector, err := labels.Parse("shard=a")
+		Expect(err).NotTo(HaveOccurred())
+		sweeper.ShardSelector = shardSelector
+
+		count, err := sweeper.Sweep(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(1))
+		Expect(exists(&corev1.Secret{}, "dpf-system", "shard-a")).To(BeFalse())
+		Expect(exists(&corev1.Secret{}, "dpf-system", "shard-b")).To(BeTrue())
+		Expect(exists(&corev1.Secret{}, "dpf-system", "deleted-kubeconfig")).To(BeTrue())
+	})
+
+	It("should do nothing once the orphans are gone", func() {
+		_, err := sweeper.Sweep(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		count, err := sweeper.Sweep(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(BeZero())
+	})
+})
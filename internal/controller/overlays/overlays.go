@@ -0,0 +1,183 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package overlays applies per-OCP-minor defaults to the HostedClusters created by the operator,
+// so that version specific settings do not have to be encoded in every DPFHCPBridge.
+package overlays
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/yaml"
+)
+
+// minorVersionPattern matches the major.minor prefix of an OCP version such as 4.18.3 or 4.19.0-ec.5
+var minorVersionPattern = regexp.MustCompile(`^v?(\d+\.\d+)(?:[.-]|$)`)
+
+// Overlay holds the HostedCluster defaults for one OCP minor version
+type Overlay struct {
+	// Version is the OCP minor version the overlay applies to, e.g. "4.18"
+	Version string `json:"version"`
+
+	// Annotations are added to the HostedCluster
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Labels are added to the HostedCluster
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Spec is merged into the HostedCluster spec as a strategic merge patch.
+	// HostedCluster spec lists carry no merge keys, so lists replace the operator defaults.
+	Spec json.RawMessage `json:"spec,omitempty"`
+
+	// PublishOIDC, if set, adds or removes the OIDC service publishing strategy, overriding the
+	// default of the publishing mode (only published in NodePort mode)
+	PublishOIDC *bool `json:"publishOIDC,omitempty"`
+}
+
+// Config is the list of version overlays loaded from the operator configuration
+type Config struct {
+	Overlays []Overlay `json:"overlays"`
+}
+
+// LoadFile reads and validates the overlay configuration from a YAML file
+func LoadFile(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read version overlays: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses and validates the overlay configuration
+func Parse(data []byte) (*Config, error) {
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse version overlays: %w", err)
+	}
+
+	seen := map[string]bool{}
+	for _, overlay := range config.Overlays {
+		if !minorVersionPattern.MatchString(overlay.Version) || MinorVersion(overlay.Version) != overlay.Version {
+			return nil, fmt.Errorf("invalid overlay version %q, expected a minor version such as 4.18", overlay.Version)
+		}
+		if seen[overlay.Version] {
+			return nil, fmt.Errorf("duplicate overlay for version %s", overlay.Version)
+		}
+		seen[overlay.Version] = true
+
+		// Reject patches that do not fit the HostedCluster spec at load time rather than on first use
+		if err := overlay.apply(&hyperv1.HostedCluster{}); err != nil {
+			return nil, fmt.Errorf("invalid overlay for version %s: %w", overlay.Version, err)
+		}
+	}
+
+	return config, nil
+}
+
+// MinorVersion returns the major.minor part of an OCP version, or "" if it is not a version
+func MinorVersion(version string) string {
+	match := minorVersionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// Apply applies the overlay matching the minor of version to the HostedCluster.
+// It returns the matched minor version, or "" if no overlay applies. A nil Config applies nothing.
+func (c *Config) Apply(hc *hyperv1.HostedCluster, version string) (string, error) {
+	if c == nil {
+		return "", nil
+	}
+
+	minor := MinorVersion(version)
+	if minor == "" {
+		return "", nil
+	}
+
+	for _, overlay := range c.Overlays {
+		if overlay.Version == minor {
+			if err := overlay.apply(hc); err != nil {
+				return "", fmt.Errorf("failed to apply overlay for version %s: %w", minor, err)
+			}
+			return minor, nil
+		}
+	}
+
+	return "", nil
+}
+
+// PublishOIDC returns whether the overlay matching the minor of version publishes the OIDC service,
+// or nil if there is no such overlay or it does not say. A nil Config says nothing.
+func (c *Config) PublishOIDC(version string) *bool {
+	if c == nil {
+		return nil
+	}
+
+	minor := MinorVersion(version)
+	for _, overlay := range c.Overlays {
+		if minor != "" && overlay.Version == minor {
+			return overlay.PublishOIDC
+		}
+	}
+
+	return nil
+}
+
+func (o *Overlay) apply(hc *hyperv1.HostedCluster) error {
+	for key, value := range o.Annotations {
+		if hc.Annotations == nil {
+			hc.Annotations = map[string]string{}
+		}
+		hc.Annotations[key] = value
+	}
+	for key, value := range o.Labels {
+		if hc.Labels == nil {
+			hc.Labels = map[string]string{}
+		}
+		hc.Labels[key] = value
+	}
+
+	if len(o.Spec) == 0 {
+		return nil
+	}
+
+	original, err := json.Marshal(hc.Spec)
+	if err != nil {
+		return err
+	}
+	patched, err := strategicpatch.StrategicMergePatch(original, o.Spec, hyperv1.HostedClusterSpec{})
+	if err != nil {
+		return err
+	}
+
+	// Unknown fields are rejected so that typos in the overlay do not go unnoticed
+	spec := hyperv1.HostedClusterSpec{}
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&spec); err != nil {
+		return err
+	}
+	hc.Spec = spec
+
+	return nil
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overlays
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const testConfig = `
+overlays:
+- version: "4.18"
+  annotations:
+    example.com/needed-on-4.18: "true"
+  labels:
+    example.com/minor: "4.18"
+  spec:
+    services:
+    - service: Konnectivity
+      servicePublishingStrategy:
+        type: LoadBalancer
+    nodeSelector:
+      example.com/pool: legacy
+- version: "4.19"
+  annotations:
+    example.com/needed-on-4.19: "true"
+  publishOIDC: true
+`
+
+var _ = Describe("Version Overlays", func() {
+	var hc *hyperv1.HostedCluster
+
+	BeforeEach(func() {
+		hc = &hyperv1.HostedCluster{
+			Spec: hyperv1.HostedClusterSpec{
+				Release: hyperv1.Release{Image: "quay.io/openshift-release-dev/ocp-release:4.18.3-multi"},
+				Services: []hyperv1.ServicePublishingStrategyMapping{
+					{Service: hyperv1.APIServer, ServicePublishingStrategy: hyperv1.ServicePublishingStrategy{Type: hyperv1.LoadBalancer}},
+					{Service: hyperv1.Konnectivity, ServicePublishingStrategy: hyperv1.ServicePublishingStrategy{Type: hyperv1.Route}},
+				},
+				PullSecret: corev1.LocalObjectReference{Name: "pull-secret"},
+			},
+		}
+	})
+
+	Describe("MinorVersion", func() {
+		DescribeTable("should extract the minor version",
+			func(version, minor string) {
+				Expect(MinorVersion(version)).To(Equal(minor))
+			},
+			Entry("release", "4.18.3", "4.18"),
+			Entry("pre-release", "4.19.0-ec.5", "4.19"),
+			Entry("minor only", "4.18", "4.18"),
+			Entry("arch suffix", "4.18.3-multi", "4.18"),
+			Entry("not a version", "custom-build", ""),
+			Entry("empty", "", ""),
+		)
+	})
+
+	Describe("Parse", func() {
+		It("should parse a valid configuration", func() {
+			config, err := Parse([]byte(testConfig))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Overlays).To(HaveLen(2))
+		})
+
+		It("should reject patch versions", func() {
+			_, err := Parse([]byte("overlays:\n- version: \"4.18.3\"\n"))
+			Expect(err).To(MatchError(ContainSubstring("invalid overlay version")))
+		})
+
+		It("should reject duplicate versions", func() {
+			_, err := Parse([]byte("overlays:\n- version: \"4.18\"\n- version: \"4.18\"\n"))
+			Expect(err).To(MatchError(ContainSubstring("duplicate overlay")))
+		})
+
+		It("should reject unknown fields", func() {
+			_, err := Parse([]byte("overlays:\n- version: \"4.18\"\n  anotations: {}\n"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject spec patches that do not fit the HostedCluster spec", func() {
+			_, err := Parse([]byte("overlays:\n- version: \"4.18\"\n  spec:\n    nodeSelecter:\n      a: b\n"))
+			Expect(err).To(MatchError(ContainSubstring("invalid overlay for version 4.18")))
+		})
+	})
+
+	Describe("Apply", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			var err error
+			config, err = Parse([]byte(testConfig))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should apply the overlay matching the minor version", func() {
+			minor, err := config.Apply(hc, "4.18.3")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(minor).To(Equal("4.18"))
+
+			Expect(hc.Annotations).To(HaveKeyWithValue("example.com/needed-on-4.18", "true"))
+			Expect(hc.Annotations).NotTo(HaveKey("example.com/needed-on-4.19"))
+			Expect(hc.Labels).To(HaveKeyWithValue("example.com/minor", "4.18"))
+			Expect(hc.Spec.NodeSelector).To(Equal(map[string]string{"example.com/pool": "legacy"}))
+
+			// Lists are replaced, fields not mentioned by the overlay are kept
+			Expect(hc.Spec.Services).To(HaveLen(1))
+			Expect(hc.Spec.Services[0].Service).To(Equal(hyperv1.Konnectivity))
+			Expect(hc.Spec.Services[0].Type).To(Equal(hyperv1.LoadBalancer))
+			Expect(hc.Spec.PullSecret.Name).To(Equal("pull-secret"))
+			Expect(hc.Spec.Release.Image).To(Equal("quay.io/openshift-release-dev/ocp-release:4.18.3-multi"))
+		})
+
+		It("should not change the HostedCluster when no overlay matches", func() {
+			original := hc.DeepCopy()
+
+			minor, err := config.Apply(hc, "4.20.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(minor).To(BeEmpty())
+			Expect(hc).To(Equal(original))
+		})
+
+		It("should not change the HostedCluster when the version is unknown", func() {
+			original := hc.DeepCopy()
+
+			minor, err := config.Apply(hc, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(minor).To(BeEmpty())
+			Expect(hc).To(Equal(original))
+		})
+
+		It("should apply nothing for a nil configuration", func() {
+			var nilConfig *Config
+			minor, err := nilConfig.Apply(hc, "4.18.3")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(minor).To(BeEmpty())
+		})
+	})
+
+	Describe("PublishOIDC", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			var err error
+			config, err = Parse([]byte(testConfig))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the setting of the overlay matching the minor version", func() {
+			Expect(config.PublishOIDC("4.19.2")).To(HaveValue(BeTrue()))
+		})
+
+		It("should return nothing when the matching overlay does not set it", func() {
+			Expect(config.PublishOIDC("4.18.3")).To(BeNil())
+		})
+
+		It("should return nothing when no overlay matches", func() {
+			Expect(config.PublishOIDC("4.20.0")).To(BeNil())
+			Expect(config.PublishOIDC("")).To(BeNil())
+
+			var nilConfig *Config
+			Expect(nilConfig.PublishOIDC("4.19.2")).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,224 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasecatalog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const (
+	// ReleaseResolved condition reasons
+	ReasonReleaseResolved         = "ReleaseResolved"
+	ReasonReleaseImageApproved    = "ReleaseImageApproved"
+	ReasonReleaseCatalogNotFound  = "ReleaseCatalogNotFound"
+	ReasonReleaseNotInCatalog     = "ReleaseNotInCatalog"
+	ReasonReleaseNotSupported     = "ReleaseNotSupported"
+	ReasonReleaseImageNotApproved = "ReleaseImageNotApproved"
+)
+
+// Resolver resolves the OCP release of DPFHCPBridges from ReleaseCatalogs
+type Resolver struct {
+	client   client.Client
+	recorder record.EventRecorder
+
+	// now returns the current time, overridable in tests
+	now func() time.Time
+}
+
+// NewResolver creates a new release catalog Resolver
+func NewResolver(client client.Client, recorder record.EventRecorder) *Resolver {
+	return &Resolver{
+		client:   client,
+		recorder: recorder,
+	}
+}
+
+// ResolveRelease sets the ReleaseResolved condition.
+//
+// For a bridge with spec.releaseCatalogRef the referenced catalog entry is resolved into
+// status.ocpReleaseImage, status.ocpVersion and status.blueFieldContainerImage. The support
+// window is checked whenever the bridge moves to a release it does not run yet; a failed
+// resolution keeps the previously resolved release so a running HostedCluster is left alone.
+//
+// For a bridge with a raw spec.ocpReleaseImage or a spec.channel, the image must be listed in a
+// ReleaseCatalog if any catalog is strict. Only the initial provisioning is checked, so that turning
+// on strict mode does not fail bridges that are already running. The admission webhook rejects unlisted
+// spec.ocpReleaseImage values up front; this check catches channel releases and bridges admitted while
+// the webhook was unavailable.
+func (r *Resolver) ResolveRelease(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithValues("feature", "release-catalog")
+
+	var condition *metav1.Condition
+	var err error
+	if cr.Spec.ReleaseCatalogRef != nil {
+		condition, err = r.resolveCatalogEntry(ctx, cr)
+	} else if cr.Status.HostedClusterRef == nil {
+		condition, err = r.checkReleaseImageApproved(ctx, cr)
+	} else {
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if condition == nil {
+		if meta.RemoveStatusCondition(&cr.Status.Conditions, provisioningv1alpha1.ReleaseResolved) {
+			return ctrl.Result{}, r.client.Status().Update(ctx, cr)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	condition.Type = provisioningv1alpha1.ReleaseResolved
+	condition.LastTransitionTime = metav1.NewTime(r.clock())
+	condition.ObservedGeneration = cr.Generation
+	if changed := meta.SetStatusCondition(&cr.Status.Conditions, *condition); !changed {
+		return ctrl.Result{}, nil
+	}
+
+	eventType := corev1.EventTypeNormal
+	if condition.Status == metav1.ConditionFalse {
+		eventType = corev1.EventTypeWarning
+	}
+	r.recorder.Event(cr, eventType, condition.Reason, condition.Message)
+	log.Info("Release resolution changed", "reason", condition.Reason)
+
+	if err := r.client.Status().Update(ctx, cr); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+
+	// Do NOT requeue on failures - ReleaseCatalog changes are picked up by the ReleaseCatalog watch
+	return ctrl.Result{}, nil
+}
+
+// resolveCatalogEntry resolves spec.releaseCatalogRef into status
+func (r *Resolver) resolveCatalogEntry(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (*metav1.Condition, error) {
+	ref := cr.Spec.ReleaseCatalogRef
+
+	var catalog provisioningv1alpha1.ReleaseCatalog
+	if err := r.client.Get(ctx, types.NamespacedName{Name: ref.Name}, &catalog); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &metav1.Condition{
+				Status:  metav1.ConditionFalse,
+				Reason:  ReasonReleaseCatalogNotFound,
+				Message: fmt.Sprintf("ReleaseCatalog '%s' not found", ref.Name),
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to get ReleaseCatalog %s: %w", ref.Name, err)
+	}
+
+	entry := catalog.Entry(ref.Version)
+	if entry == nil {
+		return &metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonReleaseNotInCatalog,
+			Message: fmt.Sprintf("ReleaseCatalog '%s' does not list version %s", ref.Name, ref.Version),
+		}, nil
+	}
+
+	if entry.ReleaseImage != cr.Status.OCPReleaseImage && !entry.Supports(metav1.NewTime(r.clock())) {
+		return &metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonReleaseNotSupported,
+			Message: fmt.Sprintf("Version %s of ReleaseCatalog '%s' is outside its support window", ref.Version, ref.Name),
+		}, nil
+	}
+
+	cr.Status.OCPReleaseImage = entry.ReleaseImage
+	cr.Status.OCPVersion = entry.Version
+	cr.Status.BlueFieldContainerImage = entry.BlueFieldImage
+	return &metav1.Condition{
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonReleaseResolved,
+		Message: fmt.Sprintf("Version %s resolved from ReleaseCatalog '%s': %s", ref.Version, ref.Name, entry.ReleaseImage),
+	}, nil
+}
+
+// checkReleaseImageApproved checks spec.ocpReleaseImage, or the release resolved from spec.channel,
+// against the strict ReleaseCatalogs. It returns nil if no ReleaseCatalog is strict.
+func (r *Resolver) checkReleaseImageApproved(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (*metav1.Condition, error) {
+	image, field := cr.Spec.OCPReleaseImage, "ocpReleaseImage"
+	if cr.Spec.Channel != "" {
+		image, field = cr.ResolvedOCPReleaseImage(), "channel "+cr.Spec.Channel+" release"
+		if image == "" {
+			// The channel is not resolved yet, as reported by the ReleaseChannelResolved condition
+			return nil, nil
+		}
+	}
+
+	catalog, strict, err := ApprovingCatalog(ctx, r.client, image)
+	if err != nil {
+		return nil, err
+	}
+	if catalog != "" {
+		return &metav1.Condition{
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonReleaseImageApproved,
+			Message: fmt.Sprintf("%s is listed in ReleaseCatalog '%s'", field, catalog),
+		}, nil
+	}
+
+	if !strict {
+		return nil, nil
+	}
+	return &metav1.Condition{
+		Status:  metav1.ConditionFalse,
+		Reason:  ReasonReleaseImageNotApproved,
+		Message: fmt.Sprintf("%s %s is not listed in any ReleaseCatalog and strict mode is on; use spec.releaseCatalogRef", field, image),
+	}, nil
+}
+
+// ApprovingCatalog returns the name of a ReleaseCatalog listing the release image or, if none does, an
+// empty string and whether any ReleaseCatalog is strict. It is shared with the DPFHCPBridge admission webhook,
+// which rejects unlisted images in strict mode before the controller sees them.
+func ApprovingCatalog(ctx context.Context, c client.Reader, image string) (string, bool, error) {
+	var catalogs provisioningv1alpha1.ReleaseCatalogList
+	if err := c.List(ctx, &catalogs); err != nil {
+		return "", false, fmt.Errorf("failed to list ReleaseCatalogs: %w", err)
+	}
+
+	strict := false
+	for i := range catalogs.Items {
+		catalog := &catalogs.Items[i]
+		strict = strict || catalog.Spec.Strict
+		if catalog.ListsReleaseImage(image) {
+			return catalog.Name, strict, nil
+		}
+	}
+	return "", strict, nil
+}
+
+func (r *Resolver) clock() time.Time {
+	if r.now == nil {
+		return time.Now()
+	}
+	return r.now()
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasecatalog
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Release catalog resolver", func() {
+	const (
+		releaseImage   = "quay.io/openshift-release-dev/ocp-release:4.19.1-multi"
+		blueFieldImage = "quay.io/example/bluefield:4.19.1"
+	)
+
+	var (
+		ctx     context.Context
+		scheme  *runtime.Scheme
+		bridge  *provisioningv1alpha1.DPFHCPBridge
+		catalog *provisioningv1alpha1.ReleaseCatalog
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+		catalog = &provisioningv1alpha1.ReleaseCatalog{
+			ObjectMeta: metav1.ObjectMeta{Name: "production"},
+			Spec: provisioningv1alpha1.ReleaseCatalogSpec{
+				Releases: []provisioningv1alpha1.ReleaseCatalogEntry{
+					{Version: "4.19.1", ReleaseImage: releaseImage, BlueFieldImage: blueFieldImage},
+				},
+			},
+		}
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", Generation: 1},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				ReleaseCatalogRef: &provisioningv1alpha1.ReleaseCatalogReference{Name: "production", Version: "4.19.1"},
+			},
+		}
+	})
+
+	resolve := func(objs ...client.Object) *metav1.Condition {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(append(objs, bridge)...).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+		r := NewResolver(c, record.NewFakeRecorder(10))
+		r.now = func() time.Time { return now }
+
+		result, err := r.ResolveRelease(ctx, bridge)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		var updated provisioningv1alpha1.DPFHCPBridge
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(bridge), &updated)).To(Succeed())
+		bridge.Status = updated.Status
+		return meta.FindStatusCondition(updated.Status.Conditions, provisioningv1alpha1.ReleaseResolved)
+	}
+
+	Context("with releaseCatalogRef", func() {
+		It("should resolve the release and BlueField image from the catalog entry", func() {
+			cond := resolve(catalog)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(ReasonReleaseResolved))
+			Expect(bridge.Status.OCPReleaseImage).To(Equal(releaseImage))
+			Expect(bridge.Status.OCPVersion).To(Equal("4.19.1"))
+			Expect(bridge.Status.BlueFieldContainerImage).To(Equal(blueFieldImage))
+			Expect(bridge.ResolvedOCPReleaseImage()).To(Equal(releaseImage))
+		})
+
+		It("should fail when the catalog does not exist", func() {
+			cond := resolve()
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(ReasonReleaseCatalogNotFound))
+			Expect(bridge.ResolvedOCPReleaseImage()).To(BeEmpty())
+		})
+
+		It("should fail when the catalog does not list the version", func() {
+			bridge.Spec.ReleaseCatalogRef.Version = "4.20.0"
+			cond := resolve(catalog)
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(ReasonReleaseNotInCatalog))
+		})
+
+		It("should reject a release outside its support window", func() {
+			catalog.Spec.Releases[0].SupportedUntil = &metav1.Time{Time: now.Add(-time.Hour)}
+			cond := resolve(catalog)
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(ReasonReleaseNotSupported))
+		})
+
+		It("should reject a release whose support window has not started", func() {
+			catalog.Spec.Releases[0].SupportedFrom = &metav1.Time{Time: now.Add(time.Hour)}
+			cond := resolve(catalog)
+			Expect(cond.Reason).To(Equal(ReasonReleaseNotSupported))
+		})
+
+		It("should keep a running release that went out of support", func() {
+			catalog.Spec.Releases[0].SupportedUntil = &metav1.Time{Time: now.Add(-time.Hour)}
+			bridge.Status.OCPReleaseImage = releaseImage
+			bridge.Status.HostedClusterRef = &corev1.ObjectReference{Name: "test-bridge", Namespace: "default"}
+			cond := resolve(catalog)
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(bridge.Status.OCPReleaseImage).To(Equal(releaseImage))
+		})
+	})
+
+	Context("with a raw ocpReleaseImage", func() {
+		BeforeEach(func() {
+			bridge.Spec.ReleaseCatalogRef = nil
+			bridge.Spec.OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.18.0-multi"
+		})
+
+		It("should not set the condition when no catalog is strict", func() {
+			Expect(resolve(catalog)).To(BeNil())
+		})
+
+		It("should reject an image not listed in any catalog when a catalog is strict", func() {
+			catalog.Spec.Strict = true
+			cond := resolve(catalog)
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(ReasonReleaseImageNotApproved))
+		})
+
+		It("should approve an image listed in a catalog when a catalog is strict", func() {
+			catalog.Spec.Strict = true
+			bridge.Spec.OCPReleaseImage = releaseImage
+			cond := resolve(catalog)
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(ReasonReleaseImageApproved))
+		})
+
+		It("should not check bridges that are already provisioned", func() {
+			catalog.Spec.Strict = true
+			bridge.Status.HostedClusterRef = &corev1.ObjectReference{Name: "test-bridge", Namespace: "default"}
+			Expect(resolve(catalog)).To(BeNil())
+		})
+	})
+
+	Context("with a release channel", func() {
+		BeforeEach(func() {
+			bridge.Spec.ReleaseCatalogRef = nil
+			bridge.Spec.Channel = "stable-4.19"
+			catalog.Spec.Strict = true
+		})
+
+		It("should wait for the channel to be resolved", func() {
+			Expect(resolve(catalog)).To(BeNil())
+		})
+
+		It("should approve a channel release listed in a catalog", func() {
+			bridge.Status.OCPReleaseImage = releaseImage
+			Expect(resolve(catalog).Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should reject a channel release not listed in any catalog", func() {
+			bridge.Status.OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release@sha256:0123456789abcdef"
+			cond := resolve(catalog)
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Message).To(ContainSubstring("channel stable-4.19 release"))
+		})
+	})
+})
@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasechannel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+const (
+	// DefaultGraphURL is the public OpenShift update graph (Cincinnati)
+	DefaultGraphURL = "https://api.openshift.com/api/upgrades_info/v1/graph"
+
+	// DefaultArchitecture is the release architecture looked up in the update graph. Multi-arch
+	// releases run both the x86 management cluster and the arm64 BlueField DPUs.
+	DefaultArchitecture = "multi"
+
+	// DefaultGraphTimeout bounds each request to the update graph
+	DefaultGraphTimeout = 30 * time.Second
+
+	// maxGraphSize bounds the size of the update graph of a channel
+	maxGraphSize = 32 << 20
+)
+
+// Release is a node of the update graph
+type Release struct {
+	// Version is the OCP version of the release, e.g. 4.17.12
+	Version string `json:"version"`
+
+	// Image is the release image of the version, referenced by digest
+	Image string `json:"payload"`
+}
+
+// Graph lists the releases of an update channel
+type Graph interface {
+	Releases(ctx context.Context, channel string) ([]Release, error)
+}
+
+// CincinnatiGraph reads the releases of a channel from an OpenShift update graph (Cincinnati) server
+type CincinnatiGraph struct {
+	// URL of the graph endpoint
+	URL string
+
+	// Architecture of the releases to list, e.g. multi or amd64
+	Architecture string
+
+	// Client is used to fetch the graph; http.DefaultClient is used if it is nil
+	Client *http.Client
+
+	// Timeout bounds each fetch of the graph; no timeout if 0
+	Timeout time.Duration
+}
+
+// NewCincinnatiGraph creates a new CincinnatiGraph for the update graph at graphURL
+func NewCincinnatiGraph(graphURL string) *CincinnatiGraph {
+	return &CincinnatiGraph{
+		URL:          graphURL,
+		Architecture: DefaultArchitecture,
+		Timeout:      DefaultGraphTimeout,
+	}
+}
+
+// Releases fetches the update graph of the channel and returns its nodes
+func (g *CincinnatiGraph) Releases(ctx context.Context, channel string) ([]Release, error) {
+	if g.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, g.Timeout)
+		defer cancel()
+	}
+
+	graphURL, err := url.Parse(g.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid update graph URL: %w", err)
+	}
+	query := graphURL.Query()
+	query.Set("channel", channel)
+	if g.Architecture != "" {
+		query.Set("arch", g.Architecture)
+	}
+	graphURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, graphURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := g.Client
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var graph struct {
+		Nodes []Release `json:"nodes"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxGraphSize)).Decode(&graph); err != nil {
+		return nil, fmt.Errorf("failed to decode update graph: %w", err)
+	}
+	return graph.Nodes, nil
+}
+
+// ChannelVersion returns the major.minor version a channel such as stable-4.17 tracks
+func ChannelVersion(channel string) string {
+	_, minor, _ := strings.Cut(channel, "-")
+	return minor
+}
+
+// Latest returns the highest release of the minor version the channel tracks, or nil if there is
+// none. Channels also list releases of the previous minor version as upgrade sources; those are skipped.
+func Latest(releases []Release, channel string) *Release {
+	minor, err := version.ParseMajorMinor(ChannelVersion(channel))
+	if err != nil {
+		return nil
+	}
+
+	var latest *Release
+	var latestVersion *version.Version
+	for i := range releases {
+		v, err := version.ParseSemantic(releases[i].Version)
+		if err != nil || v.Major() != minor.Major() || v.Minor() != minor.Minor() || releases[i].Image == "" {
+			continue
+		}
+		if latestVersion == nil || v.GreaterThan(latestVersion) {
+			latest, latestVersion = &releases[i], v
+		}
+	}
+	return latest
+}
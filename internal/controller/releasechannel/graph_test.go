@@ -0,0 +1,89 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasechannel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Update graph", func() {
+	Describe("CincinnatiGraph", func() {
+		var (
+			server *httptest.Server
+			status int
+			query  map[string]string
+		)
+
+		BeforeEach(func() {
+			status = http.StatusOK
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = map[string]string{"channel": r.URL.Query().Get("channel"), "arch": r.URL.Query().Get("arch")}
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(`{"nodes": [
+					{"version": "4.17.3", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:17-3", "metadata": {}},
+					{"version": "4.16.20", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:16-20"}
+				], "edges": [[1, 0]]}`))
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should list the releases of the channel", func() {
+			releases, err := NewCincinnatiGraph(server.URL).Releases(context.Background(), "stable-4.17")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releases).To(ConsistOf(
+				Release{Version: "4.17.3", Image: "quay.io/openshift-release-dev/ocp-release@sha256:17-3"},
+				Release{Version: "4.16.20", Image: "quay.io/openshift-release-dev/ocp-release@sha256:16-20"},
+			))
+			Expect(query).To(Equal(map[string]string{"channel": "stable-4.17", "arch": DefaultArchitecture}))
+		})
+
+		It("should return an error when the graph cannot be fetched", func() {
+			status = http.StatusServiceUnavailable
+
+			_, err := NewCincinnatiGraph(server.URL).Releases(context.Background(), "stable-4.17")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("503"))
+		})
+	})
+
+	Describe("Latest", func() {
+		releases := []Release{
+			{Version: "4.16.20", Image: "image-4.16.20"},
+			{Version: "4.17.9", Image: "image-4.17.9"},
+			{Version: "4.17.12", Image: "image-4.17.12"},
+			{Version: "4.17.10", Image: "image-4.17.10"},
+		}
+
+		It("should return the highest release of the channel's minor version", func() {
+			Expect(Latest(releases, "stable-4.17")).To(Equal(&Release{Version: "4.17.12", Image: "image-4.17.12"}))
+			Expect(Latest(releases, "eus-4.16")).To(Equal(&Release{Version: "4.16.20", Image: "image-4.16.20"}))
+		})
+
+		It("should return nil when the channel lists no release of its minor version", func() {
+			Expect(Latest(releases, "fast-4.18")).To(BeNil())
+			Expect(Latest(nil, "stable-4.17")).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,204 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package releasechannel resolves the spec.channel of DPFHCPBridges, e.g. stable-4.17, to the latest
+// z-stream release of the channel in the OpenShift update graph, and follows new z-streams as they
+// are published.
+package releasechannel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const (
+	// ReleaseChannelResolved condition reasons
+	ReasonChannelResolved    = "ChannelResolved"
+	ReasonGraphUnavailable   = "GraphUnavailable"
+	ReasonNoReleaseInChannel = "NoReleaseInChannel"
+
+	// DefaultRecheckInterval is how often the update graph is checked for a newer z-stream release
+	DefaultRecheckInterval = time.Hour
+
+	// DefaultRetryInterval is how long to wait before retrying a channel that could not be resolved
+	DefaultRetryInterval = 5 * time.Minute
+)
+
+// Resolver resolves the release channels of DPFHCPBridges against an update graph
+type Resolver struct {
+	client   client.Client
+	recorder record.EventRecorder
+
+	// Graph lists the releases of a channel
+	Graph Graph
+
+	// RecheckInterval is how often a resolved channel is checked for a newer release; never if 0
+	RecheckInterval time.Duration
+
+	// RetryInterval is how long to wait before retrying a channel that could not be resolved
+	RetryInterval time.Duration
+
+	// now returns the current time, overridable in tests
+	now func() time.Time
+}
+
+// NewResolver creates a new release channel Resolver
+func NewResolver(client client.Client, recorder record.EventRecorder, graph Graph) *Resolver {
+	return &Resolver{
+		client:          client,
+		recorder:        recorder,
+		Graph:           graph,
+		RecheckInterval: DefaultRecheckInterval,
+		RetryInterval:   DefaultRetryInterval,
+	}
+}
+
+// ResolveChannel resolves spec.channel into status.channelRelease, status.ocpReleaseImage and
+// status.ocpVersion, and sets the ReleaseChannelResolved condition.
+//
+// The update graph is read when the channel is first set or changed, every RecheckInterval after
+// that to pick up new z-stream releases, and on every reconcile while the channel cannot be resolved.
+// A failed lookup keeps the previously chosen release so a running HostedCluster is left alone.
+func (r *Resolver) ResolveChannel(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithValues("feature", "release-channel")
+
+	channel := cr.Spec.Channel
+	if channel == "" {
+		changed := meta.RemoveStatusCondition(&cr.Status.Conditions, provisioningv1alpha1.ReleaseChannelResolved)
+		if cr.Status.ChannelRelease != nil {
+			cr.Status.ChannelRelease = nil
+			changed = true
+		}
+		if changed {
+			return ctrl.Result{}, r.client.Status().Update(ctx, cr)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	now := r.clock()
+	current := cr.Status.ChannelRelease
+	if current != nil && current.Channel == channel && !r.recheckDue(cr, now) {
+		return ctrl.Result{}, nil
+	}
+
+	releases, err := r.Graph.Releases(ctx, channel)
+	if err != nil {
+		log.Info("Failed to read the update graph", "channel", channel, "error", err.Error())
+		return r.setCondition(ctx, cr, metav1.ConditionFalse, ReasonGraphUnavailable,
+			fmt.Sprintf("Failed to read channel %s from the update graph: %v", channel, err))
+	}
+	latest := Latest(releases, channel)
+	if latest == nil {
+		return r.setCondition(ctx, cr, metav1.ConditionFalse, ReasonNoReleaseInChannel,
+			fmt.Sprintf("Channel %s lists no %s release", channel, ChannelVersion(channel)))
+	}
+
+	if current == nil || current.Channel != channel || current.Version != latest.Version {
+		log.Info("Selected release from channel", "channel", channel, "version", latest.Version, "image", latest.Image)
+	}
+	cr.Status.ChannelRelease = &provisioningv1alpha1.ChannelRelease{
+		Channel:       channel,
+		Version:       latest.Version,
+		Image:         latest.Image,
+		LastCheckTime: metav1.NewTime(now),
+	}
+	cr.Status.OCPReleaseImage = latest.Image
+	cr.Status.OCPVersion = latest.Version
+	return r.setCondition(ctx, cr, metav1.ConditionTrue, ReasonChannelResolved,
+		fmt.Sprintf("Release %s selected from channel %s: %s", latest.Version, channel, latest.Image))
+}
+
+// RecheckAfter returns when the channel of the bridge must be looked up again: after RetryInterval
+// if it could not be resolved, or when the next check for a newer release is due. It returns zero
+// for bridges without a channel.
+func (r *Resolver) RecheckAfter(cr *provisioningv1alpha1.DPFHCPBridge) time.Duration {
+	if cr.Spec.Channel == "" {
+		return 0
+	}
+	if meta.IsStatusConditionFalse(cr.Status.Conditions, provisioningv1alpha1.ReleaseChannelResolved) {
+		return r.RetryInterval
+	}
+	if cr.Status.ChannelRelease == nil || r.RecheckInterval <= 0 {
+		return 0
+	}
+	next := cr.Status.ChannelRelease.LastCheckTime.Add(r.RecheckInterval).Sub(r.clock())
+	if next <= 0 {
+		// Overdue, e.g. after an operator restart
+		return time.Second
+	}
+	return next
+}
+
+// recheckDue returns whether the update graph must be read again for a resolved channel
+func (r *Resolver) recheckDue(cr *provisioningv1alpha1.DPFHCPBridge, now time.Time) bool {
+	if !meta.IsStatusConditionTrue(cr.Status.Conditions, provisioningv1alpha1.ReleaseChannelResolved) {
+		return true
+	}
+	if r.RecheckInterval <= 0 {
+		return false
+	}
+	return !now.Before(cr.Status.ChannelRelease.LastCheckTime.Add(r.RecheckInterval))
+}
+
+// setCondition sets the ReleaseChannelResolved condition and persists the status. Failures are not
+// returned as errors, they are retried after RetryInterval (see RecheckAfter).
+func (r *Resolver) setCondition(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge,
+	status metav1.ConditionStatus, reason, message string) (ctrl.Result, error) {
+	condition := metav1.Condition{
+		Type:               provisioningv1alpha1.ReleaseChannelResolved,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(r.clock()),
+		ObservedGeneration: cr.Generation,
+	}
+	changed := meta.SetStatusCondition(&cr.Status.Conditions, condition)
+	if !changed && status == metav1.ConditionFalse {
+		return ctrl.Result{}, nil
+	}
+	if changed {
+		eventType := corev1.EventTypeNormal
+		if status == metav1.ConditionFalse {
+			eventType = corev1.EventTypeWarning
+		}
+		r.recorder.Event(cr, eventType, reason, message)
+	}
+
+	// A resolved channel is persisted even if the condition is unchanged, to record LastCheckTime
+	if err := r.client.Status().Update(ctx, cr); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *Resolver) clock() time.Time {
+	if r.now == nil {
+		return time.Now()
+	}
+	return r.now()
+}
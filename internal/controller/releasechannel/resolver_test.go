@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasechannel
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// fakeGraph returns the same releases for every channel
+type fakeGraph struct {
+	releases []Release
+	err      error
+	fetched  int
+}
+
+func (f *fakeGraph) Releases(_ context.Context, _ string) ([]Release, error) {
+	f.fetched++
+	return f.releases, f.err
+}
+
+var _ = Describe("Release channel resolver", func() {
+	var (
+		ctx      context.Context
+		c        client.Client
+		bridge   *provisioningv1alpha1.DPFHCPBridge
+		graph    *fakeGraph
+		resolver *Resolver
+		now      time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", Generation: 1},
+			Spec:       provisioningv1alpha1.DPFHCPBridgeSpec{Channel: "stable-4.17"},
+		}
+		c = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(bridge).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+		graph = &fakeGraph{releases: []Release{
+			{Version: "4.16.20", Image: "quay.io/openshift-release-dev/ocp-release@sha256:16-20"},
+			{Version: "4.17.3", Image: "quay.io/openshift-release-dev/ocp-release@sha256:17-3"},
+		}}
+		now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		resolver = NewResolver(c, record.NewFakeRecorder(10), graph)
+		resolver.now = func() time.Time { return now }
+	})
+
+	resolve := func() *metav1.Condition {
+		result, err := resolver.ResolveChannel(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		return meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.ReleaseChannelResolved)
+	}
+
+	It("should select the latest release of the channel", func() {
+		condition := resolve()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(ReasonChannelResolved))
+		Expect(bridge.Status.ChannelRelease.Version).To(Equal("4.17.3"))
+		Expect(bridge.Status.ChannelRelease.LastCheckTime.Time).To(BeTemporally("==", now))
+		Expect(bridge.Status.OCPReleaseImage).To(Equal("quay.io/openshift-release-dev/ocp-release@sha256:17-3"))
+		Expect(bridge.Status.OCPVersion).To(Equal("4.17.3"))
+		Expect(bridge.ResolvedOCPReleaseImage()).To(Equal(bridge.Status.OCPReleaseImage))
+		Expect(resolver.RecheckAfter(bridge)).To(Equal(DefaultRecheckInterval))
+	})
+
+	It("should not read the graph again until the recheck is due", func() {
+		resolve()
+		now = now.Add(DefaultRecheckInterval / 2)
+
+		resolve()
+		Expect(graph.fetched).To(Equal(1))
+		Expect(resolver.RecheckAfter(bridge)).To(Equal(DefaultRecheckInterval / 2))
+	})
+
+	It("should follow new z-stream releases", func() {
+		resolve()
+		graph.releases = append(graph.releases, Release{Version: "4.17.4", Image: "quay.io/openshift-release-dev/ocp-release@sha256:17-4"})
+		now = now.Add(DefaultRecheckInterval)
+
+		condition := resolve()
+		Expect(graph.fetched).To(Equal(2))
+		Expect(condition.Message).To(ContainSubstring("4.17.4"))
+		Expect(bridge.Status.ChannelRelease.Version).To(Equal("4.17.4"))
+		Expect(bridge.Status.OCPReleaseImage).To(Equal("quay.io/openshift-release-dev/ocp-release@sha256:17-4"))
+	})
+
+	It("should resolve again when the channel changes", func() {
+		resolve()
+		graph.releases = append(graph.releases, Release{Version: "4.18.1", Image: "quay.io/openshift-release-dev/ocp-release@sha256:18-1"})
+		bridge.Spec.Channel = "stable-4.18"
+		Expect(c.Update(ctx, bridge)).To(Succeed())
+
+		resolve()
+		Expect(bridge.Status.ChannelRelease.Channel).To(Equal("stable-4.18"))
+		Expect(bridge.Status.OCPVersion).To(Equal("4.18.1"))
+	})
+
+	It("should keep the previous release when the graph is unavailable", func() {
+		resolve()
+		graph.err = errors.New("connection refused")
+		now = now.Add(DefaultRecheckInterval)
+
+		condition := resolve()
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(ReasonGraphUnavailable))
+		Expect(condition.Message).To(ContainSubstring("connection refused"))
+		Expect(bridge.Status.OCPVersion).To(Equal("4.17.3"))
+		Expect(resolver.RecheckAfter(bridge)).To(Equal(DefaultRetryInterval))
+	})
+
+	It("should report channels without a release of their minor version", func() {
+		bridge.Spec.Channel = "candidate-4.19"
+		Expect(c.Update(ctx, bridge)).To(Succeed())
+
+		condition := resolve()
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(ReasonNoReleaseInChannel))
+		Expect(bridge.Status.ChannelRelease).To(BeNil())
+		Expect(bridge.ResolvedOCPReleaseImage()).To(BeEmpty())
+	})
+
+	It("should clear the channel status when the channel is removed", func() {
+		resolve()
+		bridge.Spec.Channel = ""
+		bridge.Spec.OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.17.3-multi"
+		Expect(c.Update(ctx, bridge)).To(Succeed())
+
+		Expect(resolve()).To(BeNil())
+		Expect(bridge.Status.ChannelRelease).To(BeNil())
+		Expect(resolver.RecheckAfter(bridge)).To(BeZero())
+	})
+})
@@ -0,0 +1,183 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package releasepin pins the release image of DPFHCPBridges to the digest its tag refers to before
+// it is rolled out, so that re-pushed tags cannot change what a bridge installs.
+package releasepin
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/bluefield"
+)
+
+const (
+	// ReleaseImagePinned condition reasons
+	ReasonReleaseImagePinned    = "ReleaseImagePinned"
+	ReasonDigestNotResolved     = "DigestNotResolved"
+	ReasonSignatureLookupFailed = "SignatureLookupFailed"
+	ReasonSignatureNotVerified  = "SignatureNotVerified"
+
+	// DefaultRecheckInterval is how long to wait before retrying a release image that could not be pinned
+	DefaultRecheckInterval = time.Minute
+)
+
+// Pinner pins release images to digests and verifies their signatures
+type Pinner struct {
+	client   client.Client
+	recorder record.EventRecorder
+
+	// Registry resolves release images to digests and reads their signatures
+	Registry bluefield.ReleaseDigestReader
+
+	// PublicKeys, if set, are the keys of which one must have signed a digest before it is rolled out
+	PublicKeys []crypto.PublicKey
+
+	// RecheckInterval is how long to wait before retrying a release image that could not be pinned
+	RecheckInterval time.Duration
+}
+
+// NewPinner creates a new release image Pinner
+func NewPinner(client client.Client, recorder record.EventRecorder, registry bluefield.ReleaseDigestReader) *Pinner {
+	return &Pinner{
+		client:          client,
+		recorder:        recorder,
+		Registry:        registry,
+		RecheckInterval: DefaultRecheckInterval,
+	}
+}
+
+// PinRelease pins the release image of the bridge into status.pinnedReleaseImage and sets the
+// ReleaseImagePinned condition.
+//
+// A release image is pinned once, when the bridge is created or moves to another release image,
+// and the HostedCluster and NodePools then run the pinned digest. While a release image cannot be
+// pinned, the bridge fails before provisioning and keeps its running release afterwards. A pin
+// made before signature keys were configured is verified without resolving the tag again.
+func (p *Pinner) PinRelease(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithValues("feature", "release-pinning")
+
+	image := cr.ResolvedOCPReleaseImage()
+	if image == "" {
+		// releaseCatalogRef is not resolved yet, as reported by the ReleaseResolved condition
+		return ctrl.Result{}, nil
+	}
+
+	pin := cr.Status.PinnedReleaseImage.DeepCopy()
+	if pin != nil && pin.Image == image && (pin.SignatureVerified || len(p.PublicKeys) == 0) {
+		return ctrl.Result{}, nil
+	}
+
+	pullSecret := p.pullSecret(ctx, cr)
+	if pin == nil || pin.Image != image {
+		digest, err := p.Registry.ReleaseDigest(ctx, image, pullSecret)
+		if err != nil {
+			log.Info("Failed to resolve the release image digest", "image", image, "error", err.Error())
+			return p.setCondition(ctx, cr, metav1.ConditionFalse, ReasonDigestNotResolved,
+				fmt.Sprintf("Failed to resolve %s to a digest: %v", image, err))
+		}
+		pin = &provisioningv1alpha1.ReleaseImagePin{Image: image, Digest: digest}
+	}
+
+	if len(p.PublicKeys) > 0 {
+		signatures, err := p.Registry.ReleaseSignatures(ctx, image, pin.Digest, pullSecret)
+		if err != nil {
+			log.Info("Failed to read the release image signatures", "image", image, "error", err.Error())
+			return p.setCondition(ctx, cr, metav1.ConditionFalse, ReasonSignatureLookupFailed,
+				fmt.Sprintf("Failed to read the signatures of %s: %v", image, err))
+		}
+		if err := verifySignatures(p.PublicKeys, pin.Digest, signatures); err != nil {
+			return p.setCondition(ctx, cr, metav1.ConditionFalse, ReasonSignatureNotVerified,
+				fmt.Sprintf("Release image %s was not pinned: %v", image, err))
+		}
+		pin.SignatureVerified = true
+	}
+
+	cr.Status.PinnedReleaseImage = pin
+	message := fmt.Sprintf("Release image %s pinned to %s", image, pin.Digest)
+	if pin.SignatureVerified {
+		message += " with a verified signature"
+	}
+	log.Info("Pinned release image", "image", image, "digest", pin.Digest, "signatureVerified", pin.SignatureVerified)
+	return p.setCondition(ctx, cr, metav1.ConditionTrue, ReasonReleaseImagePinned, message)
+}
+
+// RecheckAfter returns when the release image of a bridge that could not be pinned must be tried
+// again, or zero if it is pinned
+func (p *Pinner) RecheckAfter(cr *provisioningv1alpha1.DPFHCPBridge) time.Duration {
+	if p.RecheckInterval <= 0 || !meta.IsStatusConditionFalse(cr.Status.Conditions, provisioningv1alpha1.ReleaseImagePinned) {
+		return 0
+	}
+	return p.RecheckInterval
+}
+
+// setCondition sets the ReleaseImagePinned condition and persists the status. Failures are not
+// returned as errors, they are retried after RecheckInterval (see RecheckAfter).
+func (p *Pinner) setCondition(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge,
+	status metav1.ConditionStatus, reason, message string) (ctrl.Result, error) {
+	condition := metav1.Condition{
+		Type:               provisioningv1alpha1.ReleaseImagePinned,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: cr.Generation,
+	}
+	changed := meta.SetStatusCondition(&cr.Status.Conditions, condition)
+	if !changed && status == metav1.ConditionFalse {
+		return ctrl.Result{}, nil
+	}
+	if changed {
+		eventType := corev1.EventTypeNormal
+		if status == metav1.ConditionFalse {
+			eventType = corev1.EventTypeWarning
+		}
+		p.recorder.Event(cr, eventType, reason, message)
+	}
+
+	if err := p.client.Status().Update(ctx, cr); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// pullSecret returns the .dockerconfigjson of the bridge's pull secret, or nil if it cannot be read.
+// Public release images can be pinned without it.
+func (p *Pinner) pullSecret(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) []byte {
+	if cr.Spec.PullSecretRef.Name == "" {
+		return nil
+	}
+	secret := &corev1.Secret{}
+	if err := p.client.Get(ctx, types.NamespacedName{Name: cr.Spec.PullSecretRef.Name, Namespace: cr.Namespace}, secret); err != nil {
+		logf.FromContext(ctx).V(1).Info("Failed to get pull secret for release pinning", "error", err.Error())
+		return nil
+	}
+	return secret.Data[corev1.DockerConfigJsonKey]
+}
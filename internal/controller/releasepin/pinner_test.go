@@ -0,0 +1,184 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasepin
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/bluefield"
+)
+
+// fakeDigestReader resolves every image to digest and returns signatures for it
+type fakeDigestReader struct {
+	digest     string
+	err        error
+	signatures []bluefield.ReleaseSignature
+	resolved   int
+}
+
+func (f *fakeDigestReader) ReleaseDigest(_ context.Context, _ string, _ []byte) (string, error) {
+	f.resolved++
+	return f.digest, f.err
+}
+
+func (f *fakeDigestReader) ReleaseSignatures(_ context.Context, _, digest string, _ []byte) ([]bluefield.ReleaseSignature, error) {
+	if digest != f.digest {
+		return nil, nil
+	}
+	return f.signatures, nil
+}
+
+var _ = Describe("Release image pinner", func() {
+	const (
+		releaseImage = "quay.io/openshift-release-dev/ocp-release:4.19.1-multi"
+		digest       = "sha256:0123456789abcdef"
+	)
+
+	var (
+		ctx      context.Context
+		c        client.Client
+		bridge   *provisioningv1alpha1.DPFHCPBridge
+		registry *fakeDigestReader
+		pinner   *Pinner
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default", Generation: 1},
+			Spec:       provisioningv1alpha1.DPFHCPBridgeSpec{OCPReleaseImage: releaseImage},
+		}
+		c = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(bridge).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+		registry = &fakeDigestReader{digest: digest}
+		pinner = NewPinner(c, record.NewFakeRecorder(10), registry)
+	})
+
+	pin := func() *metav1.Condition {
+		result, err := pinner.PinRelease(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		return meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.ReleaseImagePinned)
+	}
+
+	It("should pin the release image to its digest", func() {
+		condition := pin()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(ReasonReleaseImagePinned))
+		Expect(bridge.Status.PinnedReleaseImage).To(Equal(&provisioningv1alpha1.ReleaseImagePin{Image: releaseImage, Digest: digest}))
+		Expect(bridge.PinnedOCPReleaseImage()).To(Equal("quay.io/openshift-release-dev/ocp-release@" + digest))
+		Expect(pinner.RecheckAfter(bridge)).To(BeZero())
+	})
+
+	It("should keep the pin while the release image is unchanged", func() {
+		pin()
+		registry.digest = "sha256:moved"
+
+		pin()
+		Expect(registry.resolved).To(Equal(1))
+		Expect(bridge.Status.PinnedReleaseImage.Digest).To(Equal(digest))
+	})
+
+	It("should pin again when the release image changes", func() {
+		pin()
+		bridge.Spec.OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.19.2-multi"
+		Expect(c.Update(ctx, bridge)).To(Succeed())
+		registry.digest = "sha256:fedcba9876543210"
+
+		pin()
+		Expect(bridge.Status.PinnedReleaseImage.Image).To(Equal(bridge.Spec.OCPReleaseImage))
+		Expect(bridge.Status.PinnedReleaseImage.Digest).To(Equal("sha256:fedcba9876543210"))
+	})
+
+	It("should report digests that cannot be resolved and recheck them", func() {
+		registry.err = errors.New("connection refused")
+
+		condition := pin()
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(ReasonDigestNotResolved))
+		Expect(condition.Message).To(ContainSubstring("connection refused"))
+		Expect(bridge.Status.PinnedReleaseImage).To(BeNil())
+		Expect(bridge.PinnedOCPReleaseImage()).To(BeEmpty())
+		Expect(pinner.RecheckAfter(bridge)).To(Equal(DefaultRecheckInterval))
+	})
+
+	It("should wait for the release catalog to be resolved", func() {
+		bridge.Spec.OCPReleaseImage = ""
+		bridge.Spec.ReleaseCatalogRef = &provisioningv1alpha1.ReleaseCatalogReference{Name: "production", Version: "4.19.1"}
+
+		Expect(pin()).To(BeNil())
+		Expect(registry.resolved).To(BeZero())
+	})
+
+	Context("with signature keys", func() {
+		var key *ecdsa.PrivateKey
+
+		BeforeEach(func() {
+			var err error
+			key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			pinner.PublicKeys = []crypto.PublicKey{&key.PublicKey}
+		})
+
+		It("should pin signed digests", func() {
+			registry.signatures = []bluefield.ReleaseSignature{sign(key, digest)}
+
+			condition := pin()
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Message).To(ContainSubstring("with a verified signature"))
+			Expect(bridge.Status.PinnedReleaseImage.SignatureVerified).To(BeTrue())
+		})
+
+		It("should not pin unsigned digests", func() {
+			condition := pin()
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonSignatureNotVerified))
+			Expect(bridge.Status.PinnedReleaseImage).To(BeNil())
+		})
+
+		It("should verify pins made before keys were configured without resolving the tag again", func() {
+			bridge.Status.PinnedReleaseImage = &provisioningv1alpha1.ReleaseImagePin{Image: releaseImage, Digest: digest}
+			registry.signatures = []bluefield.ReleaseSignature{sign(key, digest)}
+
+			Expect(pin().Status).To(Equal(metav1.ConditionTrue))
+			Expect(registry.resolved).To(BeZero())
+			Expect(bridge.Status.PinnedReleaseImage.SignatureVerified).To(BeTrue())
+		})
+	})
+})
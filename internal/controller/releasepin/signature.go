@@ -0,0 +1,140 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasepin
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/bluefield"
+)
+
+// cosignSignatureType is the type of the simple signing payloads created by cosign
+const cosignSignatureType = "cosign container image signature"
+
+// LoadPublicKeysFile reads the PEM encoded public keys release signatures are verified against
+func LoadPublicKeysFile(path string) ([]crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read release signature keys: %w", err)
+	}
+	return ParsePublicKeys(data)
+}
+
+// ParsePublicKeys parses PEM encoded PKIX public keys. ECDSA, RSA and Ed25519 keys are supported.
+func ParsePublicKeys(data []byte) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "PUBLIC KEY" {
+			return nil, fmt.Errorf("unexpected PEM block %q, expected PUBLIC KEY", block.Type)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid release signature key: %w", err)
+		}
+		switch key.(type) {
+		case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		default:
+			return nil, fmt.Errorf("unsupported release signature key type %T", key)
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no PEM encoded public key found")
+	}
+	return keys, nil
+}
+
+// verifySignatures returns nil if one of the signatures is a signature of digest by one of the keys
+func verifySignatures(keys []crypto.PublicKey, digest string, signatures []bluefield.ReleaseSignature) error {
+	if len(signatures) == 0 {
+		return fmt.Errorf("no signature found for %s", digest)
+	}
+
+	var errs []error
+	for _, signature := range signatures {
+		err := verifyPayload(signature.Payload, digest)
+		if err == nil {
+			err = verifySignature(keys, signature)
+		}
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	return fmt.Errorf("no valid signature found for %s: %w", digest, errors.Join(errs...))
+}
+
+// verifyPayload checks that a simple signing payload is a cosign signature of digest. The identity
+// of the payload is not checked, so that release images copied to mirrors verify as well.
+func verifyPayload(payload []byte, digest string) error {
+	var simpleSigning struct {
+		Critical struct {
+			Image struct {
+				DockerManifestDigest string `json:"docker-manifest-digest"`
+			} `json:"image"`
+			Type string `json:"type"`
+		} `json:"critical"`
+	}
+	if err := json.Unmarshal(payload, &simpleSigning); err != nil {
+		return fmt.Errorf("invalid signature payload: %w", err)
+	}
+	if simpleSigning.Critical.Type != cosignSignatureType {
+		return fmt.Errorf("unexpected signature type %q", simpleSigning.Critical.Type)
+	}
+	if simpleSigning.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signature is for %s", simpleSigning.Critical.Image.DockerManifestDigest)
+	}
+	return nil
+}
+
+// verifySignature checks the signature of the payload against each key
+func verifySignature(keys []crypto.PublicKey, signature bluefield.ReleaseSignature) error {
+	hash := sha256.Sum256(signature.Payload)
+	for _, key := range keys {
+		switch key := key.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(key, hash[:], signature.Signature) {
+				return nil
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature.Signature) == nil {
+				return nil
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(key, signature.Payload, signature.Signature) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("signature does not match any of the configured keys")
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releasepin
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/bluefield"
+)
+
+// cosignPayload returns the simple signing payload cosign creates for a digest
+func cosignPayload(digest string) []byte {
+	return []byte(`{"critical":{"identity":{"docker-reference":"quay.io/openshift-release-dev/ocp-release"},` +
+		`"image":{"docker-manifest-digest":"` + digest + `"},"type":"cosign container image signature"},"optional":null}`)
+}
+
+// sign returns an ECDSA signature of the cosign payload of digest
+func sign(key *ecdsa.PrivateKey, digest string) bluefield.ReleaseSignature {
+	payload := cosignPayload(digest)
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	Expect(err).NotTo(HaveOccurred())
+	return bluefield.ReleaseSignature{Payload: payload, Signature: signature}
+}
+
+// encodePublicKey returns the PEM encoding of a public key
+func encodePublicKey(key crypto.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	Expect(err).NotTo(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+var _ = Describe("Release Signatures", func() {
+	const digest = "sha256:0123456789abcdef"
+
+	var key, otherKey *ecdsa.PrivateKey
+
+	BeforeEach(func() {
+		var err error
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		otherKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("ParsePublicKeys", func() {
+		It("should parse several PEM encoded keys", func() {
+			edKey, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			keys, err := ParsePublicKeys(append(encodePublicKey(&key.PublicKey), encodePublicKey(edKey)...))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(HaveLen(2))
+		})
+
+		It("should reject input without keys", func() {
+			_, err := ParsePublicKeys([]byte("not a key"))
+			Expect(err).To(MatchError(ContainSubstring("no PEM encoded public key")))
+		})
+
+		It("should reject other PEM blocks", func() {
+			_, err := ParsePublicKeys(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("secret")}))
+			Expect(err).To(MatchError(ContainSubstring("expected PUBLIC KEY")))
+		})
+	})
+
+	Describe("verifySignatures", func() {
+		It("should accept a signature of the digest by one of the keys", func() {
+			signatures := []bluefield.ReleaseSignature{sign(otherKey, digest), sign(key, digest)}
+			Expect(verifySignatures([]crypto.PublicKey{&key.PublicKey}, digest, signatures)).To(Succeed())
+		})
+
+		It("should reject signatures by other keys", func() {
+			err := verifySignatures([]crypto.PublicKey{&key.PublicKey}, digest, []bluefield.ReleaseSignature{sign(otherKey, digest)})
+			Expect(err).To(MatchError(ContainSubstring("does not match any of the configured keys")))
+		})
+
+		It("should reject signatures of other digests", func() {
+			err := verifySignatures([]crypto.PublicKey{&key.PublicKey}, digest, []bluefield.ReleaseSignature{sign(key, "sha256:other")})
+			Expect(err).To(MatchError(ContainSubstring("signature is for sha256:other")))
+		})
+
+		It("should reject tampered payloads", func() {
+			signature := sign(key, digest)
+			signature.Payload = cosignPayload(digest + "0")
+			err := verifySignatures([]crypto.PublicKey{&key.PublicKey}, digest+"0", []bluefield.ReleaseSignature{signature})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject unsigned digests", func() {
+			err := verifySignatures([]crypto.PublicKey{&key.PublicKey}, digest, nil)
+			Expect(err).To(MatchError(ContainSubstring("no signature found")))
+		})
+	})
+})
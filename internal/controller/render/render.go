@@ -0,0 +1,228 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package render writes the manifests the operator would create for a DPFHCPBridge to a ConfigMap, so that
+// they can be reviewed, e.g. in a GitOps workflow, before the bridge is provisioned.
+package render
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/hostedcluster"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/manifests"
+)
+
+const (
+	// HostedClusterKey is the ConfigMap key of the rendered HostedCluster
+	HostedClusterKey = "hostedcluster.yaml"
+
+	// NodePoolsKey is the ConfigMap key of the rendered NodePools
+	NodePoolsKey = "nodepools.yaml"
+
+	// HostedClusterManifestsKey is the ConfigMap key of the built-in manifests applied to the hosted cluster,
+	// only set if the bridge enables any
+	HostedClusterManifestsKey = "hosted-cluster-manifests.yaml"
+)
+
+// ConfigMapName returns the name of the ConfigMap the manifests of the bridge are rendered to
+func ConfigMapName(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	return cr.Name + "-rendered-manifests"
+}
+
+// Requested reports whether the render-manifests annotation asks for the manifests of the bridge
+func Requested(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	return cr.Annotations[provisioningv1alpha1.AnnotationRenderManifests] == "true"
+}
+
+// HoldsProvisioning reports whether the bridge waits in Pending while its manifests are reviewed:
+// the render-manifests annotation is set and the HostedCluster was not created yet
+func HoldsProvisioning(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	return Requested(cr) && cr.Status.HostedClusterRef == nil
+}
+
+// Renderer renders the HostedCluster, NodePools and built-in hosted cluster manifests of a bridge the way
+// the HostedClusterManager, NodePoolManager and manifests Applier create them
+type Renderer struct {
+	client         client.Client
+	scheme         *runtime.Scheme
+	recorder       record.EventRecorder
+	hostedClusters *hostedcluster.HostedClusterManager
+	nodePools      *hostedcluster.NodePoolManager
+}
+
+// NewRenderer creates a new Renderer
+func NewRenderer(client client.Client, scheme *runtime.Scheme, recorder record.EventRecorder,
+	hostedClusters *hostedcluster.HostedClusterManager, nodePools *hostedcluster.NodePoolManager) *Renderer {
+	return &Renderer{
+		client:         client,
+		scheme:         scheme,
+		recorder:       recorder,
+		hostedClusters: hostedClusters,
+		nodePools:      nodePools,
+	}
+}
+
+// SyncRenderedManifests writes the manifests of the bridge to the <name>-rendered-manifests ConfigMap in the
+// bridge namespace while the render-manifests annotation is "true", and deletes the ConfigMap once the
+// annotation is removed. A ManifestsRendered event is emitted whenever the rendered manifests change.
+// Nothing is rendered while the bridge is Failed, as its spec has to be fixed first.
+// A ConfigMap of the same name that is not owned by this bridge is never overwritten.
+func (r *Renderer) SyncRenderedManifests(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) error {
+	log := logf.FromContext(ctx)
+	name := ConfigMapName(cr)
+
+	existing := &corev1.ConfigMap{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: name, Namespace: cr.Namespace}, existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get rendered manifests ConfigMap: %w", err)
+	}
+	exists := err == nil
+	owned := exists && existing.Labels[common.LabelOwnedBy] == cr.Name &&
+		existing.Labels[common.LabelNamespace] == cr.Namespace &&
+		existing.Labels[common.LabelComponent] == common.ComponentRenderedManifests
+
+	if !Requested(cr) {
+		if !owned {
+			return nil
+		}
+		if err := r.client.Delete(ctx, existing); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete rendered manifests ConfigMap: %w", err)
+		}
+		log.Info("Deleted rendered manifests ConfigMap, the annotation was removed", "configMap", name)
+		return nil
+	}
+
+	if cr.Status.Phase == provisioningv1alpha1.PhaseFailed {
+		log.V(1).Info("Skipping manifest rendering - bridge failed validation")
+		return nil
+	}
+
+	data, err := r.render(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: cr.Namespace,
+				Labels:    common.ComponentOwnerLabels(cr, common.ComponentRenderedManifests),
+			},
+			Data: data,
+		}
+		if err := controllerutil.SetControllerReference(cr, configMap, r.scheme); err != nil {
+			return fmt.Errorf("failed to set owner reference on rendered manifests ConfigMap: %w", err)
+		}
+		if err := r.client.Create(ctx, configMap); err != nil {
+			return fmt.Errorf("failed to create rendered manifests ConfigMap: %w", err)
+		}
+	} else {
+		if !owned {
+			return fmt.Errorf("configMap %s/%s already exists and is not owned by this DPFHCPBridge", cr.Namespace, name)
+		}
+		if maps.Equal(existing.Data, data) {
+			return nil
+		}
+		existing.Data = data
+		if err := r.client.Update(ctx, existing); err != nil {
+			return fmt.Errorf("failed to update rendered manifests ConfigMap: %w", err)
+		}
+	}
+
+	log.Info("Rendered manifests", "configMap", name)
+	r.recorder.Eventf(cr, corev1.EventTypeNormal, "ManifestsRendered",
+		"Rendered the manifests of the bridge to ConfigMap %s", name)
+	return nil
+}
+
+// render returns the ConfigMap data holding the manifests of the bridge. The infrastructure ID of the
+// HostedCluster is left empty, as it is generated when the HostedCluster is created.
+func (r *Renderer) render(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (map[string]string, error) {
+	hc, err := r.hostedClusters.RenderHostedCluster(ctx, cr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render HostedCluster: %w", err)
+	}
+	// The infrastructure ID is random, so that it would change on every render
+	hc.Spec.InfraID = ""
+
+	nodePools, err := r.nodePools.RenderNodePools(cr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render NodePools: %w", err)
+	}
+	builtin, err := manifests.RenderBuiltinManifests(cr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render built-in hosted cluster manifests: %w", err)
+	}
+
+	hostedClusterYAML, err := r.marshal(hc)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]client.Object, 0, len(nodePools))
+	for _, np := range nodePools {
+		objs = append(objs, np)
+	}
+	nodePoolsYAML, err := r.marshal(objs...)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]string{
+		HostedClusterKey: hostedClusterYAML,
+		NodePoolsKey:     nodePoolsYAML,
+	}
+	if len(builtin) > 0 {
+		data[HostedClusterManifestsKey] = string(builtin)
+	}
+	return data, nil
+}
+
+// marshal returns objs as a multi-document YAML stream, with their apiVersion and kind set
+func (r *Renderer) marshal(objs ...client.Object) (string, error) {
+	docs := make([][]byte, 0, len(objs))
+	for _, obj := range objs {
+		gvk, err := apiutil.GVKForObject(obj, r.scheme)
+		if err != nil {
+			return "", fmt.Errorf("failed to determine kind of %s: %w", obj.GetName(), err)
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+		doc, err := yaml.Marshal(obj)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal %s %s: %w", gvk.Kind, obj.GetName(), err)
+		}
+		docs = append(docs, doc)
+	}
+	return string(bytes.Join(docs, []byte("---\n"))), nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/common"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/hostedcluster"
+)
+
+var _ = Describe("Manifest Renderer", func() {
+	var (
+		ctx      context.Context
+		scheme   *runtime.Scheme
+		recorder *record.FakeRecorder
+		cr       *provisioningv1alpha1.DPFHCPBridge
+		key      client.ObjectKey
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+		recorder = record.NewFakeRecorder(10)
+
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-bridge",
+				Namespace:   "default",
+				UID:         "bridge-uid",
+				Annotations: map[string]string{provisioningv1alpha1.AnnotationRenderManifests: "true"},
+			},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				OCPReleaseImage:                "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+				BaseDomain:                     "example.com",
+				ControlPlaneAvailabilityPolicy: hyperv1.HighlyAvailable,
+				VirtualIP:                      "192.168.1.100",
+				IngressVIP:                     "192.168.1.101",
+				NodePools:                      []provisioningv1alpha1.NodePoolSpec{{Name: "canary"}},
+			},
+		}
+		key = client.ObjectKey{Name: "test-bridge-rendered-manifests", Namespace: "default"}
+	})
+
+	newRenderer := func(c client.Client) *Renderer {
+		return NewRenderer(c, scheme, recorder,
+			hostedcluster.NewHostedClusterManager(c, scheme), hostedcluster.NewNodePoolManager(c, scheme))
+	}
+
+	It("should render the manifests without creating them and hold provisioning back", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		Expect(newRenderer(c).SyncRenderedManifests(ctx, cr)).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, key, cm)).To(Succeed())
+		Expect(metav1.IsControlledBy(cm, cr)).To(BeTrue())
+		Expect(cm.Labels).To(HaveKeyWithValue(common.LabelComponent, common.ComponentRenderedManifests))
+		Expect(cm.Data[HostedClusterKey]).To(ContainSubstring("kind: HostedCluster"))
+		Expect(cm.Data[HostedClusterKey]).To(ContainSubstring("image: quay.io/openshift-release-dev/ocp-release:4.19.0-multi"))
+		Expect(cm.Data[NodePoolsKey]).To(ContainSubstring("name: test-bridge\n"))
+		Expect(cm.Data[NodePoolsKey]).To(ContainSubstring("name: test-bridge-canary\n"))
+		Expect(cm.Data[HostedClusterManifestsKey]).To(ContainSubstring("192.168.1.101"))
+		Expect(recorder.Events).To(Receive(ContainSubstring("ManifestsRendered")))
+
+		hcs := &hyperv1.HostedClusterList{}
+		Expect(c.List(ctx, hcs)).To(Succeed())
+		Expect(hcs.Items).To(BeEmpty())
+		Expect(HoldsProvisioning(cr)).To(BeTrue())
+
+		cr.Status.HostedClusterRef = &corev1.ObjectReference{Name: "test-bridge", Namespace: "default"}
+		Expect(HoldsProvisioning(cr)).To(BeFalse())
+	})
+
+	It("should only report a change of the rendered manifests", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		renderer := newRenderer(c)
+
+		Expect(renderer.SyncRenderedManifests(ctx, cr)).To(Succeed())
+		Expect(recorder.Events).To(Receive())
+		Expect(renderer.SyncRenderedManifests(ctx, cr)).To(Succeed())
+		Expect(recorder.Events).NotTo(Receive())
+
+		cr.Spec.IngressVIP = ""
+		Expect(renderer.SyncRenderedManifests(ctx, cr)).To(Succeed())
+		Expect(recorder.Events).To(Receive(ContainSubstring("ManifestsRendered")))
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, key, cm)).To(Succeed())
+		Expect(cm.Data).NotTo(HaveKey(HostedClusterManifestsKey))
+	})
+
+	It("should delete the ConfigMap once the annotation is removed", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		renderer := newRenderer(c)
+		Expect(renderer.SyncRenderedManifests(ctx, cr)).To(Succeed())
+
+		delete(cr.Annotations, provisioningv1alpha1.AnnotationRenderManifests)
+		Expect(renderer.SyncRenderedManifests(ctx, cr)).To(Succeed())
+
+		err := c.Get(ctx, key, &corev1.ConfigMap{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(HoldsProvisioning(cr)).To(BeFalse())
+	})
+
+	It("should not overwrite a ConfigMap it does not own", func() {
+		foreign := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Data:       map[string]string{"foo": "bar"},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(foreign).Build()
+
+		Expect(newRenderer(c).SyncRenderedManifests(ctx, cr)).To(MatchError(ContainSubstring("not owned by this DPFHCPBridge")))
+
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, key, cm)).To(Succeed())
+		Expect(cm.Data).To(Equal(map[string]string{"foo": "bar"}))
+	})
+})
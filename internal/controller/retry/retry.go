@@ -0,0 +1,277 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package retry replaces the blanket exponential backoff of controller-runtime with retry
+// policies per error class, so that reconcile errors are retried at a pace that matches
+// their cause and recovery times stay predictable.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/metrics"
+)
+
+// Class is the error class a reconcile error is retried by
+type Class string
+
+const (
+	// ClassConflict is an optimistic concurrency conflict; the next attempt on a fresh read usually succeeds
+	ClassConflict Class = "Conflict"
+
+	// ClassMissingInput is a referenced object that does not exist or may not be read yet.
+	// Recovery depends on the user, so retries are slow and watches pick up most fixes earlier.
+	ClassMissingInput Class = "MissingInput"
+
+	// ClassTransient is any other error, such as timeouts or apiserver and webhook failures
+	ClassTransient Class = "Transient"
+
+	// ClassTerminal is an error that cannot be resolved by retrying, such as an object rejected
+	// by validation. It is not retried; the next change to a watched object triggers a new reconcile.
+	ClassTerminal Class = "Terminal"
+)
+
+const (
+	// DefaultConflictInitialDelay is the default delay before the first retry of a conflict
+	DefaultConflictInitialDelay = 100 * time.Millisecond
+	// DefaultConflictMaxDelay is the default upper bound for the delay between conflict retries
+	DefaultConflictMaxDelay = 5 * time.Second
+
+	// DefaultMissingInputInitialDelay is the default delay before the first retry of a missing input
+	DefaultMissingInputInitialDelay = 30 * time.Second
+	// DefaultMissingInputMaxDelay is the default upper bound for the delay between missing input retries
+	DefaultMissingInputMaxDelay = 10 * time.Minute
+
+	// DefaultTransientInitialDelay is the default delay before the first retry of a transient error
+	DefaultTransientInitialDelay = time.Second
+	// DefaultTransientMaxDelay is the default upper bound for the delay between transient error retries
+	DefaultTransientMaxDelay = 5 * time.Minute
+)
+
+// Policy is the exponential backoff of one error class. The delay starts at InitialDelay
+// and doubles with every consecutive failure of the same class, up to MaxDelay.
+type Policy struct {
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+}
+
+// Delay returns the delay before retry number attempt, counting from 0
+func (p Policy) Delay(attempt int) time.Duration {
+	delay := p.InitialDelay
+	for i := 0; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
+
+// Policies holds the retry policy of every retried error class
+type Policies struct {
+	Conflict     Policy
+	MissingInput Policy
+	Transient    Policy
+}
+
+// DefaultPolicies returns the retry policies used when the operator config does not override them
+func DefaultPolicies() Policies {
+	return Policies{
+		Conflict:     Policy{InitialDelay: DefaultConflictInitialDelay, MaxDelay: DefaultConflictMaxDelay},
+		MissingInput: Policy{InitialDelay: DefaultMissingInputInitialDelay, MaxDelay: DefaultMissingInputMaxDelay},
+		Transient:    Policy{InitialDelay: DefaultTransientInitialDelay, MaxDelay: DefaultTransientMaxDelay},
+	}
+}
+
+// Validate checks that every policy has a positive initial delay not above its max delay
+func (p Policies) Validate() error {
+	for _, class := range []Class{ClassConflict, ClassMissingInput, ClassTransient} {
+		policy, _ := p.For(class)
+		if policy.InitialDelay <= 0 {
+			return fmt.Errorf("%s retry policy: initial delay must be positive", class)
+		}
+		if policy.MaxDelay < policy.InitialDelay {
+			return fmt.Errorf("%s retry policy: max delay %s is below initial delay %s", class, policy.MaxDelay, policy.InitialDelay)
+		}
+	}
+	return nil
+}
+
+// For returns the policy of class, and false for classes that are not retried
+func (p Policies) For(class Class) (Policy, bool) {
+	switch class {
+	case ClassConflict:
+		return p.Conflict, true
+	case ClassMissingInput:
+		return p.MissingInput, true
+	case ClassTransient:
+		return p.Transient, true
+	default:
+		return Policy{}, false
+	}
+}
+
+// Classify returns the error class of a reconcile error
+func Classify(err error) Class {
+	switch {
+	case errors.Is(err, reconcile.TerminalError(nil)),
+		apierrors.IsInvalid(err),
+		apierrors.IsBadRequest(err),
+		apierrors.IsMethodNotSupported(err),
+		apierrors.IsRequestEntityTooLargeError(err):
+		return ClassTerminal
+	case apierrors.IsConflict(err), apierrors.IsAlreadyExists(err):
+		return ClassConflict
+	case apierrors.IsNotFound(err), apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+		return ClassMissingInput
+	default:
+		return ClassTransient
+	}
+}
+
+// failure is the consecutive failure state of one reconcile request
+type failure struct {
+	class    Class
+	attempts int
+}
+
+// RateLimiter is the workqueue rate limiter of a controller whose reconciler is wrapped in a Reconciler.
+// It delays the retry of a failed request following the policy of the error class the Reconciler
+// recorded for it. Consecutive failures of the same class back off exponentially; a success or a
+// failure of another class starts over. Requeues that were not caused by a classified error, such as
+// Result.Requeue, use the default controller-runtime rate limiter.
+type RateLimiter struct {
+	Policies Policies
+
+	fallback workqueue.TypedRateLimiter[reconcile.Request]
+
+	mu       sync.Mutex
+	failures map[reconcile.Request]failure
+}
+
+// NewRateLimiter creates a rate limiter with the given retry policies
+func NewRateLimiter(policies Policies) *RateLimiter {
+	return &RateLimiter{
+		Policies: policies,
+		fallback: workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](),
+		failures: map[reconcile.Request]failure{},
+	}
+}
+
+// When implements workqueue.TypedRateLimiter. It returns the delay before req is retried.
+func (l *RateLimiter) When(req reconcile.Request) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	state, ok := l.failures[req]
+	policy, retried := l.Policies.For(state.class)
+	if !ok || !retried {
+		return l.fallback.When(req)
+	}
+	delay := policy.Delay(state.attempts)
+	state.attempts++
+	l.failures[req] = state
+	return delay
+}
+
+// Forget implements workqueue.TypedRateLimiter. It is called once req no longer needs to be retried.
+func (l *RateLimiter) Forget(req reconcile.Request) {
+	l.reset(req)
+	l.fallback.Forget(req)
+}
+
+// NumRequeues implements workqueue.TypedRateLimiter
+func (l *RateLimiter) NumRequeues(req reconcile.Request) int {
+	l.mu.Lock()
+	state, ok := l.failures[req]
+	l.mu.Unlock()
+
+	if ok {
+		return state.attempts
+	}
+	return l.fallback.NumRequeues(req)
+}
+
+// record records a failure of class for req, starting over if the previous failure had another class
+func (l *RateLimiter) record(req reconcile.Request, class Class) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.failures == nil {
+		l.failures = map[reconcile.Request]failure{}
+	}
+	if state := l.failures[req]; state.class != class {
+		l.failures[req] = failure{class: class}
+	}
+}
+
+// reset drops the failure state of req
+func (l *RateLimiter) reset(req reconcile.Request) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.failures, req)
+}
+
+// Reconciler wraps a reconciler and classifies its errors for the RateLimiter of the controller,
+// which has to be set through the controller options. Errors are still returned, so that they are
+// logged and counted in the controller-runtime reconcile error metrics. Terminal errors are
+// reported to controller-runtime as terminal errors so that they are logged and counted but not requeued.
+type Reconciler struct {
+	reconcile.Reconciler
+	RateLimiter *RateLimiter
+}
+
+// NewReconciler wraps inner with a rate limiter using the given retry policies
+func NewReconciler(inner reconcile.Reconciler, policies Policies) *Reconciler {
+	return &Reconciler{
+		Reconciler:  inner,
+		RateLimiter: NewRateLimiter(policies),
+	}
+}
+
+// Reconcile runs the wrapped reconciler and records the error class of its error, if any, for the
+// rate limiter and, if the error is retried, in the retries metric
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (ctrl.Result, error) {
+	result, err := r.Reconciler.Reconcile(ctx, req)
+	if err == nil {
+		// A success starts over, also when the result requeues through the rate limiter
+		r.RateLimiter.reset(req)
+		return result, nil
+	}
+
+	class := Classify(err)
+	if _, retried := r.RateLimiter.Policies.For(class); !retried {
+		// Terminal errors are counted by controller-runtime, not as retries
+		r.RateLimiter.reset(req)
+		if errors.Is(err, reconcile.TerminalError(nil)) {
+			return result, err
+		}
+		return result, reconcile.TerminalError(err)
+	}
+
+	metrics.ReconcileRetries.WithLabelValues(string(class)).Inc()
+	r.RateLimiter.record(req, class)
+	return result, err
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/metrics"
+)
+
+var bridgeResource = schema.GroupResource{Group: "provisioning.dpu.hcp.io", Resource: "dpfhcpbridges"}
+
+var _ = Describe("Classify", func() {
+	DescribeTable("should map reconcile errors to error classes",
+		func(err error, expected Class) {
+			Expect(Classify(err)).To(Equal(expected))
+		},
+		Entry("conflict", apierrors.NewConflict(bridgeResource, "test", errors.New("modified")), ClassConflict),
+		Entry("already exists", apierrors.NewAlreadyExists(bridgeResource, "test"), ClassConflict),
+		Entry("wrapped conflict", errors.Join(errors.New("failed to update status"),
+			apierrors.NewConflict(bridgeResource, "test", errors.New("modified"))), ClassConflict),
+		Entry("not found", apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "pull-secret"), ClassMissingInput),
+		Entry("forbidden", apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "pull-secret", errors.New("rbac")), ClassMissingInput),
+		Entry("invalid", apierrors.NewInvalid(schema.GroupKind{Kind: "HostedCluster"}, "test", nil), ClassTerminal),
+		Entry("bad request", apierrors.NewBadRequest("malformed"), ClassTerminal),
+		Entry("explicit terminal error", reconcile.TerminalError(errors.New("cannot recover")), ClassTerminal),
+		Entry("timeout", apierrors.NewTimeoutError("slow", 1), ClassTransient),
+		Entry("internal error", apierrors.NewInternalError(errors.New("etcd")), ClassTransient),
+		Entry("plain error", errors.New("connection refused"), ClassTransient),
+	)
+})
+
+var _ = Describe("Policy", func() {
+	It("should double the delay up to the max delay", func() {
+		policy := Policy{InitialDelay: time.Second, MaxDelay: 5 * time.Second}
+		Expect(policy.Delay(0)).To(Equal(time.Second))
+		Expect(policy.Delay(1)).To(Equal(2 * time.Second))
+		Expect(policy.Delay(2)).To(Equal(4 * time.Second))
+		Expect(policy.Delay(3)).To(Equal(5 * time.Second))
+		Expect(policy.Delay(100)).To(Equal(5 * time.Second))
+	})
+
+	It("should accept the default policies", func() {
+		Expect(DefaultPolicies().Validate()).To(Succeed())
+	})
+
+	It("should reject a non-positive initial delay", func() {
+		policies := DefaultPolicies()
+		policies.Transient.InitialDelay = 0
+		Expect(policies.Validate()).To(MatchError(ContainSubstring("Transient retry policy")))
+	})
+
+	It("should reject a max delay below the initial delay", func() {
+		policies := DefaultPolicies()
+		policies.Conflict.MaxDelay = policies.Conflict.InitialDelay / 2
+		Expect(policies.Validate()).To(MatchError(ContainSubstring("Conflict retry policy")))
+	})
+})
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        context.Context
+		req        reconcile.Request
+		innerErr   error
+		innerRes   ctrl.Result
+		reconciler *Reconciler
+	)
+
+	// fail runs a failing reconcile and returns the delay the rate limiter applies to its retry
+	fail := func(req reconcile.Request) time.Duration {
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).To(MatchError(innerErr))
+		return reconciler.RateLimiter.When(req)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		req = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test"}}
+		innerErr = nil
+		innerRes = ctrl.Result{}
+		reconciler = NewReconciler(reconcile.Func(func(context.Context, reconcile.Request) (ctrl.Result, error) {
+			return innerRes, innerErr
+		}), Policies{
+			Conflict:     Policy{InitialDelay: 100 * time.Millisecond, MaxDelay: 400 * time.Millisecond},
+			MissingInput: Policy{InitialDelay: 30 * time.Second, MaxDelay: 2 * time.Minute},
+			Transient:    Policy{InitialDelay: time.Second, MaxDelay: time.Minute},
+		})
+	})
+
+	It("should pass successful results through", func() {
+		innerRes = ctrl.Result{RequeueAfter: time.Minute}
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{RequeueAfter: time.Minute}))
+	})
+
+	It("should return retried errors so that controller-runtime counts them", func() {
+		innerErr = errors.New("connection refused")
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).To(MatchError("connection refused"))
+		Expect(errors.Is(err, reconcile.TerminalError(nil))).To(BeFalse())
+	})
+
+	It("should back off exponentially on consecutive failures of the same class", func() {
+		innerErr = apierrors.NewConflict(bridgeResource, "test", errors.New("modified"))
+
+		var delays []time.Duration
+		for range 4 {
+			delays = append(delays, fail(req))
+		}
+		Expect(delays).To(Equal([]time.Duration{
+			100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 400 * time.Millisecond,
+		}))
+		Expect(reconciler.RateLimiter.NumRequeues(req)).To(Equal(4))
+	})
+
+	It("should start over after a success", func() {
+		innerErr = apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "pull-secret")
+		fail(req)
+		Expect(fail(req)).To(Equal(time.Minute))
+
+		innerErr = nil
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		innerErr = apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "pull-secret")
+		Expect(fail(req)).To(Equal(30 * time.Second))
+	})
+
+	It("should start over when the queue forgets the request", func() {
+		innerErr = errors.New("connection refused")
+		fail(req)
+		reconciler.RateLimiter.Forget(req)
+		Expect(fail(req)).To(Equal(time.Second))
+	})
+
+	It("should start over when the error class changes", func() {
+		innerErr = errors.New("connection refused")
+		fail(req)
+		Expect(fail(req)).To(Equal(2 * time.Second))
+
+		innerErr = apierrors.NewConflict(bridgeResource, "test", errors.New("modified"))
+		Expect(fail(req)).To(Equal(100 * time.Millisecond))
+	})
+
+	It("should track requests independently", func() {
+		innerErr = errors.New("connection refused")
+		fail(req)
+		fail(req)
+
+		other := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "other"}}
+		Expect(fail(other)).To(Equal(time.Second))
+	})
+
+	It("should use the default rate limiter for requeues without an error", func() {
+		innerRes = ctrl.Result{Requeue: true}
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reconciler.RateLimiter.When(req)).To(BeNumerically("<", 100*time.Millisecond))
+	})
+
+	It("should not retry terminal errors", func() {
+		innerErr = apierrors.NewInvalid(schema.GroupKind{Kind: "HostedCluster"}, "test", nil)
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(errors.Is(err, reconcile.TerminalError(nil))).To(BeTrue())
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	})
+
+	It("should count retried errors but not terminal errors as retries", func() {
+		transient := testutil.ToFloat64(metrics.ReconcileRetries.WithLabelValues(string(ClassTransient)))
+		terminal := testutil.ToFloat64(metrics.ReconcileRetries.WithLabelValues(string(ClassTerminal)))
+
+		innerErr = errors.New("connection refused")
+		fail(req)
+		innerErr = apierrors.NewInvalid(schema.GroupKind{Kind: "HostedCluster"}, "test", nil)
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).To(HaveOccurred())
+
+		Expect(testutil.ToFloat64(metrics.ReconcileRetries.WithLabelValues(string(ClassTransient)))).To(Equal(transient + 1))
+		Expect(testutil.ToFloat64(metrics.ReconcileRetries.WithLabelValues(string(ClassTerminal)))).To(Equal(terminal))
+	})
+})
@@ -0,0 +1,240 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package revalidation re-runs the preflight checks of every DPFHCPBridge once after an operator
+// upgrade, and reports bridges whose spec is no longer valid under the new operator version.
+package revalidation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/bluefield"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/conditions"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/dpucluster"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/metrics"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/secrets"
+)
+
+const (
+	// Revalidation results, used as metric label values
+	ResultValid   = "valid"
+	ResultInvalid = "invalid"
+	ResultError   = "error"
+)
+
+// preflightConditions are the conditions set by the preflight checks
+var preflightConditions = []string{
+	provisioningv1alpha1.DPUClusterMissing,
+	provisioningv1alpha1.ClusterTypeValid,
+	provisioningv1alpha1.DPUClusterInUse,
+	provisioningv1alpha1.SecretsValid,
+	provisioningv1alpha1.BlueFieldImageResolved,
+}
+
+// Preflight is a preflight check. It records its outcome as conditions on the bridge status.
+type Preflight func(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error)
+
+// DefaultPreflights returns the preflight checks run by the reconciler, bound to a dry-run client
+// and a discarding event recorder so that they only report into the bridge copy they are given.
+// BlueField image resolution is only included when enabled, as it is in the reconciler, and looks images up in
+// imageSource, or in the ocp-bluefield-images ConfigMap if it is nil.
+// Secrets are read from secretBackend, or from the bridge namespace if it is nil.
+func DefaultPreflights(c client.Client, imageResolution bool, metadataReader bluefield.ReleaseMetadataReader,
+	imageSource bluefield.ImageSource, secretBackend secrets.Backend) []Preflight {
+	dryRun := client.NewDryRunClient(c)
+	discard := &record.FakeRecorder{}
+
+	secretsValidator := secrets.NewValidator(dryRun, discard)
+	if secretBackend != nil {
+		secretsValidator.Backend = secretBackend
+	}
+	preflights := []Preflight{
+		dpucluster.NewValidator(dryRun, discard).ValidateDPUCluster,
+		secretsValidator.ValidateSecrets,
+	}
+	if imageResolution {
+		resolver := bluefield.NewImageResolver(dryRun, discard)
+		resolver.MetadataReader = metadataReader
+		if imageSource != nil {
+			resolver.Source = imageSource
+		}
+		preflights = append(preflights, resolver.ResolveBlueFieldImage)
+	}
+	return preflights
+}
+
+// Revalidator runs the preflight checks of every DPFHCPBridge once after the operator version changes.
+// The outcome is reported in the UpgradeRevalidated condition, which does not affect the phase so that
+// running clusters are left alone, and summarized in the upgrade revalidation metric.
+type Revalidator struct {
+	client.Client
+	Recorder record.EventRecorder
+
+	// Version is the running operator version; bridges already revalidated against it are skipped
+	Version string
+
+	// Preflights are the checks to run; see DefaultPreflights
+	Preflights []Preflight
+
+	// ShardSelector, if set, restricts revalidation to the bridges of this operator instance
+	ShardSelector labels.Selector
+}
+
+// NewRevalidator creates a new Revalidator
+func NewRevalidator(c client.Client, recorder record.EventRecorder, version string, preflights []Preflight) *Revalidator {
+	return &Revalidator{
+		Client:     c,
+		Recorder:   recorder,
+		Version:    version,
+		Preflights: preflights,
+	}
+}
+
+// Start implements manager.Runnable. It revalidates all bridges once and returns.
+// Being a leader election runnable, it runs on the elected instance only.
+func (r *Revalidator) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithValues("feature", "upgrade-revalidation", "operatorVersion", r.Version)
+	ctx = logf.IntoContext(ctx, log)
+
+	counts, err := r.RevalidateAll(ctx)
+	if err != nil {
+		// Not fatal for the manager: bridges without a recorded version are retried on next start
+		log.Error(err, "Upgrade revalidation failed")
+		return nil
+	}
+
+	log.Info("Upgrade revalidation completed",
+		"valid", counts[ResultValid],
+		"invalid", counts[ResultInvalid],
+		"errors", counts[ResultError])
+	return nil
+}
+
+// RevalidateAll revalidates every bridge not yet validated against the running operator version
+// and returns the number of bridges per result
+func (r *Revalidator) RevalidateAll(ctx context.Context) (map[string]int, error) {
+	log := logf.FromContext(ctx)
+
+	opts := []client.ListOption{}
+	if r.ShardSelector != nil {
+		opts = append(opts, client.MatchingLabelsSelector{Selector: r.ShardSelector})
+	}
+	var bridges provisioningv1alpha1.DPFHCPBridgeList
+	if err := r.List(ctx, &bridges, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list DPFHCPBridges: %w", err)
+	}
+
+	counts := map[string]int{ResultValid: 0, ResultInvalid: 0, ResultError: 0}
+	for i := range bridges.Items {
+		bridge := &bridges.Items[i]
+		if !bridge.DeletionTimestamp.IsZero() || bridge.Status.ValidatedOperatorVersion == r.Version {
+			continue
+		}
+
+		result, err := r.Revalidate(ctx, bridge)
+		if err != nil {
+			log.Error(err, "Failed to revalidate DPFHCPBridge", "namespace", bridge.Namespace, "name", bridge.Name)
+			result = ResultError
+		}
+		counts[result]++
+	}
+
+	for result, count := range counts {
+		metrics.UpgradeRevalidationBridges.WithLabelValues(result).Set(float64(count))
+	}
+	return counts, nil
+}
+
+// Revalidate runs the preflight checks against a copy of the bridge and records the outcome on it.
+// Returns ResultValid or ResultInvalid.
+func (r *Revalidator) Revalidate(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (string, error) {
+	log := logf.FromContext(ctx).WithValues("namespace", cr.Namespace, "name", cr.Name)
+
+	// Start from a clean slate so that only the outcome of this run is evaluated
+	check := cr.DeepCopy()
+	for _, condType := range preflightConditions {
+		meta.RemoveStatusCondition(&check.Status.Conditions, condType)
+	}
+	for _, preflight := range r.Preflights {
+		if _, err := preflight(ctx, check); err != nil {
+			return "", err
+		}
+	}
+
+	var failures []string
+	for _, condType := range preflightConditions {
+		cond := meta.FindStatusCondition(check.Status.Conditions, condType)
+		if cond != nil && conditions.IsFailing(*cond) {
+			failures = append(failures, fmt.Sprintf("%s (%s): %s", cond.Type, cond.Reason, cond.Message))
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:    provisioningv1alpha1.UpgradeRevalidated,
+		Status:  metav1.ConditionTrue,
+		Reason:  provisioningv1alpha1.ReasonPreflightsPassed,
+		Message: fmt.Sprintf("Spec passes all preflight checks of operator version %s", r.Version),
+	}
+	result := ResultValid
+	if len(failures) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = provisioningv1alpha1.ReasonPreflightsFailed
+		condition.Message = fmt.Sprintf("Spec fails preflight checks of operator version %s: %s", r.Version, strings.Join(failures, "; "))
+		result = ResultInvalid
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+			return err
+		}
+		condition.ObservedGeneration = cr.Generation
+		meta.SetStatusCondition(&cr.Status.Conditions, condition)
+		cr.Status.ValidatedOperatorVersion = r.Version
+		return r.Status().Update(ctx, cr)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to record revalidation result: %w", err)
+	}
+
+	if result == ResultInvalid {
+		log.Info("DPFHCPBridge spec is invalid under the running operator version", "failures", failures)
+		r.Recorder.Event(cr, corev1.EventTypeWarning, provisioningv1alpha1.ReasonPreflightsFailed, condition.Message)
+	}
+	return result, nil
+}
+
+// ClearStaleCondition removes the UpgradeRevalidated condition once the spec has been edited after
+// the revalidation; the regular preflight checks cover the new spec. Returns true if it was removed.
+func ClearStaleCondition(cr *provisioningv1alpha1.DPFHCPBridge) bool {
+	cond := meta.FindStatusCondition(cr.Status.Conditions, provisioningv1alpha1.UpgradeRevalidated)
+	if cond == nil || cond.ObservedGeneration == cr.Generation {
+		return false
+	}
+	return meta.RemoveStatusCondition(&cr.Status.Conditions, provisioningv1alpha1.UpgradeRevalidated)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revalidation
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/metrics"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/secrets"
+)
+
+var _ = Describe("Upgrade Revalidation", func() {
+	const version = "v0.2.0"
+
+	var (
+		ctx         context.Context
+		c           client.Client
+		recorder    *record.FakeRecorder
+		revalidator *Revalidator
+	)
+
+	newBridge := func(name, dpuCluster string) *provisioningv1alpha1.DPFHCPBridge {
+		return &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Generation: 1, Labels: map[string]string{"shard": "a"}},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef:   provisioningv1alpha1.DPUClusterReference{Name: dpuCluster, Namespace: "dpu-system"},
+				SSHKeySecretRef: corev1.LocalObjectReference{Name: "ssh-key"},
+				PullSecretRef:   corev1.LocalObjectReference{Name: "pull-secret"},
+			},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				Phase: provisioningv1alpha1.PhaseReady,
+				Conditions: []metav1.Condition{
+					{Type: provisioningv1alpha1.Ready, Status: metav1.ConditionTrue, Reason: provisioningv1alpha1.ReasonAllComponentsOperational},
+				},
+			},
+		}
+	}
+
+	getBridge := func(name string) *provisioningv1alpha1.DPFHCPBridge {
+		bridge := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, bridge)).To(Succeed())
+		return bridge
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(dpuprovisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		alreadyValidated := newBridge("already-validated", "gone")
+		alreadyValidated.Status.ValidatedOperatorVersion = version
+		otherShard := newBridge("other-shard", "gone")
+		otherShard.Labels["shard"] = "b"
+
+		c = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				&dpuprovisioningv1alpha1.DPUCluster{ObjectMeta: metav1.ObjectMeta{Name: "dpu-a", Namespace: "dpu-system"}},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "ssh-key", Namespace: "default"},
+					Data:       map[string][]byte{secrets.SSHPublicKeySecretKey: []byte("ssh-rsa AAAA")},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: "default"},
+					Data:       map[string][]byte{secrets.PullSecretKey: []byte("{}")},
+				},
+				newBridge("valid", "dpu-a"),
+				newBridge("invalid", "gone"),
+				alreadyValidated,
+				otherShard,
+			).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+
+		recorder = record.NewFakeRecorder(10)
+		revalidator = NewRevalidator(c, recorder, version, DefaultPreflights(c, false, nil, nil, nil))
+		revalidator.ShardSelector = labels.SelectorFromSet(labels.Set{"shard": "a"})
+	})
+
+	It("should report bridges whose spec fails the preflight checks", func() {
+		counts, err := revalidator.RevalidateAll(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(counts).To(Equal(map[string]int{ResultValid: 1, ResultInvalid: 1, ResultError: 0}))
+
+		valid := getBridge("valid")
+		Expect(valid.Status.ValidatedOperatorVersion).To(Equal(version))
+		cond := meta.FindStatusCondition(valid.Status.Conditions, provisioningv1alpha1.UpgradeRevalidated)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonPreflightsPassed))
+
+		invalid := getBridge("invalid")
+		Expect(invalid.Status.ValidatedOperatorVersion).To(Equal(version))
+		cond = meta.FindStatusCondition(invalid.Status.Conditions, provisioningv1alpha1.UpgradeRevalidated)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(provisioningv1alpha1.ReasonPreflightsFailed))
+		Expect(cond.Message).To(ContainSubstring(provisioningv1alpha1.DPUClusterMissing))
+		Expect(cond.ObservedGeneration).To(Equal(int64(1)))
+		Eventually(recorder.Events).Should(Receive(ContainSubstring(provisioningv1alpha1.ReasonPreflightsFailed)))
+
+		Expect(testutil.ToFloat64(metrics.UpgradeRevalidationBridges.WithLabelValues(ResultValid))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(metrics.UpgradeRevalidationBridges.WithLabelValues(ResultInvalid))).To(Equal(1.0))
+	})
+
+	It("should leave the phase and the regular conditions untouched", func() {
+		_, err := revalidator.RevalidateAll(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		invalid := getBridge("invalid")
+		Expect(invalid.Status.Phase).To(Equal(provisioningv1alpha1.PhaseReady))
+		Expect(meta.FindStatusCondition(invalid.Status.Conditions, provisioningv1alpha1.DPUClusterMissing)).To(BeNil())
+		Expect(meta.IsStatusConditionTrue(invalid.Status.Conditions, provisioningv1alpha1.Ready)).To(BeTrue())
+	})
+
+	It("should skip bridges already revalidated against the running version or owned by another shard", func() {
+		_, err := revalidator.RevalidateAll(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(meta.FindStatusCondition(getBridge("already-validated").Status.Conditions, provisioningv1alpha1.UpgradeRevalidated)).To(BeNil())
+		Expect(getBridge("other-shard").Status.ValidatedOperatorVersion).To(BeEmpty())
+	})
+
+	It("should revalidate only once per operator version", func() {
+		_, err := revalidator.RevalidateAll(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		counts, err := revalidator.RevalidateAll(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(counts).To(Equal(map[string]int{ResultValid: 0, ResultInvalid: 0, ResultError: 0}))
+	})
+
+	Describe("ClearStaleCondition", func() {
+		It("should drop the condition once the spec has been edited", func() {
+			bridge := newBridge("edited", "dpu-a")
+			meta.SetStatusCondition(&bridge.Status.Conditions, metav1.Condition{
+				Type:               provisioningv1alpha1.UpgradeRevalidated,
+				Status:             metav1.ConditionFalse,
+				Reason:             provisioningv1alpha1.ReasonPreflightsFailed,
+				ObservedGeneration: 1,
+			})
+
+			Expect(ClearStaleCondition(bridge)).To(BeFalse())
+
+			bridge.Generation = 2
+			Expect(ClearStaleCondition(bridge)).To(BeTrue())
+			Expect(meta.FindStatusCondition(bridge.Status.Conditions, provisioningv1alpha1.UpgradeRevalidated)).To(BeNil())
+		})
+	})
+})
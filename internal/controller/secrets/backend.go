@@ -0,0 +1,183 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+const (
+	// BackendCluster reads the secrets referenced by a DPFHCPBridge from Secrets in its namespace
+	BackendCluster = "cluster"
+
+	// BackendFile reads the secrets referenced by a DPFHCPBridge from files mounted into the operator
+	BackendFile = "file"
+
+	// DefaultFileRecheckInterval is how often missing or invalid secrets of the file backend, whose
+	// changes are not watched, are checked again
+	DefaultFileRecheckInterval = time.Minute
+)
+
+// SourceSecret is the content of a secret referenced by a DPFHCPBridge, as read from a Backend
+type SourceSecret struct {
+	// Name is the name the secret is referenced by
+	Name string
+
+	// Data holds the secret keys and their values
+	Data map[string][]byte
+
+	// Version identifies the revision of the secret in the backend, e.g. the Secret resourceVersion.
+	// Empty if the backend does not version secrets.
+	Version string
+}
+
+// Backend sources the pull secret and SSH key referenced by a DPFHCPBridge.
+//
+// Fetch reports a missing secret with an error for which apierrors.IsNotFound is true, and a secret
+// the operator may not read with one for which apierrors.IsForbidden is true; any other error is
+// treated as transient.
+type Backend interface {
+	Fetch(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, name string) (*SourceSecret, error)
+}
+
+// NewBackend returns the backend of the given kind; dir is the root directory of the file backend
+func NewBackend(kind string, c client.Client, dir string) (Backend, error) {
+	switch kind {
+	case BackendCluster:
+		return NewClusterBackend(c), nil
+	case BackendFile:
+		if dir == "" {
+			return nil, fmt.Errorf("the %s secret backend requires a directory", BackendFile)
+		}
+		return NewFileBackend(dir), nil
+	default:
+		return nil, fmt.Errorf("unknown secret backend %q, expected %q or %q", kind, BackendCluster, BackendFile)
+	}
+}
+
+// ClusterBackend reads Secrets from the DPFHCPBridge namespace. Secrets synced into the cluster by
+// other tools, such as the External Secrets Operator, are read through this backend too.
+type ClusterBackend struct {
+	client client.Client
+}
+
+// NewClusterBackend creates a backend reading Secrets with the given client
+func NewClusterBackend(c client.Client) *ClusterBackend {
+	return &ClusterBackend{client: c}
+}
+
+// Fetch returns the Secret with the given name in the DPFHCPBridge namespace
+func (b *ClusterBackend) Fetch(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge, name string) (*SourceSecret, error) {
+	secret := &corev1.Secret{}
+	if err := b.client.Get(ctx, types.NamespacedName{Name: name, Namespace: cr.Namespace}, secret); err != nil {
+		return nil, err
+	}
+	return &SourceSecret{Name: name, Data: secret.Data, Version: secret.ResourceVersion}, nil
+}
+
+// FileBackend reads secrets from a directory tree laid out like mounted Secret volumes:
+// <dir>/<bridge namespace>/<secret name>/<key>, one file per key. The ..data link and the
+// ..<timestamp> directories kubelet creates in Secret volumes are skipped.
+type FileBackend struct {
+	dir string
+}
+
+// NewFileBackend creates a backend reading secrets below dir
+func NewFileBackend(dir string) *FileBackend {
+	return &FileBackend{dir: dir}
+}
+
+// Fetch returns the secret with the given name from the directory of the DPFHCPBridge namespace.
+// File secrets are not versioned. Names that are not valid Secret names are rejected as forbidden,
+// so that a bridge cannot read files outside the directory of its namespace.
+func (b *FileBackend) Fetch(_ context.Context, cr *provisioningv1alpha1.DPFHCPBridge, name string) (*SourceSecret, error) {
+	secretDir, err := b.secretDir(cr.Namespace, name)
+	if err != nil {
+		return nil, apierrors.NewForbidden(corev1.Resource("secrets"), name, err)
+	}
+	entries, err := os.ReadDir(secretDir)
+	if err != nil {
+		return nil, fileError(name, err)
+	}
+
+	data := map[string][]byte{}
+	for _, entry := range entries {
+		// Keys may start with a dot (.dockerconfigjson), but never with two
+		if strings.HasPrefix(entry.Name(), "..") {
+			continue
+		}
+		// Keys of mounted Secret volumes are symlinks into the current ..data directory
+		info, err := os.Stat(filepath.Join(secretDir, entry.Name()))
+		if err != nil {
+			return nil, fileError(name, err)
+		}
+		if info.IsDir() {
+			continue
+		}
+		value, err := os.ReadFile(filepath.Join(secretDir, entry.Name()))
+		if err != nil {
+			return nil, fileError(name, err)
+		}
+		data[entry.Name()] = value
+	}
+
+	return &SourceSecret{Name: name, Data: data}, nil
+}
+
+// secretDir returns the directory of the named secret, checking that it is below the directory of the namespace
+func (b *FileBackend) secretDir(namespace, name string) (string, error) {
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return "", fmt.Errorf("invalid secret name: %s", strings.Join(errs, ", "))
+	}
+	if strings.ContainsAny(name, `/\`) {
+		return "", fmt.Errorf("invalid secret name: must not contain a path separator")
+	}
+
+	namespaceDir := filepath.Join(b.dir, namespace)
+	secretDir := filepath.Join(namespaceDir, name)
+	if rel, err := filepath.Rel(namespaceDir, secretDir); err != nil || rel != name {
+		return "", fmt.Errorf("secret directory %s is outside of %s", secretDir, namespaceDir)
+	}
+	return secretDir, nil
+}
+
+// fileError maps file system errors to the API errors Backend callers expect
+func fileError(name string, err error) error {
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return apierrors.NewNotFound(corev1.Resource("secrets"), name)
+	case errors.Is(err, fs.ErrPermission):
+		return apierrors.NewForbidden(corev1.Resource("secrets"), name, err)
+	default:
+		return fmt.Errorf("failed to read secret %s: %w", name, err)
+	}
+}
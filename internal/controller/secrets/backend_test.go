@@ -0,0 +1,157 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Secret Backends", func() {
+	var (
+		ctx context.Context
+		cr  *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cr = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "clusters"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				SSHKeySecretRef: corev1.LocalObjectReference{Name: "ssh"},
+				PullSecretRef:   corev1.LocalObjectReference{Name: "pull"},
+			},
+		}
+	})
+
+	Describe("NewBackend", func() {
+		It("should reject unknown backends", func() {
+			_, err := NewBackend("vault", nil, "")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should require a directory for the file backend", func() {
+			_, err := NewBackend(BackendFile, nil, "")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ClusterBackend", func() {
+		It("should read Secrets from the bridge namespace", func() {
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "pull", Namespace: "clusters"},
+				Data:       map[string][]byte{PullSecretKey: []byte("{}")},
+			}).Build()
+
+			secret, err := NewClusterBackend(c).Fetch(ctx, cr, "pull")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Data).To(HaveKeyWithValue(PullSecretKey, []byte("{}")))
+			Expect(secret.Version).NotTo(BeEmpty())
+
+			_, err = NewClusterBackend(c).Fetch(ctx, cr, "ssh")
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("FileBackend", func() {
+		var dir string
+
+		writeKey := func(name, key, value string) {
+			secretDir := filepath.Join(dir, "clusters", name)
+			Expect(os.MkdirAll(secretDir, 0o700)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(secretDir, key), []byte(value), 0o600)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+		})
+
+		It("should read one file per key and skip the entries kubelet adds", func() {
+			writeKey("pull", PullSecretKey, "{}")
+			Expect(os.Mkdir(filepath.Join(dir, "clusters", "pull", "..data"), 0o700)).To(Succeed())
+
+			secret, err := NewFileBackend(dir).Fetch(ctx, cr, "pull")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Data).To(Equal(map[string][]byte{PullSecretKey: []byte("{}")}))
+			Expect(secret.Version).To(BeEmpty())
+		})
+
+		It("should follow the symlinks of mounted Secret volumes", func() {
+			writeKey("ssh", "..data", "")
+			Expect(os.Remove(filepath.Join(dir, "clusters", "ssh", "..data"))).To(Succeed())
+			writeKey(filepath.Join("ssh", "..data"), SSHPublicKeySecretKey, "ssh-ed25519 AAAA")
+			Expect(os.Symlink(filepath.Join("..data", SSHPublicKeySecretKey),
+				filepath.Join(dir, "clusters", "ssh", SSHPublicKeySecretKey))).To(Succeed())
+
+			secret, err := NewFileBackend(dir).Fetch(ctx, cr, "ssh")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Data).To(Equal(map[string][]byte{SSHPublicKeySecretKey: []byte("ssh-ed25519 AAAA")}))
+		})
+
+		It("should report missing secrets as not found", func() {
+			_, err := NewFileBackend(dir).Fetch(ctx, cr, "pull")
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should reject names that would read outside the namespace directory", func() {
+			Expect(os.MkdirAll(filepath.Join(dir, "other", "pull"), 0o700)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "other", "pull", PullSecretKey), []byte("{}"), 0o600)).To(Succeed())
+
+			for _, name := range []string{"../other/pull", "..", ".", "pull/..", "/etc", "Pull"} {
+				_, err := NewFileBackend(dir).Fetch(ctx, cr, name)
+				Expect(apierrors.IsForbidden(err)).To(BeTrue(), "name %q", name)
+			}
+		})
+
+		It("should back the validator and request rechecks while secrets are missing", func() {
+			writeKey("ssh", SSHPublicKeySecretKey, "ssh-ed25519 AAAA")
+			scheme := runtime.NewScheme()
+			Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cr).WithStatusSubresource(cr).Build()
+
+			validator := NewValidator(c, record.NewFakeRecorder(10))
+			validator.Backend = NewFileBackend(dir)
+			validator.RecheckInterval = DefaultFileRecheckInterval
+
+			_, err := validator.ValidateSecrets(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(meta.IsStatusConditionFalse(cr.Status.Conditions, provisioningv1alpha1.SecretsValid)).To(BeTrue())
+			Expect(validator.RecheckAfter(cr)).To(Equal(DefaultFileRecheckInterval))
+
+			writeKey("pull", PullSecretKey, "{}")
+			_, err = validator.ValidateSecrets(ctx, cr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(meta.IsStatusConditionTrue(cr.Status.Conditions, provisioningv1alpha1.SecretsValid)).To(BeTrue())
+			Expect(validator.RecheckAfter(cr)).To(BeZero())
+		})
+	})
+})
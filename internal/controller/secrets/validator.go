@@ -19,12 +19,12 @@ package secrets
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -52,6 +52,13 @@ const (
 type Validator struct {
 	client   client.Client
 	recorder record.EventRecorder
+
+	// Backend sources the referenced secrets; defaults to Secrets in the bridge namespace
+	Backend Backend
+
+	// RecheckInterval, if set, is how often missing or invalid secrets are checked again. Secrets in the
+	// cluster are watched, so it is only needed for backends whose changes do not trigger reconciles.
+	RecheckInterval time.Duration
 }
 
 // NewValidator creates a new secrets validator
@@ -59,6 +66,7 @@ func NewValidator(client client.Client, recorder record.EventRecorder) *Validato
 	return &Validator{
 		client:   client,
 		recorder: recorder,
+		Backend:  NewClusterBackend(client),
 	}
 }
 
@@ -72,11 +80,8 @@ func (v *Validator) ValidateSecrets(ctx context.Context, cr *provisioningv1alpha
 		"namespace", cr.Namespace)
 
 	// Validate SSH key secret
-	sshSecret := &corev1.Secret{}
-	if err := v.client.Get(ctx, types.NamespacedName{
-		Name:      cr.Spec.SSHKeySecretRef.Name,
-		Namespace: cr.Namespace,
-	}, sshSecret); err != nil {
+	sshSecret, err := v.Backend.Fetch(ctx, cr, cr.Spec.SSHKeySecretRef.Name)
+	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return v.handleSSHKeySecretMissing(ctx, cr)
 		}
@@ -95,11 +100,8 @@ func (v *Validator) ValidateSecrets(ctx context.Context, cr *provisioningv1alpha
 	}
 
 	// Validate pull secret
-	pullSecret := &corev1.Secret{}
-	if err := v.client.Get(ctx, types.NamespacedName{
-		Name:      cr.Spec.PullSecretRef.Name,
-		Namespace: cr.Namespace,
-	}, pullSecret); err != nil {
+	pullSecret, err := v.Backend.Fetch(ctx, cr, cr.Spec.PullSecretRef.Name)
+	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return v.handlePullSecretMissing(ctx, cr)
 		}
@@ -121,6 +123,15 @@ func (v *Validator) ValidateSecrets(ctx context.Context, cr *provisioningv1alpha
 	return v.handleSecretsValid(ctx, cr)
 }
 
+// RecheckAfter returns when the secrets of a bridge whose secrets are missing or invalid must be
+// validated again, or zero if they are valid or their changes are watched
+func (v *Validator) RecheckAfter(cr *provisioningv1alpha1.DPFHCPBridge) time.Duration {
+	if v.RecheckInterval <= 0 || !meta.IsStatusConditionFalse(cr.Status.Conditions, provisioningv1alpha1.SecretsValid) {
+		return 0
+	}
+	return v.RecheckInterval
+}
+
 // handleSSHKeySecretMissing handles the case when SSH key secret is not found
 func (v *Validator) handleSSHKeySecretMissing(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (ctrl.Result, error) {
 	log := logf.FromContext(ctx).WithValues("feature", "secrets-validation")
@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package specchecksum computes a deterministic checksum of the effective DPFHCPBridge spec,
+// so that audit tooling can verify that the bridges of a fleet run the same configuration.
+// Fields identifying a single bridge, such as its DPUClusters and addresses, are left out.
+package specchecksum
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/hostedcluster"
+)
+
+// Prefix names the hash algorithm of the checksums
+const Prefix = "sha256:"
+
+// Compute returns the checksum of the normalized spec, e.g. sha256:9f86d0...
+func Compute(spec *provisioningv1alpha1.DPFHCPBridgeSpec) (string, error) {
+	data, err := json.Marshal(Normalize(spec))
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize normalized spec: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return Prefix + hex.EncodeToString(sum[:]), nil
+}
+
+// Normalize returns a copy of the spec with the fields identifying the bridge cleared and the
+// defaults the operator applies to unset fields filled in, so that bridges configured the same
+// way get the same checksum whether they spell out the defaults or not.
+//
+// Lists keep their order, since it is meaningful for hooks and mirrors, except for the NodePools
+// which are sorted by name.
+func Normalize(spec *provisioningv1alpha1.DPFHCPBridgeSpec) *provisioningv1alpha1.DPFHCPBridgeSpec {
+	n := spec.DeepCopy()
+
+	// Per-bridge identity and addressing
+	n.DPUClusterRef = provisioningv1alpha1.DPUClusterReference{}
+	n.DPUClusterSelector = nil
+	n.DPUClusterRefs = nil
+	n.BaseDomain = ""
+	n.VirtualIP = ""
+	n.IngressVIP = ""
+	n.NodePortAddresses = nil
+	n.KubeconfigExport = nil
+	n.BridgePoolRef = nil
+
+	if n.DPUClusterReadinessPolicy == "" {
+		n.DPUClusterReadinessPolicy = provisioningv1alpha1.DPUClusterReadinessIgnore
+	}
+	if n.ControlPlaneAvailabilityPolicy == "" {
+		n.ControlPlaneAvailabilityPolicy = hyperv1.HighlyAvailable
+	}
+	if n.Platform == "" {
+		n.Platform = provisioningv1alpha1.PlatformNone
+	}
+	if len(n.NodeSelector) == 0 {
+		n.NodeSelector = hostedcluster.DefaultNodeSelector()
+	}
+
+	if n.Networking == nil {
+		n.Networking = &provisioningv1alpha1.ClusterNetworkingSpec{}
+	}
+	if len(n.Networking.ClusterNetwork) == 0 {
+		n.Networking.ClusterNetwork = []provisioningv1alpha1.CIDR{hostedcluster.DefaultClusterNetwork}
+	}
+	if len(n.Networking.ServiceNetwork) == 0 {
+		n.Networking.ServiceNetwork = []provisioningv1alpha1.CIDR{hostedcluster.DefaultServiceNetwork}
+	}
+
+	if n.ProvisioningTimeouts == nil {
+		n.ProvisioningTimeouts = &provisioningv1alpha1.ProvisioningTimeouts{}
+	}
+	n.ProvisioningTimeouts.HostedClusterAvailable = durationOrDefault(n.ProvisioningTimeouts.HostedClusterAvailable,
+		hostedcluster.DefaultHostedClusterAvailableTimeout)
+	n.ProvisioningTimeouts.FirstNodeJoined = durationOrDefault(n.ProvisioningTimeouts.FirstNodeJoined,
+		hostedcluster.DefaultFirstNodeJoinedTimeout)
+	n.ProvisioningTimeouts.NodePoolReady = durationOrDefault(n.ProvisioningTimeouts.NodePoolReady,
+		hostedcluster.DefaultNodePoolReadyTimeout)
+
+	for i := range n.NodePools {
+		if n.NodePools[i].Replicas == nil {
+			n.NodePools[i].Replicas = ptr.To[int32](0)
+		}
+	}
+	slices.SortFunc(n.NodePools, func(a, b provisioningv1alpha1.NodePoolSpec) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return n
+}
+
+// durationOrDefault returns d, or def if d is unset
+func durationOrDefault(d *metav1.Duration, def time.Duration) *metav1.Duration {
+	if d != nil {
+		return d
+	}
+	return &metav1.Duration{Duration: def}
+}
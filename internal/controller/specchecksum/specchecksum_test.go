@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package specchecksum
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Spec checksum", func() {
+	var spec *provisioningv1alpha1.DPFHCPBridgeSpec
+
+	BeforeEach(func() {
+		spec = &provisioningv1alpha1.DPFHCPBridgeSpec{
+			DPUClusterRef:   provisioningv1alpha1.DPUClusterReference{Name: "dpu-a", Namespace: "dpu-ns"},
+			BaseDomain:      "site-a.example.com",
+			OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.1-multi",
+			SSHKeySecretRef: corev1.LocalObjectReference{Name: "ssh"},
+			PullSecretRef:   corev1.LocalObjectReference{Name: "pull"},
+			VirtualIP:       "192.168.1.100",
+			NodePools: []provisioningv1alpha1.NodePoolSpec{
+				{Name: "bf3", Replicas: ptr.To[int32](4)},
+				{Name: "bf2"},
+			},
+		}
+	})
+
+	It("should be stable and prefixed with the algorithm", func() {
+		first, err := Compute(spec)
+		Expect(err).NotTo(HaveOccurred())
+		second, err := Compute(spec.DeepCopy())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(first).To(HavePrefix(Prefix))
+		Expect(first).To(HaveLen(len(Prefix) + 64))
+		Expect(second).To(Equal(first))
+	})
+
+	It("should ignore the fields identifying the bridge", func() {
+		expected, err := Compute(spec)
+		Expect(err).NotTo(HaveOccurred())
+
+		other := spec.DeepCopy()
+		other.DPUClusterRef = provisioningv1alpha1.DPUClusterReference{Name: "dpu-b", Namespace: "dpu-ns"}
+		other.BaseDomain = "site-b.example.com"
+		other.VirtualIP = "192.168.2.100"
+		other.KubeconfigExport = &provisioningv1alpha1.KubeconfigExportSpec{Name: "tenant-b"}
+		Expect(Compute(other)).To(Equal(expected))
+	})
+
+	It("should treat spelled out defaults like unset fields", func() {
+		expected, err := Compute(spec)
+		Expect(err).NotTo(HaveOccurred())
+
+		explicit := spec.DeepCopy()
+		explicit.Platform = provisioningv1alpha1.PlatformNone
+		explicit.ControlPlaneAvailabilityPolicy = hyperv1.HighlyAvailable
+		explicit.NodeSelector = map[string]string{"node-role.kubernetes.io/control-plane": ""}
+		explicit.Networking = &provisioningv1alpha1.ClusterNetworkingSpec{
+			ClusterNetwork: []provisioningv1alpha1.CIDR{"10.132.0.0/14"},
+			ServiceNetwork: []provisioningv1alpha1.CIDR{"172.31.0.0/16"},
+		}
+		explicit.ProvisioningTimeouts = &provisioningv1alpha1.ProvisioningTimeouts{
+			NodePoolReady: &metav1.Duration{Duration: 2 * time.Hour},
+		}
+		explicit.NodePools = []provisioningv1alpha1.NodePoolSpec{
+			{Name: "bf2", Replicas: ptr.To[int32](0)},
+			{Name: "bf3", Replicas: ptr.To[int32](4)},
+		}
+		Expect(Compute(explicit)).To(Equal(expected))
+	})
+
+	It("should change with the fleet configuration", func() {
+		before, err := Compute(spec)
+		Expect(err).NotTo(HaveOccurred())
+
+		spec.OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.19.2-multi"
+		Expect(Compute(spec)).NotTo(Equal(before))
+	})
+
+	It("should tell an unset nodePoolReplicas from 0", func() {
+		// Unset leaves the NodePool replicas alone, while 0 scales the NodePool down
+		before, err := Compute(spec)
+		Expect(err).NotTo(HaveOccurred())
+
+		spec.NodePoolReplicas = ptr.To[int32](0)
+		Expect(Compute(spec)).NotTo(Equal(before))
+	})
+
+	It("should not modify the spec", func() {
+		original := spec.DeepCopy()
+		_, err := Compute(spec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec).To(Equal(original))
+	})
+})
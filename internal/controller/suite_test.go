@@ -41,9 +41,13 @@ import (
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/bluefield"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/dpucluster"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/finalizer"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/hooks"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/hostedcluster"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/kubeconfiginjection"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/manifests"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/releasecatalog"
 	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/secrets"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/faultinject"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -106,9 +110,17 @@ var _ = BeforeSuite(func() {
 	Expect(k8sClient).NotTo(BeNil())
 
 	By("creating controller manager")
-	k8sManager, err = ctrl.NewManager(cfg, ctrl.Options{
+	managerOptions := ctrl.Options{
 		Scheme: scheme.Scheme,
-	})
+	}
+	// Faults set in DPF_HCP_BRIDGE_FAULTS, e.g. "create:Secret#2", are injected into the controller's client
+	// to exercise its retry and timeout paths; the test client k8sClient is left alone
+	faults, err := faultinject.FromEnvironment()
+	Expect(err).NotTo(HaveOccurred())
+	if faults != nil {
+		managerOptions.NewClient = faults.NewClient
+	}
+	k8sManager, err = ctrl.NewManager(cfg, managerOptions)
 	Expect(err).NotTo(HaveOccurred())
 
 	By("creating clusters namespace")
@@ -126,6 +138,8 @@ var _ = BeforeSuite(func() {
 	// Initialize Finalizer Manager with pluggable cleanup handlers
 	finalizerManager := finalizer.NewManager(k8sManager.GetClient(), k8sManager.GetEventRecorderFor("dpfhcpbridge-controller"))
 	// Register cleanup handlers in order (dependent resources first)
+	hookRunner := hooks.NewRunner(k8sManager.GetClient(), k8sManager.GetScheme(), k8sManager.GetEventRecorderFor("dpfhcpbridge-controller"))
+	finalizerManager.RegisterHandler(hooks.NewPreDeleteCleanupHandler(k8sManager.GetClient(), hookRunner))
 	finalizerManager.RegisterHandler(kubeconfiginjection.NewCleanupHandler(k8sManager.GetClient(), k8sManager.GetEventRecorderFor("dpfhcpbridge-controller")))
 	finalizerManager.RegisterHandler(hostedcluster.NewCleanupHandler(k8sManager.GetClient(), k8sManager.GetEventRecorderFor("dpfhcpbridge-controller")))
 
@@ -136,12 +150,16 @@ var _ = BeforeSuite(func() {
 		ImageResolver:        bluefield.NewImageResolver(k8sManager.GetClient(), k8sManager.GetEventRecorderFor("bluefield-image-resolver")),
 		DPUClusterValidator:  dpucluster.NewValidator(k8sManager.GetClient(), k8sManager.GetEventRecorderFor("dpucluster-validator")),
 		SecretsValidator:     secrets.NewValidator(k8sManager.GetClient(), k8sManager.GetEventRecorderFor("secrets-validator")),
+		ConflictDetector:     hostedcluster.NewConflictDetector(k8sManager.GetClient(), k8sManager.GetEventRecorderFor("conflict-detector")),
+		ReleaseResolver:      releasecatalog.NewResolver(k8sManager.GetClient(), k8sManager.GetEventRecorderFor("release-resolver")),
 		SecretManager:        hostedcluster.NewSecretManager(k8sManager.GetClient(), k8sManager.GetScheme()),
 		NodePoolManager:      hostedcluster.NewNodePoolManager(k8sManager.GetClient(), k8sManager.GetScheme()),
 		HostedClusterManager: hostedcluster.NewHostedClusterManager(k8sManager.GetClient(), k8sManager.GetScheme()),
 		FinalizerManager:     finalizerManager,
 		StatusSyncer:         hostedcluster.NewStatusSyncer(k8sManager.GetClient()),
 		KubeconfigInjector:   kubeconfigInjector,
+		ManifestApplier:      manifests.NewApplier(k8sManager.GetClient(), k8sManager.GetEventRecorderFor("dpfhcpbridge-controller")),
+		PostProvisionManager: hooks.NewPostProvisionManager(k8sManager.GetClient(), hookRunner, k8sManager.GetEventRecorderFor("dpfhcpbridge-controller")),
 	}
 	err = reconciler.SetupWithManager(k8sManager)
 	Expect(err).NotTo(HaveOccurred())
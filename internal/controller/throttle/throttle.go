@@ -0,0 +1,152 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package throttle gives every DPFHCPBridge its own budget of management cluster API requests, so that a
+// misbehaving bridge, e.g. one whose external dependency flaps, cannot use up the request share of the
+// operator and starve the reconciles of the rest of the fleet.
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/metrics"
+)
+
+const (
+	// DefaultQPS is the sustained rate of API requests each bridge may send
+	DefaultQPS = 10
+
+	// DefaultBurst is the number of API requests a bridge may send at once before DefaultQPS applies
+	DefaultBurst = 50
+)
+
+// bridgeKey is the context key of the bridge the API requests of a reconcile are sent for
+type bridgeKey struct{}
+
+// WithBridge returns a copy of ctx whose API requests are charged to the budget of the bridge
+func WithBridge(ctx context.Context, bridge types.NamespacedName) context.Context {
+	return context.WithValue(ctx, bridgeKey{}, bridge)
+}
+
+// BridgeFrom returns the bridge the API requests of ctx are charged to, if any
+func BridgeFrom(ctx context.Context) (types.NamespacedName, bool) {
+	bridge, ok := ctx.Value(bridgeKey{}).(types.NamespacedName)
+	return bridge, ok
+}
+
+// Budget rate limits the management cluster API requests of each bridge on its own token bucket.
+// Requests not sent for a bridge, such as the watches of the informers, are not limited, and neither are
+// reads served from the cache, since they do not reach the apiserver.
+type Budget struct {
+	// QPS is the sustained rate of API requests per bridge
+	QPS float32
+
+	// Burst is the number of API requests a bridge may send at once
+	Burst int
+
+	mu       sync.Mutex
+	limiters map[types.NamespacedName]flowcontrol.RateLimiter
+}
+
+// NewBudget creates a new Budget allowing each bridge qps API requests per second, with bursts of burst requests
+func NewBudget(qps float32, burst int) *Budget {
+	return &Budget{
+		QPS:      qps,
+		Burst:    burst,
+		limiters: map[types.NamespacedName]flowcontrol.RateLimiter{},
+	}
+}
+
+// Wait blocks until the bridge of ctx may send another API request. It returns an error if ctx is done, or
+// would be before then. It returns nil right away if b is nil or ctx is not charged to a bridge.
+func (b *Budget) Wait(ctx context.Context) error {
+	bridge, ok := BridgeFrom(ctx)
+	if b == nil || !ok {
+		return nil
+	}
+
+	start := time.Now()
+	if err := b.limiter(bridge).Wait(ctx); err != nil {
+		return fmt.Errorf("API request budget of DPFHCPBridge %s exhausted: %w", bridge, err)
+	}
+	metrics.APIRequestThrottleSeconds.With(prometheus.Labels{
+		"namespace": bridge.Namespace,
+		"name":      bridge.Name,
+	}).Add(time.Since(start).Seconds())
+	return nil
+}
+
+// limiter returns the token bucket of the bridge, creating a full one on its first request
+func (b *Budget) limiter(bridge types.NamespacedName) flowcontrol.RateLimiter {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	limiter, ok := b.limiters[bridge]
+	if !ok {
+		limiter = flowcontrol.NewTokenBucketRateLimiter(b.QPS, b.Burst)
+		b.limiters[bridge] = limiter
+	}
+	return limiter
+}
+
+// Forget drops the budget and the throttle metric of a deleted bridge. It is a no-op if b is nil.
+func (b *Budget) Forget(bridge types.NamespacedName) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if limiter, ok := b.limiters[bridge]; ok {
+		limiter.Stop()
+		delete(b.limiters, bridge)
+	}
+	metrics.APIRequestThrottleSeconds.Delete(prometheus.Labels{
+		"namespace": bridge.Namespace,
+		"name":      bridge.Name,
+	})
+}
+
+// Wrap wraps the transport of the given config to charge the requests sent with a context from WithBridge
+// to the budget of their bridge
+func (b *Budget) Wrap(cfg *rest.Config) {
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &throttledRoundTripper{budget: b, next: rt}
+	})
+}
+
+// throttledRoundTripper waits for the budget of the bridge of every request before forwarding it
+type throttledRoundTripper struct {
+	budget *Budget
+	next   http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *throttledRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := rt.budget.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return rt.next.RoundTrip(req)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+)
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+var _ = Describe("API request budget", func() {
+	var (
+		budget    *Budget
+		flapping  types.NamespacedName
+		healthy   types.NamespacedName
+		shortWait func(bridge *types.NamespacedName) context.Context
+	)
+
+	BeforeEach(func() {
+		// One request every 10 minutes after a burst of 2, so that exhausting the budget is deterministic
+		budget = NewBudget(1.0/600, 2)
+		flapping = types.NamespacedName{Name: "flapping", Namespace: "clusters"}
+		healthy = types.NamespacedName{Name: "healthy", Namespace: "clusters"}
+		shortWait = func(bridge *types.NamespacedName) context.Context {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			DeferCleanup(cancel)
+			if bridge != nil {
+				ctx = WithBridge(ctx, *bridge)
+			}
+			return ctx
+		}
+	})
+
+	It("should throttle a bridge that used up its burst without affecting the others", func() {
+		Expect(budget.Wait(shortWait(&flapping))).To(Succeed())
+		Expect(budget.Wait(shortWait(&flapping))).To(Succeed())
+		Expect(budget.Wait(shortWait(&flapping))).To(MatchError(ContainSubstring("API request budget of DPFHCPBridge clusters/flapping exhausted")))
+
+		Expect(budget.Wait(shortWait(&healthy))).To(Succeed())
+		Expect(budget.Wait(shortWait(nil))).To(Succeed())
+	})
+
+	It("should start a forgotten bridge with a full budget", func() {
+		Expect(budget.Wait(shortWait(&flapping))).To(Succeed())
+		Expect(budget.Wait(shortWait(&flapping))).To(Succeed())
+
+		budget.Forget(flapping)
+		Expect(budget.Wait(shortWait(&flapping))).To(Succeed())
+	})
+
+	It("should not limit requests when disabled", func() {
+		var disabled *Budget
+		for range 5 {
+			Expect(disabled.Wait(shortWait(&flapping))).To(Succeed())
+		}
+		disabled.Forget(flapping)
+	})
+
+	It("should charge the requests of the wrapped transport to the bridge of their context", func() {
+		cfg := &rest.Config{}
+		budget.Wrap(cfg)
+		sent := 0
+		rt := cfg.WrapTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			sent++
+			return httptest.NewRecorder().Result(), nil
+		}))
+
+		for range 3 {
+			req := httptest.NewRequest(http.MethodPatch, "https://apiserver/apis/hypershift.openshift.io/v1beta1/namespaces/clusters/hostedclusters/flapping", nil)
+			_, _ = rt.RoundTrip(req.WithContext(shortWait(&flapping)))
+		}
+		Expect(sent).To(Equal(2))
+	})
+})
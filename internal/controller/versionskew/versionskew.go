@@ -0,0 +1,109 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package versionskew checks the OCP version skew between the hosted control plane and its NodePools.
+// HyperShift supports NodePools on the release of the control plane or up to MaxMinorSkew minor
+// versions older, and never newer.
+package versionskew
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MaxMinorSkew is the number of minor versions a NodePool may be behind the control plane
+const MaxMinorSkew = 2
+
+// minorVersionPattern matches the major and minor numbers of an OCP version or release tag
+var minorVersionPattern = regexp.MustCompile(`^v?(\d+)\.(\d+)(?:[.-]|$)`)
+
+// archSuffixPattern matches the architecture suffix of a release tag
+var archSuffixPattern = regexp.MustCompile(`-(multi|amd64|arm64|ppc64le|s390x|x86_64)$`)
+
+// UnsupportedSkewError is returned for a NodePool version the control plane version does not support
+type UnsupportedSkewError struct {
+	ControlPlaneVersion string
+	NodePoolVersion     string
+
+	// Skew is the number of minor versions the NodePool is behind, negative if it is ahead.
+	// It is 0 for versions of different major versions.
+	Skew int
+}
+
+func (e *UnsupportedSkewError) Error() string {
+	if e.Skew == 0 {
+		return fmt.Sprintf("NodePool version %s and control plane version %s are of different major versions",
+			e.NodePoolVersion, e.ControlPlaneVersion)
+	}
+	if e.Skew < 0 {
+		return fmt.Sprintf("NodePool version %s is newer than the control plane version %s",
+			e.NodePoolVersion, e.ControlPlaneVersion)
+	}
+	return fmt.Sprintf("NodePool version %s is %d minor versions behind the control plane version %s, at most %d are supported",
+		e.NodePoolVersion, e.Skew, e.ControlPlaneVersion, MaxMinorSkew)
+}
+
+// ImageVersion returns the OCP version in the tag of a release image, e.g. 4.19.1 for
+// quay.io/openshift-release-dev/ocp-release:4.19.1-multi, or "" if the image is referenced by digest
+// or its tag is not a version
+func ImageVersion(image string) string {
+	if strings.Contains(image, "@") {
+		return ""
+	}
+	i := strings.LastIndex(image, ":")
+	if i <= strings.LastIndex(image, "/") {
+		return ""
+	}
+	tag := image[i+1:]
+	if !minorVersionPattern.MatchString(tag) {
+		return ""
+	}
+	return archSuffixPattern.ReplaceAllString(tag, "")
+}
+
+// Check returns the number of minor versions the NodePool version is behind the control plane version.
+// ok is false if either version is unknown, in which case the skew cannot be checked. An
+// *UnsupportedSkewError is returned if the NodePool is newer, of another major version, or more than
+// MaxMinorSkew minor versions behind.
+func Check(controlPlaneVersion, nodePoolVersion string) (skew int, ok bool, err error) {
+	cpMajor, cpMinor, cpOK := parseMinor(controlPlaneVersion)
+	npMajor, npMinor, npOK := parseMinor(nodePoolVersion)
+	if !cpOK || !npOK {
+		return 0, false, nil
+	}
+
+	if cpMajor != npMajor {
+		return 0, true, &UnsupportedSkewError{ControlPlaneVersion: controlPlaneVersion, NodePoolVersion: nodePoolVersion}
+	}
+	skew = cpMinor - npMinor
+	if skew < 0 || skew > MaxMinorSkew {
+		err = &UnsupportedSkewError{ControlPlaneVersion: controlPlaneVersion, NodePoolVersion: nodePoolVersion, Skew: skew}
+	}
+	return skew, true, err
+}
+
+// parseMinor returns the major and minor numbers of an OCP version
+func parseMinor(version string) (int, int, bool) {
+	match := minorVersionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return 0, 0, false
+	}
+	major, err1 := strconv.Atoi(match[1])
+	minor, err2 := strconv.Atoi(match[2])
+	return major, minor, err1 == nil && err2 == nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versionskew
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Version skew", func() {
+	DescribeTable("ImageVersion",
+		func(image, version string) {
+			Expect(ImageVersion(image)).To(Equal(version))
+		},
+		Entry("multi-arch tag", "quay.io/openshift-release-dev/ocp-release:4.19.1-multi", "4.19.1"),
+		Entry("plain tag", "quay.io/openshift-release-dev/ocp-release:4.17.0", "4.17.0"),
+		Entry("registry port without tag", "registry.example.com:5000/ocp-release", ""),
+		Entry("digest", "quay.io/openshift-release-dev/ocp-release@sha256:0123456789abcdef", ""),
+		Entry("non-version tag", "quay.io/openshift-release-dev/ocp-release:latest", ""),
+	)
+
+	DescribeTable("Check",
+		func(controlPlane, nodePool string, wantSkew int, wantOK, wantErr bool) {
+			skew, ok, err := Check(controlPlane, nodePool)
+			Expect(skew).To(Equal(wantSkew))
+			Expect(ok).To(Equal(wantOK))
+			if wantErr {
+				var skewErr *UnsupportedSkewError
+				Expect(err).To(BeAssignableToTypeOf(skewErr))
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		},
+		Entry("same version", "4.19.1", "4.19.1", 0, true, false),
+		Entry("same minor", "4.19.3", "4.19.1", 0, true, false),
+		Entry("two minors behind", "4.19.1", "4.17.5", 2, true, false),
+		Entry("three minors behind", "4.19.1", "4.16.0", 3, true, true),
+		Entry("newer NodePool", "4.18.1", "4.19.0", -1, true, true),
+		Entry("other major", "5.0.0", "4.19.0", 0, true, true),
+		Entry("unknown control plane version", "", "4.19.0", 0, false, false),
+		Entry("unknown NodePool version", "4.19.0", "", 0, false, false),
+	)
+
+	It("should describe unsupported skews", func() {
+		_, _, err := Check("4.19.1", "4.16.0")
+		Expect(err).To(MatchError("NodePool version 4.16.0 is 3 minor versions behind the control plane version 4.19.1, at most 2 are supported"))
+
+		_, _, err = Check("4.18.1", "4.19.0")
+		Expect(err).To(MatchError("NodePool version 4.19.0 is newer than the control plane version 4.18.1"))
+	})
+})
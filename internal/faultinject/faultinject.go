@@ -0,0 +1,274 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package faultinject wraps a client with faults, e.g. failing the Nth Secret create or delaying
+// HostedCluster Gets, so that the envtest suites can exercise retry and timeout paths deterministically.
+// It is only enabled by tests, or by the EnvFaults environment variable for whole suites.
+package faultinject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// EnvFaults is the environment variable read by FromEnvironment, e.g. "create:Secret#2,get:HostedCluster=delay:5s"
+const EnvFaults = "DPF_HCP_BRIDGE_FAULTS"
+
+// Verbs that faults can be injected into
+const (
+	VerbGet    = "get"
+	VerbList   = "list"
+	VerbCreate = "create"
+	VerbUpdate = "update"
+	VerbPatch  = "patch"
+	VerbDelete = "delete"
+)
+
+// ErrInjected is wrapped by the errors returned for injected faults
+var ErrInjected = errors.New("injected fault")
+
+// Rule injects a fault into the calls of Verb on objects of Kind
+type Rule struct {
+	// Verb is one of the Verb constants
+	Verb string
+
+	// Kind is the object kind, e.g. Secret, or "<kind>/<subresource>" for subresource calls,
+	// e.g. DPFHCPBridge/status. Lists match the kind of their items.
+	Kind string
+
+	// Nth only injects the fault into the Nth matching call, counting from 1; 0 injects it into every call
+	Nth int
+
+	// Delay delays the matching calls, which are then performed. If unset, they fail with an InternalError.
+	Delay time.Duration
+}
+
+// String returns the rule in the EnvFaults format
+func (r Rule) String() string {
+	s := r.Verb + ":" + r.Kind
+	if r.Nth > 0 {
+		s += "#" + strconv.Itoa(r.Nth)
+	}
+	if r.Delay > 0 {
+		s += "=delay:" + r.Delay.String()
+	}
+	return s
+}
+
+// Injector injects faults into the calls of the clients it wraps
+type Injector struct {
+	rules []Rule
+
+	mu    sync.Mutex
+	calls map[int]int
+}
+
+// New creates an Injector for the given rules
+func New(rules ...Rule) *Injector {
+	return &Injector{rules: rules, calls: map[int]int{}}
+}
+
+// FromEnvironment creates an Injector for the rules in EnvFaults, or returns nil if it is not set
+func FromEnvironment() (*Injector, error) {
+	spec := os.Getenv(EnvFaults)
+	if spec == "" {
+		return nil, nil
+	}
+	rules, err := ParseRules(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", EnvFaults, err)
+	}
+	return New(rules...), nil
+}
+
+// ParseRules parses comma separated rules of the form "<verb>:<kind>[#<n>][=delay:<duration>]"
+func ParseRules(spec string) ([]Rule, error) {
+	var rules []Rule
+	for _, field := range strings.Split(spec, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		var rule Rule
+		target, effect, hasEffect := strings.Cut(field, "=")
+		if hasEffect {
+			value, ok := strings.CutPrefix(effect, "delay:")
+			if !ok {
+				return nil, fmt.Errorf("rule %q: unknown effect %q", field, effect)
+			}
+			delay, err := time.ParseDuration(value)
+			if err != nil || delay <= 0 {
+				return nil, fmt.Errorf("rule %q: invalid delay %q", field, value)
+			}
+			rule.Delay = delay
+		}
+
+		target, nth, hasNth := strings.Cut(target, "#")
+		if hasNth {
+			n, err := strconv.Atoi(nth)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("rule %q: invalid call number %q", field, nth)
+			}
+			rule.Nth = n
+		}
+
+		verb, kind, ok := strings.Cut(target, ":")
+		if !ok || kind == "" {
+			return nil, fmt.Errorf("rule %q: expected <verb>:<kind>", field)
+		}
+		switch verb {
+		case VerbGet, VerbList, VerbCreate, VerbUpdate, VerbPatch, VerbDelete:
+		default:
+			return nil, fmt.Errorf("rule %q: unknown verb %q", field, verb)
+		}
+		rule.Verb, rule.Kind = verb, kind
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// Wrap returns c with the faults injected
+func (i *Injector) Wrap(c client.WithWatch) client.WithWatch {
+	return interceptor.NewClient(c, i.Funcs())
+}
+
+// NewClient creates a client with the faults injected; it can be used as the NewClient option of a manager
+func (i *Injector) NewClient(config *rest.Config, options client.Options) (client.Client, error) {
+	c, err := client.NewWithWatch(config, options)
+	if err != nil {
+		return nil, err
+	}
+	return i.Wrap(c), nil
+}
+
+// Funcs returns the interceptor functions that inject the faults
+func (i *Injector) Funcs() interceptor.Funcs {
+	return interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if err := i.inject(ctx, VerbGet, kindOf(c, obj, "")); err != nil {
+				return err
+			}
+			return c.Get(ctx, key, obj, opts...)
+		},
+		List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			if err := i.inject(ctx, VerbList, strings.TrimSuffix(kindOf(c, list, ""), "List")); err != nil {
+				return err
+			}
+			return c.List(ctx, list, opts...)
+		},
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if err := i.inject(ctx, VerbCreate, kindOf(c, obj, "")); err != nil {
+				return err
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			if err := i.inject(ctx, VerbUpdate, kindOf(c, obj, "")); err != nil {
+				return err
+			}
+			return c.Update(ctx, obj, opts...)
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if err := i.inject(ctx, VerbPatch, kindOf(c, obj, "")); err != nil {
+				return err
+			}
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+		Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			if err := i.inject(ctx, VerbDelete, kindOf(c, obj, "")); err != nil {
+				return err
+			}
+			return c.Delete(ctx, obj, opts...)
+		},
+		SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			if err := i.inject(ctx, VerbUpdate, kindOf(c, obj, subResource)); err != nil {
+				return err
+			}
+			return c.SubResource(subResource).Update(ctx, obj, opts...)
+		},
+		SubResourcePatch: func(ctx context.Context, c client.Client, subResource string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			if err := i.inject(ctx, VerbPatch, kindOf(c, obj, subResource)); err != nil {
+				return err
+			}
+			return c.SubResource(subResource).Patch(ctx, obj, patch, opts...)
+		},
+	}
+}
+
+// inject applies the first rule matching the call: it returns the injected error, or waits for its delay
+func (i *Injector) inject(ctx context.Context, verb, kind string) error {
+	rule, ok := i.match(verb, kind)
+	if !ok {
+		return nil
+	}
+
+	if rule.Delay == 0 {
+		return apierrors.NewInternalError(fmt.Errorf("%w: %s", ErrInjected, rule))
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(rule.Delay):
+		return nil
+	}
+}
+
+// match counts the call against every rule for its verb and kind, and returns the first rule that fires
+func (i *Injector) match(verb, kind string) (Rule, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	var fired *Rule
+	for idx := range i.rules {
+		rule := &i.rules[idx]
+		if rule.Verb != verb || rule.Kind != kind {
+			continue
+		}
+		i.calls[idx]++
+		if fired == nil && (rule.Nth == 0 || rule.Nth == i.calls[idx]) {
+			fired = rule
+		}
+	}
+	if fired == nil {
+		return Rule{}, false
+	}
+	return *fired, true
+}
+
+// kindOf returns the kind of obj as matched by Rule.Kind
+func kindOf(c client.Client, obj runtime.Object, subResource string) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if gvk, err := c.GroupVersionKindFor(obj); err == nil {
+		kind = gvk.Kind
+	}
+	if subResource != "" {
+		kind += "/" + subResource
+	}
+	return kind
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faultinject
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Fault injection", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	newClient := func(rules ...Rule) client.Client {
+		bridge := &provisioningv1alpha1.DPFHCPBridge{ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default"}}
+		c := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(bridge).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+		return New(rules...).Wrap(c)
+	}
+
+	secret := func(name string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	}
+
+	It("should only fail the Nth matching call", func() {
+		c := newClient(Rule{Verb: VerbCreate, Kind: "Secret", Nth: 2})
+
+		Expect(c.Create(ctx, secret("first"))).To(Succeed())
+		err := c.Create(ctx, secret("second"))
+		Expect(apierrors.IsInternalError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("create:Secret#2"))
+		Expect(c.Create(ctx, secret("third"))).To(Succeed())
+
+		Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKey{Name: "second", Namespace: "default"}, &corev1.Secret{}))).To(BeTrue())
+	})
+
+	It("should leave other verbs and kinds alone", func() {
+		c := newClient(Rule{Verb: VerbCreate, Kind: "Secret"})
+
+		Expect(c.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "default"}})).To(Succeed())
+		Expect(c.List(ctx, &corev1.SecretList{})).To(Succeed())
+		Expect(c.Create(ctx, secret("any"))).NotTo(Succeed())
+	})
+
+	It("should match lists and subresources", func() {
+		c := newClient(
+			Rule{Verb: VerbList, Kind: "DPFHCPBridge"},
+			Rule{Verb: VerbUpdate, Kind: "DPFHCPBridge/status"},
+		)
+
+		Expect(c.List(ctx, &provisioningv1alpha1.DPFHCPBridgeList{})).NotTo(Succeed())
+
+		bridge := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge", Namespace: "default"}, bridge)).To(Succeed())
+		Expect(c.Update(ctx, bridge)).To(Succeed())
+		Expect(c.Status().Update(ctx, bridge)).NotTo(Succeed())
+	})
+
+	It("should delay matching calls and then perform them", func() {
+		c := newClient(Rule{Verb: VerbGet, Kind: "DPFHCPBridge", Delay: 50 * time.Millisecond})
+
+		start := time.Now()
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-bridge", Namespace: "default"}, &provisioningv1alpha1.DPFHCPBridge{})).To(Succeed())
+		Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		err := c.Get(timeoutCtx, client.ObjectKey{Name: "test-bridge", Namespace: "default"}, &provisioningv1alpha1.DPFHCPBridge{})
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	})
+
+	It("should parse rules from the environment", func() {
+		GinkgoT().Setenv(EnvFaults, "create:Secret#2, get:HostedCluster=delay:5s")
+
+		injector, err := FromEnvironment()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(injector.rules).To(Equal([]Rule{
+			{Verb: VerbCreate, Kind: "Secret", Nth: 2},
+			{Verb: VerbGet, Kind: "HostedCluster", Delay: 5 * time.Second},
+		}))
+		Expect(injector.rules[1].String()).To(Equal("get:HostedCluster=delay:5s"))
+
+		GinkgoT().Setenv(EnvFaults, "")
+		Expect(FromEnvironment()).To(BeNil())
+	})
+
+	DescribeTable("should reject invalid rules",
+		func(spec string) {
+			_, err := ParseRules(spec)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("missing kind", "create"),
+		Entry("unknown verb", "watch:Secret"),
+		Entry("invalid call number", "create:Secret#0"),
+		Entry("unknown effect", "create:Secret=panic"),
+		Entry("invalid delay", "get:Secret=delay:soon"),
+	)
+})
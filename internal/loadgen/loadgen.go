@@ -0,0 +1,410 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package loadgen drives the DPFHCPBridge controller with synthetic DPUClusters and bridges and
+// measures how quickly they are reconciled, so performance regressions surface before a release.
+// It is meant for test apiservers only: everything it creates lives in a namespace it owns.
+package loadgen
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// LabelRun is set to the run ID on every object created by a run, and on the namespace
+// created by the first run
+const LabelRun = "loadgen.provisioning.dpu.hcp.io/run"
+
+func pullSecretName(runID string) string { return "loadgen-" + runID + "-pull-secret" }
+
+func sshKeySecretName(runID string) string { return "loadgen-" + runID + "-ssh-key" }
+
+// Options configures a run
+type Options struct {
+	// Namespace holds every object of the run. It is created if missing; an existing namespace
+	// is only used if it was created by a previous run.
+	Namespace string
+
+	// Count is the number of DPUCluster and DPFHCPBridge pairs to create
+	Count int
+
+	// Concurrency is the number of create requests in flight
+	Concurrency int
+
+	// TargetPhase is the bridge phase whose latency is measured
+	TargetPhase provisioningv1alpha1.DPFHCPBridgePhase
+
+	// Timeout bounds the whole run; bridges that have not reached the target phase by then are
+	// reported as such
+	Timeout time.Duration
+
+	// ReleaseImage is the OCP release image set on the bridges
+	ReleaseImage string
+
+	// RunID labels the objects of the run; a random one is generated when empty
+	RunID string
+}
+
+// Sample is what was observed for a single bridge
+type Sample struct {
+	Name string
+
+	// Created is when the create request was sent
+	Created time.Time
+
+	// CreateError is set when the bridge or its DPUCluster could not be created
+	CreateError error
+
+	// FirstReconcile is the time from the create request until the controller first wrote a phase
+	FirstReconcile time.Duration
+
+	// Target is the time from the create request until the bridge reached the target phase
+	Target time.Duration
+
+	// Phase is the last phase observed
+	Phase provisioningv1alpha1.DPFHCPBridgePhase
+}
+
+// Reached reports whether the bridge reached the target phase
+func (s *Sample) Reached() bool {
+	return s.Target > 0
+}
+
+// Report is the outcome of a run
+type Report struct {
+	RunID       string
+	TargetPhase provisioningv1alpha1.DPFHCPBridgePhase
+	Samples     []*Sample
+
+	// Elapsed is the time from the first create request until the last bridge reached the
+	// target phase, or until the run timed out
+	Elapsed time.Duration
+}
+
+// FirstReconcileLatencies returns the first-reconcile latency of every bridge that was reconciled
+func (r *Report) FirstReconcileLatencies() []time.Duration {
+	var ds []time.Duration
+	for _, s := range r.Samples {
+		if s.FirstReconcile > 0 {
+			ds = append(ds, s.FirstReconcile)
+		}
+	}
+	return ds
+}
+
+// TargetLatencies returns the target phase latency of every bridge that reached it
+func (r *Report) TargetLatencies() []time.Duration {
+	var ds []time.Duration
+	for _, s := range r.Samples {
+		if s.Reached() {
+			ds = append(ds, s.Target)
+		}
+	}
+	return ds
+}
+
+// Throughput is the number of bridges that reached the target phase per second of the run
+func (r *Report) Throughput() float64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+	return float64(len(r.TargetLatencies())) / r.Elapsed.Seconds()
+}
+
+// Percentile returns the p-th percentile (0-100) of ds using the nearest-rank method, or 0 if
+// ds is empty
+func Percentile(ds []time.Duration, p float64) time.Duration {
+	if len(ds) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(ds)
+	slices.Sort(sorted)
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	rank = min(max(rank, 1), len(sorted))
+	return sorted[rank-1]
+}
+
+// Run creates the synthetic DPUClusters and bridges and observes them until they all reach the
+// target phase or the timeout expires. Bridges that do not make it in time are reported, not
+// returned as an error.
+func Run(ctx context.Context, c client.WithWatch, opts Options) (*Report, error) {
+	if opts.Count <= 0 {
+		return nil, fmt.Errorf("count must be positive, got %d", opts.Count)
+	}
+	if opts.RunID == "" {
+		opts.RunID = rand.String(6)
+	}
+	if opts.TargetPhase == "" {
+		opts.TargetPhase = provisioningv1alpha1.PhaseProvisioning
+	}
+	if err := prepareNamespace(ctx, c, opts); err != nil {
+		return nil, err
+	}
+
+	report := &Report{RunID: opts.RunID, TargetPhase: opts.TargetPhase}
+	samples := make(map[string]*Sample, opts.Count)
+	for i := range opts.Count {
+		s := &Sample{Name: fmt.Sprintf("loadgen-%s-%d", opts.RunID, i)}
+		report.Samples = append(report.Samples, s)
+		samples[s.Name] = s
+	}
+
+	runCtx := ctx
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	// The watch is opened before the first create and drained while creating so that no
+	// status update is missed or left to pile up
+	o := &observer{client: c, opts: opts, samples: samples}
+	w, err := o.watch(runCtx)
+	if err != nil {
+		return nil, err
+	}
+	created := make(chan struct{})
+	observed := make(chan struct{})
+	go func() {
+		defer close(observed)
+		o.run(runCtx, w, created)
+	}()
+
+	start := time.Now()
+	createAll(runCtx, c, opts, report.Samples, &o.mu)
+	close(created)
+	<-observed
+	report.Elapsed = time.Since(start)
+
+	return report, nil
+}
+
+// prepareNamespace creates the run namespace and the secrets shared by the bridges of the run,
+// refusing to use an existing namespace that was not created by loadgen
+func prepareNamespace(ctx context.Context, c client.Client, opts Options) error {
+	ns := &corev1.Namespace{}
+	err := c.Get(ctx, client.ObjectKey{Name: opts.Namespace}, ns)
+	switch {
+	case err == nil:
+		if _, ok := ns.Labels[LabelRun]; !ok {
+			return fmt.Errorf("namespace %s was not created by loadgen; use a dedicated namespace", opts.Namespace)
+		}
+	case apierrors.IsNotFound(err):
+		ns = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   opts.Namespace,
+			Labels: map[string]string{LabelRun: opts.RunID},
+		}}
+		if err := c.Create(ctx, ns); err != nil {
+			return fmt.Errorf("failed to create namespace %s: %w", opts.Namespace, err)
+		}
+	default:
+		return fmt.Errorf("failed to get namespace %s: %w", opts.Namespace, err)
+	}
+
+	secrets := []*corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: pullSecretName(opts.RunID), Namespace: opts.Namespace, Labels: map[string]string{LabelRun: opts.RunID}},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: sshKeySecretName(opts.RunID), Namespace: opts.Namespace, Labels: map[string]string{LabelRun: opts.RunID}},
+			Data:       map[string][]byte{"id_rsa.pub": []byte("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGxvYWRnZW4 loadgen")},
+		},
+	}
+	for _, secret := range secrets {
+		if err := c.Create(ctx, secret); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create secret %s: %w", secret.Name, err)
+		}
+	}
+	return nil
+}
+
+// createAll creates a DPUCluster and a bridge per sample with at most opts.Concurrency
+// requests in flight
+func createAll(ctx context.Context, c client.Client, opts Options, samples []*Sample, mu *sync.Mutex) {
+	sem := make(chan struct{}, max(opts.Concurrency, 1))
+	var wg sync.WaitGroup
+	for _, s := range samples {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := create(ctx, c, opts, s, mu)
+			if err != nil {
+				mu.Lock()
+				s.CreateError = err
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func create(ctx context.Context, c client.Client, opts Options, s *Sample, mu *sync.Mutex) error {
+	labels := map[string]string{LabelRun: opts.RunID}
+
+	dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: s.Name, Namespace: opts.Namespace, Labels: labels},
+		Spec: dpuprovisioningv1alpha1.DPUClusterSpec{
+			Type:     "static",
+			MaxNodes: 1,
+		},
+	}
+	if err := c.Create(ctx, dpuCluster); err != nil {
+		return fmt.Errorf("failed to create DPUCluster: %w", err)
+	}
+
+	bridge := &provisioningv1alpha1.DPFHCPBridge{
+		ObjectMeta: metav1.ObjectMeta{Name: s.Name, Namespace: opts.Namespace, Labels: labels},
+		Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+			DPUClusterRef:                  provisioningv1alpha1.DPUClusterReference{Name: s.Name, Namespace: opts.Namespace},
+			BaseDomain:                     "loadgen.example.com",
+			OCPReleaseImage:                opts.ReleaseImage,
+			SSHKeySecretRef:                corev1.LocalObjectReference{Name: sshKeySecretName(opts.RunID)},
+			PullSecretRef:                  corev1.LocalObjectReference{Name: pullSecretName(opts.RunID)},
+			ControlPlaneAvailabilityPolicy: hyperv1.SingleReplica,
+		},
+	}
+	// The timestamp is taken before the request so that a status update racing the response
+	// still finds it
+	mu.Lock()
+	s.Created = time.Now()
+	mu.Unlock()
+	if err := c.Create(ctx, bridge); err != nil {
+		return fmt.Errorf("failed to create DPFHCPBridge: %w", err)
+	}
+	return nil
+}
+
+// observer records the phase transitions of the bridges of a run
+type observer struct {
+	client  client.WithWatch
+	opts    Options
+	samples map[string]*Sample
+
+	// mu guards the samples, which are also written by the create workers
+	mu sync.Mutex
+}
+
+func (o *observer) watch(ctx context.Context) (watch.Interface, error) {
+	w, err := o.client.Watch(ctx, &provisioningv1alpha1.DPFHCPBridgeList{},
+		client.InNamespace(o.opts.Namespace), client.MatchingLabels{LabelRun: o.opts.RunID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch DPFHCPBridges: %w", err)
+	}
+	return w, nil
+}
+
+// run consumes w until created is closed and every bridge reached the target phase or failed,
+// or ctx is done. A watch closed by the apiserver is re-opened; its initial events carry the
+// current phases, so a transition missed in between is recorded late rather than lost.
+func (o *observer) run(ctx context.Context, w watch.Interface, created <-chan struct{}) {
+	defer func() { w.Stop() }()
+
+	creating := true
+	for {
+		if !creating && o.pending() == 0 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-created:
+			creating = false
+			created = nil
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				var err error
+				if w, err = o.watch(ctx); err != nil {
+					return
+				}
+				continue
+			}
+			if event.Type != watch.Added && event.Type != watch.Modified {
+				continue
+			}
+			if bridge, isBridge := event.Object.(*provisioningv1alpha1.DPFHCPBridge); isBridge {
+				o.record(bridge, time.Now())
+			}
+		}
+	}
+}
+
+func (o *observer) record(bridge *provisioningv1alpha1.DPFHCPBridge, now time.Time) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	s, found := o.samples[bridge.Name]
+	if !found || bridge.Status.Phase == "" || s.Created.IsZero() {
+		return
+	}
+	s.Phase = bridge.Status.Phase
+	if s.FirstReconcile == 0 {
+		s.FirstReconcile = now.Sub(s.Created)
+	}
+	if s.Target == 0 && s.Phase == o.opts.TargetPhase {
+		s.Target = now.Sub(s.Created)
+	}
+}
+
+// pending counts the bridges still expected to reach the target phase
+func (o *observer) pending() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	n := 0
+	for _, s := range o.samples {
+		if s.CreateError == nil && !s.Reached() && s.Phase != provisioningv1alpha1.PhaseFailed {
+			n++
+		}
+	}
+	return n
+}
+
+// Cleanup deletes the bridges, DPUClusters and secrets of a run. Bridges are deleted first so
+// that the controller tears down their hosted clusters while the DPUClusters still exist. It
+// does not wait for the deletions to complete.
+func Cleanup(ctx context.Context, c client.Client, namespace, runID string) error {
+	opts := []client.DeleteAllOfOption{client.InNamespace(namespace), client.MatchingLabels{LabelRun: runID}}
+	for _, obj := range []client.Object{
+		&provisioningv1alpha1.DPFHCPBridge{},
+		&dpuprovisioningv1alpha1.DPUCluster{},
+		&corev1.Secret{},
+	} {
+		if err := c.DeleteAllOf(ctx, obj, opts...); err != nil {
+			return fmt.Errorf("failed to delete %T objects of run %s: %w", obj, runID, err)
+		}
+	}
+	return nil
+}
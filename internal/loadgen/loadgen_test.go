@@ -0,0 +1,204 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadgen_test
+
+import (
+	"context"
+	"time"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/loadgen"
+)
+
+var _ = Describe("Load generator", func() {
+	const namespace = "loadgen"
+
+	var (
+		ctx context.Context
+		c   client.WithWatch
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(dpuprovisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		c = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+	})
+
+	// reconcileTo stands in for the controller: it moves every new bridge to phase until the
+	// returned function is called
+	reconcileTo := func(phase provisioningv1alpha1.DPFHCPBridgePhase) func() {
+		reconcileCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for reconcileCtx.Err() == nil {
+				bridges := &provisioningv1alpha1.DPFHCPBridgeList{}
+				if err := c.List(reconcileCtx, bridges); err == nil {
+					for i := range bridges.Items {
+						if b := &bridges.Items[i]; b.Status.Phase == "" {
+							b.Status.Phase = phase
+							_ = c.Status().Update(reconcileCtx, b)
+						}
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+			}
+		}()
+		return func() {
+			cancel()
+			<-done
+		}
+	}
+
+	options := func(runID string) loadgen.Options {
+		return loadgen.Options{
+			Namespace:    namespace,
+			Count:        5,
+			Concurrency:  2,
+			TargetPhase:  provisioningv1alpha1.PhaseProvisioning,
+			Timeout:      10 * time.Second,
+			ReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.0-multi",
+			RunID:        runID,
+		}
+	}
+
+	It("should compute nearest-rank percentiles", func() {
+		var ds []time.Duration
+		for i := 10; i >= 1; i-- {
+			ds = append(ds, time.Duration(i)*time.Second)
+		}
+
+		Expect(loadgen.Percentile(ds, 50)).To(Equal(5 * time.Second))
+		Expect(loadgen.Percentile(ds, 90)).To(Equal(9 * time.Second))
+		Expect(loadgen.Percentile(ds, 99)).To(Equal(10 * time.Second))
+		Expect(loadgen.Percentile(ds, 0)).To(Equal(1 * time.Second))
+		Expect(loadgen.Percentile(nil, 99)).To(BeZero())
+	})
+
+	It("should measure the latency of every bridge", func() {
+		stop := reconcileTo(provisioningv1alpha1.PhaseProvisioning)
+		defer stop()
+
+		report, err := loadgen.Run(ctx, c, options("a"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(report.Samples).To(HaveLen(5))
+		for _, s := range report.Samples {
+			Expect(s.CreateError).NotTo(HaveOccurred())
+			Expect(s.Reached()).To(BeTrue(), s.Name)
+			Expect(s.FirstReconcile).To(BeNumerically(">", 0))
+			Expect(s.Target).To(BeNumerically(">=", s.FirstReconcile))
+		}
+		Expect(report.TargetLatencies()).To(HaveLen(5))
+		Expect(report.Throughput()).To(BeNumerically(">", 0))
+
+		bridges := &provisioningv1alpha1.DPFHCPBridgeList{}
+		Expect(c.List(ctx, bridges, client.InNamespace(namespace), client.MatchingLabels{loadgen.LabelRun: "a"})).To(Succeed())
+		Expect(bridges.Items).To(HaveLen(5))
+		for _, b := range bridges.Items {
+			Expect(b.Spec.DPUClusterRef).To(Equal(provisioningv1alpha1.DPUClusterReference{Name: b.Name, Namespace: namespace}))
+			dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: b.Name, Namespace: namespace}, dpuCluster)).To(Succeed())
+			Expect(dpuCluster.Labels).To(HaveKeyWithValue(loadgen.LabelRun, "a"))
+		}
+	})
+
+	It("should stop waiting for a bridge once it failed", func() {
+		stop := reconcileTo(provisioningv1alpha1.PhaseFailed)
+		defer stop()
+
+		opts := options("a")
+		opts.Timeout = time.Minute
+		report, err := loadgen.Run(ctx, c, opts)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(report.Elapsed).To(BeNumerically("<", 10*time.Second))
+		Expect(report.TargetLatencies()).To(BeEmpty())
+		Expect(report.FirstReconcileLatencies()).To(HaveLen(5))
+		for _, s := range report.Samples {
+			Expect(s.Phase).To(Equal(provisioningv1alpha1.PhaseFailed))
+		}
+	})
+
+	It("should report bridges that were not reconciled in time", func() {
+		opts := options("a")
+		opts.Timeout = 200 * time.Millisecond
+		report, err := loadgen.Run(ctx, c, opts)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(report.FirstReconcileLatencies()).To(BeEmpty())
+		for _, s := range report.Samples {
+			Expect(s.CreateError).NotTo(HaveOccurred())
+			Expect(s.Reached()).To(BeFalse())
+		}
+	})
+
+	It("should refuse a namespace it did not create", func() {
+		Expect(c.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})).To(Succeed())
+
+		_, err := loadgen.Run(ctx, c, options("a"))
+		Expect(err).To(MatchError(ContainSubstring("was not created by loadgen")))
+
+		bridges := &provisioningv1alpha1.DPFHCPBridgeList{}
+		Expect(c.List(ctx, bridges)).To(Succeed())
+		Expect(bridges.Items).To(BeEmpty())
+	})
+
+	It("should only clean up the objects of its own run", func() {
+		stop := reconcileTo(provisioningv1alpha1.PhaseProvisioning)
+		defer stop()
+
+		_, err := loadgen.Run(ctx, c, options("a"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = loadgen.Run(ctx, c, options("b"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(loadgen.Cleanup(ctx, c, namespace, "a")).To(Succeed())
+
+		bridges := &provisioningv1alpha1.DPFHCPBridgeList{}
+		Expect(c.List(ctx, bridges, client.InNamespace(namespace))).To(Succeed())
+		Expect(bridges.Items).To(HaveLen(5))
+		dpuClusters := &dpuprovisioningv1alpha1.DPUClusterList{}
+		Expect(c.List(ctx, dpuClusters, client.InNamespace(namespace))).To(Succeed())
+		Expect(dpuClusters.Items).To(HaveLen(5))
+		secrets := &corev1.SecretList{}
+		Expect(c.List(ctx, secrets, client.InNamespace(namespace))).To(Succeed())
+		Expect(secrets.Items).To(HaveLen(2))
+		for _, b := range bridges.Items {
+			Expect(b.Labels).To(HaveKeyWithValue(loadgen.LabelRun, "b"))
+		}
+		for _, s := range secrets.Items {
+			Expect(s.Labels).To(HaveKeyWithValue(loadgen.LabelRun, "b"))
+		}
+	})
+})
@@ -0,0 +1,351 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migrate onboards brownfield environments by generating adoption-mode DPFHCPBridges
+// for hand-created HostedClusters that are already paired with a DPUCluster.
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/kubeconfiginjection"
+)
+
+// AnnotationDPUCluster can be set on a HostedCluster as "<namespace>/<name>" to pair it with a
+// DPUCluster explicitly when the pairing cannot be inferred from the DPUCluster kubeconfig
+const AnnotationDPUCluster = "provisioning.dpu.hcp.io/dpucluster"
+
+// Action is what the migration does for a HostedCluster
+type Action string
+
+const (
+	// ActionCreate means an adoption-mode DPFHCPBridge is created for the HostedCluster
+	ActionCreate Action = "Create"
+
+	// ActionSkip means the HostedCluster is left alone; Reason explains why
+	ActionSkip Action = "Skip"
+)
+
+// Entry is the migration plan for a single HostedCluster
+type Entry struct {
+	HostedCluster types.NamespacedName
+	DPUCluster    *types.NamespacedName
+	Action        Action
+	Reason        string
+	Warnings      []string
+	Bridge        *provisioningv1alpha1.DPFHCPBridge
+
+	// Result is filled in by Apply: "Created", "Validated" (dry-run) or the error
+	Result string
+}
+
+// Report is the migration plan for all scanned HostedClusters
+type Report struct {
+	Entries []*Entry
+}
+
+// Options configures the scan
+type Options struct {
+	// Namespace restricts the scan to HostedClusters in this namespace; empty scans all namespaces
+	Namespace string
+
+	// Name restricts the scan to the HostedCluster with this name in Namespace, e.g. to import a single cluster
+	Name string
+}
+
+// Plan scans HostedClusters and DPUClusters and builds the migration report without changing anything
+func Plan(ctx context.Context, c client.Client, opts Options) (*Report, error) {
+	hcs := &hyperv1.HostedClusterList{}
+	if opts.Name != "" {
+		hc := hyperv1.HostedCluster{}
+		key := types.NamespacedName{Name: opts.Name, Namespace: opts.Namespace}
+		if err := c.Get(ctx, key, &hc); err != nil {
+			return nil, fmt.Errorf("failed to get HostedCluster %s: %w", key, err)
+		}
+		hcs.Items = append(hcs.Items, hc)
+	} else if err := c.List(ctx, hcs, client.InNamespace(opts.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list HostedClusters: %w", err)
+	}
+
+	dpuClusters := &dpuprovisioningv1alpha1.DPUClusterList{}
+	if err := c.List(ctx, dpuClusters); err != nil {
+		return nil, fmt.Errorf("failed to list DPUClusters: %w", err)
+	}
+
+	bridges := &provisioningv1alpha1.DPFHCPBridgeList{}
+	if err := c.List(ctx, bridges); err != nil {
+		return nil, fmt.Errorf("failed to list DPFHCPBridges: %w", err)
+	}
+	existingBridges := make(map[types.NamespacedName]bool, len(bridges.Items))
+	pairedDPUClusters := make(map[types.NamespacedName]bool, len(bridges.Items))
+	for i := range bridges.Items {
+		b := &bridges.Items[i]
+		existingBridges[types.NamespacedName{Name: b.Name, Namespace: b.Namespace}] = true
+		for _, ref := range b.ResolvedDPUClusterRefs() {
+			pairedDPUClusters[types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}] = true
+		}
+	}
+
+	report := &Report{}
+	for i := range hcs.Items {
+		hc := &hcs.Items[i]
+		entry := &Entry{
+			HostedCluster: types.NamespacedName{Name: hc.Name, Namespace: hc.Namespace},
+			Action:        ActionSkip,
+		}
+		report.Entries = append(report.Entries, entry)
+
+		if owner := metav1.GetControllerOf(hc); owner != nil {
+			entry.Reason = fmt.Sprintf("already controlled by %s %s", owner.Kind, owner.Name)
+			continue
+		}
+		if existingBridges[entry.HostedCluster] {
+			entry.Reason = "a DPFHCPBridge with the same name already exists"
+			continue
+		}
+
+		dpuCluster, err := pairDPUCluster(hc, dpuClusters.Items)
+		if err != nil {
+			entry.Reason = err.Error()
+			continue
+		}
+		entry.DPUCluster = dpuCluster
+		if pairedDPUClusters[*dpuCluster] {
+			entry.Reason = fmt.Sprintf("DPUCluster %s is already referenced by a DPFHCPBridge", dpuCluster)
+			continue
+		}
+
+		// A NodePool with another name would not be adopted and a second one would be created
+		np := &hyperv1.NodePool{}
+		if err := c.Get(ctx, entry.HostedCluster, np); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to get NodePool %s: %w", entry.HostedCluster, err)
+			}
+			np = nil
+		}
+
+		entry.Bridge, entry.Warnings = buildBridge(hc, np, *dpuCluster)
+		entry.Action = ActionCreate
+		pairedDPUClusters[*dpuCluster] = true
+	}
+
+	return report, nil
+}
+
+// pairDPUCluster finds the DPUCluster paired with a HostedCluster, either from the
+// AnnotationDPUCluster annotation or from a DPUCluster whose kubeconfig secret is the
+// HostedCluster admin kubeconfig
+func pairDPUCluster(hc *hyperv1.HostedCluster, dpuClusters []dpuprovisioningv1alpha1.DPUCluster) (*types.NamespacedName, error) {
+	if ref, ok := hc.Annotations[AnnotationDPUCluster]; ok {
+		for i := range dpuClusters {
+			dc := &dpuClusters[i]
+			if dc.Namespace+"/"+dc.Name == ref {
+				return &types.NamespacedName{Name: dc.Name, Namespace: dc.Namespace}, nil
+			}
+		}
+		return nil, fmt.Errorf("DPUCluster %s from annotation %s not found", ref, AnnotationDPUCluster)
+	}
+
+	kubeconfigNames := map[string]bool{hc.Name + kubeconfiginjection.KubeconfigSecretSuffix: true}
+	if hc.Status.KubeConfig != nil {
+		kubeconfigNames[hc.Status.KubeConfig.Name] = true
+	}
+
+	var matches []types.NamespacedName
+	for i := range dpuClusters {
+		dc := &dpuClusters[i]
+		if dc.Spec.Kubeconfig != "" && kubeconfigNames[dc.Spec.Kubeconfig] {
+			matches = append(matches, types.NamespacedName{Name: dc.Name, Namespace: dc.Namespace})
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no DPUCluster uses the HostedCluster kubeconfig; set annotation %s to pair explicitly", AnnotationDPUCluster)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d DPUClusters use the HostedCluster kubeconfig; set annotation %s to pair explicitly", len(matches), AnnotationDPUCluster)
+	}
+}
+
+// buildBridge generates the adoption-mode DPFHCPBridge matching an existing HostedCluster and its
+// NodePool, which is nil if there is none with the HostedCluster's name.
+// Returns the bridge and warnings about settings that could not be carried over.
+func buildBridge(hc *hyperv1.HostedCluster, np *hyperv1.NodePool, dpuCluster types.NamespacedName) (*provisioningv1alpha1.DPFHCPBridge, []string) {
+	var warnings []string
+
+	bridge := &provisioningv1alpha1.DPFHCPBridge{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: provisioningv1alpha1.GroupVersion.String(),
+			Kind:       "DPFHCPBridge",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      hc.Name,
+			Namespace: hc.Namespace,
+			Annotations: map[string]string{
+				provisioningv1alpha1.AnnotationAdoptExisting: "true",
+			},
+		},
+		Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+			DPUClusterRef: provisioningv1alpha1.DPUClusterReference{
+				Name:      dpuCluster.Name,
+				Namespace: dpuCluster.Namespace,
+			},
+			BaseDomain:                     hc.Spec.DNS.BaseDomain,
+			OCPReleaseImage:                hc.Spec.Release.Image,
+			PullSecretRef:                  hc.Spec.PullSecret,
+			SSHKeySecretRef:                hc.Spec.SSHKey,
+			ControlPlaneAvailabilityPolicy: hc.Spec.ControllerAvailabilityPolicy,
+			NodeSelector:                   hc.Spec.NodeSelector,
+		},
+	}
+
+	if etcd := hc.Spec.Etcd.Managed; etcd != nil && etcd.Storage.PersistentVolume != nil && etcd.Storage.PersistentVolume.StorageClassName != nil {
+		bridge.Spec.EtcdStorageClass = *etcd.Storage.PersistentVolume.StorageClassName
+	}
+
+	if np != nil {
+		bridge.Spec.NodePoolReplicas = np.Spec.Replicas
+	} else {
+		warnings = append(warnings, fmt.Sprintf("no NodePool named %s found; the operator will create one", hc.Name))
+	}
+
+	warnings = append(warnings, detectServicePublishing(hc, bridge)...)
+
+	if hc.Spec.SSHKey.Name == "" {
+		warnings = append(warnings, "HostedCluster has no SSH key; set spec.sshKeySecretRef before applying")
+	}
+	if bridge.IsVIPRequired() && bridge.Spec.VirtualIP == "" {
+		warnings = append(warnings, "HighlyAvailable control plane requires spec.virtualIP; set it before applying")
+	}
+	if hc.Spec.SecretEncryption == nil || hc.Spec.SecretEncryption.AESCBC == nil ||
+		hc.Spec.SecretEncryption.AESCBC.ActiveKey.Name != hc.Name+"-etcd-encryption-key" {
+		warnings = append(warnings, "etcd encryption key differs from the operator convention; the operator will generate its own key secret but keep the existing HostedCluster spec")
+	}
+
+	return bridge, warnings
+}
+
+// detectServicePublishing carries the way the API server of the HostedCluster is published over to the bridge:
+// the address of a LoadBalancer becomes spec.virtualIP and the address of a NodePort spec.nodePortAddresses.
+// Returns warnings if the operator would publish the services differently.
+func detectServicePublishing(hc *hyperv1.HostedCluster, bridge *provisioningv1alpha1.DPFHCPBridge) []string {
+	var strategy *hyperv1.ServicePublishingStrategy
+	for i := range hc.Spec.Services {
+		if hc.Spec.Services[i].Service == hyperv1.APIServer {
+			strategy = &hc.Spec.Services[i].ServicePublishingStrategy
+			break
+		}
+	}
+	if strategy == nil {
+		return []string{"HostedCluster does not publish the API server; the operator will publish it as for a new cluster"}
+	}
+
+	switch strategy.Type {
+	case hyperv1.LoadBalancer:
+		// The API server is reached through the load balancer address, which is the VIP
+		if host := hc.Status.ControlPlaneEndpoint.Host; net.ParseIP(host) != nil {
+			bridge.Spec.VirtualIP = host
+			return nil
+		}
+		if bridge.IsVIPRequired() {
+			// Reported by the virtualIP warning
+			return nil
+		}
+		return []string{"API server is published through a LoadBalancer whose address is not known yet; " +
+			"set spec.virtualIP before applying, or the operator will switch the services to NodePort"}
+	case hyperv1.NodePort:
+		if bridge.IsVIPRequired() {
+			return []string{"API server of a HighlyAvailable control plane is published through a NodePort; " +
+				"the operator will switch the services to a LoadBalancer on spec.virtualIP"}
+		}
+		if strategy.NodePort != nil && strategy.NodePort.Address != "" {
+			bridge.Spec.NodePortAddresses = []string{strategy.NodePort.Address}
+		}
+		return nil
+	default:
+		return []string{fmt.Sprintf("API server is published through a %s; the operator will switch the services "+
+			"to a LoadBalancer or NodePort", strategy.Type)}
+	}
+}
+
+// ApplyOptions configures how the planned DPFHCPBridges are created
+type ApplyOptions struct {
+	// DryRun validates the DPFHCPBridges against the API server without persisting them
+	DryRun bool
+
+	// BatchSize is the number of DPFHCPBridges created before pausing; 0 creates all at once
+	BatchSize int
+
+	// BatchInterval is the pause between batches, so that the operator is not flooded with adoptions
+	BatchInterval time.Duration
+}
+
+// Apply creates the DPFHCPBridges of all entries with ActionCreate and records the outcome in each entry.
+// It keeps going on per-entry failures and returns the number of failed entries.
+func Apply(ctx context.Context, c client.Client, report *Report, opts ApplyOptions) int {
+	var createOpts []client.CreateOption
+	if opts.DryRun {
+		createOpts = append(createOpts, client.DryRunAll)
+	}
+
+	failed, inBatch := 0, 0
+	for _, entry := range report.Entries {
+		if entry.Action != ActionCreate {
+			continue
+		}
+
+		if opts.BatchSize > 0 && inBatch == opts.BatchSize {
+			inBatch = 0
+			if !opts.DryRun {
+				select {
+				case <-ctx.Done():
+					entry.Result = ctx.Err().Error()
+					failed++
+					continue
+				case <-time.After(opts.BatchInterval):
+				}
+			}
+		}
+		inBatch++
+
+		if err := c.Create(ctx, entry.Bridge.DeepCopy(), createOpts...); err != nil {
+			entry.Result = err.Error()
+			failed++
+			continue
+		}
+
+		if opts.DryRun {
+			entry.Result = "Validated"
+		} else {
+			entry.Result = "Created"
+		}
+	}
+
+	return failed
+}
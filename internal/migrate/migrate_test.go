@@ -0,0 +1,246 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrate_test
+
+import (
+	"context"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/migrate"
+)
+
+var _ = Describe("Migration", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+	)
+
+	newHostedCluster := func(name string) *hyperv1.HostedCluster {
+		return &hyperv1.HostedCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "clusters"},
+			Spec: hyperv1.HostedClusterSpec{
+				Release:                      hyperv1.Release{Image: "quay.io/openshift-release-dev/ocp-release:4.19.0-multi"},
+				DNS:                          hyperv1.DNSSpec{BaseDomain: "example.com"},
+				PullSecret:                   corev1.LocalObjectReference{Name: name + "-pull-secret"},
+				SSHKey:                       corev1.LocalObjectReference{Name: name + "-ssh-key"},
+				ControllerAvailabilityPolicy: hyperv1.SingleReplica,
+				Services: []hyperv1.ServicePublishingStrategyMapping{{
+					Service: hyperv1.APIServer,
+					ServicePublishingStrategy: hyperv1.ServicePublishingStrategy{
+						Type:     hyperv1.NodePort,
+						NodePort: &hyperv1.NodePortPublishingStrategy{Address: "192.168.10.5"},
+					},
+				}},
+				Etcd: hyperv1.EtcdSpec{
+					ManagementType: hyperv1.Managed,
+					Managed: &hyperv1.ManagedEtcdSpec{
+						Storage: hyperv1.ManagedEtcdStorageSpec{
+							Type: hyperv1.PersistentVolumeEtcdStorage,
+							PersistentVolume: &hyperv1.PersistentVolumeEtcdStorageSpec{
+								StorageClassName: ptr.To("lvms"),
+							},
+						},
+					},
+				},
+				SecretEncryption: &hyperv1.SecretEncryptionSpec{
+					Type:   hyperv1.AESCBC,
+					AESCBC: &hyperv1.AESCBCSpec{ActiveKey: corev1.LocalObjectReference{Name: name + "-etcd-encryption-key"}},
+				},
+			},
+		}
+	}
+
+	newDPUCluster := func(name, kubeconfig string) *dpuprovisioningv1alpha1.DPUCluster {
+		return &dpuprovisioningv1alpha1.DPUCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "dpf-operator-system"},
+			Spec:       dpuprovisioningv1alpha1.DPUClusterSpec{Kubeconfig: kubeconfig},
+		}
+	}
+
+	newNodePool := func(name string) *hyperv1.NodePool {
+		return &hyperv1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "clusters"},
+			Spec:       hyperv1.NodePoolSpec{ClusterName: name, Replicas: ptr.To[int32](3)},
+		}
+	}
+
+	build := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(dpuprovisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hyperv1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	It("should generate an adoption-mode bridge for a HostedCluster paired through the DPUCluster kubeconfig", func() {
+		c := build(newHostedCluster("dpu-a"), newNodePool("dpu-a"), newDPUCluster("dc-a", "dpu-a-admin-kubeconfig"))
+
+		report, err := migrate.Plan(ctx, c, migrate.Options{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Entries).To(HaveLen(1))
+
+		entry := report.Entries[0]
+		Expect(entry.Action).To(Equal(migrate.ActionCreate))
+		Expect(entry.Warnings).To(BeEmpty())
+		Expect(*entry.DPUCluster).To(Equal(types.NamespacedName{Name: "dc-a", Namespace: "dpf-operator-system"}))
+
+		bridge := entry.Bridge
+		Expect(bridge.AdoptsExisting()).To(BeTrue())
+		Expect(bridge.Name).To(Equal("dpu-a"))
+		Expect(bridge.Namespace).To(Equal("clusters"))
+		Expect(bridge.Spec.BaseDomain).To(Equal("example.com"))
+		Expect(bridge.Spec.OCPReleaseImage).To(Equal("quay.io/openshift-release-dev/ocp-release:4.19.0-multi"))
+		Expect(bridge.Spec.PullSecretRef.Name).To(Equal("dpu-a-pull-secret"))
+		Expect(bridge.Spec.SSHKeySecretRef.Name).To(Equal("dpu-a-ssh-key"))
+		Expect(bridge.Spec.EtcdStorageClass).To(Equal("lvms"))
+		Expect(bridge.Spec.ControlPlaneAvailabilityPolicy).To(Equal(hyperv1.SingleReplica))
+		Expect(bridge.Spec.NodePortAddresses).To(Equal([]string{"192.168.10.5"}))
+		Expect(bridge.Spec.VirtualIP).To(BeEmpty())
+		Expect(bridge.Spec.NodePoolReplicas).To(Equal(ptr.To[int32](3)))
+	})
+
+	It("should import a single HostedCluster and take its VIP from the load balancer address", func() {
+		hc := newHostedCluster("dpu-ha")
+		hc.Spec.ControllerAvailabilityPolicy = hyperv1.HighlyAvailable
+		hc.Spec.Services[0].ServicePublishingStrategy = hyperv1.ServicePublishingStrategy{Type: hyperv1.LoadBalancer}
+		hc.Status.ControlPlaneEndpoint = hyperv1.APIEndpoint{Host: "10.0.0.100", Port: 6443}
+		c := build(hc, newNodePool("dpu-ha"), newDPUCluster("dc-ha", "dpu-ha-admin-kubeconfig"),
+			newHostedCluster("dpu-b"), newDPUCluster("dc-b", "dpu-b-admin-kubeconfig"))
+
+		report, err := migrate.Plan(ctx, c, migrate.Options{Namespace: "clusters", Name: "dpu-ha"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Entries).To(HaveLen(1))
+
+		entry := report.Entries[0]
+		Expect(entry.Action).To(Equal(migrate.ActionCreate))
+		Expect(entry.Warnings).To(BeEmpty())
+		Expect(entry.Bridge.Spec.VirtualIP).To(Equal("10.0.0.100"))
+		Expect(entry.Bridge.Spec.NodePortAddresses).To(BeEmpty())
+		Expect(entry.Bridge.ShouldExposeThroughLoadBalancer()).To(BeTrue())
+	})
+
+	It("should warn when a load balancer address is not known", func() {
+		hc := newHostedCluster("dpu-lb")
+		hc.Spec.Services[0].ServicePublishingStrategy = hyperv1.ServicePublishingStrategy{Type: hyperv1.LoadBalancer}
+		c := build(hc, newNodePool("dpu-lb"), newDPUCluster("dc-lb", "dpu-lb-admin-kubeconfig"))
+
+		report, err := migrate.Plan(ctx, c, migrate.Options{Namespace: "clusters", Name: "dpu-lb"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Entries[0].Bridge.Spec.VirtualIP).To(BeEmpty())
+		Expect(report.Entries[0].Warnings).To(ConsistOf(ContainSubstring("set spec.virtualIP")))
+	})
+
+	It("should fail to import a HostedCluster that does not exist", func() {
+		_, err := migrate.Plan(ctx, build(), migrate.Options{Namespace: "clusters", Name: "missing"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should pair through the DPUCluster annotation", func() {
+		hc := newHostedCluster("dpu-a")
+		hc.Annotations = map[string]string{migrate.AnnotationDPUCluster: "dpf-operator-system/dc-explicit"}
+		c := build(hc, newNodePool("dpu-a"), newDPUCluster("dc-explicit", ""), newDPUCluster("dc-a", "dpu-a-admin-kubeconfig"))
+
+		report, err := migrate.Plan(ctx, c, migrate.Options{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Entries[0].Action).To(Equal(migrate.ActionCreate))
+		Expect(report.Entries[0].DPUCluster.Name).To(Equal("dc-explicit"))
+	})
+
+	It("should skip HostedClusters without a unique DPUCluster", func() {
+		c := build(
+			newHostedCluster("unpaired"),
+			newHostedCluster("ambiguous"),
+			newDPUCluster("dc-1", "ambiguous-admin-kubeconfig"),
+			newDPUCluster("dc-2", "ambiguous-admin-kubeconfig"),
+		)
+
+		report, err := migrate.Plan(ctx, c, migrate.Options{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Entries).To(HaveLen(2))
+		for _, entry := range report.Entries {
+			Expect(entry.Action).To(Equal(migrate.ActionSkip))
+			Expect(entry.Bridge).To(BeNil())
+		}
+	})
+
+	It("should skip HostedClusters that are already managed", func() {
+		owned := newHostedCluster("owned")
+		owned.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: provisioningv1alpha1.GroupVersion.String(),
+			Kind:       "DPFHCPBridge",
+			Name:       "owned",
+			UID:        "uid",
+			Controller: ptr.To(true),
+		}}
+		existing := &provisioningv1alpha1.DPFHCPBridge{ObjectMeta: metav1.ObjectMeta{Name: "bridged", Namespace: "clusters"}}
+		c := build(owned, newHostedCluster("bridged"), existing,
+			newDPUCluster("dc-owned", "owned-admin-kubeconfig"),
+			newDPUCluster("dc-bridged", "bridged-admin-kubeconfig"))
+
+		report, err := migrate.Plan(ctx, c, migrate.Options{})
+		Expect(err).NotTo(HaveOccurred())
+		for _, entry := range report.Entries {
+			Expect(entry.Action).To(Equal(migrate.ActionSkip), entry.HostedCluster.String())
+		}
+	})
+
+	It("should warn about settings that cannot be carried over", func() {
+		hc := newHostedCluster("ha")
+		hc.Spec.ControllerAvailabilityPolicy = hyperv1.HighlyAvailable
+		c := build(hc, newDPUCluster("dc-ha", "ha-admin-kubeconfig"))
+
+		report, err := migrate.Plan(ctx, c, migrate.Options{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Entries[0].Action).To(Equal(migrate.ActionCreate))
+		Expect(report.Entries[0].Warnings).To(ContainElements(
+			ContainSubstring("spec.virtualIP"),
+			ContainSubstring("no NodePool named ha"),
+		))
+	})
+
+	It("should create the planned bridges unless running as dry-run", func() {
+		c := build(newHostedCluster("dpu-a"), newNodePool("dpu-a"), newDPUCluster("dc-a", "dpu-a-admin-kubeconfig"))
+		report, err := migrate.Plan(ctx, c, migrate.Options{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(migrate.Apply(ctx, c, report, migrate.ApplyOptions{DryRun: true})).To(Equal(0))
+		Expect(report.Entries[0].Result).To(Equal("Validated"))
+		Expect(c.Get(ctx, types.NamespacedName{Name: "dpu-a", Namespace: "clusters"}, &provisioningv1alpha1.DPFHCPBridge{})).NotTo(Succeed())
+
+		Expect(migrate.Apply(ctx, c, report, migrate.ApplyOptions{})).To(Equal(0))
+		Expect(report.Entries[0].Result).To(Equal("Created"))
+		created := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "dpu-a", Namespace: "clusters"}, created)).To(Succeed())
+		Expect(created.AdoptsExisting()).To(BeTrue())
+	})
+})
@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package readonly turns the writes of a client into server-side dry-runs, so that the operator can run
+// against a cluster without changing it, e.g. during a disaster-recovery investigation. Writes are still
+// validated and admitted by the API server, but not persisted. The status of the operator's own resources
+// is the exception, so that bridges keep reporting their conditions and preflight results; the bridges carry a
+// ReadOnly condition so that those are not mistaken for changes of the cluster. The conversion configuration of the
+// DPFHCPBridge CRD does not go through these clients and is written regardless.
+package readonly
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// Wrap returns c with its writes turned into dry-runs
+func Wrap(c client.WithWatch) client.WithWatch {
+	return interceptor.NewClient(c, Funcs())
+}
+
+// NewClient creates a read-only client; it can be used as the NewClient option of a manager
+func NewClient(config *rest.Config, options client.Options) (client.Client, error) {
+	c, err := client.NewWithWatch(config, options)
+	if err != nil {
+		return nil, err
+	}
+	return Wrap(c), nil
+}
+
+// NewHostedClusterClient builds a read-only client for the hosted cluster from an admin kubeconfig;
+// it can be used as a manifests.HostedClusterClientFunc
+func NewHostedClusterClient(kubeconfig []byte) (client.Client, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse hosted cluster kubeconfig: %w", err)
+	}
+	return NewClient(restConfig, client.Options{})
+}
+
+// Funcs returns the interceptor functions that turn writes into dry-runs
+func Funcs() interceptor.Funcs {
+	return interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			return c.Create(ctx, obj, append(opts, client.DryRunAll)...)
+		},
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			return c.Update(ctx, obj, append(opts, client.DryRunAll)...)
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			return c.Patch(ctx, obj, patch, append(opts, client.DryRunAll)...)
+		},
+		Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			return c.Delete(ctx, obj, append(opts, client.DryRunAll)...)
+		},
+		DeleteAllOf: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteAllOfOption) error {
+			return c.DeleteAllOf(ctx, obj, append(opts, client.DryRunAll)...)
+		},
+		SubResourceCreate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, subObj client.Object, opts ...client.SubResourceCreateOption) error {
+			return c.SubResource(subResource).Create(ctx, obj, subObj, append(opts, client.DryRunAll)...)
+		},
+		SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			if !ownStatus(c, subResource, obj) {
+				opts = append(opts, client.DryRunAll)
+			}
+			return c.SubResource(subResource).Update(ctx, obj, opts...)
+		},
+		SubResourcePatch: func(ctx context.Context, c client.Client, subResource string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			if !ownStatus(c, subResource, obj) {
+				opts = append(opts, client.DryRunAll)
+			}
+			return c.SubResource(subResource).Patch(ctx, obj, patch, opts...)
+		},
+	}
+}
+
+// ownStatus returns true for writes to the status of the operator's own resources, which are let through
+func ownStatus(c client.Client, subResource string, obj client.Object) bool {
+	if subResource != "status" {
+		return false
+	}
+	gvk, err := c.GroupVersionKindFor(obj)
+	return err == nil && gvk.Group == provisioningv1alpha1.GroupVersion.Group
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readonly
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Read-only client", func() {
+	var (
+		ctx       context.Context
+		backing   client.Client
+		c         client.Client
+		bridgeKey client.ObjectKey
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		bridge := &provisioningv1alpha1.DPFHCPBridge{ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default"}}
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		bridgeKey = client.ObjectKeyFromObject(bridge)
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(bridge, namespace).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}, &corev1.Namespace{}).
+			Build()
+		backing = fakeClient
+		c = Wrap(fakeClient)
+	})
+
+	It("should not persist creates, updates and deletes", func() {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "default"}}
+		Expect(c.Create(ctx, secret)).To(Succeed())
+		err := backing.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		bridge := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, bridgeKey, bridge)).To(Succeed())
+		bridge.Finalizers = []string{"provisioning.dpu.hcp.io/finalizer"}
+		Expect(c.Update(ctx, bridge)).To(Succeed())
+		Expect(c.Delete(ctx, bridge)).To(Succeed())
+
+		persisted := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(backing.Get(ctx, bridgeKey, persisted)).To(Succeed())
+		Expect(persisted.Finalizers).To(BeEmpty())
+	})
+
+	It("should only persist the status of the operator's own resources", func() {
+		bridge := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(c.Get(ctx, bridgeKey, bridge)).To(Succeed())
+		bridge.Status.Phase = provisioningv1alpha1.PhasePending
+		Expect(c.Status().Update(ctx, bridge)).To(Succeed())
+
+		persisted := &provisioningv1alpha1.DPFHCPBridge{}
+		Expect(backing.Get(ctx, bridgeKey, persisted)).To(Succeed())
+		Expect(persisted.Status.Phase).To(Equal(provisioningv1alpha1.PhasePending))
+
+		namespace := &corev1.Namespace{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, namespace)).To(Succeed())
+		namespace.Status.Phase = corev1.NamespaceTerminating
+		Expect(c.Status().Update(ctx, namespace)).To(Succeed())
+
+		Expect(backing.Get(ctx, client.ObjectKey{Name: "default"}, namespace)).To(Succeed())
+		Expect(namespace.Status.Phase).To(BeEmpty())
+	})
+})
@@ -0,0 +1,124 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DPFHCPBridgeCRDName is the name of the DPFHCPBridge CustomResourceDefinition
+	DPFHCPBridgeCRDName = "dpfhcpbridges.provisioning.dpu.hcp.io"
+
+	// ConversionPath is the path the conversion webhook is served at
+	ConversionPath = "/convert"
+
+	// InjectCABundleAnnotation asks the OpenShift service CA operator to inject its CA bundle into
+	// the conversion webhook client config of the annotated CRD
+	InjectCABundleAnnotation = "service.beta.openshift.io/inject-cabundle"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;update
+
+// ConversionConfigurer points the conversion of the DPFHCPBridge CRD at the webhook Service of this operator.
+// The CRD is installed from static manifests (the Helm crds/ directory cannot be templated), so the Service
+// namespace is only known at runtime.
+type ConversionConfigurer struct {
+	client.Client
+
+	// ServiceNamespace and ServiceName identify the Service in front of the webhook server
+	ServiceNamespace string
+	ServiceName      string
+
+	// ServicePort is the port of the Service
+	ServicePort int32
+}
+
+// NewConversionConfigurer creates a new ConversionConfigurer for the given webhook Service
+func NewConversionConfigurer(c client.Client, namespace, name string) *ConversionConfigurer {
+	return &ConversionConfigurer{
+		Client:           c,
+		ServiceNamespace: namespace,
+		ServiceName:      name,
+		ServicePort:      443,
+	}
+}
+
+// Start implements manager.Runnable. It configures the conversion once and returns.
+func (c *ConversionConfigurer) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithValues("feature", "conversion-webhook")
+	if err := c.Configure(ctx); err != nil {
+		return err
+	}
+	log.Info("Configured DPFHCPBridge conversion webhook", "service", c.ServiceNamespace+"/"+c.ServiceName)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Every instance serves the webhook, so
+// the conversion is configured as soon as any of them starts; the update is idempotent.
+func (c *ConversionConfigurer) NeedLeaderElection() bool {
+	return false
+}
+
+// Configure sets the Webhook conversion strategy on the DPFHCPBridge CRD, keeping the CA bundle
+// injected by the service CA operator
+func (c *ConversionConfigurer) Configure(ctx context.Context) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := c.Get(ctx, types.NamespacedName{Name: DPFHCPBridgeCRDName}, crd); err != nil {
+			return err
+		}
+
+		var caBundle []byte
+		if conv := crd.Spec.Conversion; conv != nil && conv.Webhook != nil && conv.Webhook.ClientConfig != nil {
+			caBundle = conv.Webhook.ClientConfig.CABundle
+		}
+		path := ConversionPath
+		port := c.ServicePort
+		crd.Spec.Conversion = &apiextensionsv1.CustomResourceConversion{
+			Strategy: apiextensionsv1.WebhookConverter,
+			Webhook: &apiextensionsv1.WebhookConversion{
+				ClientConfig: &apiextensionsv1.WebhookClientConfig{
+					Service: &apiextensionsv1.ServiceReference{
+						Namespace: c.ServiceNamespace,
+						Name:      c.ServiceName,
+						Path:      &path,
+						Port:      &port,
+					},
+					CABundle: caBundle,
+				},
+				ConversionReviewVersions: []string{"v1"},
+			},
+		}
+		if crd.Annotations == nil {
+			crd.Annotations = map[string]string{}
+		}
+		crd.Annotations[InjectCABundleAnnotation] = "true"
+		return c.Update(ctx, crd)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to configure conversion webhook on CRD %s: %w", DPFHCPBridgeCRDName, err)
+	}
+	return nil
+}
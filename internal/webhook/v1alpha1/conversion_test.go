@@ -0,0 +1,94 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ConversionConfigurer", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(apiextensionsv1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	getCRD := func(configurer *ConversionConfigurer) *apiextensionsv1.CustomResourceDefinition {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		Expect(configurer.Get(ctx, types.NamespacedName{Name: DPFHCPBridgeCRDName}, crd)).To(Succeed())
+		return crd
+	}
+
+	It("should point the conversion at the webhook Service", func() {
+		crd := &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: DPFHCPBridgeCRDName}}
+		configurer := NewConversionConfigurer(fake.NewClientBuilder().WithScheme(scheme).WithObjects(crd).Build(),
+			"dpf-hcp-bridge-system", "dpf-hcp-bridge-operator-webhook")
+
+		Expect(configurer.Configure(ctx)).To(Succeed())
+
+		crd = getCRD(configurer)
+		Expect(crd.Annotations).To(HaveKeyWithValue(InjectCABundleAnnotation, "true"))
+		Expect(crd.Spec.Conversion.Strategy).To(Equal(apiextensionsv1.WebhookConverter))
+		Expect(crd.Spec.Conversion.Webhook.ConversionReviewVersions).To(Equal([]string{"v1"}))
+		service := crd.Spec.Conversion.Webhook.ClientConfig.Service
+		Expect(service.Namespace).To(Equal("dpf-hcp-bridge-system"))
+		Expect(service.Name).To(Equal("dpf-hcp-bridge-operator-webhook"))
+		Expect(*service.Path).To(Equal(ConversionPath))
+		Expect(*service.Port).To(Equal(int32(443)))
+	})
+
+	It("should keep the injected CA bundle", func() {
+		crd := &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: DPFHCPBridgeCRDName},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Conversion: &apiextensionsv1.CustomResourceConversion{
+					Strategy: apiextensionsv1.WebhookConverter,
+					Webhook: &apiextensionsv1.WebhookConversion{
+						ClientConfig: &apiextensionsv1.WebhookClientConfig{CABundle: []byte("service-ca")},
+					},
+				},
+			},
+		}
+		configurer := NewConversionConfigurer(fake.NewClientBuilder().WithScheme(scheme).WithObjects(crd).Build(),
+			"dpf-hcp-bridge-system", "dpf-hcp-bridge-operator-webhook")
+
+		Expect(configurer.Configure(ctx)).To(Succeed())
+		Expect(getCRD(configurer).Spec.Conversion.Webhook.ClientConfig.CABundle).To(Equal([]byte("service-ca")))
+	})
+
+	It("should fail when the CRD is not installed", func() {
+		configurer := NewConversionConfigurer(fake.NewClientBuilder().WithScheme(scheme).Build(),
+			"dpf-hcp-bridge-system", "dpf-hcp-bridge-operator-webhook")
+
+		err := configurer.Configure(ctx)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,181 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// DefaultsPath is the path the DPUCluster defaults webhook is served at
+const DefaultsPath = "/mutate-provisioning-dpu-hcp-io-v1alpha1-dpfhcpbridge"
+
+// +kubebuilder:webhook:path=/mutate-provisioning-dpu-hcp-io-v1alpha1-dpfhcpbridge,mutating=true,failurePolicy=ignore,sideEffects=None,groups=provisioning.dpu.hcp.io,resources=dpfhcpbridges,verbs=create,versions=v1alpha1,name=mdpfhcpbridge-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// DPUClusterDefaulter fills unset spec fields of new DPFHCPBridges from the AnnotationDefault* annotations
+// of their DPUCluster. It runs before the CRD validation, so a defaulted field satisfies its required and
+// CEL rules like a field set by the user. Only the fields it sets are patched: marshalling the whole
+// object would add empty values for unset optional structs, e.g. dpuClusterRef next to dpuClusterSelector.
+type DPUClusterDefaulter struct {
+	client.Client
+	Decoder admission.Decoder
+}
+
+// NewDPUClusterDefaulter creates a new DPUClusterDefaulter
+func NewDPUClusterDefaulter(c client.Client, decoder admission.Decoder) *DPUClusterDefaulter {
+	return &DPUClusterDefaulter{
+		Client:  c,
+		Decoder: decoder,
+	}
+}
+
+// Handle implements admission.Handler
+func (d *DPUClusterDefaulter) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create {
+		return admission.Allowed("")
+	}
+
+	cr := &provisioningv1alpha1.DPFHCPBridge{}
+	if err := d.Decoder.Decode(req, cr); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	log := logf.FromContext(ctx).WithValues("feature", "dpucluster-defaults", "dpfhcpbridge", req.Namespace+"/"+cr.Name)
+
+	dpuCluster, err := d.findDPUCluster(ctx, cr)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if dpuCluster == nil {
+		return admission.Allowed("no DPUCluster to take defaults from")
+	}
+
+	patches, err := d.defaults(ctx, cr, dpuCluster)
+	if err != nil {
+		return admission.Denied(err.Error())
+	}
+	if len(patches) == 0 {
+		return admission.Allowed("")
+	}
+	log.Info("Applying DPUCluster defaults",
+		"dpuCluster", dpuCluster.Namespace+"/"+dpuCluster.Name,
+		"fields", len(patches))
+	return admission.Patched(fmt.Sprintf("defaults from DPUCluster %s/%s", dpuCluster.Namespace, dpuCluster.Name), patches...)
+}
+
+// findDPUCluster returns the DPUCluster of the bridge: spec.dpuClusterRef, the first of spec.dpuClusterRefs,
+// or the single DPUCluster matching spec.dpuClusterSelector. Returns nil if there is none (yet); the controller reports that.
+func (d *DPUClusterDefaulter) findDPUCluster(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (*dpuprovisioningv1alpha1.DPUCluster, error) {
+	if cr.Spec.DPUClusterSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(cr.Spec.DPUClusterSelector)
+		if err != nil {
+			// Reported by the controller as DPUClusterSelectorInvalid
+			return nil, nil
+		}
+		var dpuClusters dpuprovisioningv1alpha1.DPUClusterList
+		if err := d.List(ctx, &dpuClusters, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, fmt.Errorf("failed to list DPUClusters matching dpuClusterSelector: %w", err)
+		}
+		if len(dpuClusters.Items) != 1 {
+			return nil, nil
+		}
+		return &dpuClusters.Items[0], nil
+	}
+
+	ref := cr.ResolvedDPUClusterRef()
+	if ref.Name == "" {
+		return nil, nil
+	}
+	dpuCluster := &dpuprovisioningv1alpha1.DPUCluster{}
+	if err := d.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, dpuCluster); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return dpuCluster, nil
+}
+
+// defaults returns the patches setting the unset spec fields that the DPUCluster has a default for
+func (d *DPUClusterDefaulter) defaults(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge,
+	dpuCluster *dpuprovisioningv1alpha1.DPUCluster) ([]jsonpatch.JsonPatchOperation, error) {
+	var patches []jsonpatch.JsonPatchOperation
+	add := func(field, value string) {
+		patches = append(patches, jsonpatch.NewOperation("add", "/spec/"+field, value))
+	}
+
+	if baseDomain := dpuCluster.Annotations[provisioningv1alpha1.AnnotationDefaultBaseDomain]; baseDomain != "" && cr.Spec.BaseDomain == "" {
+		add("baseDomain", baseDomain)
+	}
+
+	if sizeProfile := dpuCluster.Annotations[provisioningv1alpha1.AnnotationDefaultSizeProfile]; sizeProfile != "" && cr.Spec.SizeProfile == "" {
+		switch provisioningv1alpha1.SizeProfile(sizeProfile) {
+		case provisioningv1alpha1.SizeProfileSmall, provisioningv1alpha1.SizeProfileMedium, provisioningv1alpha1.SizeProfileLarge:
+			add("sizeProfile", sizeProfile)
+		default:
+			return nil, fmt.Errorf("DPUCluster %s/%s has invalid annotation %s=%q, it must be small, medium or large",
+				dpuCluster.Namespace, dpuCluster.Name, provisioningv1alpha1.AnnotationDefaultSizeProfile, sizeProfile)
+		}
+	}
+
+	if pool := dpuCluster.Annotations[provisioningv1alpha1.AnnotationDefaultVirtualIPs]; pool != "" && cr.Spec.VirtualIP == "" && cr.IsVIPRequired() {
+		vip, err := d.freeVirtualIP(ctx, pool)
+		if err != nil {
+			return nil, err
+		}
+		if vip == "" {
+			return nil, fmt.Errorf("all virtual IPs in annotation %s of DPUCluster %s/%s are in use, set spec.virtualIP",
+				provisioningv1alpha1.AnnotationDefaultVirtualIPs, dpuCluster.Namespace, dpuCluster.Name)
+		}
+		add("virtualIP", vip)
+	}
+
+	return patches, nil
+}
+
+// freeVirtualIP returns the first virtual IP of the comma-separated pool that no DPFHCPBridge uses,
+// or an empty string if all are in use
+func (d *DPUClusterDefaulter) freeVirtualIP(ctx context.Context, pool string) (string, error) {
+	var bridges provisioningv1alpha1.DPFHCPBridgeList
+	if err := d.List(ctx, &bridges); err != nil {
+		return "", fmt.Errorf("failed to list DPFHCPBridges: %w", err)
+	}
+	used := make([]string, 0, len(bridges.Items))
+	for _, bridge := range bridges.Items {
+		if bridge.Spec.VirtualIP != "" {
+			used = append(used, bridge.Spec.VirtualIP)
+		}
+	}
+
+	for _, candidate := range strings.Split(pool, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate != "" && !slices.Contains(used, candidate) {
+			return candidate, nil
+		}
+	}
+	return "", nil
+}
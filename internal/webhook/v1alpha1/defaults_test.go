@@ -0,0 +1,163 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dpuprovisioningv1alpha1 "github.com/nvidia/doca-platform/api/provisioning/v1alpha1"
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("DPUClusterDefaulter", func() {
+	var (
+		ctx        context.Context
+		scheme     *runtime.Scheme
+		dpuCluster *dpuprovisioningv1alpha1.DPUCluster
+		bridge     *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(dpuprovisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		dpuCluster = &dpuprovisioningv1alpha1.DPUCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "site-a",
+				Namespace: "dpu-clusters",
+				Labels:    map[string]string{"dpf.example.com/site": "a"},
+				Annotations: map[string]string{
+					provisioningv1alpha1.AnnotationDefaultBaseDomain:  "site-a.example.com",
+					provisioningv1alpha1.AnnotationDefaultVirtualIPs:  "192.168.1.100, 192.168.1.101",
+					provisioningv1alpha1.AnnotationDefaultSizeProfile: "medium",
+				},
+			},
+		}
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "bridge", Namespace: "default"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				DPUClusterRef:                  provisioningv1alpha1.DPUClusterReference{Name: "site-a", Namespace: "dpu-clusters"},
+				ControlPlaneAvailabilityPolicy: hyperv1.HighlyAvailable,
+			},
+		}
+	})
+
+	handle := func(objs ...client.Object) admission.Response {
+		raw, err := json.Marshal(bridge)
+		Expect(err).NotTo(HaveOccurred())
+		defaulter := NewDPUClusterDefaulter(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+			admission.NewDecoder(scheme))
+		return defaulter.Handle(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: bridge.Namespace,
+			Object:    runtime.RawExtension{Raw: raw},
+		}})
+	}
+
+	It("should fill unset fields from the DPUCluster annotations", func() {
+		resp := handle(dpuCluster)
+
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patches).To(ConsistOf(
+			jsonpatch.NewOperation("add", "/spec/baseDomain", "site-a.example.com"),
+			jsonpatch.NewOperation("add", "/spec/sizeProfile", "medium"),
+			jsonpatch.NewOperation("add", "/spec/virtualIP", "192.168.1.100"),
+		))
+	})
+
+	It("should keep fields set on the bridge", func() {
+		bridge.Spec.BaseDomain = "clusters.example.com"
+		bridge.Spec.VirtualIP = "10.0.0.5"
+		bridge.Spec.SizeProfile = provisioningv1alpha1.SizeProfileLarge
+
+		resp := handle(dpuCluster)
+
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patches).To(BeEmpty())
+	})
+
+	It("should skip virtual IPs used by other bridges", func() {
+		other := &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other"},
+			Spec:       provisioningv1alpha1.DPFHCPBridgeSpec{VirtualIP: "192.168.1.100"},
+		}
+
+		resp := handle(dpuCluster, other)
+
+		Expect(resp.Patches).To(ContainElement(jsonpatch.NewOperation("add", "/spec/virtualIP", "192.168.1.101")))
+	})
+
+	It("should deny the bridge when the virtual IP pool is exhausted", func() {
+		dpuCluster.Annotations[provisioningv1alpha1.AnnotationDefaultVirtualIPs] = "192.168.1.100"
+		other := &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other"},
+			Spec:       provisioningv1alpha1.DPFHCPBridgeSpec{VirtualIP: "192.168.1.100"},
+		}
+
+		resp := handle(dpuCluster, other)
+
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("are in use"))
+	})
+
+	It("should not default the virtual IP of a SingleReplica bridge", func() {
+		bridge.Spec.ControlPlaneAvailabilityPolicy = hyperv1.SingleReplica
+
+		resp := handle(dpuCluster)
+
+		Expect(resp.Patches).NotTo(ContainElement(HaveField("Path", "/spec/virtualIP")))
+	})
+
+	It("should deny an invalid size profile annotation", func() {
+		dpuCluster.Annotations[provisioningv1alpha1.AnnotationDefaultSizeProfile] = "huge"
+
+		resp := handle(dpuCluster)
+
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring(provisioningv1alpha1.AnnotationDefaultSizeProfile))
+	})
+
+	It("should resolve the DPUCluster from dpuClusterSelector", func() {
+		bridge.Spec.DPUClusterRef = provisioningv1alpha1.DPUClusterReference{}
+		bridge.Spec.DPUClusterSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"dpf.example.com/site": "a"}}
+
+		resp := handle(dpuCluster)
+
+		Expect(resp.Patches).To(ContainElement(jsonpatch.NewOperation("add", "/spec/baseDomain", "site-a.example.com")))
+	})
+
+	It("should allow the bridge unchanged when the DPUCluster does not exist", func() {
+		resp := handle()
+
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patches).To(BeEmpty())
+	})
+})
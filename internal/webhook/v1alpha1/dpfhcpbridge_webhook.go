@@ -0,0 +1,52 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/blackout"
+)
+
+// SetupDPFHCPBridgeWebhookWithManager registers the DPFHCPBridge webhooks with the manager.
+// v1alpha1 is the hub: the webhook converts the other served versions to and from it at /convert.
+// The DPUCluster defaults of new bridges are applied at DefaultsPath. Warnings about risky configurations,
+// which take the operator-wide blackout windows into account, unsupported NodePool version skews, release
+// images no strict ReleaseCatalog lists and duplicate API server FQDNs are reported at ValidationPath.
+func SetupDPFHCPBridgeWebhookWithManager(mgr ctrl.Manager, blackoutWindows *blackout.Config) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &provisioningv1alpha1.DPFHCPBridge{},
+		APIServerFQDNField, indexAPIServerFQDN); err != nil {
+		return err
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&provisioningv1alpha1.DPFHCPBridge{}).
+		WithValidator(&Validator{Client: mgr.GetClient(), Blackout: blackoutWindows}).
+		Complete(); err != nil {
+		return err
+	}
+
+	mgr.GetWebhookServer().Register(DefaultsPath, &webhook.Admission{
+		Handler: NewDPUClusterDefaulter(mgr.GetClient(), admission.NewDecoder(mgr.GetScheme())),
+	})
+	return nil
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/blackout"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/releasecatalog"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/releasechannel"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/versionskew"
+)
+
+// ValidationPath is the path the DPFHCPBridge validating webhook is served at
+const ValidationPath = "/validate-provisioning-dpu-hcp-io-v1alpha1-dpfhcpbridge"
+
+// APIServerFQDNField is the DPFHCPBridge field index holding the FQDN of the hosted cluster API server
+const APIServerFQDNField = "apiServerFQDN"
+
+// +kubebuilder:webhook:path=/validate-provisioning-dpu-hcp-io-v1alpha1-dpfhcpbridge,mutating=false,failurePolicy=ignore,sideEffects=None,groups=provisioning.dpu.hcp.io,resources=dpfhcpbridges,verbs=create;update,versions=v1alpha1,name=vdpfhcpbridge-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// warningCheck returns a warning for a risky but allowed DPFHCPBridge, or an empty string
+type warningCheck func(v *Validator, cr *provisioningv1alpha1.DPFHCPBridge) string
+
+// warningChecks are run in order on every created or updated bridge
+var warningChecks = []warningCheck{
+	singleReplicaForProductionSize,
+	unrestrictedNodePorts,
+	catalogUpgradesWithoutBlackout,
+}
+
+// Validator returns admission warnings for DPFHCPBridges whose configuration is allowed but risky.
+// The only changes it rejects are NodePool version skews HyperShift does not support and release images
+// no strict ReleaseCatalog lists, which the controller also refuses to roll out, and new bridges whose API
+// server FQDN is taken by another bridge, whose DNS records would collide; everything else is left to the
+// CRD validation, so that a bridge is admitted the same way whether the webhook is reachable or not.
+type Validator struct {
+	// Client lists the existing bridges by APIServerFQDNField and the ReleaseCatalogs
+	Client client.Reader
+
+	// Blackout holds the operator-wide blackout windows; nil if none are configured
+	Blackout *blackout.Config
+}
+
+var _ admission.CustomValidator = &Validator{}
+
+// ValidateCreate implements admission.CustomValidator
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, nil, obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, oldObj, newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate runs the warning checks on obj and rejects unsupported version skews, release images no strict
+// ReleaseCatalog lists and, on create, duplicate API server FQDNs; old is nil on create
+func (v *Validator) validate(ctx context.Context, oldObj, obj runtime.Object) (admission.Warnings, error) {
+	cr, ok := obj.(*provisioningv1alpha1.DPFHCPBridge)
+	if !ok {
+		return nil, fmt.Errorf("expected a DPFHCPBridge, got %T", obj)
+	}
+	if !cr.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	old, _ := oldObj.(*provisioningv1alpha1.DPFHCPBridge)
+
+	var warnings admission.Warnings
+	for _, check := range warningChecks {
+		if warning := check(v, cr); warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	errs := validateVersionSkew(old, cr)
+	unapproved, err := v.validateStrictCatalog(ctx, old, cr)
+	if err != nil {
+		return warnings, apierrors.NewInternalError(err)
+	}
+	errs = append(errs, unapproved...)
+	if old == nil {
+		duplicate, err := v.validateAPIServerFQDN(ctx, cr)
+		if err != nil {
+			return warnings, apierrors.NewInternalError(err)
+		}
+		errs = append(errs, duplicate...)
+	}
+	if len(errs) > 0 {
+		return warnings, apierrors.NewInvalid(provisioningv1alpha1.GroupVersion.WithKind("DPFHCPBridge").GroupKind(), cr.Name, errs)
+	}
+	return warnings, nil
+}
+
+// singleReplicaForProductionSize warns about a control plane without redundancy sized for more than 10 DPU workers
+func singleReplicaForProductionSize(_ *Validator, cr *provisioningv1alpha1.DPFHCPBridge) string {
+	if cr.Spec.ControlPlaneAvailabilityPolicy != hyperv1.SingleReplica {
+		return ""
+	}
+	if cr.Spec.SizeProfile != provisioningv1alpha1.SizeProfileMedium && cr.Spec.SizeProfile != provisioningv1alpha1.SizeProfileLarge {
+		return ""
+	}
+	return fmt.Sprintf("spec.controlPlaneAvailabilityPolicy: SingleReplica control plane with the %s size profile: "+
+		"the hosted cluster is unavailable while its only control plane replica restarts; use HighlyAvailable for production",
+		cr.Spec.SizeProfile)
+}
+
+// unrestrictedNodePorts warns about services published on node ports, which the operator does not restrict to any source
+func unrestrictedNodePorts(_ *Validator, cr *provisioningv1alpha1.DPFHCPBridge) string {
+	if cr.ShouldExposeThroughLoadBalancer() {
+		return ""
+	}
+	return "spec.virtualIP: unset, so the hosted control plane services are published on NodePorts of the management " +
+		"cluster nodes, reachable from any source; restrict access to them with a firewall or set virtualIP to publish " +
+		"through a LoadBalancer"
+}
+
+// catalogUpgradesWithoutBlackout warns about bridges that upgrade with their ReleaseCatalog entry or
+// release channel at any time
+func catalogUpgradesWithoutBlackout(v *Validator, cr *provisioningv1alpha1.DPFHCPBridge) string {
+	if v.Blackout != nil && len(v.Blackout.Windows) > 0 {
+		return ""
+	}
+	switch {
+	case cr.Spec.ReleaseCatalogRef != nil:
+		return "spec.releaseCatalogRef: the hosted cluster is upgraded whenever its ReleaseCatalog entry changes, " +
+			"and no blackout windows are configured to defer upgrades"
+	case cr.Spec.Channel != "":
+		return "spec.channel: the hosted cluster is upgraded whenever a new release is published to the channel, " +
+			"and no blackout windows are configured to defer upgrades"
+	}
+	return ""
+}
+
+// validateVersionSkew rejects NodePools whose release is newer than the control plane release, or more
+// than versionskew.MaxMinorSkew minor versions behind it. Versions are taken from the release image tags,
+// the ReleaseCatalog reference and the release channel; skews of digest-referenced images are left to the controller.
+// On update, only NodePools whose skew changed are checked, so that an existing skew does not block
+// unrelated changes.
+func validateVersionSkew(old, cr *provisioningv1alpha1.DPFHCPBridge) field.ErrorList {
+	controlPlane := controlPlaneVersion(cr)
+
+	unchanged := map[string]string{}
+	if old != nil && controlPlaneVersion(old) == controlPlane {
+		for _, pool := range old.Spec.NodePools {
+			unchanged[pool.Name] = pool.OCPReleaseImage
+		}
+	}
+
+	var errs field.ErrorList
+	for i, pool := range cr.Spec.NodePools {
+		if image, ok := unchanged[pool.Name]; ok && image == pool.OCPReleaseImage {
+			continue
+		}
+		if _, _, err := versionskew.Check(controlPlane, versionskew.ImageVersion(pool.OCPReleaseImage)); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "nodePools").Index(i).Child("ocpReleaseImage"),
+				pool.OCPReleaseImage, err.Error()))
+		}
+	}
+	return errs
+}
+
+// validateStrictCatalog rejects a spec.ocpReleaseImage that no ReleaseCatalog lists while a catalog is strict.
+// On update, only a changed image is checked, so that turning on strict mode does not block unrelated changes
+// of running bridges. Releases of a channel are only known once the controller resolves them, so it checks those.
+func (v *Validator) validateStrictCatalog(ctx context.Context, old, cr *provisioningv1alpha1.DPFHCPBridge) (field.ErrorList, error) {
+	image := cr.Spec.OCPReleaseImage
+	if image == "" || (old != nil && old.Spec.OCPReleaseImage == image) {
+		return nil, nil
+	}
+
+	catalog, strict, err := releasecatalog.ApprovingCatalog(ctx, v.Client, image)
+	if err != nil {
+		return nil, err
+	}
+	if catalog != "" || !strict {
+		return nil, nil
+	}
+	return field.ErrorList{field.Invalid(field.NewPath("spec", "ocpReleaseImage"), image,
+		"not listed in any ReleaseCatalog and strict mode is on; use spec.releaseCatalogRef")}, nil
+}
+
+// validateAPIServerFQDN rejects a new bridge whose hosted cluster API server FQDN, api.<name>.<baseDomain>,
+// is already used by a bridge in another namespace. Name and baseDomain are immutable, so the check only
+// runs on create.
+func (v *Validator) validateAPIServerFQDN(ctx context.Context, cr *provisioningv1alpha1.DPFHCPBridge) (field.ErrorList, error) {
+	fqdn := APIServerFQDN(cr)
+
+	var bridges provisioningv1alpha1.DPFHCPBridgeList
+	if err := v.Client.List(ctx, &bridges, client.MatchingFields{APIServerFQDNField: fqdn}); err != nil {
+		return nil, fmt.Errorf("failed to list DPFHCPBridges with API server %s: %w", fqdn, err)
+	}
+	for _, bridge := range bridges.Items {
+		if bridge.Namespace == cr.Namespace && bridge.Name == cr.Name {
+			continue
+		}
+		return field.ErrorList{field.Invalid(field.NewPath("spec", "baseDomain"), cr.Spec.BaseDomain,
+			fmt.Sprintf("API server %s is already used by DPFHCPBridge %s/%s", fqdn, bridge.Namespace, bridge.Name))}, nil
+	}
+	return nil, nil
+}
+
+// APIServerFQDN returns the FQDN HyperShift publishes the API server of the bridge's hosted cluster at
+func APIServerFQDN(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	return strings.ToLower("api." + cr.Name + "." + strings.TrimSuffix(cr.Spec.BaseDomain, "."))
+}
+
+// indexAPIServerFQDN indexes a DPFHCPBridge by its APIServerFQDN
+func indexAPIServerFQDN(obj client.Object) []string {
+	cr, ok := obj.(*provisioningv1alpha1.DPFHCPBridge)
+	if !ok || cr.Spec.BaseDomain == "" {
+		return nil
+	}
+	return []string{APIServerFQDN(cr)}
+}
+
+// controlPlaneVersion returns the OCP version of the bridge's control plane release as set in its spec
+func controlPlaneVersion(cr *provisioningv1alpha1.DPFHCPBridge) string {
+	if cr.Spec.ReleaseCatalogRef != nil {
+		return cr.Spec.ReleaseCatalogRef.Version
+	}
+	if cr.Spec.Channel != "" {
+		return releasechannel.ChannelVersion(cr.Spec.Channel)
+	}
+	return versionskew.ImageVersion(cr.Spec.OCPReleaseImage)
+}
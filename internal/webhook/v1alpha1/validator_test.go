@@ -0,0 +1,296 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/internal/controller/blackout"
+)
+
+var _ = Describe("Validator", func() {
+	var (
+		ctx       context.Context
+		validator *Validator
+		bridge    *provisioningv1alpha1.DPFHCPBridge
+	)
+
+	newValidator := func(objs ...client.Object) *Validator {
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+		return &Validator{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+			WithIndex(&provisioningv1alpha1.DPFHCPBridge{}, APIServerFQDNField, indexAPIServerFQDN).Build()}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		validator = newValidator()
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-bridge", Namespace: "default"},
+			Spec: provisioningv1alpha1.DPFHCPBridgeSpec{
+				BaseDomain:                     "example.com",
+				OCPReleaseImage:                "quay.io/openshift-release-dev/ocp-release:4.19.1-multi",
+				ControlPlaneAvailabilityPolicy: hyperv1.HighlyAvailable,
+				VirtualIP:                      "192.168.1.100",
+			},
+		}
+	})
+
+	It("should not warn about a highly available bridge", func() {
+		warnings, err := validator.ValidateCreate(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("should warn about a single replica control plane sized for production", func() {
+		bridge.Spec.ControlPlaneAvailabilityPolicy = hyperv1.SingleReplica
+		bridge.Spec.SizeProfile = provisioningv1alpha1.SizeProfileLarge
+
+		warnings, err := validator.ValidateCreate(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf(ContainSubstring("SingleReplica control plane with the large size profile")))
+	})
+
+	It("should not warn about a small single replica control plane with a virtual IP", func() {
+		bridge.Spec.ControlPlaneAvailabilityPolicy = hyperv1.SingleReplica
+		bridge.Spec.SizeProfile = provisioningv1alpha1.SizeProfileSmall
+
+		warnings, err := validator.ValidateCreate(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("should warn about services published on node ports", func() {
+		bridge.Spec.ControlPlaneAvailabilityPolicy = hyperv1.SingleReplica
+		bridge.Spec.VirtualIP = ""
+
+		warnings, err := validator.ValidateUpdate(ctx, bridge.DeepCopy(), bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf(ContainSubstring("published on NodePorts")))
+	})
+
+	It("should warn about catalog upgrades without blackout windows", func() {
+		bridge.Spec.OCPReleaseImage = ""
+		bridge.Spec.ReleaseCatalogRef = &provisioningv1alpha1.ReleaseCatalogReference{Name: "production", Version: "4.19.1"}
+
+		warnings, err := validator.ValidateCreate(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf(ContainSubstring("no blackout windows are configured")))
+
+		validator.Blackout = &blackout.Config{Windows: []blackout.Window{{Name: "weekdays", Start: "08:00", Duration: "10h"}}}
+		warnings, err = validator.ValidateCreate(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("should warn about channel upgrades without blackout windows", func() {
+		bridge.Spec.OCPReleaseImage = ""
+		bridge.Spec.Channel = "stable-4.19"
+
+		warnings, err := validator.ValidateCreate(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf(ContainSubstring("spec.channel: the hosted cluster is upgraded whenever a new release is published")))
+	})
+
+	It("should return all warnings together", func() {
+		bridge.Spec.ControlPlaneAvailabilityPolicy = hyperv1.SingleReplica
+		bridge.Spec.VirtualIP = ""
+		bridge.Spec.SizeProfile = provisioningv1alpha1.SizeProfileMedium
+
+		warnings, err := validator.ValidateCreate(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(HaveLen(2))
+	})
+
+	It("should not warn on delete", func() {
+		bridge.Spec.ControlPlaneAvailabilityPolicy = hyperv1.SingleReplica
+		bridge.Spec.VirtualIP = ""
+
+		warnings, err := validator.ValidateDelete(ctx, bridge)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	Context("NodePool version skew", func() {
+		BeforeEach(func() {
+			bridge.Spec.NodePools = []provisioningv1alpha1.NodePoolSpec{
+				{Name: "canary", OCPReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.17.3-multi"},
+				{Name: "bulk"},
+			}
+		})
+
+		It("should allow NodePools up to two minor versions behind the control plane", func() {
+			_, err := validator.ValidateCreate(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject NodePools newer than the control plane", func() {
+			bridge.Spec.NodePools[0].OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.20.0-multi"
+
+			_, err := validator.ValidateCreate(ctx, bridge)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.nodePools[0].ocpReleaseImage"))
+			Expect(err.Error()).To(ContainSubstring("NodePool version 4.20.0 is newer than the control plane version 4.19.1"))
+		})
+
+		It("should reject control plane upgrades that leave NodePools too far behind", func() {
+			old := bridge.DeepCopy()
+			bridge.Spec.OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.20.0-multi"
+
+			_, err := validator.ValidateUpdate(ctx, old, bridge)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("is 3 minor versions behind the control plane version 4.20.0"))
+		})
+
+		It("should use the version of the ReleaseCatalog reference", func() {
+			bridge.Spec.OCPReleaseImage = ""
+			bridge.Spec.ReleaseCatalogRef = &provisioningv1alpha1.ReleaseCatalogReference{Name: "production", Version: "4.21.0"}
+
+			_, err := validator.ValidateCreate(ctx, bridge)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should use the version of the release channel", func() {
+			bridge.Spec.OCPReleaseImage = ""
+			bridge.Spec.Channel = "stable-4.20"
+
+			_, err := validator.ValidateCreate(ctx, bridge)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("behind the control plane version 4.20"))
+		})
+
+		It("should not block unrelated updates of a bridge with an existing skew", func() {
+			bridge.Spec.NodePools[0].OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.16.0-multi"
+			old := bridge.DeepCopy()
+			bridge.Spec.NodePools[1].Replicas = ptr.To(int32(3))
+
+			_, err := validator.ValidateUpdate(ctx, old, bridge)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should leave digest-referenced NodePools to the controller", func() {
+			bridge.Spec.NodePools[0].OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release@sha256:0123456789abcdef"
+
+			_, err := validator.ValidateCreate(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("strict ReleaseCatalog", func() {
+		var catalog *provisioningv1alpha1.ReleaseCatalog
+
+		BeforeEach(func() {
+			catalog = &provisioningv1alpha1.ReleaseCatalog{
+				ObjectMeta: metav1.ObjectMeta{Name: "production"},
+				Spec: provisioningv1alpha1.ReleaseCatalogSpec{
+					Strict: true,
+					Releases: []provisioningv1alpha1.ReleaseCatalogEntry{{
+						Version:      "4.19.1",
+						ReleaseImage: "quay.io/openshift-release-dev/ocp-release:4.19.1-multi",
+					}},
+				},
+			}
+		})
+
+		It("should reject a release image no catalog lists", func() {
+			bridge.Spec.OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.19.2-multi"
+			validator = newValidator(catalog)
+
+			_, err := validator.ValidateCreate(ctx, bridge)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.ocpReleaseImage"))
+			Expect(err.Error()).To(ContainSubstring("not listed in any ReleaseCatalog and strict mode is on"))
+		})
+
+		It("should admit a release image the catalog lists", func() {
+			validator = newValidator(catalog)
+
+			_, err := validator.ValidateCreate(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should admit any release image when no catalog is strict", func() {
+			bridge.Spec.OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.19.2-multi"
+			catalog.Spec.Strict = false
+			validator = newValidator(catalog)
+
+			_, err := validator.ValidateCreate(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should only check changed release images on update", func() {
+			bridge.Spec.OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.19.2-multi"
+			validator = newValidator(catalog)
+			old := bridge.DeepCopy()
+			bridge.Spec.SizeProfile = provisioningv1alpha1.SizeProfileSmall
+
+			_, err := validator.ValidateUpdate(ctx, old, bridge)
+			Expect(err).NotTo(HaveOccurred())
+
+			bridge.Spec.OCPReleaseImage = "quay.io/openshift-release-dev/ocp-release:4.19.3-multi"
+			_, err = validator.ValidateUpdate(ctx, old, bridge)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+	})
+
+	Context("API server FQDN", func() {
+		var existing *provisioningv1alpha1.DPFHCPBridge
+
+		BeforeEach(func() {
+			existing = bridge.DeepCopy()
+			existing.Namespace = "site-a"
+			existing.Spec.BaseDomain = "Example.com."
+		})
+
+		It("should reject a bridge whose API server FQDN is taken in another namespace", func() {
+			validator = newValidator(existing)
+
+			_, err := validator.ValidateCreate(ctx, bridge)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.baseDomain"))
+			Expect(err.Error()).To(ContainSubstring("API server api.test-bridge.example.com is already used by DPFHCPBridge site-a/test-bridge"))
+		})
+
+		It("should allow bridges with the same name under another base domain", func() {
+			existing.Spec.BaseDomain = "lab.example.com"
+			validator = newValidator(existing)
+
+			_, err := validator.ValidateCreate(ctx, bridge)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not block updates of bridges created with a duplicate FQDN", func() {
+			validator = newValidator(existing, bridge.DeepCopy())
+			old := bridge.DeepCopy()
+			bridge.Spec.SizeProfile = provisioningv1alpha1.SizeProfileSmall
+
+			_, err := validator.ValidateUpdate(ctx, old, bridge)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
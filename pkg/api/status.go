@@ -0,0 +1,119 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package api interprets the status of DPFHCPBridges, so that automation can tell whether a bridge is
+// ready or failing without depending on condition Reasons, which may change between operator versions.
+package api
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// negativeConditions are the conditions that report a failure when True
+var negativeConditions = map[string]bool{
+	provisioningv1alpha1.DPUClusterMissing:     true,
+	provisioningv1alpha1.DPUClusterInUse:       true,
+	provisioningv1alpha1.ResourceConflict:      true,
+	provisioningv1alpha1.HostedClusterDegraded: true,
+}
+
+// informationalConditions are the conditions that never report a failure
+var informationalConditions = map[string]bool{
+	provisioningv1alpha1.HostedClusterProgressing: true,
+	provisioningv1alpha1.Paused:                   true,
+	provisioningv1alpha1.ReadOnly:                 true,
+}
+
+// inProgressReasons are Reasons of False conditions that report progress rather than a failure
+var inProgressReasons = map[string]bool{
+	provisioningv1alpha1.ReasonHooksRunning:  true,
+	provisioningv1alpha1.ReasonAwaitingClaim: true,
+}
+
+// GetCondition returns the condition of the given type, or nil if the bridge does not report it
+func GetCondition(bridge *provisioningv1alpha1.DPFHCPBridge, conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(bridge.Status.Conditions, conditionType)
+}
+
+// IsConditionTrue returns true if the bridge reports the condition as True
+func IsConditionTrue(bridge *provisioningv1alpha1.DPFHCPBridge, conditionType string) bool {
+	return meta.IsStatusConditionTrue(bridge.Status.Conditions, conditionType)
+}
+
+// IsConditionFailing returns true if the condition reports a failure.
+// Most conditions fail when False; DPUClusterMissing, DPUClusterInUse, ResourceConflict and HostedClusterDegraded
+// fail when True. HostedClusterProgressing, Paused and ReadOnly are informational and never fail, and False
+// conditions that report progress, such as running post-provision hooks, are not failures either.
+func IsConditionFailing(condition metav1.Condition) bool {
+	if informationalConditions[condition.Type] {
+		return false
+	}
+	if negativeConditions[condition.Type] {
+		return condition.Status == metav1.ConditionTrue
+	}
+	if inProgressReasons[condition.Reason] {
+		return false
+	}
+	return condition.Status == metav1.ConditionFalse
+}
+
+// FailingConditions returns the conditions of the bridge that report a failure
+func FailingConditions(bridge *provisioningv1alpha1.DPFHCPBridge) []metav1.Condition {
+	var failing []metav1.Condition
+	for _, condition := range bridge.Status.Conditions {
+		if IsConditionFailing(condition) {
+			failing = append(failing, condition)
+		}
+	}
+	return failing
+}
+
+// IsReady returns true if the hosted cluster of the bridge is operational: the bridge is in the Ready phase,
+// its Ready condition is True and it is not being deleted. Unlike the Ready condition alone, the phase also
+// accounts for validations that started failing after the bridge became ready.
+func IsReady(bridge *provisioningv1alpha1.DPFHCPBridge) bool {
+	return bridge.DeletionTimestamp.IsZero() &&
+		bridge.Status.Phase == provisioningv1alpha1.PhaseReady &&
+		IsConditionTrue(bridge, provisioningv1alpha1.Ready)
+}
+
+// IsPending returns true if the bridge is validating its spec or waiting for its DPUCluster
+func IsPending(bridge *provisioningv1alpha1.DPFHCPBridge) bool {
+	return bridge.Status.Phase == provisioningv1alpha1.PhasePending || bridge.Status.Phase == ""
+}
+
+// IsProvisioning returns true if the HostedCluster of the bridge exists but is not operational yet
+func IsProvisioning(bridge *provisioningv1alpha1.DPFHCPBridge) bool {
+	return bridge.Status.Phase == provisioningv1alpha1.PhaseProvisioning
+}
+
+// IsFailed returns true if the bridge needs user intervention; see FailingConditions for the cause
+func IsFailed(bridge *provisioningv1alpha1.DPFHCPBridge) bool {
+	return bridge.Status.Phase == provisioningv1alpha1.PhaseFailed
+}
+
+// IsDeleting returns true if the bridge is being deleted
+func IsDeleting(bridge *provisioningv1alpha1.DPFHCPBridge) bool {
+	return !bridge.DeletionTimestamp.IsZero() || bridge.Status.Phase == provisioningv1alpha1.PhaseDeleting
+}
+
+// HasHostedCluster returns true if the bridge created (or adopted) its HostedCluster
+func HasHostedCluster(bridge *provisioningv1alpha1.DPFHCPBridge) bool {
+	return bridge.Status.HostedClusterRef != nil
+}
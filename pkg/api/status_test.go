@@ -0,0 +1,141 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Status helpers", func() {
+	var bridge *provisioningv1alpha1.DPFHCPBridge
+
+	condition := func(conditionType string, status metav1.ConditionStatus, reason string) metav1.Condition {
+		return metav1.Condition{Type: conditionType, Status: status, Reason: reason}
+	}
+
+	BeforeEach(func() {
+		bridge = &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: "bridge", Namespace: "default"},
+			Status: provisioningv1alpha1.DPFHCPBridgeStatus{
+				Phase: provisioningv1alpha1.PhaseReady,
+				Conditions: []metav1.Condition{
+					condition(provisioningv1alpha1.Ready, metav1.ConditionTrue, "HostedClusterReady"),
+					condition(provisioningv1alpha1.DPUClusterMissing, metav1.ConditionFalse, "Found"),
+				},
+			},
+		}
+	})
+
+	Context("GetCondition", func() {
+		It("returns the condition of the given type", func() {
+			c := GetCondition(bridge, provisioningv1alpha1.Ready)
+			Expect(c).NotTo(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("returns nil for a condition the bridge does not report", func() {
+			Expect(GetCondition(bridge, provisioningv1alpha1.HostedClusterDegraded)).To(BeNil())
+		})
+	})
+
+	Context("IsConditionFailing", func() {
+		It("treats False as failing for most conditions", func() {
+			Expect(IsConditionFailing(condition(provisioningv1alpha1.Ready, metav1.ConditionFalse, "NotReady"))).To(BeTrue())
+			Expect(IsConditionFailing(condition(provisioningv1alpha1.Ready, metav1.ConditionTrue, "Ready"))).To(BeFalse())
+		})
+
+		It("treats True as failing for negative conditions", func() {
+			Expect(IsConditionFailing(condition(provisioningv1alpha1.DPUClusterMissing, metav1.ConditionTrue, "NotFound"))).To(BeTrue())
+			Expect(IsConditionFailing(condition(provisioningv1alpha1.HostedClusterDegraded, metav1.ConditionFalse, "AsExpected"))).To(BeFalse())
+		})
+
+		It("never treats HostedClusterProgressing as failing", func() {
+			Expect(IsConditionFailing(condition(provisioningv1alpha1.HostedClusterProgressing, metav1.ConditionFalse, "Done"))).To(BeFalse())
+			Expect(IsConditionFailing(condition(provisioningv1alpha1.HostedClusterProgressing, metav1.ConditionTrue, "Rolling"))).To(BeFalse())
+		})
+
+		It("does not treat in-progress reasons as failing", func() {
+			Expect(IsConditionFailing(condition(provisioningv1alpha1.Ready, metav1.ConditionFalse, provisioningv1alpha1.ReasonHooksRunning))).To(BeFalse())
+		})
+	})
+
+	Context("FailingConditions", func() {
+		It("returns only the failing conditions", func() {
+			bridge.Status.Conditions = append(bridge.Status.Conditions,
+				condition(provisioningv1alpha1.DPUClusterInUse, metav1.ConditionTrue, "Claimed"))
+			failing := FailingConditions(bridge)
+			Expect(failing).To(HaveLen(1))
+			Expect(failing[0].Type).To(Equal(provisioningv1alpha1.DPUClusterInUse))
+		})
+
+		It("returns nothing for a healthy bridge", func() {
+			Expect(FailingConditions(bridge)).To(BeEmpty())
+		})
+	})
+
+	Context("IsReady", func() {
+		It("returns true for a ready bridge", func() {
+			Expect(IsReady(bridge)).To(BeTrue())
+		})
+
+		It("returns false when a validation failed after the bridge became ready", func() {
+			bridge.Status.Phase = provisioningv1alpha1.PhaseFailed
+			Expect(IsReady(bridge)).To(BeFalse())
+		})
+
+		It("returns false while the bridge is being deleted", func() {
+			now := metav1.Now()
+			bridge.DeletionTimestamp = &now
+			Expect(IsReady(bridge)).To(BeFalse())
+			Expect(IsDeleting(bridge)).To(BeTrue())
+		})
+
+		It("returns false without a Ready condition", func() {
+			bridge.Status.Conditions = nil
+			Expect(IsReady(bridge)).To(BeFalse())
+		})
+	})
+
+	Context("Phase predicates", func() {
+		It("treats a bridge without a phase as pending", func() {
+			bridge.Status.Phase = ""
+			Expect(IsPending(bridge)).To(BeTrue())
+			Expect(IsProvisioning(bridge)).To(BeFalse())
+		})
+
+		It("matches the phase of the bridge", func() {
+			bridge.Status.Phase = provisioningv1alpha1.PhaseProvisioning
+			Expect(IsProvisioning(bridge)).To(BeTrue())
+			Expect(IsFailed(bridge)).To(BeFalse())
+			Expect(IsDeleting(bridge)).To(BeFalse())
+
+			bridge.Status.Phase = provisioningv1alpha1.PhaseFailed
+			Expect(IsFailed(bridge)).To(BeTrue())
+		})
+
+		It("reports whether the HostedCluster exists", func() {
+			Expect(HasHostedCluster(bridge)).To(BeFalse())
+			bridge.Status.HostedClusterRef = &corev1.ObjectReference{Name: "bridge", Namespace: "default"}
+			Expect(HasHostedCluster(bridge)).To(BeTrue())
+		})
+	})
+})
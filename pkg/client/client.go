@@ -0,0 +1,100 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client provides typed clients, listers and informers for the provisioning.dpu.hcp.io API,
+// so that external tools can consume DPFHCPBridges, BridgePools and ReleaseCatalogs without copying
+// the type definitions or setting up a scheme.
+//
+// The clients are a thin typed layer over controller-runtime: objects are updated in place like with
+// a controller-runtime client, and the usual client.ListOption, client.CreateOption, ... apply.
+//
+//	c, err := client.New(config)
+//	bridge, err := c.DPFHCPBridges("my-dpu-clusters").Get(ctx, "prod-dpu-cluster")
+//
+// Listers and informers are backed by a controller-runtime cache:
+//
+//	informers, err := client.NewInformers(config, cache.Options{})
+//	err = informers.DPFHCPBridges().AddEventHandler(ctx, handler)
+//	go informers.Start(ctx)
+//	informers.WaitForCacheSync(ctx)
+//	bridges, err := informers.DPFHCPBridges().Lister("my-dpu-clusters").List(ctx)
+package client
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// DPFHCPBridgeInterface reads and writes DPFHCPBridges
+type DPFHCPBridgeInterface = *Resource[*provisioningv1alpha1.DPFHCPBridge, *provisioningv1alpha1.DPFHCPBridgeList]
+
+// BridgePoolInterface reads and writes BridgePools
+type BridgePoolInterface = *Resource[*provisioningv1alpha1.BridgePool, *provisioningv1alpha1.BridgePoolList]
+
+// ReleaseCatalogInterface reads and writes the cluster-scoped ReleaseCatalogs
+type ReleaseCatalogInterface = *Resource[*provisioningv1alpha1.ReleaseCatalog, *provisioningv1alpha1.ReleaseCatalogList]
+
+// NewScheme returns a scheme holding the provisioning.dpu.hcp.io types and the Kubernetes built-in types
+func NewScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(provisioningv1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+// Client is a typed client for the provisioning.dpu.hcp.io API
+type Client struct {
+	client crclient.WithWatch
+}
+
+// New creates a Client for the cluster of the given REST config
+func New(config *rest.Config) (*Client, error) {
+	c, err := crclient.NewWithWatch(config, crclient.Options{Scheme: NewScheme()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create provisioning.dpu.hcp.io client: %w", err)
+	}
+	return NewForClient(c), nil
+}
+
+// NewForClient wraps an existing controller-runtime client, whose scheme must hold the
+// provisioning.dpu.hcp.io types (see NewScheme)
+func NewForClient(c crclient.WithWatch) *Client {
+	return &Client{client: c}
+}
+
+// DPFHCPBridges returns a client for the DPFHCPBridges of a namespace; an empty namespace lists
+// the DPFHCPBridges of all namespaces
+func (c *Client) DPFHCPBridges(namespace string) DPFHCPBridgeInterface {
+	return newResource[*provisioningv1alpha1.DPFHCPBridge, *provisioningv1alpha1.DPFHCPBridgeList](c.client, namespace)
+}
+
+// BridgePools returns a client for the BridgePools of a namespace; an empty namespace lists
+// the BridgePools of all namespaces
+func (c *Client) BridgePools(namespace string) BridgePoolInterface {
+	return newResource[*provisioningv1alpha1.BridgePool, *provisioningv1alpha1.BridgePoolList](c.client, namespace)
+}
+
+// ReleaseCatalogs returns a client for the ReleaseCatalogs
+func (c *Client) ReleaseCatalogs() ReleaseCatalogInterface {
+	return newResource[*provisioningv1alpha1.ReleaseCatalog, *provisioningv1alpha1.ReleaseCatalogList](c.client, "")
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/utils/ptr"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+var _ = Describe("Typed Client", func() {
+	var (
+		ctx        context.Context
+		fakeClient crclient.WithWatch
+		c          *Client
+	)
+
+	bridge := func(namespace, name string, labels map[string]string) *provisioningv1alpha1.DPFHCPBridge {
+		return &provisioningv1alpha1.DPFHCPBridge{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec:       provisioningv1alpha1.DPFHCPBridgeSpec{BaseDomain: "example.com"},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(NewScheme()).
+			WithObjects(
+				bridge("tenant-a", "prod", map[string]string{"env": "prod"}),
+				bridge("tenant-a", "staging", map[string]string{"env": "staging"}),
+				bridge("tenant-b", "prod", nil),
+				&provisioningv1alpha1.ReleaseCatalog{ObjectMeta: metav1.ObjectMeta{Name: "stable"}},
+			).
+			WithStatusSubresource(&provisioningv1alpha1.DPFHCPBridge{}).
+			Build()
+		c = NewForClient(fakeClient)
+	})
+
+	It("should get objects of its namespace", func() {
+		prod, err := c.DPFHCPBridges("tenant-a").Get(ctx, "prod")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prod.Labels).To(HaveKeyWithValue("env", "prod"))
+
+		_, err = c.DPFHCPBridges("tenant-c").Get(ctx, "prod")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should list objects of its namespace, or of all namespaces", func() {
+		list, err := c.DPFHCPBridges("tenant-a").List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(2))
+
+		list, err = c.DPFHCPBridges("tenant-a").List(ctx, crclient.MatchingLabels{"env": "prod"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(1))
+
+		list, err = c.DPFHCPBridges("").List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(3))
+	})
+
+	It("should access cluster-scoped ReleaseCatalogs", func() {
+		catalog, err := c.ReleaseCatalogs().Get(ctx, "stable")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(catalog.Name).To(Equal("stable"))
+	})
+
+	It("should create objects in its namespace and delete them by name", func() {
+		created := bridge("", "new", nil)
+		Expect(c.DPFHCPBridges("tenant-b").Create(ctx, created)).To(Succeed())
+		Expect(created.Namespace).To(Equal("tenant-b"))
+
+		Expect(c.DPFHCPBridges("tenant-b").Delete(ctx, "new")).To(Succeed())
+		_, err := c.DPFHCPBridges("tenant-b").Get(ctx, "new")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should update the spec and the status separately", func() {
+		bridges := c.DPFHCPBridges("tenant-a")
+		prod, err := bridges.Get(ctx, "prod")
+		Expect(err).NotTo(HaveOccurred())
+
+		prod.Status.Phase = provisioningv1alpha1.PhaseReady
+		Expect(bridges.UpdateStatus(ctx, prod)).To(Succeed())
+		prod.Spec.NodePoolReplicas = ptr.To[int32](3)
+		Expect(bridges.Update(ctx, prod)).To(Succeed())
+
+		prod, err = bridges.Get(ctx, "prod")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prod.Status.Phase).To(Equal(provisioningv1alpha1.PhaseReady))
+		Expect(prod.Spec.NodePoolReplicas).To(HaveValue(BeEquivalentTo(3)))
+	})
+
+	It("should watch objects of its namespace", func() {
+		watcher, err := c.DPFHCPBridges("tenant-b").Watch(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		defer watcher.Stop()
+
+		Expect(c.DPFHCPBridges("tenant-b").Create(ctx, bridge("", "watched", nil))).To(Succeed())
+		var event watch.Event
+		Eventually(watcher.ResultChan()).Should(Receive(&event))
+		Expect(event.Type).To(Equal(watch.Added))
+		Expect(event.Object.(*provisioningv1alpha1.DPFHCPBridge).Name).To(Equal("watched"))
+	})
+
+	It("should read objects through a lister", func() {
+		lister := NewLister[*provisioningv1alpha1.DPFHCPBridge, *provisioningv1alpha1.DPFHCPBridgeList](fakeClient, "tenant-b")
+
+		list, err := lister.List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(1))
+
+		prod, err := lister.Get(ctx, "prod")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prod.Namespace).To(Equal("tenant-b"))
+	})
+})
@@ -0,0 +1,105 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/rh-ecosystem-edge/dpf-hcp-bridge-operator/api/v1alpha1"
+)
+
+// Informers gives typed access to shared informers of the provisioning.dpu.hcp.io API.
+// Informers are started lazily, on first use, and stopped when the context passed to Start is done.
+type Informers struct {
+	cache cache.Cache
+}
+
+// NewInformers creates Informers for the cluster of the given REST config. opts can restrict the
+// namespaces or objects that are cached; its scheme defaults to NewScheme.
+func NewInformers(config *rest.Config, opts cache.Options) (*Informers, error) {
+	if opts.Scheme == nil {
+		opts.Scheme = NewScheme()
+	}
+	c, err := cache.New(config, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create provisioning.dpu.hcp.io informers: %w", err)
+	}
+	return NewInformersForCache(c), nil
+}
+
+// NewInformersForCache wraps an existing controller-runtime cache, e.g. the one of a manager
+func NewInformersForCache(c cache.Cache) *Informers {
+	return &Informers{cache: c}
+}
+
+// Start runs the informers until ctx is done. It blocks, so it is usually run in a goroutine.
+func (i *Informers) Start(ctx context.Context) error {
+	return i.cache.Start(ctx)
+}
+
+// WaitForCacheSync waits until all informers in use have synced; false means ctx was done first
+func (i *Informers) WaitForCacheSync(ctx context.Context) bool {
+	return i.cache.WaitForCacheSync(ctx)
+}
+
+// DPFHCPBridges returns the informer of the DPFHCPBridges
+func (i *Informers) DPFHCPBridges() *Informer[*provisioningv1alpha1.DPFHCPBridge, *provisioningv1alpha1.DPFHCPBridgeList] {
+	return &Informer[*provisioningv1alpha1.DPFHCPBridge, *provisioningv1alpha1.DPFHCPBridgeList]{cache: i.cache}
+}
+
+// BridgePools returns the informer of the BridgePools
+func (i *Informers) BridgePools() *Informer[*provisioningv1alpha1.BridgePool, *provisioningv1alpha1.BridgePoolList] {
+	return &Informer[*provisioningv1alpha1.BridgePool, *provisioningv1alpha1.BridgePoolList]{cache: i.cache}
+}
+
+// ReleaseCatalogs returns the informer of the ReleaseCatalogs
+func (i *Informers) ReleaseCatalogs() *Informer[*provisioningv1alpha1.ReleaseCatalog, *provisioningv1alpha1.ReleaseCatalogList] {
+	return &Informer[*provisioningv1alpha1.ReleaseCatalog, *provisioningv1alpha1.ReleaseCatalogList]{cache: i.cache}
+}
+
+// Informer is the shared informer of one kind of object
+type Informer[T crclient.Object, L crclient.ObjectList] struct {
+	cache cache.Cache
+}
+
+// AddEventHandler registers a handler notified of every add, update and delete of the kind
+func (i *Informer[T, L]) AddEventHandler(ctx context.Context, handler toolscache.ResourceEventHandler) error {
+	informer, err := i.cache.GetInformer(ctx, newObject[T]())
+	if err != nil {
+		return err
+	}
+	_, err = informer.AddEventHandler(handler)
+	return err
+}
+
+// Lister returns a lister reading the objects of a namespace from the informer; an empty namespace
+// lists the objects of all namespaces
+func (i *Informer[T, L]) Lister(namespace string) *Lister[T, L] {
+	return &Lister[T, L]{reader: i.cache, namespace: namespace}
+}
+
+// NewLister returns a lister reading objects of one kind from any controller-runtime reader, such as
+// the client of a manager
+func NewLister[T crclient.Object, L crclient.ObjectList](reader crclient.Reader, namespace string) *Lister[T, L] {
+	return &Lister[T, L]{reader: reader, namespace: namespace}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Resource is a typed client for one kind of object, scoped to a namespace for namespaced kinds
+type Resource[T crclient.Object, L crclient.ObjectList] struct {
+	client    crclient.WithWatch
+	namespace string
+}
+
+func newResource[T crclient.Object, L crclient.ObjectList](c crclient.WithWatch, namespace string) *Resource[T, L] {
+	return &Resource[T, L]{client: c, namespace: namespace}
+}
+
+// Get returns the object with the given name
+func (r *Resource[T, L]) Get(ctx context.Context, name string) (T, error) {
+	obj := newObject[T]()
+	if err := r.client.Get(ctx, types.NamespacedName{Name: name, Namespace: r.namespace}, obj); err != nil {
+		var zero T
+		return zero, err
+	}
+	return obj, nil
+}
+
+// List returns the objects of the namespace matching the options
+func (r *Resource[T, L]) List(ctx context.Context, opts ...crclient.ListOption) (L, error) {
+	list := newObject[L]()
+	if err := r.client.List(ctx, list, r.listOptions(opts)...); err != nil {
+		var zero L
+		return zero, err
+	}
+	return list, nil
+}
+
+// Watch watches the objects of the namespace matching the options
+func (r *Resource[T, L]) Watch(ctx context.Context, opts ...crclient.ListOption) (watch.Interface, error) {
+	return r.client.Watch(ctx, newObject[L](), r.listOptions(opts)...)
+}
+
+// Create creates obj, defaulting its namespace to the one of the client
+func (r *Resource[T, L]) Create(ctx context.Context, obj T, opts ...crclient.CreateOption) error {
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(r.namespace)
+	}
+	return r.client.Create(ctx, obj, opts...)
+}
+
+// Update updates the spec and metadata of obj
+func (r *Resource[T, L]) Update(ctx context.Context, obj T, opts ...crclient.UpdateOption) error {
+	return r.client.Update(ctx, obj, opts...)
+}
+
+// UpdateStatus updates the status of obj
+func (r *Resource[T, L]) UpdateStatus(ctx context.Context, obj T, opts ...crclient.SubResourceUpdateOption) error {
+	return r.client.Status().Update(ctx, obj, opts...)
+}
+
+// Patch patches the spec and metadata of obj
+func (r *Resource[T, L]) Patch(ctx context.Context, obj T, patch crclient.Patch, opts ...crclient.PatchOption) error {
+	return r.client.Patch(ctx, obj, patch, opts...)
+}
+
+// Delete deletes the object with the given name
+func (r *Resource[T, L]) Delete(ctx context.Context, name string, opts ...crclient.DeleteOption) error {
+	obj := newObject[T]()
+	obj.SetName(name)
+	obj.SetNamespace(r.namespace)
+	return r.client.Delete(ctx, obj, opts...)
+}
+
+// listOptions restricts the options to the namespace of the client
+func (r *Resource[T, L]) listOptions(opts []crclient.ListOption) []crclient.ListOption {
+	if r.namespace == "" {
+		return opts
+	}
+	return append([]crclient.ListOption{crclient.InNamespace(r.namespace)}, opts...)
+}
+
+// Lister is a typed, read-only view of one kind of object in an informer cache
+type Lister[T crclient.Object, L crclient.ObjectList] struct {
+	reader    crclient.Reader
+	namespace string
+}
+
+// Get returns the cached object with the given name
+func (l *Lister[T, L]) Get(ctx context.Context, name string) (T, error) {
+	obj := newObject[T]()
+	if err := l.reader.Get(ctx, types.NamespacedName{Name: name, Namespace: l.namespace}, obj); err != nil {
+		var zero T
+		return zero, err
+	}
+	return obj, nil
+}
+
+// List returns the cached objects of the namespace matching the options
+func (l *Lister[T, L]) List(ctx context.Context, opts ...crclient.ListOption) (L, error) {
+	list := newObject[L]()
+	if l.namespace != "" {
+		opts = append([]crclient.ListOption{crclient.InNamespace(l.namespace)}, opts...)
+	}
+	if err := l.reader.List(ctx, list, opts...); err != nil {
+		var zero L
+		return zero, err
+	}
+	return list, nil
+}
+
+// newObject allocates the object a pointer type such as *v1alpha1.DPFHCPBridge points to
+func newObject[T any]() T {
+	var zero T
+	return reflect.New(reflect.TypeOf(zero).Elem()).Interface().(T)
+}